@@ -39,6 +39,9 @@ type Chat struct {
 	// PlanID is the ID of the plan created from the chat as a final result.
 	PlanID string `firestore:"planId"`
 
+	// WeeklyBudget is the weekly food budget in yen the user asked to plan within, or 0 if unset.
+	WeeklyBudget int `firestore:"weeklyBudget,omitempty"`
+
 	// CreatedAt is the timestamp when the chat was created.
 	CreatedAt time.Time `firestore:"createdAt"`
 
@@ -0,0 +1,121 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package cookchatdb
+
+import "time"
+
+// CrawlJobType is the type of crawl requested by a crawl job.
+type CrawlJobType string
+
+const (
+	// CrawlJobTypeRecipe crawls a single recipe.
+	CrawlJobTypeRecipe CrawlJobType = "recipe"
+	// CrawlJobTypeCookpadUser crawls the recipes of a cookpad user.
+	CrawlJobTypeCookpadUser CrawlJobType = "cookpad_user"
+	// CrawlJobTypeSitemap crawls the recipes listed in a sitemap.
+	CrawlJobTypeSitemap CrawlJobType = "sitemap"
+	// CrawlJobTypeRecrawl crawls recipes again that were last crawled long ago.
+	CrawlJobTypeRecrawl CrawlJobType = "recrawl"
+)
+
+// CrawlJobItemStatus is the result of crawling an item in a crawl job.
+type CrawlJobItemStatus string
+
+const (
+	// CrawlJobItemStatusCrawled is an item that was crawled and saved.
+	CrawlJobItemStatusCrawled CrawlJobItemStatus = "crawled"
+	// CrawlJobItemStatusSkipped is an item that did not need to be crawled, for example
+	// because it was already crawled or has not changed since it was.
+	CrawlJobItemStatusSkipped CrawlJobItemStatus = "skipped"
+	// CrawlJobItemStatusFailed is an item that could not be crawled. The reason is in the
+	// item's Error.
+	CrawlJobItemStatusFailed CrawlJobItemStatus = "failed"
+)
+
+// MaxCrawlJobItems is the maximum number of items returned with a crawl job. Failed items
+// are returned in preference to others.
+const MaxCrawlJobItems = 1000
+
+// CrawlJobItem is the result of crawling an item, such as a recipe page or sitemap, in a
+// crawl job. Items are stored in the items subcollection of their job.
+type CrawlJobItem struct {
+	// URL is the URL of the item.
+	URL string `firestore:"url"`
+
+	// Status is the result of crawling the item.
+	Status CrawlJobItemStatus `firestore:"status"`
+
+	// RecipeID is the ID of the crawled recipe, if the item is a recipe that was crawled.
+	RecipeID string `firestore:"recipeId,omitempty"`
+
+	// Error is the error crawling the item, if it failed.
+	Error string `firestore:"error,omitempty"`
+}
+
+// CrawlJobStatus is the status of a crawl job.
+type CrawlJobStatus string
+
+const (
+	CrawlJobStatusRunning   CrawlJobStatus = "running"
+	CrawlJobStatusSucceeded CrawlJobStatus = "succeeded"
+	// CrawlJobStatusFailed is a crawl job that could not complete, for example because its
+	// sitemap could not be fetched. Jobs that complete with some recipes failing to crawl
+	// are still succeeded.
+	CrawlJobStatusFailed CrawlJobStatus = "failed"
+)
+
+// CrawlJob records a crawl requested from the crawler. Jobs are stored in the crawlJobs
+// collection.
+type CrawlJob struct {
+	// ID is the unique identifier of the job.
+	ID string `firestore:"id"`
+
+	// Type is the type of crawl.
+	Type CrawlJobType `firestore:"type"`
+
+	// URL is the URL requested to be crawled, such as a sitemap.
+	URL string `firestore:"url,omitempty"`
+
+	// CookpadUserID is the ID of the user requested to be crawled for cookpad user jobs.
+	CookpadUserID string `firestore:"cookpadUserId,omitempty"`
+
+	// Status is the status of the job.
+	Status CrawlJobStatus `firestore:"status"`
+
+	// Discovered is the number of recipes found to crawl.
+	Discovered int `firestore:"discovered"`
+
+	// Crawled is the number of items crawled successfully.
+	Crawled int `firestore:"crawled"`
+
+	// Skipped is the number of items that did not need to be crawled.
+	Skipped int `firestore:"skipped"`
+
+	// Failed is the number of items that failed to crawl.
+	Failed int `firestore:"failed"`
+
+	// Error is the error that failed the job.
+	Error string `firestore:"error,omitempty"`
+
+	// CreatedAt is the time the job was created.
+	CreatedAt time.Time `firestore:"createdAt"`
+
+	// UpdatedAt is the time progress of the job was last recorded. Running jobs record
+	// progress periodically so a job not updated for long stopped without finishing.
+	UpdatedAt time.Time `firestore:"updatedAt,omitempty"`
+
+	// FinishedAt is the time the job finished, or zero if it is still running.
+	FinishedAt time.Time `firestore:"finishedAt,omitempty"`
+}
+
+// RecrawlState is the state of re-crawling recipes kept between scheduled runs. It is stored
+// in the recrawl document of the crawlerState collection.
+type RecrawlState struct {
+	// BackfillCursor is the ID of the last recipe document checked for having been crawled
+	// before crawl state was recorded.
+	BackfillCursor string `firestore:"backfillCursor,omitempty"`
+
+	// BackfillDone is whether all recipe documents have been checked.
+	BackfillDone bool `firestore:"backfillDone,omitempty"`
+}
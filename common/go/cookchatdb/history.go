@@ -0,0 +1,34 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package cookchatdb
+
+import "time"
+
+// CookingHistoryEntry is a record of a user actually cooking a recipe or plan. Entries
+// are stored in the cookingHistory collection for a user.
+type CookingHistoryEntry struct {
+	// ID is the unique identifier of the entry.
+	ID string `firestore:"id"`
+
+	// RecipeIDs are the IDs of the recipes cooked.
+	RecipeIDs []string `firestore:"recipeIds"`
+
+	// PlanID is the ID of the plan cooked, if a plan was cooked rather than a single recipe.
+	PlanID string `firestore:"planId,omitempty"`
+
+	// CookedAt is the time the recipes were cooked.
+	CookedAt time.Time `firestore:"cookedAt"`
+
+	// Rating is the user's rating from 1 to 5, or 0 if not rated.
+	Rating int `firestore:"rating"`
+
+	// Servings is the number of servings cooked, or 0 if not provided.
+	Servings int `firestore:"servings"`
+
+	// Notes are free-form notes from the user.
+	Notes string `firestore:"notes"`
+
+	// CreatedAt is the time the entry was created.
+	CreatedAt time.Time `firestore:"createdAt"`
+}
@@ -0,0 +1,82 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package cookchatdb
+
+import "time"
+
+type HouseholdRole string
+
+const (
+	// HouseholdRoleOwner can manage members and invitations and edit shared data.
+	HouseholdRoleOwner HouseholdRole = "owner"
+	// HouseholdRoleEditor can edit shared data such as plans.
+	HouseholdRoleEditor HouseholdRole = "editor"
+	// HouseholdRoleViewer can only view shared data.
+	HouseholdRoleViewer HouseholdRole = "viewer"
+)
+
+// User is the profile of a user. Users are stored in the users collection
+// with the Firebase UID as the ID.
+type User struct {
+	// HouseholdID is the ID of the household the user belongs to, if any.
+	HouseholdID string `firestore:"householdId,omitempty"`
+
+	// TimeZone is the IANA time zone of the user, e.g. Asia/Tokyo, used to compute the
+	// calendar dates of plans.
+	TimeZone string `firestore:"timeZone,omitempty"`
+}
+
+// Household is a group of users sharing plans, bookmarks, and other data. Shared data is
+// stored in collections under the household instead of under each user.
+type Household struct {
+	// ID is the unique identifier of the household.
+	ID string `firestore:"id"`
+
+	// Name is the display name of the household.
+	Name string `firestore:"name"`
+
+	// OwnerID is the ID of the user that owns the household.
+	OwnerID string `firestore:"ownerId"`
+
+	// CreatedAt is the time the household was created.
+	CreatedAt time.Time `firestore:"createdAt"`
+}
+
+// HouseholdMember is a member of a household. Members are stored in the members
+// collection of a household with the user ID as the ID.
+type HouseholdMember struct {
+	// UserID is the ID of the member.
+	UserID string `firestore:"userId"`
+
+	// Role is the role of the member.
+	Role HouseholdRole `firestore:"role"`
+
+	// JoinedAt is the time the member joined the household.
+	JoinedAt time.Time `firestore:"joinedAt"`
+}
+
+// HouseholdInvitation is an invitation to join a household. Invitations are stored in the
+// householdInvitations collection so they can be looked up by ID alone.
+type HouseholdInvitation struct {
+	// ID is the unique identifier of the invitation, shared with the invitee.
+	ID string `firestore:"id"`
+
+	// HouseholdID is the ID of the household to join.
+	HouseholdID string `firestore:"householdId"`
+
+	// Role is the role the invitee is given when accepting.
+	Role HouseholdRole `firestore:"role"`
+
+	// CreatedBy is the ID of the user that created the invitation.
+	CreatedBy string `firestore:"createdBy"`
+
+	// CreatedAt is the time the invitation was created.
+	CreatedAt time.Time `firestore:"createdAt"`
+
+	// ExpiresAt is the time after which the invitation can no longer be accepted.
+	ExpiresAt time.Time `firestore:"expiresAt"`
+
+	// AcceptedBy is the ID of the user that accepted the invitation, if accepted.
+	AcceptedBy string `firestore:"acceptedBy,omitempty"`
+}
@@ -3,7 +3,11 @@
 
 package cookchatdb
 
-import "time"
+import (
+	"time"
+
+	"cloud.google.com/go/firestore"
+)
 
 // A group of steps to execute together.
 type StepGroup struct {
@@ -17,13 +21,88 @@ type StepGroup struct {
 	Note string `firestore:"note"`
 }
 
+// PlanTrashRetention is how long trashed plans are kept before being purged.
+const PlanTrashRetention = 30 * 24 * time.Hour
+
 type PlanStatus string
 
 const (
 	PlanStatusProcessing PlanStatus = "processing"
 	PlanStatusActive     PlanStatus = "active"
+	// PlanStatusFailed is a plan that could not be processed. It can be processed again
+	// with a retry.
+	PlanStatusFailed PlanStatus = "failed"
+)
+
+// ProgressStage is a stage of filling a plan.
+type ProgressStage string
+
+const (
+	// ProgressStageTranslate is translating a recipe to other languages.
+	ProgressStageTranslate ProgressStage = "translate"
+	// ProgressStageRewrite is rewriting a recipe for each language.
+	ProgressStageRewrite ProgressStage = "rewrite"
+	// ProgressStageImage is generating the main image of a recipe.
+	ProgressStageImage ProgressStage = "image"
+	// ProgressStageStepImages is generating the images of the steps of a recipe.
+	ProgressStageStepImages ProgressStage = "stepImages"
+	// ProgressStageExecutionPlan is generating the execution plan of the plan.
+	ProgressStageExecutionPlan ProgressStage = "executionPlan"
+)
+
+// StageProgress is the progress of a stage of filling a plan.
+type StageProgress struct {
+	// Stage is the stage.
+	Stage ProgressStage `firestore:"stage"`
+
+	// RecipeID is the ID of the recipe the stage processes, or empty for stages of the
+	// whole plan.
+	RecipeID string `firestore:"recipeId,omitempty"`
+
+	// Done is the number of completed items of the stage, such as generated images.
+	Done int `firestore:"done"`
+
+	// Total is the number of items of the stage.
+	Total int `firestore:"total"`
+
+	// StartedAt is the time the stage started.
+	StartedAt time.Time `firestore:"startedAt"`
+
+	// CompletedAt is the time all items of the stage were completed.
+	CompletedAt time.Time `firestore:"completedAt,omitempty"`
+}
+
+type PlanType string
+
+const (
+	// PlanTypeDaily is a plan cooking the meal for the day it is scheduled.
+	PlanTypeDaily PlanType = "daily"
+	// PlanTypeBatchPrep is a plan for a batch-cooking (作り置き) session that cooks
+	// several dishes to be eaten on later days.
+	PlanTypeBatchPrep PlanType = "batch_prep"
+	// PlanTypeLeftovers is a plan that eats dishes cooked in a previous batch-prep plan
+	// instead of cooking new ones.
+	PlanTypeLeftovers PlanType = "leftovers"
 )
 
+// BatchDish is a dish cooked in a batch-prep plan to be eaten on later days.
+type BatchDish struct {
+	// RecipeID is the ID of the recipe for the dish.
+	RecipeID string `firestore:"recipeId" json:"recipeId"`
+
+	// Servings is the number of servings to cook.
+	Servings int `firestore:"servings" json:"servings"`
+
+	// Storage is how to store the dish, e.g. refrigerator or freezer.
+	Storage string `firestore:"storage" json:"storage"`
+
+	// StorageDays is the number of days the dish keeps when stored.
+	StorageDays int `firestore:"storageDays" json:"storageDays"`
+
+	// ReheatingNotes are instructions for reheating the dish before eating.
+	ReheatingNotes string `firestore:"reheatingNotes" json:"reheatingNotes"`
+}
+
 // Plan is the plan for a single day. Plans are stored in the
 // plans collection for a user, with the ID YYYY-mm-dd.
 type Plan struct {
@@ -39,12 +118,72 @@ type Plan struct {
 	// Any notes about the plan.
 	Notes []string `firestore:"notes"`
 
-	// The time the plan was scheduled.
+	// ServingSizes are serving sizes set by the user to show instead of those of the
+	// recipes, keyed by recipe ID.
+	ServingSizes map[string]string `firestore:"servingSizes,omitempty"`
+
+	// The time the plan was scheduled, the start of Date in TimeZone.
 	ScheduledAt time.Time `firestore:"scheduledAt"`
 
+	// Date is the local calendar date of the plan in YYYY-MM-DD format.
+	Date string `firestore:"date,omitempty"`
+
+	// TimeZone is the IANA time zone Date is in.
+	TimeZone string `firestore:"timeZone,omitempty"`
+
 	// The time the plan was created.
 	CreatedAt time.Time `firestore:"createdAt"`
 
 	// Status of the plan.
 	Status PlanStatus `firestore:"status"`
+
+	// Type of the plan. Plans without a type are daily plans.
+	Type PlanType `firestore:"type,omitempty"`
+
+	// BatchDishes are the dishes cooked in a batch-prep plan.
+	BatchDishes []BatchDish `firestore:"batchDishes,omitempty"`
+
+	// BatchPlanID is the ID of the batch-prep plan whose dishes are eaten in a leftovers plan.
+	BatchPlanID string `firestore:"batchPlanId,omitempty"`
+
+	// FailureReason is the error from the last failed attempt to process the plan.
+	FailureReason string `firestore:"failureReason,omitempty"`
+
+	// Attempts is the number of failed attempts to process the plan since it was created or
+	// last retried.
+	Attempts int `firestore:"attempts,omitempty"`
+
+	// ProcessingStartedAt is the time the plan was last retried. If zero, processing started
+	// at CreatedAt.
+	ProcessingStartedAt time.Time `firestore:"processingStartedAt,omitempty"`
+
+	// Progress is the progress of the stages of filling the plan, keyed by recipe ID and
+	// stage.
+	Progress map[string]StageProgress `firestore:"progress,omitempty"`
+
+	// DeletedAt is the time the plan was moved to the trash, or zero if it is not trashed.
+	// Trashed plans are purged after PlanTrashRetention.
+	DeletedAt time.Time `firestore:"deletedAt,omitempty"`
+}
+
+// PlanShareLink gives anyone with the link read-only access to a plan. Links are stored in
+// the planShareLinks collection so they can be looked up by ID alone.
+type PlanShareLink struct {
+	// ID is the unique identifier of the link, used as the token in the link.
+	ID string `firestore:"id"`
+
+	// Plan is the shared plan.
+	Plan *firestore.DocumentRef `firestore:"plan"`
+
+	// CreatedBy is the ID of the user that created the link.
+	CreatedBy string `firestore:"createdBy"`
+
+	// CreatedAt is the time the link was created.
+	CreatedAt time.Time `firestore:"createdAt"`
+
+	// ExpiresAt is the time after which the link can no longer be used.
+	ExpiresAt time.Time `firestore:"expiresAt"`
+
+	// RevokedAt is the time the link was revoked, or zero if it is not revoked.
+	RevokedAt time.Time `firestore:"revokedAt,omitempty"`
 }
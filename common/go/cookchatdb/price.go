@@ -0,0 +1,23 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package cookchatdb
+
+import "time"
+
+// IngredientPrice is a user-provided price for an ingredient, overriding the bundled
+// default price for the same ingredient and unit. Prices are stored in the
+// ingredientPrices collection alongside plans.
+type IngredientPrice struct {
+	// Name is the name of the ingredient.
+	Name string `firestore:"name"`
+
+	// Unit is the unit the price is for, e.g. g, ml, or 個.
+	Unit string `firestore:"unit"`
+
+	// Yen is the price in yen of one unit of the ingredient.
+	Yen float64 `firestore:"yen"`
+
+	// UpdatedAt is the time the price was last set.
+	UpdatedAt time.Time `firestore:"updatedAt"`
+}
@@ -18,6 +18,8 @@ const (
 	RecipeSourceOrangePage RecipeSource = "orangepage"
 	// RecipeSourceDelishKitchen is the source for recipes from DelishKitchen.
 	RecipeSourceDelishKitchen RecipeSource = "delishkitchen"
+	// RecipeSourceWeb is the source for recipes from other sites publishing schema.org recipes.
+	RecipeSourceWeb RecipeSource = "web"
 	// RecipeSourceUser is the source for user-submitted recipes.
 	RecipeSourceUser RecipeSource = "user"
 	// RecipeSourceAI is the source for AI-generated recipes.
@@ -45,6 +47,14 @@ const (
 	RecipeTypeSoup     RecipeType = "soup"
 )
 
+// AllRecipeTypes is all the RecipeType values a recipe can be classified as.
+var AllRecipeTypes = []RecipeType{
+	RecipeTypeUnknown,
+	RecipeTypeMainDish,
+	RecipeTypeSideDish,
+	RecipeTypeSoup,
+}
+
 type RecipeGenre string
 
 const (
@@ -57,6 +67,17 @@ const (
 	RecipeGenreEthnic   RecipeGenre = "ethnic"
 )
 
+// AllRecipeGenres is all the RecipeGenre values a recipe can be classified as.
+var AllRecipeGenres = []RecipeGenre{
+	RecipeGenreUnknown,
+	RecipeGenreJapanese,
+	RecipeGenreChinese,
+	RecipeGenreWestern,
+	RecipeGenreKorean,
+	RecipeGenreItalian,
+	RecipeGenreEthnic,
+}
+
 // RecipeIngredient represents an ingredient in a recipe.
 type RecipeIngredient struct {
 	// Name is the name of the ingredient.
@@ -73,6 +94,14 @@ type RecipeStep struct {
 
 	// ImageURL is the URL of an image of the step.
 	ImageURL string `firestore:"imageUrl" json:"imageUrl"`
+
+	// ActiveMinutes is the estimated hands-on time of the step in minutes.
+	// Only populated for steps in the step groups of a plan.
+	ActiveMinutes int `firestore:"activeMinutes,omitempty" json:"activeMinutes,omitempty"`
+
+	// WaitMinutes is the estimated passive time after the step in minutes, such as
+	// simmering or marinating. Only populated for steps in the step groups of a plan.
+	WaitMinutes int `firestore:"waitMinutes,omitempty" json:"waitMinutes,omitempty"`
 }
 
 // IngredientSection represents a section of ingredients in a recipe.
@@ -121,6 +150,9 @@ type RecipeStatus string
 const (
 	RecipeStatusProcessing RecipeStatus = "processing"
 	RecipeStatusActive     RecipeStatus = "active"
+	// RecipeStatusFailed is a recipe that could not be processed. It is processed again when
+	// a plan using it is retried.
+	RecipeStatusFailed RecipeStatus = "failed"
 )
 
 // Recipe represents a recipe stored in Firestore.
@@ -156,6 +188,10 @@ type Recipe struct {
 	// StepImageURLs are URLs for images of the steps in the recipe.
 	StepImageURLs []string `firestore:"stepImageUrls"`
 
+	// TotalMinutes is the total time to make the recipe in minutes as published by its
+	// source, or zero if unknown.
+	TotalMinutes int `firestore:"totalMinutes,omitempty"`
+
 	// LanguageCode is the source language code of the recipe.
 	// For example, "en" for English, "ja" for Japanese.
 	LanguageCode string `firestore:"languageCode"`
@@ -165,6 +201,37 @@ type Recipe struct {
 
 	// LocalizedContent contains localized content for the recipe.
 	LocalizedContent map[string]*RecipeContent `firestore:"localizedContent,omitempty"`
+
+	// FailureReason is the error from the last failed attempt to process the recipe.
+	FailureReason string `firestore:"failureReason,omitempty"`
+
+	// Attempts is the number of failed attempts to process the recipe since it was created or
+	// last retried.
+	Attempts int `firestore:"attempts,omitempty"`
+
+	// ProcessingStartedAt is the time processing of the recipe last started.
+	ProcessingStartedAt time.Time `firestore:"processingStartedAt,omitempty"`
+
+	// Crawl is the state of the source page of the recipe when it was last crawled, unset
+	// for recipes not from a crawled site or crawled before the state was recorded.
+	Crawl *RecipeCrawl `firestore:"crawl,omitempty"`
+}
+
+// RecipeCrawl is the state of the source page of a crawled recipe, to detect whether the page
+// has changed when crawling it again.
+type RecipeCrawl struct {
+	// ContentHash is the hex-encoded SHA-256 hash of the content of the recipe as extracted
+	// from the page, before any post-processing.
+	ContentHash string `firestore:"contentHash"`
+
+	// ETag is the ETag header of the page, if any.
+	ETag string `firestore:"etag,omitempty"`
+
+	// LastModified is the Last-Modified header of the page, if any.
+	LastModified string `firestore:"lastModified,omitempty"`
+
+	// CrawledAt is the time the page was last crawled, whether or not it had changed.
+	CrawledAt time.Time `firestore:"crawledAt"`
 }
 
 // RecipeBookmark is a bookmarked recipe.
@@ -0,0 +1,50 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package cookchatdb
+
+import "time"
+
+// ShoppingListIngredient is an ingredient to buy in a shopping list entry.
+type ShoppingListIngredient struct {
+	// Name is the name of the ingredient.
+	Name string `firestore:"name"`
+
+	// Quantity is the quantity of the ingredient, e.g. 200g.
+	Quantity string `firestore:"quantity"`
+
+	// Selected is whether the ingredient has been bought.
+	Selected bool `firestore:"selected"`
+}
+
+// ShoppingListEntry is an entry of the shopping list, either the ingredients of a recipe or an
+// extra item added by hand. Entries are stored in the shoppingList collection alongside plans.
+type ShoppingListEntry struct {
+	// RecipeID is the ID of the recipe the ingredients are for, empty for an extra item.
+	RecipeID string `firestore:"recipeId"`
+
+	// Title is the title of the recipe, or the name of an extra item.
+	Title string `firestore:"title"`
+
+	// ServingSize is the serving size of the recipe the ingredients are for.
+	ServingSize string `firestore:"servingSize"`
+
+	// Ingredients are the ingredients to buy for the recipe.
+	Ingredients []ShoppingListIngredient `firestore:"ingredients"`
+
+	// AddedAt is the time the entry was added.
+	AddedAt time.Time `firestore:"addedAt"`
+}
+
+// PantryItem is an ingredient kept in the pantry. Items are stored in the pantry collection
+// alongside plans.
+type PantryItem struct {
+	// Name is the name of the ingredient.
+	Name string `firestore:"name"`
+
+	// Quantity is the quantity of the ingredient on hand, e.g. 200g. Empty if unknown.
+	Quantity string `firestore:"quantity"`
+
+	// UpdatedAt is the time the item was last set.
+	UpdatedAt time.Time `firestore:"updatedAt"`
+}
@@ -0,0 +1,35 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package cookchatdb
+
+import "time"
+
+// PlanTemplateSlot is the recipes to cook on a day of the week in a plan template.
+type PlanTemplateSlot struct {
+	// DayOfWeek is the day of the week of the slot.
+	DayOfWeek time.Weekday `firestore:"dayOfWeek"`
+
+	// Recipes are the IDs of the recipes to cook on the day.
+	Recipes []string `firestore:"recipes"`
+}
+
+// PlanTemplate is a recurring weekly rotation of recipes that can be applied to
+// create plans for a week. Templates are stored in the planTemplates collection
+// alongside plans.
+type PlanTemplate struct {
+	// ID is the unique identifier of the template.
+	ID string `firestore:"id"`
+
+	// Name is the name of the template.
+	Name string `firestore:"name"`
+
+	// Slots are the days of the week the template has recipes for.
+	Slots []PlanTemplateSlot `firestore:"slots"`
+
+	// CreatedAt is the time the template was created.
+	CreatedAt time.Time `firestore:"createdAt"`
+
+	// UpdatedAt is the time the template was last saved.
+	UpdatedAt time.Time `firestore:"updatedAt"`
+}
@@ -5,11 +5,17 @@ package file
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"cloud.google.com/go/storage"
+	"google.golang.org/api/googleapi"
 )
 
+// ErrExists is returned by CreateFile when the file already exists.
+var ErrExists = errors.New("file: file already exists")
+
 type IO struct {
 	storage *storage.Client
 	bucket  string
@@ -23,14 +29,35 @@ func NewIO(storage *storage.Client, bucket string) *IO {
 }
 
 func (io *IO) WriteFile(ctx context.Context, path string, contentType string, data []byte) (string, error) {
-	wc := io.storage.Bucket(io.bucket).Object(path).NewWriter(ctx)
-	defer func() {
-		_ = wc.Close()
-	}()
+	return io.write(ctx, io.storage.Bucket(io.bucket).Object(path), contentType, data)
+}
+
+// CreateFile writes the file at path only if it doesn't exist, returning ErrExists if it does.
+// The check is part of the write, so no separate request is needed.
+func (io *IO) CreateFile(ctx context.Context, path string, contentType string, data []byte) (string, error) {
+	obj := io.storage.Bucket(io.bucket).Object(path).If(storage.Conditions{DoesNotExist: true})
+	url, err := io.write(ctx, obj, contentType, data)
+	if gerr := (*googleapi.Error)(nil); errors.As(err, &gerr) && gerr.Code == http.StatusPreconditionFailed {
+		return "", ErrExists
+	}
+	return url, err
+}
+
+func (io *IO) write(ctx context.Context, obj *storage.ObjectHandle, contentType string, data []byte) (string, error) {
+	wc := obj.NewWriter(ctx)
 	wc.ContentType = contentType
 	if _, err := wc.Write(data); err != nil {
+		_ = wc.Close()
 		return "", fmt.Errorf("file: writing file: %w", err)
 	}
-	url := fmt.Sprintf("https://storage.googleapis.com/%s/%s", io.bucket, path)
-	return url, nil
+	// The upload only completes on close, so its errors are returned from there.
+	if err := wc.Close(); err != nil {
+		return "", fmt.Errorf("file: closing file: %w", err)
+	}
+	return io.URL(obj.ObjectName()), nil
+}
+
+// URL returns the public URL of the file at path.
+func (io *IO) URL(path string) string {
+	return fmt.Sprintf("https://storage.googleapis.com/%s/%s", io.bucket, path)
 }
@@ -6,7 +6,10 @@ require (
 	cloud.google.com/go/firestore v1.24.0
 	cloud.google.com/go/storage v1.64.0
 	golang.org/x/sync v0.22.0
+	golang.org/x/text v0.40.0
+	google.golang.org/api v0.291.0
 	google.golang.org/genai v1.66.0
+	google.golang.org/grpc v1.83.0
 )
 
 require (
@@ -50,12 +53,9 @@ require (
 	golang.org/x/net v0.57.0 // indirect
 	golang.org/x/oauth2 v0.36.0 // indirect
 	golang.org/x/sys v0.47.0 // indirect
-	golang.org/x/text v0.40.0 // indirect
 	golang.org/x/time v0.15.0 // indirect
-	google.golang.org/api v0.291.0 // indirect
 	google.golang.org/genproto v0.0.0-20260519071638-aa98bba5eb94 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20260630182238-925bb5da69e7 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20260724162435-b2f20204f0df // indirect
-	google.golang.org/grpc v1.83.0 // indirect
 	google.golang.org/protobuf v1.36.11 // indirect
 )
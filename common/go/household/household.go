@@ -0,0 +1,234 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package household
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"cloud.google.com/go/firestore"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+// copyConcurrency is the number of documents copied at the same time by CopyPersonal.
+const copyConcurrency = 10
+
+// ErrReadOnly is returned when the role of the user in their household does not allow
+// modifying the household's data.
+var ErrReadOnly = errors.New("household role cannot edit household data")
+
+// Scope is where data shared within a household, such as plans, bookmarks, plan templates,
+// ingredient prices, the shopping list, and the pantry, is stored for a user. For users in a
+// household, this is the household document, otherwise it is the user's own document.
+type Scope struct {
+	// Doc is the document containing the shared collections.
+	Doc *firestore.DocumentRef
+
+	// HouseholdID is the ID of the household of the user, or empty if the user is not in one.
+	HouseholdID string
+
+	// Role is the role of the user within the scope. Users are always owners of their own scope.
+	Role cookchatdb.HouseholdRole
+}
+
+// Resolve returns the scope for the user.
+func Resolve(ctx context.Context, store *firestore.Client, userID string) (Scope, error) {
+	userDoc := store.Collection("users").Doc(userID)
+	personal := Scope{
+		Doc:  userDoc,
+		Role: cookchatdb.HouseholdRoleOwner,
+	}
+
+	doc, err := userDoc.Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return personal, nil
+		}
+		return Scope{}, fmt.Errorf("household: fetching user: %w", err)
+	}
+	var user cookchatdb.User
+	if err := doc.DataTo(&user); err != nil {
+		return Scope{}, fmt.Errorf("household: decoding user: %w", err)
+	}
+	if user.HouseholdID == "" {
+		return personal, nil
+	}
+
+	householdDoc := store.Collection("households").Doc(user.HouseholdID)
+	memberDoc, err := householdDoc.Collection("members").Doc(userID).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			// Stale pointer from a removed membership, fall back to personal data.
+			return personal, nil
+		}
+		return Scope{}, fmt.Errorf("household: fetching household member: %w", err)
+	}
+	var member cookchatdb.HouseholdMember
+	if err := memberDoc.DataTo(&member); err != nil {
+		return Scope{}, fmt.Errorf("household: decoding household member: %w", err)
+	}
+
+	return Scope{
+		Doc:         householdDoc,
+		HouseholdID: user.HouseholdID,
+		Role:        member.Role,
+	}, nil
+}
+
+// Plans returns the plans collection of the scope.
+func (s Scope) Plans() *firestore.CollectionRef {
+	return s.Doc.Collection("plans")
+}
+
+// Bookmarks returns the bookmarks collection of the scope.
+func (s Scope) Bookmarks() *firestore.CollectionRef {
+	return s.Doc.Collection("bookmarks")
+}
+
+// PlanTemplates returns the plan templates collection of the scope.
+func (s Scope) PlanTemplates() *firestore.CollectionRef {
+	return s.Doc.Collection("planTemplates")
+}
+
+// IngredientPrices returns the ingredient prices collection of the scope.
+func (s Scope) IngredientPrices() *firestore.CollectionRef {
+	return s.Doc.Collection("ingredientPrices")
+}
+
+// ShoppingList returns the shopping list collection of the scope.
+func (s Scope) ShoppingList() *firestore.CollectionRef {
+	return s.Doc.Collection("shoppingList")
+}
+
+// Pantry returns the pantry collection of the scope.
+func (s Scope) Pantry() *firestore.CollectionRef {
+	return s.Doc.Collection("pantry")
+}
+
+// CanEdit returns whether the user can modify data in the scope.
+func (s Scope) CanEdit() bool {
+	return s.Role == cookchatdb.HouseholdRoleOwner || s.Role == cookchatdb.HouseholdRoleEditor
+}
+
+// Conflict is a document of personal data that already exists in the household with different
+// values for some of its fields.
+type Conflict struct {
+	// Path is the path of the document in the household.
+	Path string
+
+	// Fields are the fields whose household values were kept instead of the personal values.
+	Fields []string
+}
+
+// CopyPersonal copies the personal data of the user, such as plans and bookmarks, into the
+// household so it is still available after joining it. It must only be called once the user is
+// a member of the household with a role that can edit its data. The personal data itself is
+// kept, for use again if the user leaves the household.
+//
+// Documents that already exist in the household, for example a bookmark of the same recipe, are
+// merged field by field. Fields missing in the household are copied, while fields the household
+// already has are kept and returned as conflicts if their values differ. Copying again is safe.
+func CopyPersonal(ctx context.Context, store *firestore.Client, userID string, householdID string) ([]Conflict, error) {
+	personal := Scope{Doc: store.Collection("users").Doc(userID)}
+	shared := Scope{Doc: store.Collection("households").Doc(householdID)}
+
+	var mu sync.Mutex
+	var conflicts []Conflict
+
+	var grp errgroup.Group
+	grp.SetLimit(copyConcurrency)
+	for _, collection := range []func(Scope) *firestore.CollectionRef{
+		Scope.Plans,
+		Scope.Bookmarks,
+		Scope.PlanTemplates,
+		Scope.IngredientPrices,
+		Scope.ShoppingList,
+		Scope.Pantry,
+	} {
+		iter := collection(personal).Documents(ctx)
+		for {
+			doc, err := iter.Next()
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			if err != nil {
+				iter.Stop()
+				_ = grp.Wait()
+				return nil, fmt.Errorf("household: fetching personal data: %w", err)
+			}
+			grp.Go(func() error {
+				dst := collection(shared).Doc(doc.Ref.ID)
+				fields, err := copyDoc(ctx, store, doc, dst)
+				if err != nil {
+					return fmt.Errorf("household: copying %s: %w", doc.Ref.Path, err)
+				}
+				if len(fields) > 0 {
+					mu.Lock()
+					conflicts = append(conflicts, Conflict{Path: dst.Path, Fields: fields})
+					mu.Unlock()
+				}
+				return nil
+			})
+		}
+		iter.Stop()
+	}
+	if err := grp.Wait(); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(conflicts, func(a, b Conflict) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return conflicts, nil
+}
+
+// copyDoc copies src to dst, merging it into dst if it already exists. The fields with values
+// that differ between them are returned.
+func copyDoc(ctx context.Context, store *firestore.Client, src *firestore.DocumentSnapshot, dst *firestore.DocumentRef) ([]string, error) {
+	var conflicts []string
+	err := store.RunTransaction(ctx, func(_ context.Context, t *firestore.Transaction) error {
+		existing, err := t.Get(dst)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				conflicts = nil
+				return t.Create(dst, src.Data())
+			}
+			return err
+		}
+		var missing map[string]any
+		missing, conflicts = mergeFields(existing.Data(), src.Data())
+		if len(missing) == 0 {
+			return nil
+		}
+		return t.Set(dst, missing, firestore.MergeAll)
+	})
+	return conflicts, err
+}
+
+// mergeFields returns the fields of personal that are missing in existing, and the names of the
+// fields present in both with different values, sorted.
+func mergeFields(existing map[string]any, personal map[string]any) (map[string]any, []string) {
+	missing := map[string]any{}
+	var conflicts []string
+	for k, v := range personal {
+		ev, ok := existing[k]
+		switch {
+		case !ok:
+			missing[k] = v
+		case !reflect.DeepEqual(ev, v):
+			conflicts = append(conflicts, k)
+		}
+	}
+	slices.Sort(conflicts)
+	return missing, conflicts
+}
@@ -0,0 +1,62 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package household
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestMergeFields(t *testing.T) {
+	created := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		existing      map[string]any
+		personal      map[string]any
+		wantMissing   map[string]any
+		wantConflicts []string
+	}{
+		{
+			name:        "same values",
+			existing:    map[string]any{"recipeId": "a", "createdAt": created},
+			personal:    map[string]any{"recipeId": "a", "createdAt": created},
+			wantMissing: map[string]any{},
+		},
+		{
+			name:        "missing fields copied",
+			existing:    map[string]any{"recipeId": "a"},
+			personal:    map[string]any{"recipeId": "a", "createdAt": created},
+			wantMissing: map[string]any{"createdAt": created},
+		},
+		{
+			name:          "conflicting fields kept",
+			existing:      map[string]any{"name": "egg", "yen": 30.0, "updatedAt": created},
+			personal:      map[string]any{"name": "egg", "yen": 25.0, "updatedAt": created.AddDate(0, 0, 1)},
+			wantMissing:   map[string]any{},
+			wantConflicts: []string{"updatedAt", "yen"},
+		},
+		{
+			name:          "nested values compared",
+			existing:      map[string]any{"slots": []any{map[string]any{"dayOfWeek": int64(1)}}},
+			personal:      map[string]any{"slots": []any{map[string]any{"dayOfWeek": int64(2)}}, "name": "weekdays"},
+			wantMissing:   map[string]any{"name": "weekdays"},
+			wantConflicts: []string{"slots"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			missing, conflicts := mergeFields(tc.existing, tc.personal)
+			if !reflect.DeepEqual(missing, tc.wantMissing) {
+				t.Errorf("got missing %v, want %v", missing, tc.wantMissing)
+			}
+			if !slices.Equal(conflicts, tc.wantConflicts) {
+				t.Errorf("got conflicts %v, want %v", conflicts, tc.wantConflicts)
+			}
+		})
+	}
+}
@@ -4,19 +4,26 @@
 package image
 
 import (
-	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
-	"image/jpeg"
-	"image/png"
 
 	"google.golang.org/genai"
 
 	"github.com/curioswitch/cookchat/common/file"
 )
 
+// files stores files, implemented by *file.IO.
+type files interface {
+	WriteFile(ctx context.Context, path string, contentType string, data []byte) (string, error)
+	CreateFile(ctx context.Context, path string, contentType string, data []byte) (string, error)
+	URL(path string) string
+}
+
 type Writer struct {
-	io *file.IO
+	io files
 }
 
 func NewWriter(io *file.IO) *Writer {
@@ -26,21 +33,12 @@ func NewWriter(io *file.IO) *Writer {
 }
 
 func (w *Writer) WriteGenAIImage(ctx context.Context, path string, blob *genai.Blob) (string, error) {
-	var image []byte
-	if blob.MIMEType == "image/png" {
-		img, err := png.Decode(bytes.NewReader(blob.Data))
-		if err != nil {
-			return "", fmt.Errorf("image: decoding png image: %w", err)
-		}
-		var buf bytes.Buffer
-		if err := jpeg.Encode(&buf, img, nil); err != nil {
-			return "", fmt.Errorf("image: encoding png to jpeg: %w", err)
-		}
-		image = buf.Bytes()
-	} else if blob.MIMEType != "image/jpeg" {
+	if blob.MIMEType != "image/png" && blob.MIMEType != "image/jpeg" {
 		return "", fmt.Errorf("image: unsupported mime type %s", blob.MIMEType)
-	} else {
-		image = blob.Data
+	}
+	image, err := Normalize(blob.Data)
+	if err != nil {
+		return "", err
 	}
 
 	url, err := w.io.WriteFile(ctx, path, "image/jpeg", image)
@@ -49,3 +47,24 @@ func (w *Writer) WriteGenAIImage(ctx context.Context, path string, blob *genai.B
 	}
 	return url, nil
 }
+
+// WriteImage normalizes the image in data to JPEG and writes it, returning its URL. Images are
+// stored by the hash of the normalized image, so identical images, such as ones shared by
+// several recipes, are only stored once even if served in different formats.
+func (w *Writer) WriteImage(ctx context.Context, data []byte) (string, error) {
+	image, err := Normalize(data)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(image)
+	path := fmt.Sprintf("images/%s.jpg", hex.EncodeToString(sum[:]))
+	url, err := w.io.CreateFile(ctx, path, "image/jpeg", image)
+	if errors.Is(err, file.ErrExists) {
+		return w.io.URL(path), nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("image: writing image to file io: %w", err)
+	}
+	return url, nil
+}
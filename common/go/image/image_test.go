@@ -0,0 +1,89 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package image
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/curioswitch/cookchat/common/file"
+)
+
+type fakeFiles struct {
+	files  map[string][]byte
+	writes int
+}
+
+func (f *fakeFiles) WriteFile(_ context.Context, path string, _ string, data []byte) (string, error) {
+	f.writes++
+	f.files[path] = data
+	return f.URL(path), nil
+}
+
+func (f *fakeFiles) CreateFile(ctx context.Context, path string, contentType string, data []byte) (string, error) {
+	if _, ok := f.files[path]; ok {
+		return "", file.ErrExists
+	}
+	return f.WriteFile(ctx, path, contentType, data)
+}
+
+func (f *fakeFiles) URL(path string) string {
+	return "https://files/" + path
+}
+
+func TestWriteImage(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	img.Set(1, 1, color.NRGBA{G: 255, A: 255})
+	encode := func(level png.CompressionLevel) []byte {
+		var buf bytes.Buffer
+		if err := (&png.Encoder{CompressionLevel: level}).Encode(&buf, img); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+	data := encode(png.NoCompression)
+	// The same image encoded differently.
+	recompressed := encode(png.BestCompression)
+	if bytes.Equal(data, recompressed) {
+		t.Fatal("encodings are identical")
+	}
+
+	files := &fakeFiles{files: map[string][]byte{}}
+	w := &Writer{io: files}
+
+	url, err := w.WriteImage(t.Context(), data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if files.writes != 1 {
+		t.Fatalf("got %d writes, want 1", files.writes)
+	}
+
+	for name, data := range map[string][]byte{"same": data, "recompressed": recompressed} {
+		t.Run(name, func(t *testing.T) {
+			got, err := w.WriteImage(t.Context(), data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != url {
+				t.Errorf("got url %s, want %s", got, url)
+			}
+			if files.writes != 1 {
+				t.Errorf("existing image written again, got %d writes", files.writes)
+			}
+		})
+	}
+
+	if _, err := w.WriteImage(t.Context(), []byte("not an image")); !errors.Is(err, ErrInvalidImage) {
+		t.Errorf("got error %v, want %v", err, ErrInvalidImage)
+	}
+	if files.writes != 1 {
+		t.Errorf("invalid image written, got %d writes", files.writes)
+	}
+}
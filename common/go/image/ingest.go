@@ -0,0 +1,122 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package image
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif" // Register decoder.
+	"image/jpeg"
+	_ "image/png" // Register decoder.
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+const (
+	// maxImageBytes is the maximum size of an image.
+	maxImageBytes = 20 << 20
+
+	// maxImageDimension is the maximum width or height of an image, checked before decoding
+	// so images that decompress to huge sizes are not decoded.
+	maxImageDimension = 8192
+
+	// jpegQuality is the quality of images converted to JPEG.
+	jpegQuality = 90
+)
+
+// ErrInvalidImage is returned for images that cannot be used, for example because they could
+// not be fetched, are too large, or are not a supported format.
+var ErrInvalidImage = errors.New("image: invalid image")
+
+// Fetch fetches the image at imageURL with client. The response must be successful and not
+// exceed the size limit for images. The format is not checked until the image is normalized.
+func Fetch(ctx context.Context, client *http.Client, imageURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("image: creating request: %w", err)
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("image: fetching image: %w", err)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: fetching %s: status %d", ErrInvalidImage, imageURL, res.StatusCode)
+	}
+	if ct := res.Header.Get("Content-Type"); ct != "" {
+		// Servers sometimes respond with error pages with a success status. Images served as
+		// generic binary data are allowed since their format is sniffed later.
+		mediaType, _, _ := mime.ParseMediaType(ct)
+		if mediaType != "application/octet-stream" && !strings.HasPrefix(mediaType, "image/") {
+			return nil, fmt.Errorf("%w: fetching %s: content type %s", ErrInvalidImage, imageURL, ct)
+		}
+	}
+	if res.ContentLength > maxImageBytes {
+		return nil, fmt.Errorf("%w: fetching %s: size %d exceeds %d", ErrInvalidImage, imageURL, res.ContentLength, maxImageBytes)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(res.Body, maxImageBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("image: reading image: %w", err)
+	}
+	if len(data) > maxImageBytes {
+		return nil, fmt.Errorf("%w: fetching %s: size exceeds %d", ErrInvalidImage, imageURL, maxImageBytes)
+	}
+	return data, nil
+}
+
+// Normalize validates the image in data and returns it as a JPEG. The format is sniffed from
+// the content, ignoring any declared type. JPEGs are returned as-is to avoid recompressing
+// them, and transparent areas of other formats become white.
+func Normalize(data []byte) ([]byte, error) {
+	if len(data) > maxImageBytes {
+		return nil, fmt.Errorf("%w: size %d exceeds %d", ErrInvalidImage, len(data), maxImageBytes)
+	}
+
+	contentType := http.DetectContentType(data)
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif":
+	default:
+		return nil, fmt.Errorf("%w: unsupported format %s", ErrInvalidImage, contentType)
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: decoding image config: %v", ErrInvalidImage, err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width > maxImageDimension || cfg.Height > maxImageDimension {
+		return nil, fmt.Errorf("%w: dimensions %dx%d exceed %dx%d", ErrInvalidImage, cfg.Width, cfg.Height, maxImageDimension, maxImageDimension)
+	}
+
+	// Decode even JPEGs to catch truncated or corrupt images.
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: decoding image: %v", ErrInvalidImage, err)
+	}
+	if contentType == "image/jpeg" {
+		return data, nil
+	}
+
+	// JPEG has no transparency, so draw the image over white rather than the default of black.
+	bounds := img.Bounds()
+	rgba := image.NewRGBA(bounds)
+	draw.Draw(rgba, bounds, image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(rgba, bounds, img, bounds.Min, draw.Over)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, rgba, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, fmt.Errorf("image: encoding jpeg: %w", err)
+	}
+	return buf.Bytes(), nil
+}
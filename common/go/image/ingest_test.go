@@ -0,0 +1,109 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package image
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func encodePNG(t *testing.T, width int, height int) []byte {
+	t.Helper()
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	img.Set(0, 0, color.NRGBA{R: 255, A: 255})
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestNormalize(t *testing.T) {
+	pngData := encodePNG(t, 4, 4)
+
+	got, err := Normalize(pngData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := jpeg.Decode(bytes.NewReader(got))
+	if err != nil {
+		t.Fatalf("normalized image is not a jpeg: %v", err)
+	}
+	// Transparent pixels become white.
+	if r, g, b, _ := img.At(3, 3).RGBA(); r < 0xf000 || g < 0xf000 || b < 0xf000 {
+		t.Errorf("got transparent pixel (%d, %d, %d), want white", r, g, b)
+	}
+
+	// JPEGs are not recompressed.
+	again, err := Normalize(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(again, got) {
+		t.Error("jpeg was modified by normalization")
+	}
+}
+
+func TestNormalizeInvalid(t *testing.T) {
+	pngData := encodePNG(t, 4, 4)
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "html", data: []byte("<!DOCTYPE html><html><body>Not found</body></html>")},
+		{name: "truncated", data: pngData[:len(pngData)/2]},
+		{name: "too large", data: encodePNG(t, maxImageDimension+1, 1)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := Normalize(tc.data); !errors.Is(err, ErrInvalidImage) {
+				t.Errorf("got error %v, want %v", err, ErrInvalidImage)
+			}
+		})
+	}
+}
+
+func TestFetch(t *testing.T) {
+	pngData := encodePNG(t, 4, 4)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/image.png":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(pngData)
+		case "/error.html":
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write([]byte("<html>error</html>"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	got, err := Fetch(t.Context(), srv.Client(), srv.URL+"/image.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, pngData) {
+		t.Error("fetched image differs from served image")
+	}
+
+	for _, path := range []string{"/error.html", "/missing.png"} {
+		t.Run(path, func(t *testing.T) {
+			if _, err := Fetch(t.Context(), srv.Client(), srv.URL+path); !errors.Is(err, ErrInvalidImage) {
+				t.Errorf("got error %v, want %v", err, ErrInvalidImage)
+			}
+		})
+	}
+}
@@ -0,0 +1,398 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package pricing estimates the cost of recipes from a table of ingredient prices.
+package pricing
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"golang.org/x/text/width"
+	"google.golang.org/api/iterator"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+//go:embed prices.json
+var defaultPricesJSON []byte
+
+type defaultPrice struct {
+	Name    string             `json:"name"`
+	Aliases []string           `json:"aliases"`
+	Prices  map[string]float64 `json:"prices"`
+}
+
+// Price is the price of one unit of an ingredient.
+type Price struct {
+	// Name is the name of the ingredient.
+	Name string
+
+	// Unit is the unit the price is for.
+	Unit string
+
+	// Yen is the price in yen of one unit.
+	Yen float64
+
+	// Custom is whether the price was set by the user rather than bundled.
+	Custom bool
+}
+
+// Table is a table of ingredient prices.
+type Table struct {
+	// prices maps ingredient name to unit to price.
+	prices map[string]map[string]Price
+
+	// aliases maps alternative names of ingredients to their name in prices.
+	aliases map[string]string
+}
+
+// DefaultTable returns a table with the bundled default prices.
+func DefaultTable() *Table {
+	defaults := defaultTable()
+	t := &Table{
+		prices:  make(map[string]map[string]Price, len(defaults.prices)),
+		aliases: defaults.aliases,
+	}
+	for name, units := range defaults.prices {
+		t.prices[name] = maps.Clone(units)
+	}
+	return t
+}
+
+// defaultTable parses the bundled default prices once. The returned table is shared and must
+// not be modified.
+var defaultTable = sync.OnceValue(func() *Table {
+	var defaults []defaultPrice
+	if err := json.Unmarshal(defaultPricesJSON, &defaults); err != nil {
+		panic(fmt.Sprintf("pricing: invalid bundled prices: %v", err))
+	}
+
+	t := &Table{
+		prices:  make(map[string]map[string]Price, len(defaults)),
+		aliases: map[string]string{},
+	}
+	for _, d := range defaults {
+		units := make(map[string]Price, len(d.Prices))
+		for unit, yen := range d.Prices {
+			units[unit] = Price{Name: d.Name, Unit: unit, Yen: yen}
+		}
+		t.prices[d.Name] = units
+		for _, alias := range d.Aliases {
+			t.aliases[alias] = d.Name
+		}
+	}
+	return t
+})
+
+// LoadTable returns a table with the bundled default prices overridden by the prices
+// saved in col.
+func LoadTable(ctx context.Context, col *firestore.CollectionRef) (*Table, error) {
+	t := DefaultTable()
+
+	iter := col.Documents(ctx)
+	defer iter.Stop()
+	for {
+		doc, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("pricing: fetching ingredient price: %w", err)
+		}
+		var price cookchatdb.IngredientPrice
+		if err := doc.DataTo(&price); err != nil {
+			return nil, fmt.Errorf("pricing: decoding ingredient price: %w", err)
+		}
+		t.set(price)
+	}
+	return t, nil
+}
+
+// Cache caches the tables loaded by LoadTable for a short time, since prices are needed every
+// time a plan is read but rarely change. Tables returned by a Cache are shared and must not be
+// modified.
+type Cache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	table   *Table
+	expires time.Time
+}
+
+// NewCache returns a Cache keeping tables for ttl. Prices saved by another server may not be
+// reflected until then, prices saved by this server should be followed by Invalidate.
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{
+		ttl:     ttl,
+		entries: map[string]cacheEntry{},
+	}
+}
+
+// Load returns the table for the prices saved in col, loading it with LoadTable if it is not
+// cached.
+func (c *Cache) Load(ctx context.Context, col *firestore.CollectionRef) (*Table, error) {
+	now := time.Now()
+
+	c.mu.Lock()
+	entry, ok := c.entries[col.Path]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.table, nil
+	}
+
+	t, err := LoadTable(ctx, col)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Remove expired tables of other collections so the cache doesn't grow with every user.
+	maps.DeleteFunc(c.entries, func(_ string, e cacheEntry) bool {
+		return !now.Before(e.expires)
+	})
+	c.entries[col.Path] = cacheEntry{table: t, expires: now.Add(c.ttl)}
+	return t, nil
+}
+
+// Invalidate removes the table for the prices saved in col, so it is loaded again on next use.
+func (c *Cache) Invalidate(col *firestore.CollectionRef) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, col.Path)
+}
+
+func (t *Table) set(price cookchatdb.IngredientPrice) {
+	name := CanonicalName(price.Name)
+	units := t.prices[name]
+	if units == nil {
+		units = map[string]Price{}
+		t.prices[name] = units
+	}
+	unit := NormalizeUnit(price.Unit)
+	units[unit] = Price{Name: name, Unit: unit, Yen: price.Yen, Custom: true}
+}
+
+// Prices returns all prices in the table, sorted by ingredient name and unit.
+func (t *Table) Prices() []Price {
+	var prices []Price
+	for _, units := range t.prices {
+		for _, price := range units {
+			prices = append(prices, price)
+		}
+	}
+	slices.SortFunc(prices, func(a, b Price) int {
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Unit, b.Unit)
+	})
+	return prices
+}
+
+// RecipeCost returns the estimated cost in yen of the ingredients of a recipe. Ingredients
+// without a known price or with a quantity that cannot be parsed, such as 適量, are ignored.
+func (t *Table) RecipeCost(content *cookchatdb.RecipeContent) int {
+	total := 0.0
+	for _, ing := range content.Ingredients {
+		total += t.ingredientCost(ing)
+	}
+	for _, sec := range content.AdditionalIngredients {
+		for _, ing := range sec.Ingredients {
+			total += t.ingredientCost(ing)
+		}
+	}
+	return int(math.Round(total))
+}
+
+func (t *Table) ingredientCost(ing cookchatdb.RecipeIngredient) float64 {
+	units := t.lookup(ing.Name)
+	if units == nil {
+		return 0
+	}
+	amount, unit, ok := ParseQuantity(ing.Quantity)
+	if !ok {
+		return 0
+	}
+	price, ok := units[unit]
+	if !ok {
+		return 0
+	}
+	return amount * price.Yen
+}
+
+// lookup returns the prices for an ingredient, matching an exact name or alias first and
+// otherwise the longest known name contained in the ingredient, e.g. 豚肉 for 豚肩ロース肉.
+// Names of the same length are broken by the first in lexical order so the match doesn't
+// depend on map iteration order.
+func (t *Table) lookup(name string) map[string]Price {
+	name = NormalizeName(name)
+	if units, ok := t.prices[name]; ok {
+		return units
+	}
+	if canonical, ok := t.aliases[name]; ok {
+		return t.prices[canonical]
+	}
+
+	best, bestMatch := "", ""
+	match := func(known string, canonical string) {
+		if known == "" || !strings.Contains(name, known) {
+			return
+		}
+		if len(known) > len(bestMatch) || (len(known) == len(bestMatch) && known < bestMatch) {
+			best, bestMatch = canonical, known
+		}
+	}
+	for known := range t.prices {
+		match(known, known)
+	}
+	for alias, canonical := range t.aliases {
+		match(alias, canonical)
+	}
+	if best == "" {
+		return nil
+	}
+	return t.prices[best]
+}
+
+var (
+	parentheticalRE = regexp.MustCompile(`\(.*?\)|（.*?）|【.*?】|\[.*?\]`)
+	numberRE        = regexp.MustCompile(`^(\d+(?:\.\d+)?)(?:と(\d+)/(\d+)|/(\d+))?`)
+	rangeRE         = regexp.MustCompile(`^\s*[~〜～\-]\s*\d+(?:\.\d+)?(?:/\d+)?`)
+)
+
+// NormalizeName normalizes an ingredient name for matching, removing decorations commonly
+// used in recipes such as marks for grouping and parenthesized notes.
+func NormalizeName(name string) string {
+	name = width.Fold.String(name)
+	name = parentheticalRE.ReplaceAllString(name, "")
+	name = strings.TrimLeft(name, "●○◎◯☆★・*※◆◇■□▲△ ")
+	return strings.TrimSpace(name)
+}
+
+// CanonicalName returns the name prices of an ingredient are saved under, normalizing it with
+// NormalizeName and resolving known aliases, e.g. 玉ねぎ for たまねぎ.
+func CanonicalName(name string) string {
+	name = NormalizeName(name)
+	if canonical, ok := defaultTable().aliases[name]; ok {
+		return canonical
+	}
+	return name
+}
+
+// spoonUnits are units that precede the amount and are converted to ml.
+var spoonUnits = []struct {
+	prefix string
+	ml     float64
+}{
+	{"大さじ", 15},
+	{"小さじ", 5},
+	{"カップ", 200},
+}
+
+// NormalizeUnit converts a unit to the unit prices are stored in, e.g. cc to ml.
+func NormalizeUnit(unit string) string {
+	unit = strings.ToLower(strings.TrimSpace(width.Fold.String(unit)))
+	switch unit {
+	case "cc":
+		return "ml"
+	case "グラム":
+		return "g"
+	}
+	return unit
+}
+
+// ParseQuantity parses a free-form quantity like 200g, 大さじ1と1/2, or 1/2個 into an amount
+// and unit. Spoons and cups are converted to ml, and kilograms and liters to g and ml.
+// ok is false for quantities without an amount, such as 適量 or 少々.
+func ParseQuantity(quantity string) (amount float64, unit string, ok bool) {
+	q := strings.TrimSpace(width.Fold.String(quantity))
+	q = parentheticalRE.ReplaceAllString(q, "")
+
+	for _, s := range spoonUnits {
+		if rest, found := strings.CutPrefix(q, s.prefix); found {
+			amount, _, ok := parseNumber(strings.TrimSpace(rest))
+			if !ok {
+				return 0, "", false
+			}
+			return amount * s.ml, "ml", true
+		}
+	}
+
+	amount, rest, ok := parseNumber(q)
+	if !ok {
+		return 0, "", false
+	}
+	rest = rangeRE.ReplaceAllString(rest, "")
+	rest = strings.TrimSpace(rest)
+
+	unitEnd := strings.IndexAny(rest, " 、,・~〜")
+	if unitEnd >= 0 {
+		rest = rest[:unitEnd]
+	}
+	// e.g. 1個半
+	if u, found := strings.CutSuffix(rest, "半"); found {
+		amount += 0.5
+		rest = u
+	}
+
+	unit = NormalizeUnit(rest)
+	switch unit {
+	case "kg":
+		return amount * 1000, "g", true
+	case "l":
+		return amount * 1000, "ml", true
+	case "":
+		// A bare number is a count, e.g. 卵 2.
+		return amount, "個", true
+	}
+	return amount, unit, true
+}
+
+// parseNumber parses a leading amount like 2, 1.5, 1/2, 1と1/2, or 半, returning the amount and
+// the remaining text.
+func parseNumber(s string) (float64, string, bool) {
+	if rest, found := strings.CutPrefix(s, "半"); found {
+		return 0.5, strings.TrimPrefix(rest, "分"), true
+	}
+	m := numberRE.FindStringSubmatch(s)
+	if m == nil {
+		return 0, "", false
+	}
+	n, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, "", false
+	}
+	switch {
+	case m[2] != "":
+		num, _ := strconv.ParseFloat(m[2], 64)
+		den, _ := strconv.ParseFloat(m[3], 64)
+		if den != 0 {
+			n += num / den
+		}
+	case m[4] != "":
+		den, _ := strconv.ParseFloat(m[4], 64)
+		if den == 0 {
+			return 0, "", false
+		}
+		n /= den
+	}
+	return n, s[len(m[0]):], true
+}
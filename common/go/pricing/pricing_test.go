@@ -0,0 +1,183 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package pricing
+
+import (
+	"testing"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+func TestParseQuantity(t *testing.T) {
+	tests := []struct {
+		quantity string
+		amount   float64
+		unit     string
+		ok       bool
+	}{
+		{quantity: "200g", amount: 200, unit: "g", ok: true},
+		{quantity: "２００ｇ", amount: 200, unit: "g", ok: true},
+		{quantity: "1.5kg", amount: 1500, unit: "g", ok: true},
+		{quantity: "500cc", amount: 500, unit: "ml", ok: true},
+		{quantity: "1L", amount: 1000, unit: "ml", ok: true},
+		{quantity: "大さじ2", amount: 30, unit: "ml", ok: true},
+		{quantity: "小さじ1/2", amount: 2.5, unit: "ml", ok: true},
+		{quantity: "大さじ1と1/2", amount: 22.5, unit: "ml", ok: true},
+		{quantity: "カップ1", amount: 200, unit: "ml", ok: true},
+		{quantity: "1/2個", amount: 0.5, unit: "個", ok: true},
+		{quantity: "1個半", amount: 1.5, unit: "個", ok: true},
+		{quantity: "半分", amount: 0.5, unit: "個", ok: true},
+		{quantity: "2", amount: 2, unit: "個", ok: true},
+		{quantity: "2〜3枚", amount: 2, unit: "枚", ok: true},
+		{quantity: "300g（約1枚）", amount: 300, unit: "g", ok: true},
+		{quantity: "適量", ok: false},
+		{quantity: "少々", ok: false},
+		{quantity: "大さじ適量", ok: false},
+		{quantity: "1/0個", ok: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.quantity, func(t *testing.T) {
+			amount, unit, ok := ParseQuantity(tc.quantity)
+			if ok != tc.ok || amount != tc.amount || unit != tc.unit {
+				t.Errorf("got (%v, %q, %v), want (%v, %q, %v)", amount, unit, ok, tc.amount, tc.unit, tc.ok)
+			}
+		})
+	}
+}
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "玉ねぎ", want: "玉ねぎ"},
+		{name: "●玉ねぎ", want: "玉ねぎ"},
+		{name: "☆醤油", want: "醤油"},
+		{name: "玉ねぎ（中）", want: "玉ねぎ"},
+		{name: "豚肉【薄切り】", want: "豚肉"},
+		{name: " ＡＢＣ ", want: "ABC"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := NormalizeName(tc.name); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "玉ねぎ", want: "玉ねぎ"},
+		{name: "たまねぎ", want: "玉ねぎ"},
+		{name: "●人参（中）", want: "にんじん"},
+		{name: "豚肩ロース肉", want: "豚肩ロース肉"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := CanonicalName(tc.name); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeUnit(t *testing.T) {
+	tests := []struct {
+		unit string
+		want string
+	}{
+		{unit: "g", want: "g"},
+		{unit: "グラム", want: "g"},
+		{unit: "CC", want: "ml"},
+		{unit: "ｍｌ", want: "ml"},
+		{unit: " 個 ", want: "個"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.unit, func(t *testing.T) {
+			if got := NormalizeUnit(tc.unit); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	table := &Table{
+		prices: map[string]map[string]Price{
+			"豚肉":   {"g": {Name: "豚肉", Unit: "g", Yen: 2}},
+			"豚ひき肉": {"g": {Name: "豚ひき肉", Unit: "g", Yen: 1.5}},
+			"aa":   {"g": {Name: "aa", Unit: "g", Yen: 1}},
+			"bb":   {"g": {Name: "bb", Unit: "g", Yen: 3}},
+		},
+		aliases: map[string]string{
+			"豚バラ": "豚肉",
+		},
+	}
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "豚肉", want: "豚肉"},
+		{name: "豚バラ", want: "豚肉"},
+		{name: "●豚バラ（薄切り）", want: "豚肉"},
+		{name: "国産豚ひき肉", want: "豚ひき肉"},
+		{name: "豚バラブロック", want: "豚肉"},
+		{name: "bb aa", want: "aa"},
+		{name: "牛肉", want: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Matching is repeated since map iteration order changes between iterations.
+			for range 20 {
+				got := ""
+				if units := table.lookup(tc.name); units != nil {
+					got = units["g"].Name
+				}
+				if got != tc.want {
+					t.Fatalf("got %q, want %q", got, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRecipeCost(t *testing.T) {
+	table := DefaultTable()
+	table.set(cookchatdb.IngredientPrice{Name: "たまねぎ", Unit: "個", Yen: 100})
+
+	content := &cookchatdb.RecipeContent{
+		Ingredients: []cookchatdb.RecipeIngredient{
+			{Name: "玉ねぎ", Quantity: "2個"},
+			{Name: "塩", Quantity: "少々"},
+			{Name: "謎の食材", Quantity: "100g"},
+		},
+		AdditionalIngredients: []cookchatdb.IngredientSection{
+			{
+				Ingredients: []cookchatdb.RecipeIngredient{
+					{Name: "醤油", Quantity: "大さじ1"},
+				},
+			},
+		},
+	}
+
+	// 2 onions at the custom price and 15ml of soy sauce at the default price.
+	if got, want := table.RecipeCost(content), 206; got != want {
+		t.Errorf("got %d, want %d", got, want)
+	}
+
+	// Custom prices don't leak into other tables.
+	if got, want := DefaultTable().RecipeCost(content), 106; got != want {
+		t.Errorf("got %d, want %d", got, want)
+	}
+}
@@ -70,6 +70,14 @@ the rewritten content to the voice AI.
 
 const VerRewriteRecipe = 1
 
+func ClassifyRecipe() string {
+	return classifyRecipe
+}
+
+const classifyRecipe = `
+Classify the type and genre of the provided recipe. Return unknown for either if low confidence.
+`
+
 func RecipeImage() string {
 	return recipeImage
 }
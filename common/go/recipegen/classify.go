@@ -0,0 +1,71 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package recipegen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/genai"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/prompts"
+)
+
+// classification is the type and genre of a recipe.
+type classification struct {
+	Type  cookchatdb.RecipeType  `json:"type"`
+	Genre cookchatdb.RecipeGenre `json:"genre"`
+}
+
+var classificationSchema = func() *genai.Schema {
+	types := make([]string, len(cookchatdb.AllRecipeTypes))
+	for i, t := range cookchatdb.AllRecipeTypes {
+		types[i] = string(t)
+	}
+	genres := make([]string, len(cookchatdb.AllRecipeGenres))
+	for i, g := range cookchatdb.AllRecipeGenres {
+		genres[i] = string(g)
+	}
+	return &genai.Schema{
+		Type: "object",
+		Properties: map[string]*genai.Schema{
+			"type": {
+				Type:        "string",
+				Description: "The type of the recipe.",
+				Enum:        types,
+			},
+			"genre": {
+				Type:        "string",
+				Description: "The genre of the recipe.",
+				Enum:        genres,
+			},
+		},
+		Required: []string{"type", "genre"},
+	}
+}()
+
+func (p *PostProcessor) classifyRecipe(ctx context.Context, rID string, contentJSON string) (classification, error) {
+	res, err := p.genAI.Models.GenerateContent(ctx, "gemini-3.6-flash", []*genai.Content{
+		genai.NewContentFromText(contentJSON, genai.RoleUser),
+	}, &genai.GenerateContentConfig{
+		SystemInstruction: genai.NewContentFromText(prompts.ClassifyRecipe(), genai.RoleModel),
+		ResponseMIMEType:  "application/json",
+		ResponseSchema:    classificationSchema,
+	})
+	if err != nil {
+		return classification{}, fmt.Errorf("recipegen: classifying recipe %s: %w", rID, err)
+	}
+	text := res.Text()
+	if text == "" {
+		return classification{}, fmt.Errorf("recipegen: unexpected response from genai for classification request: %v", res)
+	}
+
+	var c classification
+	if err := json.Unmarshal([]byte(text), &c); err != nil {
+		return classification{}, fmt.Errorf("recipegen: unmarshalling classification of recipe %s: %w", rID, err)
+	}
+	return c, nil
+}
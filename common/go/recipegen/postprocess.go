@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 
 	"cloud.google.com/go/firestore"
 	"golang.org/x/sync/errgroup"
@@ -33,16 +34,18 @@ func NewPostProcessor(genAI *genai.Client, store *firestore.Client, images *imag
 	}
 }
 
-func (p *PostProcessor) PostProcessRecipe(ctx context.Context, recipe *cookchatdb.Recipe) error {
+// Progress is notified of the progress of post-processing a recipe. It is called when a stage
+// starts and each time one of its items, such as a translation or step image, completes.
+// It is called concurrently, so done may be reported out of order.
+type Progress func(stage cookchatdb.ProgressStage, done int, total int)
+
+// PostProcessRecipe translates the content of the recipe to all languages, rewrites it for
+// voice in each language, classifies it and generates any missing images. Languages already
+// processed with the current prompts are not processed again. progress may be nil.
+func (p *PostProcessor) PostProcessRecipe(ctx context.Context, recipe *cookchatdb.Recipe, progress Progress) error {
 	if recipe.LanguageCode == "" {
 		recipe.LanguageCode = string(cookchatdb.LanguageCodeJa)
 	}
-	var targetLanguages []cookchatdb.LanguageCode
-	for _, code := range cookchatdb.AllLanguageCodes {
-		if recipe.LanguageCode != string(code) {
-			targetLanguages = append(targetLanguages, code)
-		}
-	}
 
 	contentJSONBytes, err := json.Marshal(recipe.Content)
 	if err != nil {
@@ -53,54 +56,100 @@ func (p *PostProcessor) PostProcessRecipe(ctx context.Context, recipe *cookchatd
 		recipe.LocalizedContent = map[string]*cookchatdb.RecipeContent{}
 	}
 
-	var grp errgroup.Group
-	for _, lang := range targetLanguages {
-		grp.Go(func() error {
-			cnt, err := p.translateRecipe(ctx, recipe.ID, contentJSON, cookchatdb.LanguageCode(recipe.LanguageCode), lang)
-			if err != nil {
-				return err
-			}
-			recipe.LocalizedContent[string(lang)] = cnt
-			return nil
-		})
-	}
+	var translateLanguages []cookchatdb.LanguageCode
+	var rewriteLanguages []cookchatdb.LanguageCode
+	// The content to rewrite for each language not being translated, read before any
+	// translations are written.
+	langContentJSONs := map[cookchatdb.LanguageCode]string{}
 	for _, lang := range cookchatdb.AllLanguageCodes {
-		langAI := string(lang) + "-ai"
-		if cnt := recipe.LocalizedContent[langAI]; cnt != nil && cnt.Version == prompts.VerRewriteRecipe {
+		translate := false
+		if recipe.LanguageCode != string(lang) {
+			if cnt := recipe.LocalizedContent[string(lang)]; cnt == nil || cnt.Version != prompts.VerTranslateRecipe {
+				translate = true
+				translateLanguages = append(translateLanguages, lang)
+			}
+		}
+		// Rewrites are of the translation so must be redone when it is.
+		if cnt := recipe.LocalizedContent[string(lang)+"-ai"]; translate || cnt == nil || cnt.Version != prompts.VerRewriteRecipe {
+			rewriteLanguages = append(rewriteLanguages, lang)
+		}
+		if translate {
 			continue
 		}
-		var langContentJSON string
 		if cnt := recipe.LocalizedContent[string(lang)]; cnt != nil {
 			cj, err := json.Marshal(cnt)
 			if err != nil {
 				return fmt.Errorf("recipegen: marshalling recipe content for rewrite: %w", err)
 			}
-			langContentJSON = string(cj)
+			langContentJSONs[lang] = string(cj)
 		} else {
-			langContentJSON = contentJSON
+			langContentJSONs[lang] = contentJSON
 		}
+	}
+
+	// Guards recipe.LocalizedContent, which is written for each language concurrently.
+	var mu sync.Mutex
+	var grp errgroup.Group
+	translated := newStageCounter(progress, cookchatdb.ProgressStageTranslate, len(translateLanguages))
+	rewritten := newStageCounter(progress, cookchatdb.ProgressStageRewrite, len(rewriteLanguages))
+	for _, lang := range rewriteLanguages {
 		grp.Go(func() error {
+			langContentJSON, ok := langContentJSONs[lang]
+			if !ok {
+				cnt, err := p.translateRecipe(ctx, recipe.ID, contentJSON, cookchatdb.LanguageCode(recipe.LanguageCode), lang)
+				if err != nil {
+					return err
+				}
+				mu.Lock()
+				recipe.LocalizedContent[string(lang)] = cnt
+				mu.Unlock()
+				translated.inc()
+
+				cj, err := json.Marshal(cnt)
+				if err != nil {
+					return fmt.Errorf("recipegen: marshalling recipe content for rewrite: %w", err)
+				}
+				langContentJSON = string(cj)
+			}
+
 			cnt, err := p.rewriteRecipe(ctx, recipe.ID, langContentJSON)
 			if err != nil {
 				return err
 			}
-			recipe.LocalizedContent[langAI] = cnt
+			mu.Lock()
+			recipe.LocalizedContent[string(lang)+"-ai"] = cnt
+			mu.Unlock()
+			rewritten.inc()
+			return nil
+		})
+	}
+	if recipe.Type == "" || recipe.Genre == "" {
+		grp.Go(func() error {
+			c, err := p.classifyRecipe(ctx, recipe.ID, contentJSON)
+			if err != nil {
+				return err
+			}
+			recipe.Type = c.Type
+			recipe.Genre = c.Genre
 			return nil
 		})
 	}
 	if recipe.ImageURL == "" {
+		imaged := newStageCounter(progress, cookchatdb.ProgressStageImage, 1)
 		grp.Go(func() error {
 			url, err := p.generateRecipeImage(ctx, recipe.ID, contentJSON)
 			if err != nil {
 				return err
 			}
 			recipe.ImageURL = url
+			imaged.inc()
 			return nil
 		})
 	}
 
 	if len(recipe.StepImageURLs) == 0 {
 		recipe.StepImageURLs = make([]string, len(recipe.Content.Steps))
+		stepImaged := newStageCounter(progress, cookchatdb.ProgressStageStepImages, len(recipe.Content.Steps))
 		for i := range recipe.Content.Steps {
 			grp.Go(func() error {
 				url, err := p.generateStepImage(ctx, recipe.ID, i, contentJSON)
@@ -108,6 +157,8 @@ func (p *PostProcessor) PostProcessRecipe(ctx context.Context, recipe *cookchatd
 					return err
 				}
 				recipe.StepImageURLs[i] = url
+				recipe.Content.Steps[i].ImageURL = url
+				stepImaged.inc()
 				return nil
 			})
 		}
@@ -0,0 +1,40 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package recipegen
+
+import (
+	"sync/atomic"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+// stageCounter counts the completed items of a post-processing stage, reporting them to a
+// Progress.
+type stageCounter struct {
+	progress Progress
+	stage    cookchatdb.ProgressStage
+	total    int
+	done     atomic.Int64
+}
+
+// newStageCounter returns a counter for stage with total items, reporting that the stage
+// started if it has any items.
+func newStageCounter(progress Progress, stage cookchatdb.ProgressStage, total int) *stageCounter {
+	c := &stageCounter{
+		progress: progress,
+		stage:    stage,
+		total:    total,
+	}
+	if progress != nil && total > 0 {
+		progress(stage, 0, total)
+	}
+	return c
+}
+
+func (c *stageCounter) inc() {
+	done := c.done.Add(1)
+	if c.progress != nil {
+		c.progress(c.stage, int(done), c.total)
+	}
+}
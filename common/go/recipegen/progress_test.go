@@ -0,0 +1,56 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package recipegen
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+func TestStageCounter(t *testing.T) {
+	var mu sync.Mutex
+	var reports []int
+	progress := func(stage cookchatdb.ProgressStage, done int, total int) {
+		if stage != cookchatdb.ProgressStageImage || total != 3 {
+			t.Errorf("got report of %s with total %d, want image with total 3", stage, total)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		reports = append(reports, done)
+	}
+
+	c := newStageCounter(progress, cookchatdb.ProgressStageImage, 3)
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Go(c.inc)
+	}
+	wg.Wait()
+
+	// The start and each item are reported, in any order.
+	if len(reports) != 4 || reports[0] != 0 {
+		t.Fatalf("got reports %v, want start and 3 items", reports)
+	}
+	seen := map[int]bool{}
+	for _, done := range reports[1:] {
+		seen[done] = true
+	}
+	if !seen[1] || !seen[2] || !seen[3] {
+		t.Errorf("got reports %v, want 1, 2 and 3", reports)
+	}
+}
+
+func TestStageCounterEmpty(t *testing.T) {
+	called := false
+	newStageCounter(func(cookchatdb.ProgressStage, int, int) {
+		called = true
+	}, cookchatdb.ProgressStageImage, 0)
+	if called {
+		t.Error("got report for stage without items")
+	}
+
+	// Progress is optional.
+	newStageCounter(nil, cookchatdb.ProgressStageImage, 1).inc()
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.8
+// 	protoc-gen-go v1.36.11
 // 	protoc        (unknown)
 // source: crawlerapi/crawler.proto
 
@@ -9,6 +9,7 @@ package crawlerapi
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,10 +22,185 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The type of crawl of a crawl job.
+type CrawlJobType int32
+
+const (
+	CrawlJobType_CRAWL_JOB_TYPE_UNSPECIFIED CrawlJobType = 0
+	// Crawl of a single recipe.
+	CrawlJobType_CRAWL_JOB_TYPE_RECIPE CrawlJobType = 1
+	// Crawl of the recipes of a cookpad user.
+	CrawlJobType_CRAWL_JOB_TYPE_COOKPAD_USER CrawlJobType = 2
+	// Crawl of the recipes in a sitemap.
+	CrawlJobType_CRAWL_JOB_TYPE_SITEMAP CrawlJobType = 3
+	// Crawl of recipes last crawled long ago to refresh them.
+	CrawlJobType_CRAWL_JOB_TYPE_RECRAWL CrawlJobType = 4
+)
+
+// Enum value maps for CrawlJobType.
+var (
+	CrawlJobType_name = map[int32]string{
+		0: "CRAWL_JOB_TYPE_UNSPECIFIED",
+		1: "CRAWL_JOB_TYPE_RECIPE",
+		2: "CRAWL_JOB_TYPE_COOKPAD_USER",
+		3: "CRAWL_JOB_TYPE_SITEMAP",
+		4: "CRAWL_JOB_TYPE_RECRAWL",
+	}
+	CrawlJobType_value = map[string]int32{
+		"CRAWL_JOB_TYPE_UNSPECIFIED":  0,
+		"CRAWL_JOB_TYPE_RECIPE":       1,
+		"CRAWL_JOB_TYPE_COOKPAD_USER": 2,
+		"CRAWL_JOB_TYPE_SITEMAP":      3,
+		"CRAWL_JOB_TYPE_RECRAWL":      4,
+	}
+)
+
+func (x CrawlJobType) Enum() *CrawlJobType {
+	p := new(CrawlJobType)
+	*p = x
+	return p
+}
+
+func (x CrawlJobType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CrawlJobType) Descriptor() protoreflect.EnumDescriptor {
+	return file_crawlerapi_crawler_proto_enumTypes[0].Descriptor()
+}
+
+func (CrawlJobType) Type() protoreflect.EnumType {
+	return &file_crawlerapi_crawler_proto_enumTypes[0]
+}
+
+func (x CrawlJobType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CrawlJobType.Descriptor instead.
+func (CrawlJobType) EnumDescriptor() ([]byte, []int) {
+	return file_crawlerapi_crawler_proto_rawDescGZIP(), []int{0}
+}
+
+// The status of a crawl job.
+type CrawlJobStatus int32
+
+const (
+	CrawlJobStatus_CRAWL_JOB_STATUS_UNSPECIFIED CrawlJobStatus = 0
+	// The job is still running.
+	CrawlJobStatus_CRAWL_JOB_STATUS_RUNNING CrawlJobStatus = 1
+	// The job completed, though individual items may have failed.
+	CrawlJobStatus_CRAWL_JOB_STATUS_SUCCEEDED CrawlJobStatus = 2
+	// The job could not complete.
+	CrawlJobStatus_CRAWL_JOB_STATUS_FAILED CrawlJobStatus = 3
+)
+
+// Enum value maps for CrawlJobStatus.
+var (
+	CrawlJobStatus_name = map[int32]string{
+		0: "CRAWL_JOB_STATUS_UNSPECIFIED",
+		1: "CRAWL_JOB_STATUS_RUNNING",
+		2: "CRAWL_JOB_STATUS_SUCCEEDED",
+		3: "CRAWL_JOB_STATUS_FAILED",
+	}
+	CrawlJobStatus_value = map[string]int32{
+		"CRAWL_JOB_STATUS_UNSPECIFIED": 0,
+		"CRAWL_JOB_STATUS_RUNNING":     1,
+		"CRAWL_JOB_STATUS_SUCCEEDED":   2,
+		"CRAWL_JOB_STATUS_FAILED":      3,
+	}
+)
+
+func (x CrawlJobStatus) Enum() *CrawlJobStatus {
+	p := new(CrawlJobStatus)
+	*p = x
+	return p
+}
+
+func (x CrawlJobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CrawlJobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_crawlerapi_crawler_proto_enumTypes[1].Descriptor()
+}
+
+func (CrawlJobStatus) Type() protoreflect.EnumType {
+	return &file_crawlerapi_crawler_proto_enumTypes[1]
+}
+
+func (x CrawlJobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CrawlJobStatus.Descriptor instead.
+func (CrawlJobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_crawlerapi_crawler_proto_rawDescGZIP(), []int{1}
+}
+
+// The result of crawling an item in a crawl job.
+type CrawlJobItemStatus int32
+
+const (
+	CrawlJobItemStatus_CRAWL_JOB_ITEM_STATUS_UNSPECIFIED CrawlJobItemStatus = 0
+	// The item was crawled.
+	CrawlJobItemStatus_CRAWL_JOB_ITEM_STATUS_CRAWLED CrawlJobItemStatus = 1
+	// The item did not need to be crawled, for example because it was already crawled.
+	CrawlJobItemStatus_CRAWL_JOB_ITEM_STATUS_SKIPPED CrawlJobItemStatus = 2
+	// The item failed to crawl.
+	CrawlJobItemStatus_CRAWL_JOB_ITEM_STATUS_FAILED CrawlJobItemStatus = 3
+)
+
+// Enum value maps for CrawlJobItemStatus.
+var (
+	CrawlJobItemStatus_name = map[int32]string{
+		0: "CRAWL_JOB_ITEM_STATUS_UNSPECIFIED",
+		1: "CRAWL_JOB_ITEM_STATUS_CRAWLED",
+		2: "CRAWL_JOB_ITEM_STATUS_SKIPPED",
+		3: "CRAWL_JOB_ITEM_STATUS_FAILED",
+	}
+	CrawlJobItemStatus_value = map[string]int32{
+		"CRAWL_JOB_ITEM_STATUS_UNSPECIFIED": 0,
+		"CRAWL_JOB_ITEM_STATUS_CRAWLED":     1,
+		"CRAWL_JOB_ITEM_STATUS_SKIPPED":     2,
+		"CRAWL_JOB_ITEM_STATUS_FAILED":      3,
+	}
+)
+
+func (x CrawlJobItemStatus) Enum() *CrawlJobItemStatus {
+	p := new(CrawlJobItemStatus)
+	*p = x
+	return p
+}
+
+func (x CrawlJobItemStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CrawlJobItemStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_crawlerapi_crawler_proto_enumTypes[2].Descriptor()
+}
+
+func (CrawlJobItemStatus) Type() protoreflect.EnumType {
+	return &file_crawlerapi_crawler_proto_enumTypes[2]
+}
+
+func (x CrawlJobItemStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CrawlJobItemStatus.Descriptor instead.
+func (CrawlJobItemStatus) EnumDescriptor() ([]byte, []int) {
+	return file_crawlerapi_crawler_proto_rawDescGZIP(), []int{2}
+}
+
 type CrawlCookpadRecipeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The ID of the cookpad recipe to crawl.
-	RecipeId      string `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	RecipeId string `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	// The ID of the crawl job this crawl is part of, which records its result. If unset, the
+	// crawl is recorded as its own job.
+	ParentJobId   string `protobuf:"bytes,2,opt,name=parent_job_id,json=parentJobId,proto3" json:"parent_job_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,8 +242,22 @@ func (x *CrawlCookpadRecipeRequest) GetRecipeId() string {
 	return ""
 }
 
+func (x *CrawlCookpadRecipeRequest) GetParentJobId() string {
+	if x != nil {
+		return x.ParentJobId
+	}
+	return ""
+}
+
 type CrawlCookpadRecipeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the crawl job recording the crawl, unset if part of a parent job.
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// The ID of the crawled recipe.
+	RecipeId string `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	// Whether the recipe was already crawled and its page has not changed since, in which
+	// case it was not updated.
+	Unchanged     bool `protobuf:"varint,3,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -102,11 +292,35 @@ func (*CrawlCookpadRecipeResponse) Descriptor() ([]byte, []int) {
 	return file_crawlerapi_crawler_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *CrawlCookpadRecipeResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *CrawlCookpadRecipeResponse) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *CrawlCookpadRecipeResponse) GetUnchanged() bool {
+	if x != nil {
+		return x.Unchanged
+	}
+	return false
+}
+
 // A request for CrawlerService.CrawlCookpadUser.
 type CrawlCookpadUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The user ID of the cookpad user to crawl.
-	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The maximum number of pages of the user's recipes to crawl. If unset, a default of 50
+	// is used.
+	MaxPages      uint32 `protobuf:"varint,2,opt,name=max_pages,json=maxPages,proto3" json:"max_pages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -148,9 +362,26 @@ func (x *CrawlCookpadUserRequest) GetUserId() string {
 	return ""
 }
 
+func (x *CrawlCookpadUserRequest) GetMaxPages() uint32 {
+	if x != nil {
+		return x.MaxPages
+	}
+	return 0
+}
+
 // A response from CrawlerService.CrawlCookpadUser.
 type CrawlCookpadUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of recipes found on the user's pages.
+	Discovered int32 `protobuf:"varint,1,opt,name=discovered,proto3" json:"discovered,omitempty"`
+	// The number of recipes crawled successfully.
+	Crawled int32 `protobuf:"varint,2,opt,name=crawled,proto3" json:"crawled,omitempty"`
+	// The number of recipes skipped because they were already crawled.
+	Skipped int32 `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// The number of recipes that failed to crawl.
+	Failed int32 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	// The ID of the crawl job recording the results of the crawl.
+	JobId         string `protobuf:"bytes,5,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -185,10 +416,50 @@ func (*CrawlCookpadUserResponse) Descriptor() ([]byte, []int) {
 	return file_crawlerapi_crawler_proto_rawDescGZIP(), []int{3}
 }
 
+func (x *CrawlCookpadUserResponse) GetDiscovered() int32 {
+	if x != nil {
+		return x.Discovered
+	}
+	return 0
+}
+
+func (x *CrawlCookpadUserResponse) GetCrawled() int32 {
+	if x != nil {
+		return x.Crawled
+	}
+	return 0
+}
+
+func (x *CrawlCookpadUserResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *CrawlCookpadUserResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *CrawlCookpadUserResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 // A request for CrawlerService.CrawlRecipe.
 type CrawlRecipeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the recipe page. Sites without a dedicated extractor are supported if
+	// they publish schema.org Recipe JSON-LD.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The ID of the crawl job this crawl is part of, which records its result. If unset, the
+	// crawl is recorded as its own job.
+	ParentJobId   string `protobuf:"bytes,2,opt,name=parent_job_id,json=parentJobId,proto3" json:"parent_job_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -230,9 +501,23 @@ func (x *CrawlRecipeRequest) GetUrl() string {
 	return ""
 }
 
+func (x *CrawlRecipeRequest) GetParentJobId() string {
+	if x != nil {
+		return x.ParentJobId
+	}
+	return ""
+}
+
 // A response from CrawlerService.CrawlRecipe.
 type CrawlRecipeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the crawl job recording the crawl, unset if part of a parent job.
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// The ID of the crawled recipe.
+	RecipeId string `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	// Whether the recipe was already crawled and its page has not changed since, in which
+	// case it was not updated.
+	Unchanged     bool `protobuf:"varint,3,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -267,25 +552,772 @@ func (*CrawlRecipeResponse) Descriptor() ([]byte, []int) {
 	return file_crawlerapi_crawler_proto_rawDescGZIP(), []int{5}
 }
 
-var File_crawlerapi_crawler_proto protoreflect.FileDescriptor
+func (x *CrawlRecipeResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
 
-const file_crawlerapi_crawler_proto_rawDesc = "" +
-	"\n" +
-	"\x18crawlerapi/crawler.proto\x12\n" +
-	"crawlerapi\"8\n" +
-	"\x19CrawlCookpadRecipeRequest\x12\x1b\n" +
-	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\"\x1c\n" +
-	"\x1aCrawlCookpadRecipeResponse\"2\n" +
-	"\x17CrawlCookpadUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x1a\n" +
-	"\x18CrawlCookpadUserResponse\"&\n" +
-	"\x12CrawlRecipeRequest\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\"\x15\n" +
-	"\x13CrawlRecipeResponse2\xa4\x02\n" +
+func (x *CrawlRecipeResponse) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *CrawlRecipeResponse) GetUnchanged() bool {
+	if x != nil {
+		return x.Unchanged
+	}
+	return false
+}
+
+// A request for CrawlerService.CrawlSitemap.
+type CrawlSitemapRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the sitemap or sitemap index to discover recipes from. Sitemaps may be
+	// gzipped.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// If set, only pages modified after this time according to the sitemap are crawled.
+	// Pages without a modification time are always crawled.
+	ModifiedAfter *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=modified_after,json=modifiedAfter,proto3" json:"modified_after,omitempty"`
+	// The maximum number of recipes to crawl. If unset, all discovered recipes are crawled.
+	Limit         uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CrawlSitemapRequest) Reset() {
+	*x = CrawlSitemapRequest{}
+	mi := &file_crawlerapi_crawler_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrawlSitemapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrawlSitemapRequest) ProtoMessage() {}
+
+func (x *CrawlSitemapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crawlerapi_crawler_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrawlSitemapRequest.ProtoReflect.Descriptor instead.
+func (*CrawlSitemapRequest) Descriptor() ([]byte, []int) {
+	return file_crawlerapi_crawler_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CrawlSitemapRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CrawlSitemapRequest) GetModifiedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModifiedAfter
+	}
+	return nil
+}
+
+func (x *CrawlSitemapRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// A response from CrawlerService.CrawlSitemap.
+type CrawlSitemapResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the crawl job recording the progress and results of the crawl, which runs
+	// after the response is returned.
+	JobId         string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CrawlSitemapResponse) Reset() {
+	*x = CrawlSitemapResponse{}
+	mi := &file_crawlerapi_crawler_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrawlSitemapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrawlSitemapResponse) ProtoMessage() {}
+
+func (x *CrawlSitemapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crawlerapi_crawler_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrawlSitemapResponse.ProtoReflect.Descriptor instead.
+func (*CrawlSitemapResponse) Descriptor() ([]byte, []int) {
+	return file_crawlerapi_crawler_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CrawlSitemapResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// The result of crawling an item, such as a recipe page or sitemap, in a crawl job.
+type CrawlJobItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the item.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The result of crawling the item.
+	Status CrawlJobItemStatus `protobuf:"varint,2,opt,name=status,proto3,enum=crawlerapi.CrawlJobItemStatus" json:"status,omitempty"`
+	// The ID of the crawled recipe, if the item is a recipe that was crawled.
+	RecipeId string `protobuf:"bytes,3,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	// The error crawling the item, if it failed.
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CrawlJobItem) Reset() {
+	*x = CrawlJobItem{}
+	mi := &file_crawlerapi_crawler_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrawlJobItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrawlJobItem) ProtoMessage() {}
+
+func (x *CrawlJobItem) ProtoReflect() protoreflect.Message {
+	mi := &file_crawlerapi_crawler_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrawlJobItem.ProtoReflect.Descriptor instead.
+func (*CrawlJobItem) Descriptor() ([]byte, []int) {
+	return file_crawlerapi_crawler_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CrawlJobItem) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CrawlJobItem) GetStatus() CrawlJobItemStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CrawlJobItemStatus_CRAWL_JOB_ITEM_STATUS_UNSPECIFIED
+}
+
+func (x *CrawlJobItem) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *CrawlJobItem) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// A crawl requested from the crawler.
+type CrawlJob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the job.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The type of crawl.
+	Type CrawlJobType `protobuf:"varint,2,opt,name=type,proto3,enum=crawlerapi.CrawlJobType" json:"type,omitempty"`
+	// The URL requested to be crawled, such as a recipe page or sitemap.
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// The ID of the user requested to be crawled for cookpad user jobs.
+	CookpadUserId string `protobuf:"bytes,4,opt,name=cookpad_user_id,json=cookpadUserId,proto3" json:"cookpad_user_id,omitempty"`
+	// The status of the job.
+	Status CrawlJobStatus `protobuf:"varint,5,opt,name=status,proto3,enum=crawlerapi.CrawlJobStatus" json:"status,omitempty"`
+	// The number of recipes found to crawl.
+	Discovered int32 `protobuf:"varint,6,opt,name=discovered,proto3" json:"discovered,omitempty"`
+	// The number of items crawled successfully.
+	Crawled int32 `protobuf:"varint,7,opt,name=crawled,proto3" json:"crawled,omitempty"`
+	// The number of items that did not need to be crawled.
+	Skipped int32 `protobuf:"varint,8,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// The number of items that failed to crawl.
+	Failed int32 `protobuf:"varint,9,opt,name=failed,proto3" json:"failed,omitempty"`
+	// The error that failed the job.
+	Error string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	// The time the job was created.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time the job finished, unset if still running.
+	FinishTime *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
+	// The results of crawling each item. Only populated by GetCrawlJob.
+	Items []*CrawlJobItem `protobuf:"bytes,13,rep,name=items,proto3" json:"items,omitempty"`
+	// Whether there were too many items to return all of them. Failed items are returned in
+	// preference to others.
+	ItemsTruncated bool `protobuf:"varint,14,opt,name=items_truncated,json=itemsTruncated,proto3" json:"items_truncated,omitempty"`
+	// The time progress of the job was last recorded.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CrawlJob) Reset() {
+	*x = CrawlJob{}
+	mi := &file_crawlerapi_crawler_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrawlJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrawlJob) ProtoMessage() {}
+
+func (x *CrawlJob) ProtoReflect() protoreflect.Message {
+	mi := &file_crawlerapi_crawler_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrawlJob.ProtoReflect.Descriptor instead.
+func (*CrawlJob) Descriptor() ([]byte, []int) {
+	return file_crawlerapi_crawler_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CrawlJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CrawlJob) GetType() CrawlJobType {
+	if x != nil {
+		return x.Type
+	}
+	return CrawlJobType_CRAWL_JOB_TYPE_UNSPECIFIED
+}
+
+func (x *CrawlJob) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CrawlJob) GetCookpadUserId() string {
+	if x != nil {
+		return x.CookpadUserId
+	}
+	return ""
+}
+
+func (x *CrawlJob) GetStatus() CrawlJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CrawlJobStatus_CRAWL_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *CrawlJob) GetDiscovered() int32 {
+	if x != nil {
+		return x.Discovered
+	}
+	return 0
+}
+
+func (x *CrawlJob) GetCrawled() int32 {
+	if x != nil {
+		return x.Crawled
+	}
+	return 0
+}
+
+func (x *CrawlJob) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *CrawlJob) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *CrawlJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CrawlJob) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *CrawlJob) GetFinishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishTime
+	}
+	return nil
+}
+
+func (x *CrawlJob) GetItems() []*CrawlJobItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CrawlJob) GetItemsTruncated() bool {
+	if x != nil {
+		return x.ItemsTruncated
+	}
+	return false
+}
+
+func (x *CrawlJob) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// A request for CrawlerService.RecrawlRecipes.
+type RecrawlRecipesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecrawlRecipesRequest) Reset() {
+	*x = RecrawlRecipesRequest{}
+	mi := &file_crawlerapi_crawler_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecrawlRecipesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecrawlRecipesRequest) ProtoMessage() {}
+
+func (x *RecrawlRecipesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crawlerapi_crawler_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecrawlRecipesRequest.ProtoReflect.Descriptor instead.
+func (*RecrawlRecipesRequest) Descriptor() ([]byte, []int) {
+	return file_crawlerapi_crawler_proto_rawDescGZIP(), []int{10}
+}
+
+// A response from CrawlerService.RecrawlRecipes.
+type RecrawlRecipesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the crawl job recording the results of the crawl.
+	JobId         string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecrawlRecipesResponse) Reset() {
+	*x = RecrawlRecipesResponse{}
+	mi := &file_crawlerapi_crawler_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecrawlRecipesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecrawlRecipesResponse) ProtoMessage() {}
+
+func (x *RecrawlRecipesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crawlerapi_crawler_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecrawlRecipesResponse.ProtoReflect.Descriptor instead.
+func (*RecrawlRecipesResponse) Descriptor() ([]byte, []int) {
+	return file_crawlerapi_crawler_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RecrawlRecipesResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// A request for CrawlerService.GetCrawlJob.
+type GetCrawlJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the job to get.
+	JobId         string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCrawlJobRequest) Reset() {
+	*x = GetCrawlJobRequest{}
+	mi := &file_crawlerapi_crawler_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCrawlJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCrawlJobRequest) ProtoMessage() {}
+
+func (x *GetCrawlJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crawlerapi_crawler_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCrawlJobRequest.ProtoReflect.Descriptor instead.
+func (*GetCrawlJobRequest) Descriptor() ([]byte, []int) {
+	return file_crawlerapi_crawler_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetCrawlJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// A response from CrawlerService.GetCrawlJob.
+type GetCrawlJobResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The job.
+	Job           *CrawlJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCrawlJobResponse) Reset() {
+	*x = GetCrawlJobResponse{}
+	mi := &file_crawlerapi_crawler_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCrawlJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCrawlJobResponse) ProtoMessage() {}
+
+func (x *GetCrawlJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crawlerapi_crawler_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCrawlJobResponse.ProtoReflect.Descriptor instead.
+func (*GetCrawlJobResponse) Descriptor() ([]byte, []int) {
+	return file_crawlerapi_crawler_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetCrawlJobResponse) GetJob() *CrawlJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// A request for CrawlerService.ListCrawlJobs.
+type ListCrawlJobsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// If set, only jobs with this status are returned.
+	Status CrawlJobStatus `protobuf:"varint,1,opt,name=status,proto3,enum=crawlerapi.CrawlJobStatus" json:"status,omitempty"`
+	// The maximum number of jobs to return. If unset, 20 jobs are returned.
+	PageSize uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The ID of the last job of the previous page, to return the next page.
+	LastId        string `protobuf:"bytes,3,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCrawlJobsRequest) Reset() {
+	*x = ListCrawlJobsRequest{}
+	mi := &file_crawlerapi_crawler_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCrawlJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCrawlJobsRequest) ProtoMessage() {}
+
+func (x *ListCrawlJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_crawlerapi_crawler_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCrawlJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListCrawlJobsRequest) Descriptor() ([]byte, []int) {
+	return file_crawlerapi_crawler_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListCrawlJobsRequest) GetStatus() CrawlJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CrawlJobStatus_CRAWL_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *ListCrawlJobsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCrawlJobsRequest) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+// A response from CrawlerService.ListCrawlJobs.
+type ListCrawlJobsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The jobs, most recent first, without their items.
+	Jobs          []*CrawlJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCrawlJobsResponse) Reset() {
+	*x = ListCrawlJobsResponse{}
+	mi := &file_crawlerapi_crawler_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCrawlJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCrawlJobsResponse) ProtoMessage() {}
+
+func (x *ListCrawlJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_crawlerapi_crawler_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCrawlJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListCrawlJobsResponse) Descriptor() ([]byte, []int) {
+	return file_crawlerapi_crawler_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListCrawlJobsResponse) GetJobs() []*CrawlJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+var File_crawlerapi_crawler_proto protoreflect.FileDescriptor
+
+const file_crawlerapi_crawler_proto_rawDesc = "" +
+	"\n" +
+	"\x18crawlerapi/crawler.proto\x12\n" +
+	"crawlerapi\x1a\x1fgoogle/protobuf/timestamp.proto\"\\\n" +
+	"\x19CrawlCookpadRecipeRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\"\n" +
+	"\rparent_job_id\x18\x02 \x01(\tR\vparentJobId\"n\n" +
+	"\x1aCrawlCookpadRecipeResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x1b\n" +
+	"\trecipe_id\x18\x02 \x01(\tR\brecipeId\x12\x1c\n" +
+	"\tunchanged\x18\x03 \x01(\bR\tunchanged\"O\n" +
+	"\x17CrawlCookpadUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tmax_pages\x18\x02 \x01(\rR\bmaxPages\"\x9d\x01\n" +
+	"\x18CrawlCookpadUserResponse\x12\x1e\n" +
+	"\n" +
+	"discovered\x18\x01 \x01(\x05R\n" +
+	"discovered\x12\x18\n" +
+	"\acrawled\x18\x02 \x01(\x05R\acrawled\x12\x18\n" +
+	"\askipped\x18\x03 \x01(\x05R\askipped\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x12\x15\n" +
+	"\x06job_id\x18\x05 \x01(\tR\x05jobId\"J\n" +
+	"\x12CrawlRecipeRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\"\n" +
+	"\rparent_job_id\x18\x02 \x01(\tR\vparentJobId\"g\n" +
+	"\x13CrawlRecipeResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x1b\n" +
+	"\trecipe_id\x18\x02 \x01(\tR\brecipeId\x12\x1c\n" +
+	"\tunchanged\x18\x03 \x01(\bR\tunchanged\"\x80\x01\n" +
+	"\x13CrawlSitemapRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12A\n" +
+	"\x0emodified_after\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rmodifiedAfter\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\"-\n" +
+	"\x14CrawlSitemapResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x8b\x01\n" +
+	"\fCrawlJobItem\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x126\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1e.crawlerapi.CrawlJobItemStatusR\x06status\x12\x1b\n" +
+	"\trecipe_id\x18\x03 \x01(\tR\brecipeId\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xc8\x04\n" +
+	"\bCrawlJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x18.crawlerapi.CrawlJobTypeR\x04type\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12&\n" +
+	"\x0fcookpad_user_id\x18\x04 \x01(\tR\rcookpadUserId\x122\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1a.crawlerapi.CrawlJobStatusR\x06status\x12\x1e\n" +
+	"\n" +
+	"discovered\x18\x06 \x01(\x05R\n" +
+	"discovered\x12\x18\n" +
+	"\acrawled\x18\a \x01(\x05R\acrawled\x12\x18\n" +
+	"\askipped\x18\b \x01(\x05R\askipped\x12\x16\n" +
+	"\x06failed\x18\t \x01(\x05R\x06failed\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12;\n" +
+	"\vcreate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vfinish_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishTime\x12.\n" +
+	"\x05items\x18\r \x03(\v2\x18.crawlerapi.CrawlJobItemR\x05items\x12'\n" +
+	"\x0fitems_truncated\x18\x0e \x01(\bR\x0eitemsTruncated\x12;\n" +
+	"\vupdate_time\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\x17\n" +
+	"\x15RecrawlRecipesRequest\"/\n" +
+	"\x16RecrawlRecipesResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"+\n" +
+	"\x12GetCrawlJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"=\n" +
+	"\x13GetCrawlJobResponse\x12&\n" +
+	"\x03job\x18\x01 \x01(\v2\x14.crawlerapi.CrawlJobR\x03job\"\x80\x01\n" +
+	"\x14ListCrawlJobsRequest\x122\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1a.crawlerapi.CrawlJobStatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x17\n" +
+	"\alast_id\x18\x03 \x01(\tR\x06lastId\"A\n" +
+	"\x15ListCrawlJobsResponse\x12(\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x14.crawlerapi.CrawlJobR\x04jobs*\xa2\x01\n" +
+	"\fCrawlJobType\x12\x1e\n" +
+	"\x1aCRAWL_JOB_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15CRAWL_JOB_TYPE_RECIPE\x10\x01\x12\x1f\n" +
+	"\x1bCRAWL_JOB_TYPE_COOKPAD_USER\x10\x02\x12\x1a\n" +
+	"\x16CRAWL_JOB_TYPE_SITEMAP\x10\x03\x12\x1a\n" +
+	"\x16CRAWL_JOB_TYPE_RECRAWL\x10\x04*\x8d\x01\n" +
+	"\x0eCrawlJobStatus\x12 \n" +
+	"\x1cCRAWL_JOB_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18CRAWL_JOB_STATUS_RUNNING\x10\x01\x12\x1e\n" +
+	"\x1aCRAWL_JOB_STATUS_SUCCEEDED\x10\x02\x12\x1b\n" +
+	"\x17CRAWL_JOB_STATUS_FAILED\x10\x03*\xa3\x01\n" +
+	"\x12CrawlJobItemStatus\x12%\n" +
+	"!CRAWL_JOB_ITEM_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dCRAWL_JOB_ITEM_STATUS_CRAWLED\x10\x01\x12!\n" +
+	"\x1dCRAWL_JOB_ITEM_STATUS_SKIPPED\x10\x02\x12 \n" +
+	"\x1cCRAWL_JOB_ITEM_STATUS_FAILED\x10\x032\xf6\x04\n" +
 	"\x0eCrawlerService\x12]\n" +
 	"\x10CrawlCookpadUser\x12#.crawlerapi.CrawlCookpadUserRequest\x1a$.crawlerapi.CrawlCookpadUserResponse\x12c\n" +
 	"\x12CrawlCookpadRecipe\x12%.crawlerapi.CrawlCookpadRecipeRequest\x1a&.crawlerapi.CrawlCookpadRecipeResponse\x12N\n" +
-	"\vCrawlRecipe\x12\x1e.crawlerapi.CrawlRecipeRequest\x1a\x1f.crawlerapi.CrawlRecipeResponseB;Z9github.com/curioswitch/cookchat/crawler/api/go;crawlerapib\x06proto3"
+	"\vCrawlRecipe\x12\x1e.crawlerapi.CrawlRecipeRequest\x1a\x1f.crawlerapi.CrawlRecipeResponse\x12Q\n" +
+	"\fCrawlSitemap\x12\x1f.crawlerapi.CrawlSitemapRequest\x1a .crawlerapi.CrawlSitemapResponse\x12W\n" +
+	"\x0eRecrawlRecipes\x12!.crawlerapi.RecrawlRecipesRequest\x1a\".crawlerapi.RecrawlRecipesResponse\x12N\n" +
+	"\vGetCrawlJob\x12\x1e.crawlerapi.GetCrawlJobRequest\x1a\x1f.crawlerapi.GetCrawlJobResponse\x12T\n" +
+	"\rListCrawlJobs\x12 .crawlerapi.ListCrawlJobsRequest\x1a!.crawlerapi.ListCrawlJobsResponseB;Z9github.com/curioswitch/cookchat/crawler/api/go;crawlerapib\x06proto3"
 
 var (
 	file_crawlerapi_crawler_proto_rawDescOnce sync.Once
@@ -299,27 +1331,61 @@ func file_crawlerapi_crawler_proto_rawDescGZIP() []byte {
 	return file_crawlerapi_crawler_proto_rawDescData
 }
 
-var file_crawlerapi_crawler_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_crawlerapi_crawler_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_crawlerapi_crawler_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_crawlerapi_crawler_proto_goTypes = []any{
-	(*CrawlCookpadRecipeRequest)(nil),  // 0: crawlerapi.CrawlCookpadRecipeRequest
-	(*CrawlCookpadRecipeResponse)(nil), // 1: crawlerapi.CrawlCookpadRecipeResponse
-	(*CrawlCookpadUserRequest)(nil),    // 2: crawlerapi.CrawlCookpadUserRequest
-	(*CrawlCookpadUserResponse)(nil),   // 3: crawlerapi.CrawlCookpadUserResponse
-	(*CrawlRecipeRequest)(nil),         // 4: crawlerapi.CrawlRecipeRequest
-	(*CrawlRecipeResponse)(nil),        // 5: crawlerapi.CrawlRecipeResponse
+	(CrawlJobType)(0),                  // 0: crawlerapi.CrawlJobType
+	(CrawlJobStatus)(0),                // 1: crawlerapi.CrawlJobStatus
+	(CrawlJobItemStatus)(0),            // 2: crawlerapi.CrawlJobItemStatus
+	(*CrawlCookpadRecipeRequest)(nil),  // 3: crawlerapi.CrawlCookpadRecipeRequest
+	(*CrawlCookpadRecipeResponse)(nil), // 4: crawlerapi.CrawlCookpadRecipeResponse
+	(*CrawlCookpadUserRequest)(nil),    // 5: crawlerapi.CrawlCookpadUserRequest
+	(*CrawlCookpadUserResponse)(nil),   // 6: crawlerapi.CrawlCookpadUserResponse
+	(*CrawlRecipeRequest)(nil),         // 7: crawlerapi.CrawlRecipeRequest
+	(*CrawlRecipeResponse)(nil),        // 8: crawlerapi.CrawlRecipeResponse
+	(*CrawlSitemapRequest)(nil),        // 9: crawlerapi.CrawlSitemapRequest
+	(*CrawlSitemapResponse)(nil),       // 10: crawlerapi.CrawlSitemapResponse
+	(*CrawlJobItem)(nil),               // 11: crawlerapi.CrawlJobItem
+	(*CrawlJob)(nil),                   // 12: crawlerapi.CrawlJob
+	(*RecrawlRecipesRequest)(nil),      // 13: crawlerapi.RecrawlRecipesRequest
+	(*RecrawlRecipesResponse)(nil),     // 14: crawlerapi.RecrawlRecipesResponse
+	(*GetCrawlJobRequest)(nil),         // 15: crawlerapi.GetCrawlJobRequest
+	(*GetCrawlJobResponse)(nil),        // 16: crawlerapi.GetCrawlJobResponse
+	(*ListCrawlJobsRequest)(nil),       // 17: crawlerapi.ListCrawlJobsRequest
+	(*ListCrawlJobsResponse)(nil),      // 18: crawlerapi.ListCrawlJobsResponse
+	(*timestamppb.Timestamp)(nil),      // 19: google.protobuf.Timestamp
 }
 var file_crawlerapi_crawler_proto_depIdxs = []int32{
-	2, // 0: crawlerapi.CrawlerService.CrawlCookpadUser:input_type -> crawlerapi.CrawlCookpadUserRequest
-	0, // 1: crawlerapi.CrawlerService.CrawlCookpadRecipe:input_type -> crawlerapi.CrawlCookpadRecipeRequest
-	4, // 2: crawlerapi.CrawlerService.CrawlRecipe:input_type -> crawlerapi.CrawlRecipeRequest
-	3, // 3: crawlerapi.CrawlerService.CrawlCookpadUser:output_type -> crawlerapi.CrawlCookpadUserResponse
-	1, // 4: crawlerapi.CrawlerService.CrawlCookpadRecipe:output_type -> crawlerapi.CrawlCookpadRecipeResponse
-	5, // 5: crawlerapi.CrawlerService.CrawlRecipe:output_type -> crawlerapi.CrawlRecipeResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	19, // 0: crawlerapi.CrawlSitemapRequest.modified_after:type_name -> google.protobuf.Timestamp
+	2,  // 1: crawlerapi.CrawlJobItem.status:type_name -> crawlerapi.CrawlJobItemStatus
+	0,  // 2: crawlerapi.CrawlJob.type:type_name -> crawlerapi.CrawlJobType
+	1,  // 3: crawlerapi.CrawlJob.status:type_name -> crawlerapi.CrawlJobStatus
+	19, // 4: crawlerapi.CrawlJob.create_time:type_name -> google.protobuf.Timestamp
+	19, // 5: crawlerapi.CrawlJob.finish_time:type_name -> google.protobuf.Timestamp
+	11, // 6: crawlerapi.CrawlJob.items:type_name -> crawlerapi.CrawlJobItem
+	19, // 7: crawlerapi.CrawlJob.update_time:type_name -> google.protobuf.Timestamp
+	12, // 8: crawlerapi.GetCrawlJobResponse.job:type_name -> crawlerapi.CrawlJob
+	1,  // 9: crawlerapi.ListCrawlJobsRequest.status:type_name -> crawlerapi.CrawlJobStatus
+	12, // 10: crawlerapi.ListCrawlJobsResponse.jobs:type_name -> crawlerapi.CrawlJob
+	5,  // 11: crawlerapi.CrawlerService.CrawlCookpadUser:input_type -> crawlerapi.CrawlCookpadUserRequest
+	3,  // 12: crawlerapi.CrawlerService.CrawlCookpadRecipe:input_type -> crawlerapi.CrawlCookpadRecipeRequest
+	7,  // 13: crawlerapi.CrawlerService.CrawlRecipe:input_type -> crawlerapi.CrawlRecipeRequest
+	9,  // 14: crawlerapi.CrawlerService.CrawlSitemap:input_type -> crawlerapi.CrawlSitemapRequest
+	13, // 15: crawlerapi.CrawlerService.RecrawlRecipes:input_type -> crawlerapi.RecrawlRecipesRequest
+	15, // 16: crawlerapi.CrawlerService.GetCrawlJob:input_type -> crawlerapi.GetCrawlJobRequest
+	17, // 17: crawlerapi.CrawlerService.ListCrawlJobs:input_type -> crawlerapi.ListCrawlJobsRequest
+	6,  // 18: crawlerapi.CrawlerService.CrawlCookpadUser:output_type -> crawlerapi.CrawlCookpadUserResponse
+	4,  // 19: crawlerapi.CrawlerService.CrawlCookpadRecipe:output_type -> crawlerapi.CrawlCookpadRecipeResponse
+	8,  // 20: crawlerapi.CrawlerService.CrawlRecipe:output_type -> crawlerapi.CrawlRecipeResponse
+	10, // 21: crawlerapi.CrawlerService.CrawlSitemap:output_type -> crawlerapi.CrawlSitemapResponse
+	14, // 22: crawlerapi.CrawlerService.RecrawlRecipes:output_type -> crawlerapi.RecrawlRecipesResponse
+	16, // 23: crawlerapi.CrawlerService.GetCrawlJob:output_type -> crawlerapi.GetCrawlJobResponse
+	18, // 24: crawlerapi.CrawlerService.ListCrawlJobs:output_type -> crawlerapi.ListCrawlJobsResponse
+	18, // [18:25] is the sub-list for method output_type
+	11, // [11:18] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_crawlerapi_crawler_proto_init() }
@@ -332,13 +1398,14 @@ func file_crawlerapi_crawler_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_crawlerapi_crawler_proto_rawDesc), len(file_crawlerapi_crawler_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      3,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_crawlerapi_crawler_proto_goTypes,
 		DependencyIndexes: file_crawlerapi_crawler_proto_depIdxs,
+		EnumInfos:         file_crawlerapi_crawler_proto_enumTypes,
 		MessageInfos:      file_crawlerapi_crawler_proto_msgTypes,
 	}.Build()
 	File_crawlerapi_crawler_proto = out.File
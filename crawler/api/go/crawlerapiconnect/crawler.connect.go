@@ -42,6 +42,18 @@ const (
 	// CrawlerServiceCrawlRecipeProcedure is the fully-qualified name of the CrawlerService's
 	// CrawlRecipe RPC.
 	CrawlerServiceCrawlRecipeProcedure = "/crawlerapi.CrawlerService/CrawlRecipe"
+	// CrawlerServiceCrawlSitemapProcedure is the fully-qualified name of the CrawlerService's
+	// CrawlSitemap RPC.
+	CrawlerServiceCrawlSitemapProcedure = "/crawlerapi.CrawlerService/CrawlSitemap"
+	// CrawlerServiceRecrawlRecipesProcedure is the fully-qualified name of the CrawlerService's
+	// RecrawlRecipes RPC.
+	CrawlerServiceRecrawlRecipesProcedure = "/crawlerapi.CrawlerService/RecrawlRecipes"
+	// CrawlerServiceGetCrawlJobProcedure is the fully-qualified name of the CrawlerService's
+	// GetCrawlJob RPC.
+	CrawlerServiceGetCrawlJobProcedure = "/crawlerapi.CrawlerService/GetCrawlJob"
+	// CrawlerServiceListCrawlJobsProcedure is the fully-qualified name of the CrawlerService's
+	// ListCrawlJobs RPC.
+	CrawlerServiceListCrawlJobsProcedure = "/crawlerapi.CrawlerService/ListCrawlJobs"
 )
 
 // CrawlerServiceClient is a client for the crawlerapi.CrawlerService service.
@@ -52,6 +64,16 @@ type CrawlerServiceClient interface {
 	CrawlCookpadRecipe(context.Context, *connect.Request[_go.CrawlCookpadRecipeRequest]) (*connect.Response[_go.CrawlCookpadRecipeResponse], error)
 	// Crawl a recipe.
 	CrawlRecipe(context.Context, *connect.Request[_go.CrawlRecipeRequest]) (*connect.Response[_go.CrawlRecipeResponse], error)
+	// Crawl the recipes listed in a sitemap, for sites with an extractor. The crawl runs in
+	// the background, returning the ID of its crawl job without waiting for it to finish.
+	CrawlSitemap(context.Context, *connect.Request[_go.CrawlSitemapRequest]) (*connect.Response[_go.CrawlSitemapResponse], error)
+	// Crawl a batch of recipes again that were last crawled long ago, updating those whose
+	// pages have changed. Called periodically by a scheduler rather than by users.
+	RecrawlRecipes(context.Context, *connect.Request[_go.RecrawlRecipesRequest]) (*connect.Response[_go.RecrawlRecipesResponse], error)
+	// Get a crawl job with the results of each item.
+	GetCrawlJob(context.Context, *connect.Request[_go.GetCrawlJobRequest]) (*connect.Response[_go.GetCrawlJobResponse], error)
+	// List crawl jobs, most recent first.
+	ListCrawlJobs(context.Context, *connect.Request[_go.ListCrawlJobsRequest]) (*connect.Response[_go.ListCrawlJobsResponse], error)
 }
 
 // NewCrawlerServiceClient constructs a client for the crawlerapi.CrawlerService service. By
@@ -83,6 +105,30 @@ func NewCrawlerServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(crawlerServiceMethods.ByName("CrawlRecipe")),
 			connect.WithClientOptions(opts...),
 		),
+		crawlSitemap: connect.NewClient[_go.CrawlSitemapRequest, _go.CrawlSitemapResponse](
+			httpClient,
+			baseURL+CrawlerServiceCrawlSitemapProcedure,
+			connect.WithSchema(crawlerServiceMethods.ByName("CrawlSitemap")),
+			connect.WithClientOptions(opts...),
+		),
+		recrawlRecipes: connect.NewClient[_go.RecrawlRecipesRequest, _go.RecrawlRecipesResponse](
+			httpClient,
+			baseURL+CrawlerServiceRecrawlRecipesProcedure,
+			connect.WithSchema(crawlerServiceMethods.ByName("RecrawlRecipes")),
+			connect.WithClientOptions(opts...),
+		),
+		getCrawlJob: connect.NewClient[_go.GetCrawlJobRequest, _go.GetCrawlJobResponse](
+			httpClient,
+			baseURL+CrawlerServiceGetCrawlJobProcedure,
+			connect.WithSchema(crawlerServiceMethods.ByName("GetCrawlJob")),
+			connect.WithClientOptions(opts...),
+		),
+		listCrawlJobs: connect.NewClient[_go.ListCrawlJobsRequest, _go.ListCrawlJobsResponse](
+			httpClient,
+			baseURL+CrawlerServiceListCrawlJobsProcedure,
+			connect.WithSchema(crawlerServiceMethods.ByName("ListCrawlJobs")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -91,6 +137,10 @@ type crawlerServiceClient struct {
 	crawlCookpadUser   *connect.Client[_go.CrawlCookpadUserRequest, _go.CrawlCookpadUserResponse]
 	crawlCookpadRecipe *connect.Client[_go.CrawlCookpadRecipeRequest, _go.CrawlCookpadRecipeResponse]
 	crawlRecipe        *connect.Client[_go.CrawlRecipeRequest, _go.CrawlRecipeResponse]
+	crawlSitemap       *connect.Client[_go.CrawlSitemapRequest, _go.CrawlSitemapResponse]
+	recrawlRecipes     *connect.Client[_go.RecrawlRecipesRequest, _go.RecrawlRecipesResponse]
+	getCrawlJob        *connect.Client[_go.GetCrawlJobRequest, _go.GetCrawlJobResponse]
+	listCrawlJobs      *connect.Client[_go.ListCrawlJobsRequest, _go.ListCrawlJobsResponse]
 }
 
 // CrawlCookpadUser calls crawlerapi.CrawlerService.CrawlCookpadUser.
@@ -108,6 +158,26 @@ func (c *crawlerServiceClient) CrawlRecipe(ctx context.Context, req *connect.Req
 	return c.crawlRecipe.CallUnary(ctx, req)
 }
 
+// CrawlSitemap calls crawlerapi.CrawlerService.CrawlSitemap.
+func (c *crawlerServiceClient) CrawlSitemap(ctx context.Context, req *connect.Request[_go.CrawlSitemapRequest]) (*connect.Response[_go.CrawlSitemapResponse], error) {
+	return c.crawlSitemap.CallUnary(ctx, req)
+}
+
+// RecrawlRecipes calls crawlerapi.CrawlerService.RecrawlRecipes.
+func (c *crawlerServiceClient) RecrawlRecipes(ctx context.Context, req *connect.Request[_go.RecrawlRecipesRequest]) (*connect.Response[_go.RecrawlRecipesResponse], error) {
+	return c.recrawlRecipes.CallUnary(ctx, req)
+}
+
+// GetCrawlJob calls crawlerapi.CrawlerService.GetCrawlJob.
+func (c *crawlerServiceClient) GetCrawlJob(ctx context.Context, req *connect.Request[_go.GetCrawlJobRequest]) (*connect.Response[_go.GetCrawlJobResponse], error) {
+	return c.getCrawlJob.CallUnary(ctx, req)
+}
+
+// ListCrawlJobs calls crawlerapi.CrawlerService.ListCrawlJobs.
+func (c *crawlerServiceClient) ListCrawlJobs(ctx context.Context, req *connect.Request[_go.ListCrawlJobsRequest]) (*connect.Response[_go.ListCrawlJobsResponse], error) {
+	return c.listCrawlJobs.CallUnary(ctx, req)
+}
+
 // CrawlerServiceHandler is an implementation of the crawlerapi.CrawlerService service.
 type CrawlerServiceHandler interface {
 	// Crawl a cookpad user, crawling all of their recipes.
@@ -116,6 +186,16 @@ type CrawlerServiceHandler interface {
 	CrawlCookpadRecipe(context.Context, *connect.Request[_go.CrawlCookpadRecipeRequest]) (*connect.Response[_go.CrawlCookpadRecipeResponse], error)
 	// Crawl a recipe.
 	CrawlRecipe(context.Context, *connect.Request[_go.CrawlRecipeRequest]) (*connect.Response[_go.CrawlRecipeResponse], error)
+	// Crawl the recipes listed in a sitemap, for sites with an extractor. The crawl runs in
+	// the background, returning the ID of its crawl job without waiting for it to finish.
+	CrawlSitemap(context.Context, *connect.Request[_go.CrawlSitemapRequest]) (*connect.Response[_go.CrawlSitemapResponse], error)
+	// Crawl a batch of recipes again that were last crawled long ago, updating those whose
+	// pages have changed. Called periodically by a scheduler rather than by users.
+	RecrawlRecipes(context.Context, *connect.Request[_go.RecrawlRecipesRequest]) (*connect.Response[_go.RecrawlRecipesResponse], error)
+	// Get a crawl job with the results of each item.
+	GetCrawlJob(context.Context, *connect.Request[_go.GetCrawlJobRequest]) (*connect.Response[_go.GetCrawlJobResponse], error)
+	// List crawl jobs, most recent first.
+	ListCrawlJobs(context.Context, *connect.Request[_go.ListCrawlJobsRequest]) (*connect.Response[_go.ListCrawlJobsResponse], error)
 }
 
 // NewCrawlerServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -143,6 +223,30 @@ func NewCrawlerServiceHandler(svc CrawlerServiceHandler, opts ...connect.Handler
 		connect.WithSchema(crawlerServiceMethods.ByName("CrawlRecipe")),
 		connect.WithHandlerOptions(opts...),
 	)
+	crawlerServiceCrawlSitemapHandler := connect.NewUnaryHandler(
+		CrawlerServiceCrawlSitemapProcedure,
+		svc.CrawlSitemap,
+		connect.WithSchema(crawlerServiceMethods.ByName("CrawlSitemap")),
+		connect.WithHandlerOptions(opts...),
+	)
+	crawlerServiceRecrawlRecipesHandler := connect.NewUnaryHandler(
+		CrawlerServiceRecrawlRecipesProcedure,
+		svc.RecrawlRecipes,
+		connect.WithSchema(crawlerServiceMethods.ByName("RecrawlRecipes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	crawlerServiceGetCrawlJobHandler := connect.NewUnaryHandler(
+		CrawlerServiceGetCrawlJobProcedure,
+		svc.GetCrawlJob,
+		connect.WithSchema(crawlerServiceMethods.ByName("GetCrawlJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	crawlerServiceListCrawlJobsHandler := connect.NewUnaryHandler(
+		CrawlerServiceListCrawlJobsProcedure,
+		svc.ListCrawlJobs,
+		connect.WithSchema(crawlerServiceMethods.ByName("ListCrawlJobs")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/crawlerapi.CrawlerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case CrawlerServiceCrawlCookpadUserProcedure:
@@ -151,6 +255,14 @@ func NewCrawlerServiceHandler(svc CrawlerServiceHandler, opts ...connect.Handler
 			crawlerServiceCrawlCookpadRecipeHandler.ServeHTTP(w, r)
 		case CrawlerServiceCrawlRecipeProcedure:
 			crawlerServiceCrawlRecipeHandler.ServeHTTP(w, r)
+		case CrawlerServiceCrawlSitemapProcedure:
+			crawlerServiceCrawlSitemapHandler.ServeHTTP(w, r)
+		case CrawlerServiceRecrawlRecipesProcedure:
+			crawlerServiceRecrawlRecipesHandler.ServeHTTP(w, r)
+		case CrawlerServiceGetCrawlJobProcedure:
+			crawlerServiceGetCrawlJobHandler.ServeHTTP(w, r)
+		case CrawlerServiceListCrawlJobsProcedure:
+			crawlerServiceListCrawlJobsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -171,3 +283,19 @@ func (UnimplementedCrawlerServiceHandler) CrawlCookpadRecipe(context.Context, *c
 func (UnimplementedCrawlerServiceHandler) CrawlRecipe(context.Context, *connect.Request[_go.CrawlRecipeRequest]) (*connect.Response[_go.CrawlRecipeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crawlerapi.CrawlerService.CrawlRecipe is not implemented"))
 }
+
+func (UnimplementedCrawlerServiceHandler) CrawlSitemap(context.Context, *connect.Request[_go.CrawlSitemapRequest]) (*connect.Response[_go.CrawlSitemapResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crawlerapi.CrawlerService.CrawlSitemap is not implemented"))
+}
+
+func (UnimplementedCrawlerServiceHandler) RecrawlRecipes(context.Context, *connect.Request[_go.RecrawlRecipesRequest]) (*connect.Response[_go.RecrawlRecipesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crawlerapi.CrawlerService.RecrawlRecipes is not implemented"))
+}
+
+func (UnimplementedCrawlerServiceHandler) GetCrawlJob(context.Context, *connect.Request[_go.GetCrawlJobRequest]) (*connect.Response[_go.GetCrawlJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crawlerapi.CrawlerService.GetCrawlJob is not implemented"))
+}
+
+func (UnimplementedCrawlerServiceHandler) ListCrawlJobs(context.Context, *connect.Request[_go.ListCrawlJobsRequest]) (*connect.Response[_go.ListCrawlJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("crawlerapi.CrawlerService.ListCrawlJobs is not implemented"))
+}
@@ -4,9 +4,34 @@
 package config
 
 import (
+	"time"
+
 	"github.com/curioswitch/go-curiostack/config"
 )
 
+// Politeness configures how the crawler limits its requests to each site. Unset values use
+// defaults.
+type Politeness struct {
+	// Concurrency is the maximum number of concurrent requests to a host.
+	Concurrency int `koanf:"concurrency"`
+	// Delay is the minimum time between starting requests to a host. A longer Crawl-delay in
+	// the robots.txt of the host takes precedence.
+	Delay time.Duration `koanf:"delay"`
+	// MaxRetries is the maximum number of times to retry a request the host rejected with
+	// 429 or 503.
+	MaxRetries int `koanf:"maxretries"`
+}
+
+// Recrawl configures the scheduled re-crawling of recipes to pick up changes to their pages.
+// Unset values use defaults.
+type Recrawl struct {
+	// MaxAge is how long after a recipe was last crawled it is crawled again.
+	MaxAge time.Duration `koanf:"maxage"`
+	// BatchSize is the maximum number of recipes crawled again each time re-crawling is
+	// scheduled.
+	BatchSize int `koanf:"batchsize"`
+}
+
 // Services are URLs to access other services.
 type Services struct {
 	// Crawler is the URL to access the crawler service.
@@ -16,5 +41,15 @@ type Services struct {
 type Config struct {
 	Services Services `koanf:"services"`
 
+	// SiteURLs overrides the base URLs of recipe sites by their recipe source, such as
+	// cookpad, for example to crawl a local server. Unset sites use their actual URL.
+	SiteURLs map[string]string `koanf:"siteurls"`
+
+	// Politeness is the configuration for limiting requests to sites.
+	Politeness Politeness `koanf:"politeness"`
+
+	// Recrawl is the configuration for re-crawling recipes.
+	Recrawl Recrawl `koanf:"recrawl"`
+
 	config.Common
 }
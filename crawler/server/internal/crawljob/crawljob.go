@@ -0,0 +1,255 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package crawljob records crawls requested from the crawler in the crawlJobs collection.
+package crawljob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+const (
+	// progressInterval is how often the progress of a running job is recorded.
+	progressInterval = 10 * time.Second
+
+	// staleAfter is how long a running job can go without recording progress before it is
+	// considered stopped, for example because its server shut down.
+	staleAfter = 10 * time.Minute
+)
+
+var errStale = errors.New("crawljob: job stopped without finishing")
+
+// writeFunc records job and new items of the job, keyed by document ID.
+type writeFunc func(ctx context.Context, job cookchatdb.CrawlJob, items map[string]cookchatdb.CrawlJobItem) error
+
+// Start records the start of a crawl job. ID, Status, CreatedAt and UpdatedAt of job are
+// populated. Progress of the job is recorded periodically until it is finished.
+func Start(ctx context.Context, store *firestore.Client, job cookchatdb.CrawlJob) (*Job, error) {
+	doc := store.Collection("crawlJobs").NewDoc()
+	job.ID = doc.ID
+	job.Status = cookchatdb.CrawlJobStatusRunning
+	job.CreatedAt = time.Now()
+	job.UpdatedAt = job.CreatedAt
+	if _, err := doc.Create(ctx, job); err != nil {
+		return nil, fmt.Errorf("crawljob: creating job: %w", err)
+	}
+	write := func(ctx context.Context, job cookchatdb.CrawlJob, items map[string]cookchatdb.CrawlJobItem) error {
+		return writeJob(ctx, store, doc, job, items)
+	}
+	// Jobs can outlive the request that started them.
+	return newJob(context.WithoutCancel(ctx), job, write, progressInterval), nil
+}
+
+func newJob(ctx context.Context, job cookchatdb.CrawlJob, write writeFunc, interval time.Duration) *Job {
+	j := &Job{
+		write:   write,
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+		job:     job,
+		pending: map[string]cookchatdb.CrawlJobItem{},
+	}
+	go j.recordProgress(ctx, interval)
+	return j
+}
+
+// Job is a running crawl job. Items can be added concurrently.
+type Job struct {
+	write   writeFunc
+	stop    chan struct{}
+	stopped chan struct{}
+
+	// writeMu serializes writes so the latest counts are not overwritten by an earlier write.
+	writeMu sync.Mutex
+
+	mu       sync.Mutex
+	job      cookchatdb.CrawlJob
+	numItems int
+	pending  map[string]cookchatdb.CrawlJobItem
+}
+
+// ID returns the ID of the job.
+func (j *Job) ID() string {
+	return j.job.ID
+}
+
+// SetDiscovered sets the number of recipes discovered to crawl.
+func (j *Job) SetDiscovered(n int) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.job.Discovered = n
+}
+
+// AddItem records the result of crawling an item. It is written with the next progress of
+// the job.
+func (j *Job) AddItem(item cookchatdb.CrawlJobItem) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	switch item.Status {
+	case cookchatdb.CrawlJobItemStatusCrawled:
+		j.job.Crawled++
+	case cookchatdb.CrawlJobItemStatusSkipped:
+		j.job.Skipped++
+	case cookchatdb.CrawlJobItemStatusFailed:
+		j.job.Failed++
+	}
+
+	// Sequential IDs keep items in the order they were crawled and make retried writes
+	// idempotent.
+	j.pending[fmt.Sprintf("%08d", j.numItems)] = item
+	j.numItems++
+}
+
+// Finish records the end of the job, as failed with err if non-nil. It returns the recorded
+// job.
+func (j *Job) Finish(ctx context.Context, err error) (cookchatdb.CrawlJob, error) {
+	close(j.stop)
+	<-j.stopped
+
+	j.mu.Lock()
+	j.job.Status = cookchatdb.CrawlJobStatusSucceeded
+	if err != nil {
+		j.job.Status = cookchatdb.CrawlJobStatusFailed
+		j.job.Error = err.Error()
+	}
+	j.job.FinishedAt = time.Now()
+	j.mu.Unlock()
+
+	// Record the job even if the request was cancelled.
+	job, err := j.flush(context.WithoutCancel(ctx))
+	if err != nil {
+		return job, fmt.Errorf("crawljob: updating job: %w", err)
+	}
+	return job, nil
+}
+
+// recordProgress writes the progress of the job every interval until it is finished. Jobs
+// without new items are still written so they are not considered stale.
+func (j *Job) recordProgress(ctx context.Context, interval time.Duration) {
+	defer close(j.stopped)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-j.stop:
+			return
+		case <-ticker.C:
+			if _, err := j.flush(ctx); err != nil {
+				// Progress is best-effort, the job is written again when it finishes.
+				slog.WarnContext(ctx, "crawljob: recording progress", "jobId", j.job.ID, "error", err)
+			}
+		}
+	}
+}
+
+// flush writes the job and its pending items, returning the written job. Items that fail to
+// write are kept pending to be written again.
+func (j *Job) flush(ctx context.Context) (cookchatdb.CrawlJob, error) {
+	j.writeMu.Lock()
+	defer j.writeMu.Unlock()
+
+	j.mu.Lock()
+	j.job.UpdatedAt = time.Now()
+	job := j.job
+	items := j.pending
+	j.pending = map[string]cookchatdb.CrawlJobItem{}
+	j.mu.Unlock()
+
+	if err := j.write(ctx, job, items); err != nil {
+		j.mu.Lock()
+		for id, item := range items {
+			j.pending[id] = item
+		}
+		j.mu.Unlock()
+		return job, err
+	}
+	return job, nil
+}
+
+// writeJob writes job to doc and items to its items subcollection.
+func writeJob(ctx context.Context, store *firestore.Client, doc *firestore.DocumentRef, job cookchatdb.CrawlJob, items map[string]cookchatdb.CrawlJobItem) error {
+	bw := store.BulkWriter(ctx)
+	writes := make([]*firestore.BulkWriterJob, 0, len(items)+1)
+	for id, item := range items {
+		w, err := bw.Set(doc.Collection("items").Doc(id), item)
+		if err != nil {
+			bw.End()
+			return fmt.Errorf("crawljob: writing item: %w", err)
+		}
+		writes = append(writes, w)
+	}
+	w, err := bw.Set(doc, job)
+	if err != nil {
+		bw.End()
+		return fmt.Errorf("crawljob: writing job: %w", err)
+	}
+	writes = append(writes, w)
+	bw.End()
+
+	for _, w := range writes {
+		if _, err := w.Results(); err != nil {
+			return fmt.Errorf("crawljob: writing job: %w", err)
+		}
+	}
+	return nil
+}
+
+// FailStale marks running jobs that have not recorded progress recently as failed. Jobs stop
+// without finishing if their server shuts down while they run.
+func FailStale(ctx context.Context, store *firestore.Client) error {
+	docs, err := store.Collection("crawlJobs").
+		Where("status", "==", cookchatdb.CrawlJobStatusRunning).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return fmt.Errorf("crawljob: fetching running jobs: %w", err)
+	}
+
+	now := time.Now()
+	for _, doc := range docs {
+		var job cookchatdb.CrawlJob
+		if err := doc.DataTo(&job); err != nil {
+			return fmt.Errorf("crawljob: decoding job: %w", err)
+		}
+		if !isStale(&job, now) {
+			continue
+		}
+		if _, err := doc.Ref.Update(ctx, []firestore.Update{
+			{Path: "status", Value: cookchatdb.CrawlJobStatusFailed},
+			{Path: "error", Value: errStale.Error()},
+			{Path: "finishedAt", Value: now},
+		}, firestore.LastUpdateTime(doc.UpdateTime)); err != nil {
+			if status.Code(err) == codes.FailedPrecondition {
+				// The job recorded progress after all.
+				continue
+			}
+			return fmt.Errorf("crawljob: failing stale job: %w", err)
+		}
+		slog.InfoContext(ctx, "crawljob: failed stale job", "jobId", job.ID)
+	}
+	return nil
+}
+
+// isStale returns whether the running job has not recorded progress within staleAfter of now.
+func isStale(job *cookchatdb.CrawlJob, now time.Time) bool {
+	updated := job.UpdatedAt
+	if updated.IsZero() {
+		// Jobs from before progress was recorded.
+		updated = job.CreatedAt
+	}
+	return now.Sub(updated) > staleAfter
+}
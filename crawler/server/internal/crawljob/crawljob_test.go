@@ -0,0 +1,159 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package crawljob
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+type fakeWriter struct {
+	mu    sync.Mutex
+	fail  bool
+	jobs  []cookchatdb.CrawlJob
+	items map[string]cookchatdb.CrawlJobItem
+}
+
+func (w *fakeWriter) write(_ context.Context, job cookchatdb.CrawlJob, items map[string]cookchatdb.CrawlJobItem) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.fail {
+		return errors.New("write failed")
+	}
+	w.jobs = append(w.jobs, job)
+	if w.items == nil {
+		w.items = map[string]cookchatdb.CrawlJobItem{}
+	}
+	maps.Copy(w.items, items)
+	return nil
+}
+
+func (w *fakeWriter) numWrites() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return len(w.jobs)
+}
+
+func TestJobRecordsProgress(t *testing.T) {
+	w := &fakeWriter{}
+	j := newJob(t.Context(), cookchatdb.CrawlJob{ID: "job"}, w.write, time.Millisecond)
+
+	j.SetDiscovered(2)
+	j.AddItem(cookchatdb.CrawlJobItem{URL: "a", Status: cookchatdb.CrawlJobItemStatusCrawled})
+
+	deadline := time.Now().Add(5 * time.Second)
+	for w.numWrites() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("progress not recorded before finishing")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	j.AddItem(cookchatdb.CrawlJobItem{URL: "b", Status: cookchatdb.CrawlJobItemStatusFailed, Error: "boom"})
+	job, err := j.Finish(t.Context(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if job.Status != cookchatdb.CrawlJobStatusSucceeded || job.FinishedAt.IsZero() {
+		t.Errorf("got status %q finished at %v, want succeeded", job.Status, job.FinishedAt)
+	}
+	if job.Discovered != 2 || job.Crawled != 1 || job.Failed != 1 {
+		t.Errorf("got counts %d/%d/%d, want 2/1/1", job.Discovered, job.Crawled, job.Failed)
+	}
+
+	last := w.jobs[len(w.jobs)-1]
+	if last.Status != cookchatdb.CrawlJobStatusSucceeded {
+		t.Errorf("last write has status %q, want succeeded", last.Status)
+	}
+	for _, job := range w.jobs[:len(w.jobs)-1] {
+		if job.Status == cookchatdb.CrawlJobStatusSucceeded {
+			t.Error("progress written with finished status")
+		}
+		if job.UpdatedAt.IsZero() {
+			t.Error("progress written without update time")
+		}
+	}
+
+	ids := slices.Sorted(maps.Keys(w.items))
+	if want := []string{"00000000", "00000001"}; !slices.Equal(ids, want) {
+		t.Fatalf("got item IDs %v, want %v", ids, want)
+	}
+	if w.items[ids[0]].URL != "a" || w.items[ids[1]].URL != "b" {
+		t.Errorf("items written out of order: %v", w.items)
+	}
+}
+
+func TestJobRetriesFailedWrites(t *testing.T) {
+	w := &fakeWriter{fail: true}
+	// Long interval so only Finish writes.
+	j := newJob(t.Context(), cookchatdb.CrawlJob{ID: "job"}, w.write, time.Hour)
+
+	j.AddItem(cookchatdb.CrawlJobItem{URL: "a", Status: cookchatdb.CrawlJobItemStatusCrawled})
+	if _, err := j.flush(t.Context()); err == nil {
+		t.Fatal("expected error")
+	}
+
+	w.fail = false
+	if _, err := j.Finish(t.Context(), errors.New("crawl failed")); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(w.jobs) != 1 {
+		t.Fatalf("got %d writes, want 1", len(w.jobs))
+	}
+	if job := w.jobs[0]; job.Status != cookchatdb.CrawlJobStatusFailed || job.Error != "crawl failed" {
+		t.Errorf("got status %q error %q, want failed", job.Status, job.Error)
+	}
+	if _, ok := w.items["00000000"]; !ok || len(w.items) != 1 {
+		t.Errorf("got items %v, want the item that failed to write", w.items)
+	}
+}
+
+func TestIsStale(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name string
+		job  cookchatdb.CrawlJob
+		want bool
+	}{
+		{
+			name: "recently updated",
+			job:  cookchatdb.CrawlJob{CreatedAt: now.Add(-time.Hour), UpdatedAt: now.Add(-time.Minute)},
+			want: false,
+		},
+		{
+			name: "not updated for long",
+			job:  cookchatdb.CrawlJob{CreatedAt: now.Add(-time.Hour), UpdatedAt: now.Add(-staleAfter - time.Minute)},
+			want: true,
+		},
+		{
+			name: "old job recently created",
+			job:  cookchatdb.CrawlJob{CreatedAt: now.Add(-time.Minute)},
+			want: false,
+		},
+		{
+			name: "old job created long ago",
+			job:  cookchatdb.CrawlJob{CreatedAt: now.Add(-time.Hour)},
+			want: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isStale(&tc.job, now); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
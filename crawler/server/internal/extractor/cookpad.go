@@ -0,0 +1,134 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package extractor
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+func init() {
+	register(cookpad{})
+}
+
+type cookpad struct{}
+
+func (cookpad) Source() cookchatdb.RecipeSource {
+	return cookchatdb.RecipeSourceCookpad
+}
+
+func (cookpad) Match(u *url.URL) (string, bool) {
+	if u.Host != "cookpad.com" {
+		return "", false
+	}
+	id, ok := strings.CutPrefix(u.Path, "/jp/recipes/")
+	if !ok {
+		return "", false
+	}
+	if _, err := strconv.Atoi(id); err != nil {
+		return "", false
+	}
+	return id, true
+}
+
+func (cookpad) URL(sourceID string) string {
+	return "https://cookpad.com/jp/recipes/" + sourceID
+}
+
+func (cookpad) Extract(doc *goquery.Document, sourceID string) (*cookchatdb.Recipe, error) {
+	e := doc.Find("div[id=recipe]").First()
+	if e.Length() == 0 {
+		return nil, ErrNoRecipe
+	}
+
+	title := childText(e, "h1")
+	userID := strings.TrimPrefix(e.Find(`a[href^="/jp/users/"]`).AttrOr("href", ""), "/jp/users/")
+	description := childText(e, "h1 ~ div:last-child")
+	servingSize := childText(e, "#serving_recipe_"+sourceID)
+
+	var baseIngredients []cookchatdb.RecipeIngredient
+
+	var additionalSections []cookchatdb.IngredientSection
+	var curSection *cookchatdb.IngredientSection
+	curIngedients := &baseIngredients
+
+	e.Find(".ingredient-list ol > li").Each(func(_ int, li *goquery.Selection) {
+		if strings.Contains(li.AttrOr("class", ""), "not-headline") {
+			*curIngedients = append(*curIngedients, cookchatdb.RecipeIngredient{
+				Name:     childText(li, "*:first-child"),
+				Quantity: childText(li, "*:last-child"),
+			})
+		} else {
+			if curSection != nil {
+				additionalSections = append(additionalSections, *curSection)
+			}
+			curSection = &cookchatdb.IngredientSection{
+				Title:       childText(li, "*:first-child"),
+				Ingredients: []cookchatdb.RecipeIngredient{},
+			}
+			curIngedients = &curSection.Ingredients
+		}
+	})
+	if curSection != nil {
+		additionalSections = append(additionalSections, *curSection)
+	}
+
+	stepNodes := e.Find("#steps ol > li")
+	steps := make([]cookchatdb.RecipeStep, stepNodes.Length())
+	for i, node := range stepNodes.EachIter() {
+		steps[i] = cookchatdb.RecipeStep{
+			Description: strings.TrimSpace(node.Children().Last().Text()),
+			ImageURL:    cookpadImageURL(node.Find("img").AttrOr("src", "")),
+		}
+	}
+
+	return &cookchatdb.Recipe{
+		Source:   cookchatdb.RecipeSourceCookpad,
+		SourceID: sourceID,
+		UserID:   userID,
+		ImageURL: cookpadImageURL(e.Find("img").AttrOr("src", "")),
+		Content: cookchatdb.RecipeContent{
+			Title:                 title,
+			Description:           description,
+			Ingredients:           baseIngredients,
+			AdditionalIngredients: additionalSections,
+			Steps:                 steps,
+			ServingSize:           servingSize,
+		},
+		LanguageCode: "ja",
+	}, nil
+}
+
+func (cookpad) Rewrite() bool {
+	return false
+}
+
+// cookpadImageURL returns the URL of a consistently sized version of the image at imageURL.
+func cookpadImageURL(imageURL string) string {
+	if !strings.HasPrefix(imageURL, "https://img-global-jp.cpcdn.com/") {
+		return imageURL
+	}
+
+	slashIdx := strings.LastIndexByte(imageURL, '/')
+	if slashIdx == -1 {
+		return imageURL
+	}
+	base := imageURL[:slashIdx]
+	slashIdx = strings.LastIndexByte(base, '/')
+	if slashIdx == -1 {
+		return imageURL
+	}
+	return base[:slashIdx] + "/640x640sq70/photo.jpg"
+}
+
+// childText returns the trimmed text of the descendants of s matching selector, like
+// colly.HTMLElement.ChildText.
+func childText(s *goquery.Selection, selector string) string {
+	return strings.TrimSpace(s.Find(selector).Text())
+}
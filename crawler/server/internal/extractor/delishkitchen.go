@@ -0,0 +1,46 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package extractor
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+func init() {
+	register(delishKitchen{})
+}
+
+type delishKitchen struct{}
+
+func (delishKitchen) Source() cookchatdb.RecipeSource {
+	return cookchatdb.RecipeSourceDelishKitchen
+}
+
+func (delishKitchen) Match(u *url.URL) (string, bool) {
+	if u.Host != "delishkitchen.tv" {
+		return "", false
+	}
+	id, ok := strings.CutPrefix(u.Path, "/recipes/")
+	if !ok || id == "" || strings.Contains(id, "/") {
+		return "", false
+	}
+	return id, true
+}
+
+func (delishKitchen) URL(sourceID string) string {
+	return "https://delishkitchen.tv/recipes/" + sourceID
+}
+
+func (delishKitchen) Extract(doc *goquery.Document, sourceID string) (*cookchatdb.Recipe, error) {
+	return extractSchemaRecipe(doc, cookchatdb.RecipeSourceDelishKitchen, sourceID)
+}
+
+func (delishKitchen) Rewrite() bool {
+	return true
+}
@@ -0,0 +1,105 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package extractor extracts recipes from the pages of recipe sites. Each site is
+// implemented in its own file, registering its Extractor from init.
+package extractor
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+// ErrNoRecipe is returned when a page does not contain a recipe.
+var ErrNoRecipe = errors.New("extractor: no recipe found in page")
+
+// Extractor extracts recipes from the pages of a site.
+type Extractor interface {
+	// Source returns the source of recipes from the site.
+	Source() cookchatdb.RecipeSource
+
+	// Match returns the ID of the recipe within the site for the recipe page at u, or false
+	// if u is not a recipe page of the site.
+	Match(u *url.URL) (string, bool)
+
+	// URL returns the canonical URL of the recipe page for the recipe with sourceID.
+	URL(sourceID string) string
+
+	// Extract extracts the recipe with sourceID from its page. The returned recipe has
+	// its source, content and image URLs populated, with images still pointing to the site.
+	Extract(doc *goquery.Document, sourceID string) (*cookchatdb.Recipe, error)
+
+	// Rewrite returns whether the content of recipes from the site is rewritten by AI
+	// rather than used as-is.
+	Rewrite() bool
+}
+
+var extractors []Extractor
+
+// register adds e to the registered extractors.
+func register(e Extractor) {
+	extractors = append(extractors, e)
+}
+
+// Match returns the extractor for the recipe page at rawURL along with the ID of the recipe
+// within its site. Pages of sites without a registered extractor are matched by a generic
+// extractor of schema.org recipes. Returns false if rawURL is not a web page URL.
+func Match(rawURL string) (Extractor, string, bool) {
+	if e, id, ok := MatchSite(rawURL); ok {
+		return e, id, true
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, "", false
+	}
+	if id, ok := (web{}).Match(u); ok {
+		return web{}, id, true
+	}
+	return nil, "", false
+}
+
+// MatchSite is like Match but only matches the recipe pages of sites with a registered
+// extractor, for example to find recipes among all the pages of a site.
+func MatchSite(rawURL string) (Extractor, string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, "", false
+	}
+	for _, e := range extractors {
+		if id, ok := e.Match(u); ok {
+			return e, id, true
+		}
+	}
+	return nil, "", false
+}
+
+// DocumentID returns the ID of the document of the recipe with sourceID from the site of ext.
+// IDs of recipes matched by the generic extractor are URLs, which can exceed the size limit of
+// document IDs, so they are hashed.
+func DocumentID(ext Extractor, sourceID string) string {
+	if ext.Source() == cookchatdb.RecipeSourceWeb {
+		sum := sha256.Sum256([]byte(sourceID))
+		sourceID = hex.EncodeToString(sum[:])
+	}
+	return fmt.Sprintf("%s-%s", ext.Source(), sourceID)
+}
+
+// ForSource returns the extractor for source, or false if there is none.
+func ForSource(source cookchatdb.RecipeSource) (Extractor, bool) {
+	for _, e := range extractors {
+		if e.Source() == source {
+			return e, true
+		}
+	}
+	if source == cookchatdb.RecipeSourceWeb {
+		return web{}, true
+	}
+	return nil, false
+}
@@ -0,0 +1,380 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package extractor
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+var (
+	update = flag.Bool("update", false, "update golden files with the extracted recipes")
+	record = flag.Bool("record", false, "record the recipe pages from the live sites, used with -update")
+)
+
+// fixtureETag is the ETag of all recorded pages served by the fixture server.
+const fixtureETag = `"fixture"`
+
+// webSourceID is the source ID of the recorded page of the generic extractor.
+var webSourceID = url.PathEscape("https://example.com/recipes/chicken-curry")
+
+// newFixtureServer returns a server serving the recorded page at testdata/<source>/<name>.html
+// at the path of the recipe page of the recipe with sourceID from the site of ext. Conditional
+// requests are supported with fixtureETag.
+func newFixtureServer(t *testing.T, ext Extractor, sourceID string, name string) *httptest.Server {
+	t.Helper()
+
+	page, err := os.ReadFile(filepath.Join("testdata", string(ext.Source()), name+".html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(ext.URL(sourceID))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != u.Path {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("ETag", fixtureETag)
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(page))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// recordPage fetches the recipe page of the recipe with sourceID from the live site of ext and
+// saves it to testdata/<source>/<sourceID>.html. The page is trimmed of scripts, styles, and
+// other markup not read by extractors to keep it small, leaving the DOM structure intact.
+func recordPage(t *testing.T, ext Extractor, sourceID string) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, ext.URL(sourceID), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("recording %s: got status %d", req.URL, res.StatusCode)
+	}
+	doc, err := goquery.NewDocumentFromReader(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc.Find(`script:not([type="application/ld+json"]), style, noscript, svg, iframe, link, template`).Remove()
+	doc.Find("head meta:not([charset])").Remove()
+	doc.Find("*").Each(func(_ int, s *goquery.Selection) {
+		var remove []string
+		for _, attr := range s.Nodes[0].Attr {
+			if attr.Key == "style" || strings.HasPrefix(attr.Key, "data-") || strings.HasPrefix(attr.Key, "aria-") {
+				remove = append(remove, attr.Key)
+			}
+		}
+		for _, key := range remove {
+			s.RemoveAttr(key)
+		}
+	})
+
+	page, err := goquery.OuterHtml(doc.Selection)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join("testdata", string(ext.Source()), sourceID+".html")
+	if err := os.WriteFile(path, []byte("<!DOCTYPE html>\n"+page+"\n"), 0o644); err != nil { //nolint:gosec // test data
+		t.Fatal(err)
+	}
+}
+
+// goldenRecipe is the part of a recipe populated by extractors, compared with the golden
+// files. Other fields are bookkeeping of the crawler and recipe processing.
+type goldenRecipe struct {
+	Source        cookchatdb.RecipeSource  `json:"source"`
+	SourceID      string                   `json:"sourceId"`
+	UserID        string                   `json:"userId,omitempty"`
+	ImageURL      string                   `json:"imageUrl"`
+	StepImageURLs []string                 `json:"stepImageUrls,omitempty"`
+	TotalMinutes  int                      `json:"totalMinutes,omitempty"`
+	LanguageCode  string                   `json:"languageCode"`
+	Content       cookchatdb.RecipeContent `json:"content"`
+}
+
+func newGoldenRecipe(recipe *cookchatdb.Recipe) goldenRecipe {
+	return goldenRecipe{
+		Source:        recipe.Source,
+		SourceID:      recipe.SourceID,
+		UserID:        recipe.UserID,
+		ImageURL:      recipe.ImageURL,
+		StepImageURLs: recipe.StepImageURLs,
+		TotalMinutes:  recipe.TotalMinutes,
+		LanguageCode:  recipe.LanguageCode,
+		Content:       recipe.Content,
+	}
+}
+
+func fetchFixture(t *testing.T, source cookchatdb.RecipeSource, sourceID string, name string, prev *cookchatdb.RecipeCrawl) (*cookchatdb.Recipe, error) {
+	t.Helper()
+
+	ext, ok := ForSource(source)
+	if !ok {
+		t.Fatalf("no extractor for %s", source)
+	}
+	srv := newFixtureServer(t, ext, sourceID, name)
+	baseURLs := BaseURLs{source: srv.URL}
+
+	return Fetch(t.Context(), srv.Client(), ext, baseURLs.URL(ext, sourceID), sourceID, prev)
+}
+
+func TestFetch(t *testing.T) {
+	tests := []struct {
+		source   cookchatdb.RecipeSource
+		sourceID string
+		// name is the name of the recorded page, the source ID if empty.
+		name string
+	}{
+		{source: cookchatdb.RecipeSourceCookpad, sourceID: "24664122"},
+		{source: cookchatdb.RecipeSourceOrangePage, sourceID: "300487"},
+		{source: cookchatdb.RecipeSourceDelishKitchen, sourceID: "144271072034816499"},
+		{source: cookchatdb.RecipeSourceWeb, sourceID: webSourceID, name: "curry"},
+	}
+
+	for _, tc := range tests {
+		t.Run(string(tc.source), func(t *testing.T) {
+			name := tc.name
+			if name == "" {
+				name = tc.sourceID
+				if *record {
+					ext, _ := ForSource(tc.source)
+					recordPage(t, ext, tc.sourceID)
+				}
+			}
+			recipe, err := fetchFixture(t, tc.source, tc.sourceID, name, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if recipe.Crawl == nil || recipe.Crawl.ContentHash == "" || recipe.Crawl.ETag != fixtureETag {
+				t.Errorf("got crawl state %+v, want content hash and etag %s", recipe.Crawl, fixtureETag)
+			}
+			got, err := json.MarshalIndent(newGoldenRecipe(recipe), "", "  ")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, '\n')
+
+			golden := filepath.Join("testdata", string(tc.source), name+".golden.json")
+			if *update {
+				if err := os.WriteFile(golden, got, 0o644); err != nil { //nolint:gosec // test data
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("extracted recipe differs from %s, run with -update if the change is expected\ngot:\n%s\nwant:\n%s", golden, got, want)
+			}
+		})
+	}
+}
+
+func TestFetchNoRecipe(t *testing.T) {
+	tests := []struct {
+		source   cookchatdb.RecipeSource
+		sourceID string
+	}{
+		{source: cookchatdb.RecipeSourceCookpad, sourceID: "1"},
+		{source: cookchatdb.RecipeSourceOrangePage, sourceID: "1"},
+		{source: cookchatdb.RecipeSourceDelishKitchen, sourceID: "1"},
+		{source: cookchatdb.RecipeSourceWeb, sourceID: webSourceID},
+	}
+
+	for _, tc := range tests {
+		t.Run(string(tc.source), func(t *testing.T) {
+			_, err := fetchFixture(t, tc.source, tc.sourceID, "no_recipe", nil)
+			if !errors.Is(err, ErrNoRecipe) {
+				t.Errorf("got error %v, want %v", err, ErrNoRecipe)
+			}
+		})
+	}
+}
+
+func TestFetchNotFound(t *testing.T) {
+	ext, _ := ForSource(cookchatdb.RecipeSourceCookpad)
+	srv := newFixtureServer(t, ext, "24664122", "24664122")
+	baseURLs := BaseURLs{cookchatdb.RecipeSourceCookpad: srv.URL}
+
+	recipe, err := Fetch(t.Context(), srv.Client(), ext, baseURLs.URL(ext, "1"), "1", nil)
+	if err == nil {
+		t.Errorf("got recipe %v, want error", recipe)
+	}
+}
+
+func TestFetchNotModified(t *testing.T) {
+	source := cookchatdb.RecipeSourceOrangePage
+	sourceID := "300487"
+
+	first, err := fetchFixture(t, source, sourceID, sourceID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = fetchFixture(t, source, sourceID, sourceID, first.Crawl)
+	if !errors.Is(err, ErrNotModified) {
+		t.Errorf("got error %v, want %v", err, ErrNotModified)
+	}
+
+	// Sites that don't support conditional requests return the page again, which has the same
+	// content hash.
+	second, err := fetchFixture(t, source, sourceID, sourceID, &cookchatdb.RecipeCrawl{ETag: `"other"`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.Crawl.ContentHash != first.Crawl.ContentHash {
+		t.Errorf("got content hash %s, want %s", second.Crawl.ContentHash, first.Crawl.ContentHash)
+	}
+}
+
+func TestContentHash(t *testing.T) {
+	extracted := func() *cookchatdb.Recipe {
+		return &cookchatdb.Recipe{
+			Source:       cookchatdb.RecipeSourceWeb,
+			SourceID:     webSourceID,
+			ImageURL:     "https://example.com/curry.jpg",
+			LanguageCode: "ja",
+			Content: cookchatdb.RecipeContent{
+				Title:       "カレー",
+				Ingredients: []cookchatdb.RecipeIngredient{{Name: "玉ねぎ", Quantity: "2個"}},
+				AdditionalIngredients: []cookchatdb.IngredientSection{
+					{Title: "A", Ingredients: []cookchatdb.RecipeIngredient{{Name: "醤油", Quantity: "大さじ1"}}},
+				},
+				Steps: []cookchatdb.RecipeStep{{Description: "炒める"}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(recipe *cookchatdb.Recipe)
+		changed bool
+	}{
+		{
+			name: "bookkeeping",
+			modify: func(recipe *cookchatdb.Recipe) {
+				recipe.ID = "id"
+				recipe.Status = cookchatdb.RecipeStatusFailed
+				recipe.FailureReason = "failed"
+				recipe.Attempts = 2
+				recipe.ProcessingStartedAt = time.Now()
+				recipe.Crawl = &cookchatdb.RecipeCrawl{ContentHash: "hash"}
+				recipe.LocalizedContent = map[string]*cookchatdb.RecipeContent{"en": {Title: "Curry"}}
+				recipe.Content.Version = 3
+				recipe.Content.Steps[0].ActiveMinutes = 5
+			},
+			changed: false,
+		},
+		{
+			name: "title",
+			modify: func(recipe *cookchatdb.Recipe) {
+				recipe.Content.Title = "チキンカレー"
+			},
+			changed: true,
+		},
+		{
+			name: "section ingredient",
+			modify: func(recipe *cookchatdb.Recipe) {
+				recipe.Content.AdditionalIngredients[0].Ingredients[0].Quantity = "大さじ2"
+			},
+			changed: true,
+		},
+		{
+			name: "step image",
+			modify: func(recipe *cookchatdb.Recipe) {
+				recipe.Content.Steps[0].ImageURL = "https://example.com/step.jpg"
+			},
+			changed: true,
+		},
+	}
+
+	want, err := contentHash(extracted())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			recipe := extracted()
+			tc.modify(recipe)
+			got, err := contentHash(recipe)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if changed := got != want; changed != tc.changed {
+				t.Errorf("got hash changed %v, want %v", changed, tc.changed)
+			}
+		})
+	}
+}
+
+func TestBaseURLsRebase(t *testing.T) {
+	baseURLs := BaseURLs{
+		cookchatdb.RecipeSourceCookpad:    "http://127.0.0.1:8080",
+		cookchatdb.RecipeSourceOrangePage: "http://localhost:8081/orangepage/",
+	}
+
+	tests := []struct {
+		name   string
+		source cookchatdb.RecipeSource
+		url    string
+		want   string
+	}{
+		{
+			name:   "host",
+			source: cookchatdb.RecipeSourceCookpad,
+			url:    "https://cookpad.com/jp/users/40054625?page=2",
+			want:   "http://127.0.0.1:8080/jp/users/40054625?page=2",
+		},
+		{
+			name:   "path prefix",
+			source: cookchatdb.RecipeSourceOrangePage,
+			url:    "https://www.orangepage.net/recipes/300487",
+			want:   "http://localhost:8081/orangepage/recipes/300487",
+		},
+		{
+			name:   "not overridden",
+			source: cookchatdb.RecipeSourceDelishKitchen,
+			url:    "https://delishkitchen.tv/recipes/144271072034816499",
+			want:   "https://delishkitchen.tv/recipes/144271072034816499",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := baseURLs.Rebase(tc.source, tc.url); got != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package extractor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+// maxPageSize is the maximum size of a recipe page, to protect against unexpectedly large
+// responses.
+const maxPageSize = 10 << 20
+
+// ErrNotModified is returned by Fetch when the site reports the page has not changed since it
+// was last crawled.
+var ErrNotModified = errors.New("extractor: page not modified")
+
+// BaseURLs overrides the base URLs of sites by their source, for example to crawl a local
+// server in tests. Sites without an override use their actual URL.
+type BaseURLs map[cookchatdb.RecipeSource]string
+
+// URL returns the URL to fetch the recipe page of the recipe with sourceID from the site of
+// ext.
+func (b BaseURLs) URL(ext Extractor, sourceID string) string {
+	return b.Rebase(ext.Source(), ext.URL(sourceID))
+}
+
+// Rebase returns rawURL, a URL of the site of source, with its scheme and host replaced by the
+// base URL of the site if overridden. The path of the base URL, if any, is prefixed to the
+// path of rawURL.
+func (b BaseURLs) Rebase(source cookchatdb.RecipeSource, rawURL string) string {
+	base, ok := b[source]
+	if !ok {
+		return rawURL
+	}
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return rawURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	u.Scheme = baseURL.Scheme
+	u.Host = baseURL.Host
+	u.Path = strings.TrimSuffix(baseURL.Path, "/") + u.Path
+	u.RawPath = ""
+	return u.String()
+}
+
+// Fetch fetches the recipe page at pageURL with client and extracts the recipe with sourceID
+// from it using ext. The returned recipe has its Crawl populated with the state of the page.
+// If prev is the state of the page when it was last crawled, the page is only fetched if the
+// site reports it has changed, otherwise ErrNotModified is returned. Sites may not report
+// changes, so the content hash of the recipe should still be compared with prev.
+func Fetch(ctx context.Context, client *http.Client, ext Extractor, pageURL string, sourceID string, prev *cookchatdb.RecipeCrawl) (*cookchatdb.Recipe, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("extractor: creating request: %w", err)
+	}
+	if prev != nil {
+		if prev.ETag != "" {
+			req.Header.Set("If-None-Match", prev.ETag)
+		}
+		if prev.LastModified != "" {
+			req.Header.Set("If-Modified-Since", prev.LastModified)
+		}
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("extractor: fetching page: %w", err)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode == http.StatusNotModified && prev != nil {
+		return nil, ErrNotModified
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("extractor: fetching page %s: status %d", pageURL, res.StatusCode)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(io.LimitReader(res.Body, maxPageSize))
+	if err != nil {
+		return nil, fmt.Errorf("extractor: parsing page: %w", err)
+	}
+
+	recipe, err := ext.Extract(doc, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := contentHash(recipe)
+	if err != nil {
+		return nil, err
+	}
+	recipe.Crawl = &cookchatdb.RecipeCrawl{
+		ContentHash:  hash,
+		ETag:         res.Header.Get("ETag"),
+		LastModified: res.Header.Get("Last-Modified"),
+		CrawledAt:    time.Now(),
+	}
+	return recipe, nil
+}
+
+// hashedContent is the content of an extracted recipe included in its content hash. Fields are
+// copied explicitly so the hash doesn't change when fields unrelated to extraction are added
+// to recipes, which would make every recipe appear changed.
+type hashedContent struct {
+	UserID                string             `json:"userId"`
+	ImageURL              string             `json:"imageUrl"`
+	TotalMinutes          int                `json:"totalMinutes"`
+	LanguageCode          string             `json:"languageCode"`
+	Title                 string             `json:"title"`
+	Description           string             `json:"description"`
+	Ingredients           []hashedIngredient `json:"ingredients"`
+	AdditionalIngredients []hashedSection    `json:"additionalIngredients"`
+	Steps                 []hashedStep       `json:"steps"`
+	Notes                 string             `json:"notes"`
+	ServingSize           string             `json:"servingSize"`
+}
+
+type hashedIngredient struct {
+	Name     string `json:"name"`
+	Quantity string `json:"quantity"`
+}
+
+type hashedSection struct {
+	Title       string             `json:"title"`
+	Ingredients []hashedIngredient `json:"ingredients"`
+}
+
+type hashedStep struct {
+	Description string `json:"description"`
+	ImageURL    string `json:"imageUrl"`
+}
+
+func newHashedIngredients(ingredients []cookchatdb.RecipeIngredient) []hashedIngredient {
+	res := make([]hashedIngredient, len(ingredients))
+	for i, ingredient := range ingredients {
+		res[i] = hashedIngredient{Name: ingredient.Name, Quantity: ingredient.Quantity}
+	}
+	return res
+}
+
+// contentHash returns the hash of the content of the extracted recipe, which changes only
+// when the recipe on the page does.
+func contentHash(recipe *cookchatdb.Recipe) (string, error) {
+	content := hashedContent{
+		UserID:                recipe.UserID,
+		ImageURL:              recipe.ImageURL,
+		TotalMinutes:          recipe.TotalMinutes,
+		LanguageCode:          recipe.LanguageCode,
+		Title:                 recipe.Content.Title,
+		Description:           recipe.Content.Description,
+		Ingredients:           newHashedIngredients(recipe.Content.Ingredients),
+		AdditionalIngredients: make([]hashedSection, len(recipe.Content.AdditionalIngredients)),
+		Steps:                 make([]hashedStep, len(recipe.Content.Steps)),
+		Notes:                 recipe.Content.Notes,
+		ServingSize:           recipe.Content.ServingSize,
+	}
+	for i, section := range recipe.Content.AdditionalIngredients {
+		content.AdditionalIngredients[i] = hashedSection{
+			Title:       section.Title,
+			Ingredients: newHashedIngredients(section.Ingredients),
+		}
+	}
+	for i, step := range recipe.Content.Steps {
+		content.Steps[i] = hashedStep{Description: step.Description, ImageURL: step.ImageURL}
+	}
+
+	b, err := json.Marshal(content)
+	if err != nil {
+		return "", fmt.Errorf("extractor: marshalling recipe for hash: %w", err)
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,46 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package extractor
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+func init() {
+	register(orangePage{})
+}
+
+type orangePage struct{}
+
+func (orangePage) Source() cookchatdb.RecipeSource {
+	return cookchatdb.RecipeSourceOrangePage
+}
+
+func (orangePage) Match(u *url.URL) (string, bool) {
+	if u.Host != "www.orangepage.net" {
+		return "", false
+	}
+	id, ok := strings.CutPrefix(u.Path, "/recipes/")
+	if !ok || id == "" || strings.Contains(id, "/") {
+		return "", false
+	}
+	return id, true
+}
+
+func (orangePage) URL(sourceID string) string {
+	return "https://www.orangepage.net/recipes/" + sourceID
+}
+
+func (orangePage) Extract(doc *goquery.Document, sourceID string) (*cookchatdb.Recipe, error) {
+	return extractSchemaRecipe(doc, cookchatdb.RecipeSourceOrangePage, sourceID)
+}
+
+func (orangePage) Rewrite() bool {
+	return true
+}
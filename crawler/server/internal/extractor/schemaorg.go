@@ -0,0 +1,279 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package extractor
+
+import (
+	"encoding/json"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+// isoDurationRegexp matches ISO 8601 durations as used by schema.org, e.g. PT1H30M.
+var isoDurationRegexp = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// extractSchemaRecipe extracts the recipe with sourceID from the schema.org Recipe in the
+// JSON-LD of doc.
+func extractSchemaRecipe(doc *goquery.Document, source cookchatdb.RecipeSource, sourceID string) (*cookchatdb.Recipe, error) {
+	var schema map[string]any
+	doc.Find(`script[type="application/ld+json"]`).EachWithBreak(func(_ int, s *goquery.Selection) bool {
+		var ld any
+		if err := json.Unmarshal([]byte(s.Text()), &ld); err != nil {
+			// Sites often have broken JSON-LD unrelated to the recipe.
+			return true
+		}
+		schema = findRecipeNode(ld)
+		return schema == nil
+	})
+	if schema == nil {
+		return nil, ErrNoRecipe
+	}
+
+	var ingredients []cookchatdb.RecipeIngredient
+	ingredientTexts := textValues(schema["recipeIngredient"])
+	if len(ingredientTexts) == 0 {
+		// Deprecated property still used by some sites.
+		ingredientTexts = textValues(schema["ingredients"])
+	}
+	for _, text := range ingredientTexts {
+		ingredients = append(ingredients, parseIngredient(text))
+	}
+
+	steps := instructionSteps(schema["recipeInstructions"])
+
+	languageCode := textValue(schema["inLanguage"])
+	if languageCode == "" {
+		languageCode = doc.Find("html").AttrOr("lang", "")
+	}
+	languageCode, _, _ = strings.Cut(strings.ToLower(languageCode), "-")
+	if languageCode == "" {
+		// Post-processing assumes Japanese when the language is unknown.
+		languageCode = "ja"
+	}
+
+	totalMinutes, ok := parseDurationMinutes(textValue(schema["totalTime"]))
+	if !ok {
+		prepMinutes, _ := parseDurationMinutes(textValue(schema["prepTime"]))
+		cookMinutes, _ := parseDurationMinutes(textValue(schema["cookTime"]))
+		totalMinutes = prepMinutes + cookMinutes
+	}
+
+	return &cookchatdb.Recipe{
+		Source:       source,
+		SourceID:     sourceID,
+		ImageURL:     imageURL(schema["image"]),
+		TotalMinutes: totalMinutes,
+		Content: cookchatdb.RecipeContent{
+			Title:       textValue(schema["name"]),
+			Description: textValue(schema["description"]),
+			Ingredients: ingredients,
+			Steps:       steps,
+			ServingSize: recipeYield(schema["recipeYield"]),
+		},
+		LanguageCode: languageCode,
+	}, nil
+}
+
+// findRecipeNode returns the first node with type Recipe within the JSON-LD node, searching
+// arrays, @graph and properties such as mainEntity.
+func findRecipeNode(node any) map[string]any {
+	switch node := node.(type) {
+	case []any:
+		for _, n := range node {
+			if recipe := findRecipeNode(n); recipe != nil {
+				return recipe
+			}
+		}
+	case map[string]any:
+		if hasType(node, "Recipe") {
+			return node
+		}
+		if recipe := findRecipeNode(node["@graph"]); recipe != nil {
+			return recipe
+		}
+		// Sort for a deterministic result when there are multiple recipes.
+		for _, key := range slices.Sorted(maps.Keys(node)) {
+			if key == "@graph" {
+				continue
+			}
+			if recipe := findRecipeNode(node[key]); recipe != nil {
+				return recipe
+			}
+		}
+	}
+	return nil
+}
+
+// hasType returns whether node has typ as its @type, which may be a single type or an array
+// of types, with or without the schema.org prefix.
+func hasType(node map[string]any, typ string) bool {
+	for _, t := range textValues(node["@type"]) {
+		t = strings.TrimPrefix(t, "http://schema.org/")
+		t = strings.TrimPrefix(t, "https://schema.org/")
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// instructionSteps returns the steps in recipeInstructions, which may be text, HowToStep or
+// HowToSection, nested in arrays or the itemListElement of sections.
+func instructionSteps(node any) []cookchatdb.RecipeStep {
+	switch node := node.(type) {
+	case string:
+		var steps []cookchatdb.RecipeStep
+		for line := range strings.Lines(htmlText(node)) {
+			if line = strings.TrimSpace(line); line != "" {
+				steps = append(steps, cookchatdb.RecipeStep{Description: line})
+			}
+		}
+		return steps
+	case []any:
+		var steps []cookchatdb.RecipeStep
+		for _, n := range node {
+			steps = append(steps, instructionSteps(n)...)
+		}
+		return steps
+	case map[string]any:
+		if elements, ok := node["itemListElement"]; ok {
+			return instructionSteps(elements)
+		}
+		text := textValue(node["text"])
+		if text == "" {
+			text = textValue(node["name"])
+		}
+		if text == "" {
+			return nil
+		}
+		return []cookchatdb.RecipeStep{
+			{
+				Description: text,
+				ImageURL:    imageURL(node["image"]),
+			},
+		}
+	}
+	return nil
+}
+
+// imageURL returns the URL of the first image in node, which may be a URL, ImageObject or an
+// array of either.
+func imageURL(node any) string {
+	switch node := node.(type) {
+	case string:
+		return strings.TrimSpace(node)
+	case []any:
+		for _, n := range node {
+			if u := imageURL(n); u != "" {
+				return u
+			}
+		}
+	case map[string]any:
+		if u := textValue(node["url"]); u != "" {
+			return u
+		}
+		return textValue(node["contentUrl"])
+	}
+	return ""
+}
+
+// recipeYield returns the serving size from recipeYield, which may be text, a number, or an
+// array of both such as ["4", "4 servings"], in which case the most descriptive is used.
+func recipeYield(node any) string {
+	values := textValues(node)
+	for _, v := range values {
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			return v
+		}
+	}
+	if len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// parseIngredient parses an ingredient line. Lines starting with a number are assumed to be
+// quantity first as in English, e.g. "2 eggs", otherwise name first as in Japanese, e.g.
+// "卵 2個".
+func parseIngredient(text string) cookchatdb.RecipeIngredient {
+	text = strings.Join(strings.FieldsFunc(text, unicode.IsSpace), " ")
+	first, rest, _ := strings.Cut(text, " ")
+	if r := []rune(first); len(r) > 0 && (unicode.IsDigit(r[0]) || unicode.Is(unicode.No, r[0])) {
+		return cookchatdb.RecipeIngredient{
+			Name:     rest,
+			Quantity: first,
+		}
+	}
+	return cookchatdb.RecipeIngredient{
+		Name:     first,
+		Quantity: rest,
+	}
+}
+
+// parseDurationMinutes parses an ISO 8601 duration into minutes, rounding down seconds.
+func parseDurationMinutes(duration string) (int, bool) {
+	m := isoDurationRegexp.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(duration)))
+	if m == nil || m[1]+m[2]+m[3]+m[4] == "" {
+		return 0, false
+	}
+	days, _ := strconv.Atoi(m[1])
+	hours, _ := strconv.Atoi(m[2])
+	minutes, _ := strconv.Atoi(m[3])
+	seconds, _ := strconv.ParseFloat(m[4], 64)
+	return days*24*60 + hours*60 + minutes + int(seconds)/60, true
+}
+
+// textValue returns the first text value of node.
+func textValue(node any) string {
+	if values := textValues(node); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// textValues returns the text values of node, which may be a string, number, or an array of
+// either. Text is unescaped and stripped of HTML, which some sites include.
+func textValues(node any) []string {
+	switch node := node.(type) {
+	case string:
+		if s := strings.TrimSpace(htmlText(node)); s != "" {
+			return []string{s}
+		}
+	case float64:
+		return []string{strconv.FormatFloat(node, 'f', -1, 64)}
+	case []any:
+		var values []string
+		for _, n := range node {
+			values = append(values, textValues(n)...)
+		}
+		return values
+	}
+	return nil
+}
+
+// htmlText returns s with HTML tags removed and entities unescaped. Line breaks are
+// preserved for tags that commonly separate instructions.
+func htmlText(s string) string {
+	if !strings.ContainsAny(s, "<&") {
+		return s
+	}
+	s = strings.NewReplacer("<br>", "\n", "<br/>", "\n", "<br />", "\n", "</p>", "\n", "</li>", "\n").Replace(s)
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(s))
+	if err != nil {
+		return s
+	}
+	text := doc.Text()
+	if text != s && strings.Contains(text, "<") {
+		// Some sites escape HTML in JSON-LD twice.
+		return htmlText(text)
+	}
+	return text
+}
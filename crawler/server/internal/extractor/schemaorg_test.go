@@ -0,0 +1,201 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package extractor
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+// parseJSON parses the JSON-LD in s for tests.
+func parseJSON(t *testing.T, s string) any {
+	t.Helper()
+	var node any
+	if err := json.Unmarshal([]byte(s), &node); err != nil {
+		t.Fatal(err)
+	}
+	return node
+}
+
+func TestFindRecipeNode(t *testing.T) {
+	tests := []struct {
+		name string
+		ld   string
+		want string
+	}{
+		{
+			name: "top level",
+			ld:   `{"@type": "Recipe", "name": "top"}`,
+			want: "top",
+		},
+		{
+			name: "array of types with prefix",
+			ld:   `{"@type": ["NewsArticle", "https://schema.org/Recipe"], "name": "typed"}`,
+			want: "typed",
+		},
+		{
+			name: "array",
+			ld:   `[{"@type": "WebSite"}, {"@type": "Recipe", "name": "second"}]`,
+			want: "second",
+		},
+		{
+			name: "graph",
+			ld:   `{"@graph": [{"@type": "WebPage"}, {"@type": "Recipe", "name": "graph"}]}`,
+			want: "graph",
+		},
+		{
+			name: "graph before properties",
+			ld:   `{"@type": "WebPage", "about": {"@type": "Recipe", "name": "about"}, "@graph": [{"@type": "Recipe", "name": "graph"}]}`,
+			want: "graph",
+		},
+		{
+			name: "main entity",
+			ld:   `{"@type": "WebPage", "mainEntity": {"@type": "Recipe", "name": "main"}}`,
+			want: "main",
+		},
+		{
+			name: "properties in order",
+			ld:   `{"@type": "WebPage", "mainEntity": {"@type": "Recipe", "name": "main"}, "about": {"@type": "Recipe", "name": "about"}}`,
+			want: "about",
+		},
+		{
+			name: "none",
+			ld:   `{"@type": "WebPage", "name": "page"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			recipe := findRecipeNode(parseJSON(t, tc.ld))
+			got := ""
+			if recipe != nil {
+				got, _ = recipe["name"].(string)
+			}
+			if got != tc.want {
+				t.Errorf("got recipe %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestInstructionSteps(t *testing.T) {
+	tests := []struct {
+		name         string
+		instructions string
+		want         []cookchatdb.RecipeStep
+	}{
+		{
+			name:         "text",
+			instructions: `"切る。\n<p>焼く。</p><p>盛り付ける。</p>"`,
+			want: []cookchatdb.RecipeStep{
+				{Description: "切る。"},
+				{Description: "焼く。"},
+				{Description: "盛り付ける。"},
+			},
+		},
+		{
+			name:         "steps",
+			instructions: `[{"@type": "HowToStep", "text": "切る。", "image": {"@type": "ImageObject", "url": "https://example.com/1.jpg"}}, {"@type": "HowToStep", "name": "焼く。"}, {"@type": "HowToStep"}]`,
+			want: []cookchatdb.RecipeStep{
+				{Description: "切る。", ImageURL: "https://example.com/1.jpg"},
+				{Description: "焼く。"},
+			},
+		},
+		{
+			name:         "sections",
+			instructions: `[{"@type": "HowToSection", "name": "準備", "itemListElement": [{"@type": "HowToStep", "text": "切る。"}]}, {"@type": "HowToSection", "name": "調理", "itemListElement": ["焼く。"]}]`,
+			want: []cookchatdb.RecipeStep{
+				{Description: "切る。"},
+				{Description: "焼く。"},
+			},
+		},
+		{
+			name:         "missing",
+			instructions: `null`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := instructionSteps(parseJSON(t, tc.instructions)); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseDurationMinutes(t *testing.T) {
+	tests := []struct {
+		duration string
+		want     int
+		ok       bool
+	}{
+		{duration: "PT30M", want: 30, ok: true},
+		{duration: "PT1H30M", want: 90, ok: true},
+		{duration: "P1DT2H", want: 26 * 60, ok: true},
+		{duration: "PT90S", want: 1, ok: true},
+		{duration: " pt45m ", want: 45, ok: true},
+		{duration: "P0D", want: 0, ok: true},
+		{duration: "PT", ok: false},
+		{duration: "P", ok: false},
+		{duration: "30 minutes", ok: false},
+		{duration: "", ok: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.duration, func(t *testing.T) {
+			got, ok := parseDurationMinutes(tc.duration)
+			if got != tc.want || ok != tc.ok {
+				t.Errorf("got (%d, %v), want (%d, %v)", got, ok, tc.want, tc.ok)
+			}
+		})
+	}
+}
+
+func TestRecipeYield(t *testing.T) {
+	tests := []struct {
+		name  string
+		yield string
+		want  string
+	}{
+		{name: "text", yield: `"2人分"`, want: "2人分"},
+		{name: "number", yield: `4`, want: "4"},
+		{name: "descriptive in array", yield: `["4", "4 servings"]`, want: "4 servings"},
+		{name: "numbers in array", yield: `[4, "6"]`, want: "4"},
+		{name: "missing", yield: `null`, want: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := recipeYield(parseJSON(t, tc.yield)); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseIngredient(t *testing.T) {
+	tests := []struct {
+		text string
+		want cookchatdb.RecipeIngredient
+	}{
+		{text: "卵 2個", want: cookchatdb.RecipeIngredient{Name: "卵", Quantity: "2個"}},
+		{text: "鶏もも肉　1枚（約300g）", want: cookchatdb.RecipeIngredient{Name: "鶏もも肉", Quantity: "1枚（約300g）"}},
+		{text: "2 large  eggs", want: cookchatdb.RecipeIngredient{Name: "large eggs", Quantity: "2"}},
+		{text: "½ cup milk", want: cookchatdb.RecipeIngredient{Name: "cup milk", Quantity: "½"}},
+		{text: "塩", want: cookchatdb.RecipeIngredient{Name: "塩"}},
+		{text: "  ", want: cookchatdb.RecipeIngredient{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.text, func(t *testing.T) {
+			if got := parseIngredient(tc.text); got != tc.want {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package extractor
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+// web extracts recipes from any site publishing schema.org Recipe JSON-LD. It is not
+// registered, instead being the fallback for sites without their own extractor.
+type web struct{}
+
+func (web) Source() cookchatdb.RecipeSource {
+	return cookchatdb.RecipeSourceWeb
+}
+
+func (web) Match(u *url.URL) (string, bool) {
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return "", false
+	}
+	// Escape the URL so the ID has no slashes while still being able to recover the URL.
+	// Document IDs are hashed from it by DocumentID since URLs can be long.
+	return url.PathEscape(canonicalURL(u)), true
+}
+
+// canonicalURL returns u normalized so the same page is crawled as the same recipe, ignoring
+// the case of the host, default ports, fragments and tracking parameters.
+func canonicalURL(u *url.URL) string {
+	canonical := *u
+	canonical.Scheme = strings.ToLower(u.Scheme)
+	canonical.Host = strings.ToLower(u.Host)
+	if port := u.Port(); (canonical.Scheme == "https" && port == "443") || (canonical.Scheme == "http" && port == "80") {
+		canonical.Host = strings.ToLower(u.Hostname())
+	}
+	if canonical.Path == "" {
+		canonical.Path = "/"
+	}
+	canonical.Fragment = ""
+	canonical.RawFragment = ""
+	if u.RawQuery != "" {
+		query := u.Query()
+		for key := range query {
+			if strings.HasPrefix(key, "utm_") {
+				query.Del(key)
+			}
+		}
+		canonical.RawQuery = query.Encode()
+	}
+	return canonical.String()
+}
+
+func (web) URL(sourceID string) string {
+	u, err := url.PathUnescape(sourceID)
+	if err != nil {
+		// Only IDs from Match are used, which are always escaped.
+		return sourceID
+	}
+	return u
+}
+
+func (web) Extract(doc *goquery.Document, sourceID string) (*cookchatdb.Recipe, error) {
+	return extractSchemaRecipe(doc, cookchatdb.RecipeSourceWeb, sourceID)
+}
+
+func (web) Rewrite() bool {
+	return true
+}
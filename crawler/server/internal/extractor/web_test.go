@@ -0,0 +1,94 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package extractor
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+func TestWebMatch(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want string
+		ok   bool
+	}{
+		{
+			name: "page",
+			url:  "https://example.com/recipes/curry",
+			want: "https://example.com/recipes/curry",
+			ok:   true,
+		},
+		{
+			name: "normalized",
+			url:  "HTTPS://Example.COM:443/recipes/curry?utm_source=feed&b=2&a=1#steps",
+			want: "https://example.com/recipes/curry?a=1&b=2",
+			ok:   true,
+		},
+		{
+			name: "root",
+			url:  "http://example.com:80",
+			want: "http://example.com/",
+			ok:   true,
+		},
+		{
+			name: "non default port",
+			url:  "http://example.com:8080/recipes/curry",
+			want: "http://example.com:8080/recipes/curry",
+			ok:   true,
+		},
+		{
+			name: "not web",
+			url:  "ftp://example.com/recipes/curry",
+		},
+		{
+			name: "no host",
+			url:  "/recipes/curry",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			u, err := url.Parse(tc.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			id, ok := (web{}).Match(u)
+			if ok != tc.ok {
+				t.Fatalf("got match %v, want %v", ok, tc.ok)
+			}
+			if !ok {
+				return
+			}
+			if strings.Contains(id, "/") {
+				t.Errorf("got ID %q with slash", id)
+			}
+			if got := (web{}).URL(id); got != tc.want {
+				t.Errorf("got URL %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDocumentID(t *testing.T) {
+	cookpad, _ := ForSource(cookchatdb.RecipeSourceCookpad)
+	if got, want := DocumentID(cookpad, "24664122"), "cookpad-24664122"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	long := "https://example.com/recipes/" + strings.Repeat("a", 2000)
+	_, id, _ := Match(long)
+	docID := DocumentID(web{}, id)
+	// Document IDs are limited to 1500 bytes.
+	if len(docID) > 100 || !strings.HasPrefix(docID, "web-") {
+		t.Errorf("got document ID %q, want short hash", docID)
+	}
+	if other := DocumentID(web{}, url.PathEscape("https://example.com/recipes/other")); other == docID {
+		t.Errorf("got same document ID %q for different pages", docID)
+	}
+}
@@ -6,65 +6,189 @@ package user
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 
+	"cloud.google.com/go/firestore"
 	"connectrpc.com/connect"
 	"github.com/gocolly/colly/v2"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/curioswitch/cookchat/common/cookchatdb"
 	crawlerapi "github.com/curioswitch/cookchat/crawler/api/go"
 	"github.com/curioswitch/cookchat/crawler/api/go/crawlerapiconnect"
+	"github.com/curioswitch/cookchat/crawler/server/internal/crawljob"
+	"github.com/curioswitch/cookchat/crawler/server/internal/extractor"
+	"github.com/curioswitch/cookchat/crawler/server/internal/polite"
 )
 
-func NewHandler(baseCollector *colly.Collector, crawlerClient crawlerapiconnect.CrawlerServiceClient) *Handler {
+const (
+	// defaultMaxPages is the maximum number of pages of a user's recipes crawled when not
+	// specified, to guard against pagination that never ends.
+	defaultMaxPages = 50
+
+	// crawlConcurrency is the number of recipes crawled at the same time.
+	crawlConcurrency = 5
+)
+
+func NewHandler(baseCollector *colly.Collector, baseURLs extractor.BaseURLs, transport *polite.Transport, store *firestore.Client, crawlerClient crawlerapiconnect.CrawlerServiceClient) *Handler {
 	return &Handler{
 		baseCollector: baseCollector,
+		baseURLs:      baseURLs,
+		transport:     transport,
+		store:         store,
 		crawlerClient: crawlerClient,
 	}
 }
 
 type Handler struct {
 	baseCollector *colly.Collector
+	baseURLs      extractor.BaseURLs
+	transport     *polite.Transport
+	store         *firestore.Client
 	crawlerClient crawlerapiconnect.CrawlerServiceClient
 }
 
 func (h *Handler) CrawlCookpadUser(ctx context.Context, req *crawlerapi.CrawlCookpadUserRequest) (*crawlerapi.CrawlCookpadUserResponse, error) {
+	job, err := crawljob.Start(ctx, h.store, cookchatdb.CrawlJob{
+		Type:          cookchatdb.CrawlJobTypeCookpadUser,
+		CookpadUserID: req.GetUserId(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cookpad:user: starting crawl job: %w", err)
+	}
+
+	crawlErr := h.crawlUser(ctx, job, req)
+	jobRes, err := job.Finish(ctx, crawlErr)
+	if err != nil {
+		return nil, fmt.Errorf("cookpad:user: finishing crawl job: %w", err)
+	}
+	if crawlErr != nil {
+		return nil, crawlErr
+	}
+
+	return &crawlerapi.CrawlCookpadUserResponse{
+		Discovered: int32(jobRes.Discovered), //nolint:gosec // far fewer than max int32
+		Crawled:    int32(jobRes.Crawled),    //nolint:gosec // far fewer than max int32
+		Skipped:    int32(jobRes.Skipped),    //nolint:gosec // far fewer than max int32
+		Failed:     int32(jobRes.Failed),     //nolint:gosec // far fewer than max int32
+		JobId:      jobRes.ID,
+	}, nil
+}
+
+// crawlUser crawls the recipes of the user, recording the results in job.
+func (h *Handler) crawlUser(ctx context.Context, job *crawljob.Job, req *crawlerapi.CrawlCookpadUserRequest) error {
 	// Avoid clone since we don't want to share storage.
 	c := colly.NewCollector(
 		colly.UserAgent(h.baseCollector.UserAgent),
 		colly.StdlibContext(ctx),
-		colly.Async(true),
 	)
+	c.WithTransport(h.transport)
 
+	var pageRecipeIDs []string
+	var nextURL string
 	c.OnHTML(`a[href^="/jp/recipes/"]`, func(e *colly.HTMLElement) {
 		id := strings.TrimPrefix(e.Attr("href"), "/jp/recipes/")
 		if _, err := strconv.Atoi(id); err != nil {
 			return
 		}
-
-		if _, err := h.crawlerClient.CrawlCookpadRecipe(ctx, connect.NewRequest(&crawlerapi.CrawlCookpadRecipeRequest{
-			RecipeId: id,
-		})); err != nil {
-			// TODO: Log error
-			return
+		pageRecipeIDs = append(pageRecipeIDs, id)
+	})
+	c.OnHTML(`a[rel="next"], link[rel="next"]`, func(e *colly.HTMLElement) {
+		if nextURL == "" {
+			nextURL = e.Request.AbsoluteURL(e.Attr("href"))
 		}
 	})
 
-	c.OnHTML(fmt.Sprintf(`a[href^="/jp/users/%s/recipes?page="]`, req.GetUserId()), func(e *colly.HTMLElement) {
-		if err := c.Visit(e.Request.AbsoluteURL(e.Attr("href"))); err != nil {
-			// TODO: Log error
-			return
+	maxPages := int(req.GetMaxPages())
+	if maxPages == 0 {
+		maxPages = defaultMaxPages
+	}
+
+	discovered := 0
+
+	var grp errgroup.Group
+	grp.SetLimit(crawlConcurrency)
+
+	seen := map[string]struct{}{}
+	pageURL := h.baseURLs.Rebase(cookchatdb.RecipeSourceCookpad, "https://cookpad.com/jp/users/"+url.PathEscape(req.GetUserId()))
+	for page := 1; page <= maxPages; page++ {
+		pageRecipeIDs = nil
+		nextURL = ""
+		if err := c.Visit(pageURL); err != nil {
+			if page == 1 {
+				return fmt.Errorf("cookpad:user: crawl user page: %w", err)
+			}
+			// Pages past the end may be errors rather than empty.
+			break
 		}
-	})
 
-	// TODO: Figure out how to generalize this.
-	for i := 1; i <= 13; i++ {
-		if err := c.Visit(fmt.Sprintf("https://cookpad.com/jp/users/%s?page=%d", req.GetUserId(), i)); err != nil {
-			return nil, fmt.Errorf("cookpad:user: crawl user page: %w", err)
+		var newRecipe bool
+		for _, id := range pageRecipeIDs {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			newRecipe = true
+			discovered++
+
+			grp.Go(func() error {
+				job.AddItem(h.crawlRecipe(ctx, job, id))
+				return nil
+			})
 		}
+		// Stop once pages no longer have new recipes, which some sites serve past the end
+		// instead of an error.
+		if !newRecipe {
+			break
+		}
+
+		if nextURL == "" {
+			nextURL = h.baseURLs.Rebase(cookchatdb.RecipeSourceCookpad,
+				fmt.Sprintf("https://cookpad.com/jp/users/%s?page=%d", url.PathEscape(req.GetUserId()), page+1))
+		}
+		pageURL = nextURL
+	}
+
+	_ = grp.Wait()
+	job.SetDiscovered(discovered)
+
+	return nil
+}
+
+// crawlRecipe crawls the recipe with id if it has not been crawled yet, returning the result.
+func (h *Handler) crawlRecipe(ctx context.Context, job *crawljob.Job, id string) cookchatdb.CrawlJobItem {
+	item := cookchatdb.CrawlJobItem{
+		URL: "https://cookpad.com/jp/recipes/" + id,
 	}
 
-	c.Wait()
+	existing, err := h.store.Collection("recipes").Doc("cookpad-" + id).Get(ctx)
+	if err == nil {
+		item.Status = cookchatdb.CrawlJobItemStatusSkipped
+		if recipeID, ok := existing.Data()["id"].(string); ok {
+			item.RecipeID = recipeID
+		}
+		return item
+	}
+	if status.Code(err) != codes.NotFound {
+		item.Status = cookchatdb.CrawlJobItemStatusFailed
+		item.Error = fmt.Sprintf("cookpad:user: checking existing recipe: %v", err)
+		return item
+	}
 
-	return &crawlerapi.CrawlCookpadUserResponse{}, nil
+	res, err := h.crawlerClient.CrawlCookpadRecipe(ctx, connect.NewRequest(&crawlerapi.CrawlCookpadRecipeRequest{
+		RecipeId:    id,
+		ParentJobId: job.ID(),
+	}))
+	if err != nil {
+		item.Status = cookchatdb.CrawlJobItemStatusFailed
+		item.Error = err.Error()
+		return item
+	}
+	item.Status = cookchatdb.CrawlJobItemStatusCrawled
+	item.RecipeID = res.Msg.GetRecipeId()
+	return item
 }
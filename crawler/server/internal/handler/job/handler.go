@@ -0,0 +1,228 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	crawlerapi "github.com/curioswitch/cookchat/crawler/api/go"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var errJobNotFound = errors.New("job: crawl job not found")
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) GetCrawlJob(ctx context.Context, req *crawlerapi.GetCrawlJobRequest) (*crawlerapi.GetCrawlJobResponse, error) {
+	doc, err := h.store.Collection("crawlJobs").Doc(req.GetJobId()).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, errJobNotFound)
+		}
+		return nil, fmt.Errorf("job: fetching crawl job: %w", err)
+	}
+	var job cookchatdb.CrawlJob
+	if err := doc.DataTo(&job); err != nil {
+		return nil, fmt.Errorf("job: decoding crawl job: %w", err)
+	}
+
+	items, err := h.items(ctx, doc.Ref)
+	if err != nil {
+		return nil, err
+	}
+
+	res := jobToProto(&job)
+	res.Items = make([]*crawlerapi.CrawlJobItem, len(items))
+	for i, item := range items {
+		res.Items[i] = &crawlerapi.CrawlJobItem{
+			Url:      item.URL,
+			Status:   itemStatusToProto(item.Status),
+			RecipeId: item.RecipeID,
+			Error:    item.Error,
+		}
+	}
+
+	return &crawlerapi.GetCrawlJobResponse{
+		Job: res,
+	}, nil
+}
+
+// items returns up to cookchatdb.MaxCrawlJobItems items of the job, failed items first.
+func (h *Handler) items(ctx context.Context, ref *firestore.DocumentRef) ([]cookchatdb.CrawlJobItem, error) {
+	col := ref.Collection("items")
+	failed, err := col.
+		Where("status", "==", cookchatdb.CrawlJobItemStatusFailed).
+		OrderBy(firestore.DocumentID, firestore.Asc).
+		Limit(cookchatdb.MaxCrawlJobItems).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("job: fetching failed crawl job items: %w", err)
+	}
+	docs := failed
+	if remaining := cookchatdb.MaxCrawlJobItems - len(failed); remaining > 0 {
+		others, err := col.
+			Where("status", "in", []cookchatdb.CrawlJobItemStatus{cookchatdb.CrawlJobItemStatusCrawled, cookchatdb.CrawlJobItemStatusSkipped}).
+			OrderBy(firestore.DocumentID, firestore.Asc).
+			Limit(remaining).
+			Documents(ctx).
+			GetAll()
+		if err != nil {
+			return nil, fmt.Errorf("job: fetching crawl job items: %w", err)
+		}
+		docs = append(docs, others...)
+	}
+
+	items := make([]cookchatdb.CrawlJobItem, len(docs))
+	for i, doc := range docs {
+		if err := doc.DataTo(&items[i]); err != nil {
+			return nil, fmt.Errorf("job: decoding crawl job item: %w", err)
+		}
+	}
+	return items, nil
+}
+
+func (h *Handler) ListCrawlJobs(ctx context.Context, req *crawlerapi.ListCrawlJobsRequest) (*crawlerapi.ListCrawlJobsResponse, error) {
+	jobs := h.store.Collection("crawlJobs")
+	q := jobs.Query
+	if s := statusFromProto(req.GetStatus()); s != "" {
+		q = q.Where("status", "==", s)
+	}
+	q = q.OrderBy("createdAt", firestore.Desc)
+	if lid := req.GetLastId(); lid != "" {
+		last, err := jobs.Doc(lid).Get(ctx)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, connect.NewError(connect.CodeNotFound, errJobNotFound)
+			}
+			return nil, fmt.Errorf("job: fetching last crawl job: %w", err)
+		}
+		q = q.StartAfter(last)
+	}
+	pageSize := int(req.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	q = q.Limit(min(pageSize, maxPageSize))
+
+	docs, err := q.Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("job: listing crawl jobs: %w", err)
+	}
+
+	res := make([]*crawlerapi.CrawlJob, len(docs))
+	for i, doc := range docs {
+		var job cookchatdb.CrawlJob
+		if err := doc.DataTo(&job); err != nil {
+			return nil, fmt.Errorf("job: decoding crawl job: %w", err)
+		}
+		res[i] = jobToProto(&job)
+	}
+
+	return &crawlerapi.ListCrawlJobsResponse{
+		Jobs: res,
+	}, nil
+}
+
+// jobToProto converts job to proto without its items.
+func jobToProto(job *cookchatdb.CrawlJob) *crawlerapi.CrawlJob {
+	res := &crawlerapi.CrawlJob{
+		Id:             job.ID,
+		Type:           typeToProto(job.Type),
+		Url:            job.URL,
+		CookpadUserId:  job.CookpadUserID,
+		Status:         statusToProto(job.Status),
+		Discovered:     int32(job.Discovered), //nolint:gosec // far fewer than max int32
+		Crawled:        int32(job.Crawled),    //nolint:gosec // far fewer than max int32
+		Skipped:        int32(job.Skipped),    //nolint:gosec // far fewer than max int32
+		Failed:         int32(job.Failed),     //nolint:gosec // far fewer than max int32
+		Error:          job.Error,
+		CreateTime:     timestamppb.New(job.CreatedAt),
+		ItemsTruncated: job.Crawled+job.Skipped+job.Failed > cookchatdb.MaxCrawlJobItems,
+	}
+	if !job.UpdatedAt.IsZero() {
+		res.UpdateTime = timestamppb.New(job.UpdatedAt)
+	}
+	if !job.FinishedAt.IsZero() {
+		res.FinishTime = timestamppb.New(job.FinishedAt)
+	}
+	return res
+}
+
+func typeToProto(t cookchatdb.CrawlJobType) crawlerapi.CrawlJobType {
+	switch t {
+	case cookchatdb.CrawlJobTypeRecipe:
+		return crawlerapi.CrawlJobType_CRAWL_JOB_TYPE_RECIPE
+	case cookchatdb.CrawlJobTypeCookpadUser:
+		return crawlerapi.CrawlJobType_CRAWL_JOB_TYPE_COOKPAD_USER
+	case cookchatdb.CrawlJobTypeSitemap:
+		return crawlerapi.CrawlJobType_CRAWL_JOB_TYPE_SITEMAP
+	case cookchatdb.CrawlJobTypeRecrawl:
+		return crawlerapi.CrawlJobType_CRAWL_JOB_TYPE_RECRAWL
+	default:
+		return crawlerapi.CrawlJobType_CRAWL_JOB_TYPE_UNSPECIFIED
+	}
+}
+
+func statusToProto(s cookchatdb.CrawlJobStatus) crawlerapi.CrawlJobStatus {
+	switch s {
+	case cookchatdb.CrawlJobStatusRunning:
+		return crawlerapi.CrawlJobStatus_CRAWL_JOB_STATUS_RUNNING
+	case cookchatdb.CrawlJobStatusSucceeded:
+		return crawlerapi.CrawlJobStatus_CRAWL_JOB_STATUS_SUCCEEDED
+	case cookchatdb.CrawlJobStatusFailed:
+		return crawlerapi.CrawlJobStatus_CRAWL_JOB_STATUS_FAILED
+	default:
+		return crawlerapi.CrawlJobStatus_CRAWL_JOB_STATUS_UNSPECIFIED
+	}
+}
+
+func statusFromProto(s crawlerapi.CrawlJobStatus) cookchatdb.CrawlJobStatus {
+	switch s {
+	case crawlerapi.CrawlJobStatus_CRAWL_JOB_STATUS_RUNNING:
+		return cookchatdb.CrawlJobStatusRunning
+	case crawlerapi.CrawlJobStatus_CRAWL_JOB_STATUS_SUCCEEDED:
+		return cookchatdb.CrawlJobStatusSucceeded
+	case crawlerapi.CrawlJobStatus_CRAWL_JOB_STATUS_FAILED:
+		return cookchatdb.CrawlJobStatusFailed
+	case crawlerapi.CrawlJobStatus_CRAWL_JOB_STATUS_UNSPECIFIED:
+		fallthrough
+	default:
+		return ""
+	}
+}
+
+func itemStatusToProto(s cookchatdb.CrawlJobItemStatus) crawlerapi.CrawlJobItemStatus {
+	switch s {
+	case cookchatdb.CrawlJobItemStatusCrawled:
+		return crawlerapi.CrawlJobItemStatus_CRAWL_JOB_ITEM_STATUS_CRAWLED
+	case cookchatdb.CrawlJobItemStatusSkipped:
+		return crawlerapi.CrawlJobItemStatus_CRAWL_JOB_ITEM_STATUS_SKIPPED
+	case cookchatdb.CrawlJobItemStatusFailed:
+		return crawlerapi.CrawlJobItemStatus_CRAWL_JOB_ITEM_STATUS_FAILED
+	default:
+		return crawlerapi.CrawlJobItemStatus_CRAWL_JOB_ITEM_STATUS_UNSPECIFIED
+	}
+}
@@ -4,213 +4,367 @@
 package recipe
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"image/jpeg"
-	"image/png"
-	"strings"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
 
 	"cloud.google.com/go/firestore"
-	"cloud.google.com/go/storage"
-	"github.com/gocolly/colly/v2"
+	"connectrpc.com/connect"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/genai"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/image"
+	"github.com/curioswitch/cookchat/common/recipegen"
 	crawlerapi "github.com/curioswitch/cookchat/crawler/api/go"
+	"github.com/curioswitch/cookchat/crawler/server/internal/crawljob"
+	"github.com/curioswitch/cookchat/crawler/server/internal/extractor"
+	"github.com/curioswitch/cookchat/crawler/server/internal/polite"
 )
 
-var errMalformedID = errors.New("cookpad:recipe: malformed ID in existing recipe")
+// imageConcurrency is the number of images of a recipe stored at the same time. Images are
+// usually on the same host, which the polite transport limits further.
+const imageConcurrency = 4
 
-type howToStep struct {
-	Text string `json:"text"`
+var (
+	errMalformedID    = errors.New("recipe: malformed ID in existing recipe")
+	errUnsupportedURL = errors.New("recipe: unsupported recipe URL")
+)
+
+func NewHandler(baseURLs extractor.BaseURLs, transport *polite.Transport, store *firestore.Client, images *image.Writer, genAI *genai.Client, processor *recipegen.PostProcessor) *Handler {
+	return &Handler{
+		baseURLs:   baseURLs,
+		httpClient: &http.Client{Transport: transport},
+		store:      store,
+		images:     images,
+		genAI:      genAI,
+		processor:  processor,
+	}
 }
 
-type recipeSchema struct {
-	Type               string      `json:"@type"`
-	Description        string      `json:"description"`
-	Name               string      `json:"name"`
-	RecipeIngredient   []string    `json:"recipeIngredient"`
-	RecipeInstructions []howToStep `json:"recipeInstructions"`
-	RecipeYield        string      `json:"recipeYield"`
+type Handler struct {
+	baseURLs   extractor.BaseURLs
+	httpClient *http.Client
+	store      *firestore.Client
+	images     *image.Writer
+	genAI      *genai.Client
+	processor  *recipegen.PostProcessor
 }
 
-type orangepadSchema struct {
-	Graph []recipeSchema `json:"@graph"`
+func (h *Handler) CrawlRecipe(ctx context.Context, req *crawlerapi.CrawlRecipeRequest) (*crawlerapi.CrawlRecipeResponse, error) {
+	ext, sourceID, ok := extractor.Match(req.GetUrl())
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %s", errUnsupportedURL, req.GetUrl()))
+	}
+	res, err := h.crawlAsJob(ctx, ext, sourceID, req.GetParentJobId())
+	if err != nil {
+		return nil, err
+	}
+	return &crawlerapi.CrawlRecipeResponse{
+		JobId:     res.jobID,
+		RecipeId:  res.recipeID,
+		Unchanged: res.unchanged,
+	}, nil
 }
 
-func NewHandler(baseCollector *colly.Collector, store *firestore.Client, storage *storage.Client, genAI *genai.Client, publicBucket string) *Handler {
-	return &Handler{
-		baseCollector: baseCollector,
-		store:         store,
-		storage:       storage,
-		genAI:         genAI,
-		publicBucket:  publicBucket,
+func (h *Handler) CrawlCookpadRecipe(ctx context.Context, req *crawlerapi.CrawlCookpadRecipeRequest) (*crawlerapi.CrawlCookpadRecipeResponse, error) {
+	ext, _ := extractor.ForSource(cookchatdb.RecipeSourceCookpad)
+	res, err := h.crawlAsJob(ctx, ext, req.GetRecipeId(), req.GetParentJobId())
+	if err != nil {
+		return nil, err
 	}
+	return &crawlerapi.CrawlCookpadRecipeResponse{
+		JobId:     res.jobID,
+		RecipeId:  res.recipeID,
+		Unchanged: res.unchanged,
+	}, nil
 }
 
-type Handler struct {
-	baseCollector *colly.Collector
-	store         *firestore.Client
-	storage       *storage.Client
-	genAI         *genai.Client // Not used in this handler, but included for consistency.
-	publicBucket  string
+// crawlResult is the result of crawling a recipe.
+type crawlResult struct {
+	// jobID is the ID of the job recording the crawl, empty if part of a parent job.
+	jobID string
+
+	recipeID string
+
+	// unchanged is whether the recipe was already crawled and its page hasn't changed.
+	unchanged bool
 }
 
-func (h *Handler) CrawlRecipe(ctx context.Context, req *crawlerapi.CrawlRecipeRequest) (*crawlerapi.CrawlRecipeResponse, error) {
-	// Avoid clone since we don't want to share storage.
-	c := colly.NewCollector(
-		colly.UserAgent(h.baseCollector.UserAgent),
-		colly.StdlibContext(ctx),
-	)
-
-	var recipe *cookchatdb.Recipe
-
-	var sourceID string
-	var source cookchatdb.RecipeSource
-	switch {
-	case strings.HasPrefix(req.GetUrl(), "https://www.orangepage.net/recipes/"):
-		sourceID = req.GetUrl()[len("https://www.orangepage.net/recipes/"):]
-		source = cookchatdb.RecipeSourceOrangePage
-	case strings.HasPrefix(req.GetUrl(), "https://delishkitchen.tv/recipes/"):
-		sourceID = req.GetUrl()[len("https://delishkitchen.tv/recipes/"):]
-		source = cookchatdb.RecipeSourceDelishKitchen
-	default:
-		return nil, fmt.Errorf("recipe: unsupported recipe URL: %s", req.GetUrl())
+// crawlAsJob crawls the recipe, recording the crawl as its own job unless it is part of the
+// parent job with parentJobID, which records the result itself.
+func (h *Handler) crawlAsJob(ctx context.Context, ext extractor.Extractor, sourceID string, parentJobID string) (crawlResult, error) {
+	if parentJobID != "" {
+		recipeID, unchanged, err := h.crawl(ctx, ext, sourceID)
+		return crawlResult{recipeID: recipeID, unchanged: unchanged}, err
+	}
+
+	recipeURL := ext.URL(sourceID)
+	job, err := crawljob.Start(ctx, h.store, cookchatdb.CrawlJob{
+		Type:       cookchatdb.CrawlJobTypeRecipe,
+		URL:        recipeURL,
+		Discovered: 1,
+	})
+	if err != nil {
+		return crawlResult{}, fmt.Errorf("recipe: starting crawl job: %w", err)
+	}
+
+	recipeID, unchanged, crawlErr := h.crawl(ctx, ext, sourceID)
+	item := cookchatdb.CrawlJobItem{
+		URL:      recipeURL,
+		Status:   cookchatdb.CrawlJobItemStatusCrawled,
+		RecipeID: recipeID,
 	}
+	if unchanged {
+		item.Status = cookchatdb.CrawlJobItemStatusSkipped
+	}
+	if crawlErr != nil {
+		item.Status = cookchatdb.CrawlJobItemStatusFailed
+		item.Error = crawlErr.Error()
+	}
+	job.AddItem(item)
 
+	if _, err := job.Finish(ctx, crawlErr); err != nil {
+		return crawlResult{}, fmt.Errorf("recipe: finishing crawl job: %w", err)
+	}
+	if crawlErr != nil {
+		return crawlResult{}, crawlErr
+	}
+	return crawlResult{
+		jobID:     job.ID(),
+		recipeID:  recipeID,
+		unchanged: unchanged,
+	}, nil
+}
+
+// crawl crawls the recipe with sourceID from the site of ext, saving it to the recipes
+// collection and returning its ID. If the recipe has already been crawled, it is only updated
+// if its page has changed, returning true if it has not.
+func (h *Handler) crawl(ctx context.Context, ext extractor.Extractor, sourceID string) (string, bool, error) {
 	recipes := h.store.Collection("recipes")
-	doc := recipes.Doc(fmt.Sprintf("%s-%s", source, sourceID))
-	existing, err := doc.Get(ctx)
+	doc := recipes.Doc(extractor.DocumentID(ext, sourceID))
+	existingDoc, err := doc.Get(ctx)
 	if err != nil && status.Code(err) != codes.NotFound {
-		return nil, fmt.Errorf("recipe: failed to get existing recipe: %w", err)
+		return "", false, fmt.Errorf("recipe: failed to get existing recipe: %w", err)
 	}
-	if existing.Exists() {
-		var recipe *cookchatdb.Recipe
-		if err := existing.DataTo(&recipe); err != nil {
-			return nil, fmt.Errorf("recipe: failed to unmarshal existing recipe: %w", err)
-		}
-		if err := h.postProcessRecipe(ctx, recipe); err != nil {
-			return nil, err
+	var existing *cookchatdb.Recipe
+	var prev *cookchatdb.RecipeCrawl
+	if existingDoc.Exists() {
+		if err := existingDoc.DataTo(&existing); err != nil {
+			return "", false, fmt.Errorf("recipe: failed to unmarshal existing recipe: %w", err)
 		}
-		if _, err := doc.Set(ctx, recipe); err != nil {
-			return nil, fmt.Errorf("recipe: failed to update existing recipe: %w", err)
-		}
-		return &crawlerapi.CrawlRecipeResponse{}, nil
+		prev = existing.Crawl
 	}
 
-	// Follow firestore conventions for IDs, though we don't use it in the actual document ID.
-	recipeID := recipes.NewDoc().ID
-
-	c.OnHTML(`script[type="application/ld+json"]`, func(e *colly.HTMLElement) {
-		if recipe != nil {
-			return
+	recipe, err := extractor.Fetch(ctx, h.httpClient, ext, h.baseURLs.URL(ext, sourceID), sourceID, prev)
+	if errors.Is(err, extractor.ErrNotModified) {
+		crawl := *prev
+		crawl.CrawledAt = time.Now()
+		if err := markCrawled(ctx, doc, &crawl); err != nil {
+			return "", false, err
 		}
-
-		var recipeJSON recipeSchema
-
-		switch source { //nolint:exhaustive
-		case cookchatdb.RecipeSourceOrangePage:
-			var schema orangepadSchema
-			if err := json.Unmarshal([]byte(e.Text), &schema); err != nil {
-				// TODO: Log errors
-				return
-			}
-			if len(schema.Graph) < 3 {
-				// TODO: Log errors
-				return
-			}
-			recipeJSON = schema.Graph[2]
-		case cookchatdb.RecipeSourceDelishKitchen:
-			if err := json.Unmarshal([]byte(e.Text), &recipeJSON); err != nil {
-				// TODO: Log errors
-				return
-			}
-			if recipeJSON.Type != "Recipe" {
-				return
-			}
-		default:
-			// Should not happen.
-			return
+		return existing.ID, true, nil
+	}
+	if err != nil {
+		if errors.Is(err, polite.ErrDisallowed) {
+			return "", false, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		if errors.Is(err, extractor.ErrNoRecipe) {
+			return "", false, connect.NewError(connect.CodeInvalidArgument, err)
 		}
+		return "", false, fmt.Errorf("recipe: failed to crawl page: %w", err)
+	}
+	if prev != nil && prev.ContentHash == recipe.Crawl.ContentHash {
+		// The site doesn't support conditional requests but the recipe hasn't changed. Still
+		// save the new state in case it starts to.
+		if err := markCrawled(ctx, doc, recipe.Crawl); err != nil {
+			return "", false, err
+		}
+		return existing.ID, true, nil
+	}
 
-		description := recipeJSON.Description
-		servingSize := recipeJSON.RecipeYield
+	if existing != nil {
+		// Keep what isn't extracted from the page while all content derived from the page,
+		// such as translations, is generated again.
+		recipe.ID = existing.ID
+		recipe.Type = existing.Type
+		recipe.Genre = existing.Genre
+		resetStatus(recipe, existing)
+	} else {
+		// Follow firestore conventions for IDs, though we don't use it in the actual document ID.
+		recipe.ID = recipes.NewDoc().ID
+	}
 
-		ingredients := make([]cookchatdb.RecipeIngredient, len(recipeJSON.RecipeIngredient))
-		for i, ingredientText := range recipeJSON.RecipeIngredient {
-			name, quantity, _ := strings.Cut(ingredientText, " ")
-			ingredients[i] = cookchatdb.RecipeIngredient{
-				Name:     name,
-				Quantity: quantity,
-			}
+	if ext.Rewrite() {
+		// Images of rewritten recipes are generated during post-processing rather than copied.
+		recipe.ImageURL = ""
+		for i := range recipe.Content.Steps {
+			recipe.Content.Steps[i].ImageURL = ""
 		}
-
-		steps := make([]cookchatdb.RecipeStep, len(recipeJSON.RecipeInstructions))
-		for i, stepJSON := range recipeJSON.RecipeInstructions {
-			steps[i] = cookchatdb.RecipeStep{
-				Description: stepJSON.Text,
+		if existing != nil {
+			// Generated images are expensive, so keep them as long as the steps they are for
+			// still exist.
+			recipe.ImageURL = existing.ImageURL
+			if len(existing.StepImageURLs) == len(recipe.Content.Steps) {
+				recipe.StepImageURLs = existing.StepImageURLs
+				for i, imageURL := range existing.StepImageURLs {
+					recipe.Content.Steps[i].ImageURL = imageURL
+				}
 			}
 		}
+	} else if err := h.storeImages(ctx, recipe); err != nil {
+		return "", false, err
+	}
 
-		recipe = &cookchatdb.Recipe{
-			ID:       recipeID,
-			Source:   source,
-			SourceID: sourceID,
-			Content: cookchatdb.RecipeContent{
-				Title:       recipeJSON.Name,
-				Description: description,
-				Ingredients: ingredients,
-				Steps:       steps,
-				ServingSize: servingSize,
-			},
-			LanguageCode: "ja",
-		}
-	})
-
-	if err := c.Visit(req.GetUrl()); err != nil {
-		return nil, fmt.Errorf("recipe: failed to scrape page: %w", err)
+	if err := h.postProcessRecipe(ctx, recipe, ext.Rewrite()); err != nil {
+		return "", false, err
 	}
 
-	if err := h.postProcessRecipe(ctx, recipe); err != nil {
-		return nil, err
+	if existing != nil {
+		if _, err := doc.Set(ctx, recipe); err != nil {
+			return "", false, fmt.Errorf("recipe: failed to update existing recipe: %w", err)
+		}
+		return recipe.ID, false, nil
 	}
 
 	if _, err := doc.Create(ctx, recipe); err != nil {
 		if status.Code(err) != codes.AlreadyExists {
-			return nil, fmt.Errorf("recipe: failed to create recipe: %w", err)
+			return "", false, fmt.Errorf("recipe: failed to create recipe: %w", err)
 		}
 		existing, err := doc.Get(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("recipe: failed to get existing recipe: %w", err)
+			return "", false, fmt.Errorf("recipe: failed to get existing recipe: %w", err)
 		}
 		// TODO: We can save an RPC by using a merge instead of fetching, but it's tedious since
 		// it doesn't support structs.
 		id, ok := existing.Data()["id"].(string)
 		if !ok {
-			return nil, errMalformedID
+			return "", false, errMalformedID
 		}
 		recipe.ID = id
 		if _, err := doc.Set(ctx, recipe); err != nil {
-			return nil, fmt.Errorf("recipe: failed to update recipe: %w", err)
+			return "", false, fmt.Errorf("recipe: failed to update recipe: %w", err)
 		}
 	}
 
-	return &crawlerapi.CrawlRecipeResponse{}, nil
+	return recipe.ID, false, nil
+}
+
+// resetStatus sets the processing status of recipe, which replaces existing with changed
+// content. The recipe is saved only once post-processed, so it is complete unless a task is
+// still processing it, which is left to finish. Processing state of existing that is not
+// copied, such as its failure reason, is cleared.
+func resetStatus(recipe *cookchatdb.Recipe, existing *cookchatdb.Recipe) {
+	switch existing.Status {
+	case cookchatdb.RecipeStatusProcessing:
+		recipe.Status = existing.Status
+		recipe.ProcessingStartedAt = existing.ProcessingStartedAt
+		recipe.Attempts = existing.Attempts
+	case cookchatdb.RecipeStatusFailed:
+		// Failures were of the previous content, which has been processed again.
+		recipe.Status = cookchatdb.RecipeStatusActive
+	default:
+		recipe.Status = existing.Status
+	}
 }
 
-type classificationResult struct {
-	Type  cookchatdb.RecipeType  `json:"type"`
-	Genre cookchatdb.RecipeGenre `json:"genre"`
+// markCrawled records that the recipe in doc was crawled without changes, with the state of
+// its page.
+func markCrawled(ctx context.Context, doc *firestore.DocumentRef, crawl *cookchatdb.RecipeCrawl) error {
+	if _, err := doc.Update(ctx, []firestore.Update{
+		{Path: "crawl", Value: crawl},
+	}); err != nil {
+		return fmt.Errorf("recipe: failed to update crawl state: %w", err)
+	}
+	return nil
 }
 
-func (h *Handler) postProcessRecipe(ctx context.Context, recipe *cookchatdb.Recipe) error {
-	sourceJSON, err := json.Marshal(recipe)
+// storeImages copies the images of the extracted recipe from the site to our storage,
+// replacing their URLs. Images that can't be used are removed, to be generated instead.
+func (h *Handler) storeImages(ctx context.Context, recipe *cookchatdb.Recipe) error {
+	grp, ctx := errgroup.WithContext(ctx)
+	grp.SetLimit(imageConcurrency)
+	if recipe.ImageURL != "" {
+		grp.Go(func() error {
+			imageURL, err := h.storeImage(ctx, recipe.ImageURL)
+			if err != nil {
+				return fmt.Errorf("recipe: store main image: %w", err)
+			}
+			recipe.ImageURL = imageURL
+			return nil
+		})
+	}
+	for i := range recipe.Content.Steps {
+		step := &recipe.Content.Steps[i]
+		if step.ImageURL == "" {
+			continue
+		}
+		grp.Go(func() error {
+			imageURL, err := h.storeImage(ctx, step.ImageURL)
+			if err != nil {
+				return fmt.Errorf("recipe: store step image: %w", err)
+			}
+			step.ImageURL = imageURL
+			return nil
+		})
+	}
+	return grp.Wait()
+}
+
+// storeImage copies the image at imageURL to our storage, returning its new URL, or an empty
+// URL if the image can't be used.
+func (h *Handler) storeImage(ctx context.Context, imageURL string) (string, error) {
+	data, err := image.Fetch(ctx, h.httpClient, imageURL)
+	if err == nil {
+		var url string
+		url, err = h.images.WriteImage(ctx, data)
+		if err == nil {
+			return url, nil
+		}
+	}
+	if errors.Is(err, image.ErrInvalidImage) {
+		slog.WarnContext(ctx, "recipe: skipping invalid image", "url", imageURL, "error", err)
+		return "", nil
+	}
+	return "", err
+}
+
+// postProcessRecipe retells the content of recipe if rewrite, then post-processes it the same
+// as recipes from any other source.
+func (h *Handler) postProcessRecipe(ctx context.Context, recipe *cookchatdb.Recipe, rewrite bool) error {
+	if rewrite {
+		if err := h.rewriteRecipe(ctx, recipe); err != nil {
+			return err
+		}
+	}
+
+	// Step images are only generated when there are none, so only use those from the site
+	// if it has any.
+	if len(recipe.StepImageURLs) == 0 && slices.ContainsFunc(recipe.Content.Steps, func(step cookchatdb.RecipeStep) bool {
+		return step.ImageURL != ""
+	}) {
+		recipe.StepImageURLs = make([]string, len(recipe.Content.Steps))
+		for i, step := range recipe.Content.Steps {
+			recipe.StepImageURLs[i] = step.ImageURL
+		}
+	}
+
+	if err := h.processor.PostProcessRecipe(ctx, recipe, nil); err != nil {
+		return fmt.Errorf("recipe: post-processing recipe: %w", err)
+	}
+	return nil
+}
+
+// rewriteRecipe retells the content of recipe with AI so it is not copied as-is from the site.
+func (h *Handler) rewriteRecipe(ctx context.Context, recipe *cookchatdb.Recipe) error {
+	sourceJSON, err := json.Marshal(recipe.Content)
 	if err != nil {
 		return fmt.Errorf("recipe: failed to marshal recipe content: %w", err)
 	}
@@ -229,7 +383,7 @@ func (h *Handler) postProcessRecipe(ctx context.Context, recipe *cookchatdb.Reci
 			Role: "model",
 			Parts: []*genai.Part{
 				{
-					Text: "Read the provided recipe and return the same recipe, with title, recipe description, and step description updated to be told by you, in Japanese. Do not copy-paste the input as-is, but update these by retelling them. It must be the same recipe conceptually. Return all other fields as-is from the input.",
+					Text: "Read the provided recipe and return the same recipe, with title, recipe description, and step description updated to be told by you, in the same language as the input. Do not copy-paste the input as-is, but update these by retelling them. It must be the same recipe conceptually. Return all other fields as-is from the input.",
 				},
 			},
 		},
@@ -240,296 +394,11 @@ func (h *Handler) postProcessRecipe(ctx context.Context, recipe *cookchatdb.Reci
 		return fmt.Errorf("recipe: recreate recipe: %w", err)
 	}
 	if len(res.Candidates) != 1 || len(res.Candidates[0].Content.Parts) != 1 || res.Candidates[0].Content.Parts[0].Text == "" {
-		return fmt.Errorf("cookpad:recipe: unexpected recipe recreation response from generate ai: %v", res)
+		return fmt.Errorf("recipe: unexpected recipe recreation response from generate ai: %v", res)
 	}
-	if err := json.Unmarshal([]byte(res.Candidates[0].Content.Parts[0].Text), &recipe); err != nil {
+	if err := json.Unmarshal([]byte(res.Candidates[0].Content.Parts[0].Text), &recipe.Content); err != nil {
 		return fmt.Errorf("recipe: unmarshal recreated recipe: %w", err)
 	}
 
-	hasImage := recipe.ImageURL != ""
-
-	if len(recipe.StepImageURLs) == 0 {
-		recipe.StepImageURLs = make([]string, len(recipe.Content.Steps))
-		for i, step := range recipe.Content.Steps {
-			recipe.StepImageURLs[i] = step.ImageURL
-			if step.ImageURL != "" {
-				hasImage = true
-			}
-		}
-	}
-
-	sourceJSON, err = json.Marshal(recipe.Content)
-	if err != nil {
-		return fmt.Errorf("recipe: failed to marshal recipe content: %w", err)
-	}
-
-	if !hasImage {
-		prompts := []string{
-			"Generate an photo for the provided recipe. This usually represents the final product. If you cannot generate a photo with confidence it represents the recipe, do not return an image.",
-		}
-		for range recipe.Content.Steps {
-			prompts = append(prompts, "Generate a photo for the provided recipe step. This usually represents the ingredients used in the step and possibly action described in the step. If you cannot generate a photo with confidence it represents the step, do not return an image.")
-		}
-
-		var grp errgroup.Group
-		imageURLs := make([]string, len(prompts))
-		for i, prompt := range prompts {
-			grp.Go(func() error {
-				var content string
-				if i == 0 {
-					content = string(sourceJSON)
-				} else {
-					content = recipe.Content.Steps[i-1].Description
-				}
-
-				res, err := h.genAI.Models.GenerateContent(ctx, "gemini-3.1-flash-image", []*genai.Content{
-					{
-						Role: "user",
-						Parts: []*genai.Part{
-							{
-								Text: content,
-							},
-						},
-					},
-				}, &genai.GenerateContentConfig{
-					ResponseModalities: []string{"IMAGE"},
-					SystemInstruction: &genai.Content{
-						Role: "model",
-						Parts: []*genai.Part{
-							{
-								Text: prompt,
-							},
-						},
-					},
-				})
-				if err != nil {
-					return fmt.Errorf("recipe: generate ai image: %w", err)
-				}
-				if len(res.Candidates) > 0 && len(res.Candidates[0].Content.Parts) > 0 && res.Candidates[0].Content.Parts[0].InlineData != nil {
-					filename := "main-image.jpg"
-					if i > 0 {
-						filename = fmt.Sprintf("step-%03d.jpg", i-1)
-					}
-
-					imageBlob := res.Candidates[0].Content.Parts[0].InlineData
-					image := imageBlob.Data
-					if imageBlob.MIMEType == "image/png" {
-						img, err := png.Decode(bytes.NewReader(image))
-						if err != nil {
-							return fmt.Errorf("recipe: decoding png image: %w", err)
-						}
-						var buf bytes.Buffer
-						if err := jpeg.Encode(&buf, img, nil); err != nil {
-							return fmt.Errorf("recipe: encoding png to jpeg: %w", err)
-						}
-						image = buf.Bytes()
-					} else if imageBlob.MIMEType != "image/jpeg" {
-						return nil
-					}
-
-					path := fmt.Sprintf("recipes/%s/%s", recipe.ID, filename)
-					if err := h.saveFile(ctx, path, image); err != nil {
-						return fmt.Errorf("recipe: saving image to storage: %w", err)
-					}
-
-					imageURLs[i] = fmt.Sprintf("https://storage.googleapis.com/%s/%s", h.publicBucket, path)
-				}
-				return nil
-			})
-		}
-		if err := grp.Wait(); err != nil {
-			return err
-		}
-		recipe.ImageURL = imageURLs[0]
-		for i := 1; i < len(imageURLs); i++ {
-			recipe.StepImageURLs[i-1] = imageURLs[i]
-			recipe.Content.Steps[i-1].ImageURL = imageURLs[i]
-		}
-	}
-
-	if len(recipe.LocalizedContent) != 1 {
-		ingredientsSchema := &genai.Schema{
-			Type:        "array",
-			Description: "The ingredients in English.",
-			Items: &genai.Schema{
-				Type:        "object",
-				Description: "An ingredient in the recipe.",
-				Properties: map[string]*genai.Schema{
-					"name": {
-						Type:        "string",
-						Description: "The name of the ingredient in English.",
-					},
-					"quantity": {
-						Type:        "string",
-						Description: "The quantity of the ingredient in English.",
-					},
-				},
-				Required: []string{"name", "quantity"},
-			},
-		}
-
-		res, err := h.genAI.Models.GenerateContent(ctx, "gemini-3.6-flash", []*genai.Content{
-			{
-				Role: "user",
-				Parts: []*genai.Part{
-					{
-						Text: string(sourceJSON),
-					},
-				},
-			},
-		}, &genai.GenerateContentConfig{
-			SystemInstruction: &genai.Content{
-				Role: "model",
-				Parts: []*genai.Part{
-					{
-						Text: "Translate the provided recipe from Japanese to English.",
-					},
-				},
-			},
-			ResponseMIMEType: "application/json",
-			ResponseSchema: &genai.Schema{
-				Type: "object",
-				Properties: map[string]*genai.Schema{
-					"en": {
-						Type:        "object",
-						Description: "The English translation of the recipe.",
-						Required:    []string{"title", "description", "ingredients", "additionalIngredients", "steps", "notes", "servingSize"},
-						Properties: map[string]*genai.Schema{
-							"title": {
-								Type:        "string",
-								Description: "The English translation of the recipe title.",
-							},
-							"description": {
-								Type:        "string",
-								Description: "The English translation of the recipe description.",
-							},
-							"ingredients": ingredientsSchema,
-							"additionalIngredients": {
-								Type:        "array",
-								Description: "The additional ingredients of the recipe in English, grouped into sections.",
-								Items: &genai.Schema{
-									Type:        "object",
-									Description: "An additional ingredient section in the recipe.",
-									Properties: map[string]*genai.Schema{
-										"title": {
-											Type:        "string",
-											Description: "The title of the additional ingredient section in English.",
-										},
-										"ingredients": ingredientsSchema,
-									},
-									Required: []string{"title", "ingredients"},
-								},
-							},
-							"steps": {
-								Type:        "array",
-								Description: "The steps of the recipe in English.",
-								Items: &genai.Schema{
-									Type:        "object",
-									Description: "A step in the recipe.",
-									Properties: map[string]*genai.Schema{
-										"description": {
-											Type:        "string",
-											Description: "The description of the step in English.",
-										},
-									},
-									Required: []string{"description"},
-								},
-							},
-							"notes": {
-								Type:        "string",
-								Description: "Additional notes or comments about the recipe in English.",
-							},
-							"servingSize": {
-								Type:        "string",
-								Description: "The serving size of the recipe in English.",
-							},
-						},
-					},
-				},
-			},
-		})
-		if err != nil {
-			return fmt.Errorf("cookpad:recipe: generate ai translation: %w", err)
-		}
-		if len(res.Candidates) != 1 || len(res.Candidates[0].Content.Parts) != 1 || res.Candidates[0].Content.Parts[0].Text == "" {
-			return fmt.Errorf("cookpad:recipe: unexpected translate response from generate ai: %v", res)
-		}
-		text := res.Candidates[0].Content.Parts[0].Text
-		if err := json.Unmarshal([]byte(text), &recipe.LocalizedContent); err != nil {
-			return fmt.Errorf("cookpad:recipe: failed to unmarshal localized content: %w", err)
-		}
-	}
-
-	if recipe.Type == "" || recipe.Genre == "" {
-		res, err := h.genAI.Models.GenerateContent(ctx, "gemini-3.6-flash", []*genai.Content{
-			{
-				Role: "user",
-				Parts: []*genai.Part{
-					{
-						Text: string(sourceJSON),
-					},
-				},
-			},
-		}, &genai.GenerateContentConfig{
-			SystemInstruction: &genai.Content{
-				Role: "model",
-				Parts: []*genai.Part{
-					{
-						Text: "Classify the type and genre of the recipe. Return unknown for either if low confidence.",
-					},
-				},
-			},
-			ResponseMIMEType: "application/json",
-			ResponseSchema: &genai.Schema{
-				Type: "object",
-				Properties: map[string]*genai.Schema{
-					"type": {
-						Type:        "string",
-						Description: "The type of the recipe.",
-						Enum:        []string{string(cookchatdb.RecipeTypeUnknown), string(cookchatdb.RecipeTypeMainDish), string(cookchatdb.RecipeTypeSideDish), string(cookchatdb.RecipeTypeSoup)},
-					},
-					"genre": {
-						Type:        "string",
-						Description: "The genre of the recipe.",
-						Enum: []string{
-							string(cookchatdb.RecipeGenreUnknown),
-							string(cookchatdb.RecipeGenreJapanese),
-							string(cookchatdb.RecipeGenreWestern),
-							string(cookchatdb.RecipeGenreChinese),
-							string(cookchatdb.RecipeGenreEthnic),
-						},
-					},
-				},
-				Required: []string{"type", "genre"},
-			},
-		})
-		if err != nil {
-			return fmt.Errorf("cookpad:recipe: classify recipe: %w", err)
-		}
-		if len(res.Candidates) != 1 || len(res.Candidates[0].Content.Parts) != 1 || res.Candidates[0].Content.Parts[0].Text == "" {
-			return fmt.Errorf("cookpad:recipe: unexpected classification response from generate ai: %v", res)
-		}
-		text := res.Candidates[0].Content.Parts[0].Text
-		var classRes classificationResult
-		if err := json.Unmarshal([]byte(text), &classRes); err != nil {
-			return fmt.Errorf("cookpad:recipe: failed to unmarshal classification result: %w", err)
-		}
-		recipe.Type = classRes.Type
-		recipe.Genre = classRes.Genre
-	}
-
-	return nil
-}
-
-func (h *Handler) saveFile(ctx context.Context, path string, contents []byte) error {
-	w := h.storage.Bucket(h.publicBucket).Object(path).NewWriter(ctx)
-	defer func() {
-		// TODO: Log error
-		_ = w.Close()
-	}()
-	w.ContentType = "image/jpeg"
-	if _, err := w.Write(contents); err != nil {
-		return fmt.Errorf("cookpad:recipe: save image: %w", err)
-	}
 	return nil
 }
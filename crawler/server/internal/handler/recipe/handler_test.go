@@ -0,0 +1,67 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package recipe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+func TestResetStatus(t *testing.T) {
+	started := time.Now()
+	tests := []struct {
+		name     string
+		existing cookchatdb.Recipe
+		want     cookchatdb.Recipe
+	}{
+		{
+			name: "failed",
+			existing: cookchatdb.Recipe{
+				Status:              cookchatdb.RecipeStatusFailed,
+				FailureReason:       "translation failed",
+				Attempts:            3,
+				ProcessingStartedAt: started,
+			},
+			want: cookchatdb.Recipe{Status: cookchatdb.RecipeStatusActive},
+		},
+		{
+			name: "processing",
+			existing: cookchatdb.Recipe{
+				Status:              cookchatdb.RecipeStatusProcessing,
+				Attempts:            1,
+				ProcessingStartedAt: started,
+			},
+			want: cookchatdb.Recipe{
+				Status:              cookchatdb.RecipeStatusProcessing,
+				Attempts:            1,
+				ProcessingStartedAt: started,
+			},
+		},
+		{
+			name:     "active",
+			existing: cookchatdb.Recipe{Status: cookchatdb.RecipeStatusActive},
+			want:     cookchatdb.Recipe{Status: cookchatdb.RecipeStatusActive},
+		},
+		{
+			name:     "no status",
+			existing: cookchatdb.Recipe{},
+			want:     cookchatdb.Recipe{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got cookchatdb.Recipe
+			resetStatus(&got, &tc.existing)
+			if got.Status != tc.want.Status || got.FailureReason != tc.want.FailureReason ||
+				got.Attempts != tc.want.Attempts || !got.ProcessingStartedAt.Equal(tc.want.ProcessingStartedAt) {
+				t.Errorf("got status %q reason %q attempts %d started %v, want %q %q %d %v",
+					got.Status, got.FailureReason, got.Attempts, got.ProcessingStartedAt,
+					tc.want.Status, tc.want.FailureReason, tc.want.Attempts, tc.want.ProcessingStartedAt)
+			}
+		})
+	}
+}
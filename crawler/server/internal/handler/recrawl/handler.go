@@ -0,0 +1,222 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package recrawl
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	crawlerapi "github.com/curioswitch/cookchat/crawler/api/go"
+	"github.com/curioswitch/cookchat/crawler/api/go/crawlerapiconnect"
+	"github.com/curioswitch/cookchat/crawler/server/internal/config"
+	"github.com/curioswitch/cookchat/crawler/server/internal/crawljob"
+	"github.com/curioswitch/cookchat/crawler/server/internal/extractor"
+)
+
+const (
+	defaultMaxAge    = 30 * 24 * time.Hour
+	defaultBatchSize = 20
+
+	// crawlConcurrency is the number of recipes crawled at the same time. It is low since
+	// re-crawling is not urgent.
+	crawlConcurrency = 2
+
+	// backfillScanSize is the maximum number of recipes checked each time for ones crawled
+	// before their crawl state was recorded.
+	backfillScanSize = 200
+)
+
+func NewHandler(store *firestore.Client, crawlerClient crawlerapiconnect.CrawlerServiceClient, conf config.Recrawl) *Handler {
+	h := &Handler{
+		store:         store,
+		crawlerClient: crawlerClient,
+		maxAge:        conf.MaxAge,
+		batchSize:     conf.BatchSize,
+	}
+	if h.maxAge <= 0 {
+		h.maxAge = defaultMaxAge
+	}
+	if h.batchSize <= 0 {
+		h.batchSize = defaultBatchSize
+	}
+	return h
+}
+
+type Handler struct {
+	store         *firestore.Client
+	crawlerClient crawlerapiconnect.CrawlerServiceClient
+	maxAge        time.Duration
+	batchSize     int
+}
+
+func (h *Handler) RecrawlRecipes(ctx context.Context, _ *crawlerapi.RecrawlRecipesRequest) (*crawlerapi.RecrawlRecipesResponse, error) {
+	// Recrawls are scheduled periodically so also clean up jobs that stopped without finishing.
+	if err := crawljob.FailStale(ctx, h.store); err != nil {
+		slog.ErrorContext(ctx, "recrawl: failing stale crawl jobs", "error", err)
+	}
+
+	job, err := crawljob.Start(ctx, h.store, cookchatdb.CrawlJob{
+		Type: cookchatdb.CrawlJobTypeRecrawl,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("recrawl: starting crawl job: %w", err)
+	}
+
+	crawlErr := h.recrawl(ctx, job)
+	if _, err := job.Finish(ctx, crawlErr); err != nil {
+		return nil, fmt.Errorf("recrawl: finishing crawl job: %w", err)
+	}
+
+	return &crawlerapi.RecrawlRecipesResponse{
+		JobId: job.ID(),
+	}, nil
+}
+
+// recrawl crawls the recipes last crawled the longest ago, if before the max age, recording
+// the results in job. Recipes crawled before their crawl state was recorded are crawled
+// first since their age is unknown.
+func (h *Handler) recrawl(ctx context.Context, job *crawljob.Job) error {
+	docs, err := h.uncrawled(ctx, h.batchSize)
+	if err != nil {
+		return err
+	}
+	if remaining := h.batchSize - len(docs); remaining > 0 {
+		cutoff := time.Now().Add(-h.maxAge)
+		stale, err := h.store.Collection("recipes").
+			Select("source", "sourceId").
+			Where("crawl.crawledAt", "<", cutoff).
+			OrderBy("crawl.crawledAt", firestore.Asc).
+			Limit(remaining).
+			Documents(ctx).
+			GetAll()
+		if err != nil {
+			return fmt.Errorf("recrawl: querying recipes: %w", err)
+		}
+		docs = append(docs, stale...)
+	}
+	job.SetDiscovered(len(docs))
+
+	var grp errgroup.Group
+	grp.SetLimit(crawlConcurrency)
+	for _, doc := range docs {
+		var recipe cookchatdb.Recipe
+		if err := doc.DataTo(&recipe); err != nil {
+			return fmt.Errorf("recrawl: parsing recipe doc: %w", err)
+		}
+		grp.Go(func() error {
+			item := h.crawlRecipe(ctx, job, recipe)
+			if item.Status == cookchatdb.CrawlJobItemStatusFailed {
+				// Move the recipe to the back of the queue so recipes that keep failing, for
+				// example because they were removed from the site, don't block the rest.
+				if _, err := doc.Ref.Update(ctx, []firestore.Update{
+					{Path: "crawl.crawledAt", Value: time.Now()},
+				}); err != nil {
+					slog.ErrorContext(ctx, "recrawl: updating crawl time", "error", err)
+				}
+			}
+			job.AddItem(item)
+			return nil
+		})
+	}
+	_ = grp.Wait()
+
+	return nil
+}
+
+// uncrawled returns up to limit recipes from crawled sites without crawl state, which can't
+// be queried for. Up to backfillScanSize recipes are scanned each time, continuing from where
+// the previous scan stopped until all have been checked.
+func (h *Handler) uncrawled(ctx context.Context, limit int) ([]*firestore.DocumentSnapshot, error) {
+	stateDoc := h.store.Collection("crawlerState").Doc("recrawl")
+	var state cookchatdb.RecrawlState
+	snap, err := stateDoc.Get(ctx)
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, fmt.Errorf("recrawl: fetching recrawl state: %w", err)
+	}
+	if snap.Exists() {
+		if err := snap.DataTo(&state); err != nil {
+			return nil, fmt.Errorf("recrawl: decoding recrawl state: %w", err)
+		}
+	}
+	if state.BackfillDone {
+		return nil, nil
+	}
+
+	q := h.store.Collection("recipes").
+		Select("source", "sourceId", "crawl").
+		OrderBy(firestore.DocumentID, firestore.Asc).
+		Limit(backfillScanSize)
+	if state.BackfillCursor != "" {
+		q = q.StartAfter(state.BackfillCursor)
+	}
+	docs, err := q.Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("recrawl: scanning recipes: %w", err)
+	}
+
+	var res []*firestore.DocumentSnapshot
+	for _, doc := range docs {
+		state.BackfillCursor = doc.Ref.ID
+		var recipe cookchatdb.Recipe
+		if err := doc.DataTo(&recipe); err != nil {
+			return nil, fmt.Errorf("recrawl: parsing recipe doc: %w", err)
+		}
+		if _, ok := extractor.ForSource(recipe.Source); !ok || recipe.Crawl != nil {
+			continue
+		}
+		res = append(res, doc)
+		if len(res) == limit {
+			break
+		}
+	}
+	// A partial page that was fully checked reached the last recipe.
+	if len(res) < limit && len(docs) < backfillScanSize {
+		state.BackfillDone = true
+	}
+
+	if _, err := stateDoc.Set(ctx, state); err != nil {
+		return nil, fmt.Errorf("recrawl: saving recrawl state: %w", err)
+	}
+	return res, nil
+}
+
+// crawlRecipe crawls the recipe again, returning the result.
+func (h *Handler) crawlRecipe(ctx context.Context, job *crawljob.Job, recipe cookchatdb.Recipe) cookchatdb.CrawlJobItem {
+	ext, ok := extractor.ForSource(recipe.Source)
+	if !ok {
+		return cookchatdb.CrawlJobItem{
+			URL:    fmt.Sprintf("%s-%s", recipe.Source, recipe.SourceID),
+			Status: cookchatdb.CrawlJobItemStatusFailed,
+			Error:  fmt.Sprintf("recrawl: no extractor for recipe source %s", recipe.Source),
+		}
+	}
+
+	item := cookchatdb.CrawlJobItem{
+		URL: ext.URL(recipe.SourceID),
+	}
+	res, err := h.crawlerClient.CrawlRecipe(ctx, connect.NewRequest(&crawlerapi.CrawlRecipeRequest{
+		Url:         item.URL,
+		ParentJobId: job.ID(),
+	}))
+	if err != nil {
+		item.Status = cookchatdb.CrawlJobItemStatusFailed
+		item.Error = err.Error()
+		return item
+	}
+	item.Status = cookchatdb.CrawlJobItemStatusCrawled
+	if res.Msg.GetUnchanged() {
+		item.Status = cookchatdb.CrawlJobItemStatusSkipped
+	}
+	item.RecipeID = res.Msg.GetRecipeId()
+	return item
+}
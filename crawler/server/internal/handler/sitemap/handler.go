@@ -0,0 +1,201 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package sitemap
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	crawlerapi "github.com/curioswitch/cookchat/crawler/api/go"
+	"github.com/curioswitch/cookchat/crawler/api/go/crawlerapiconnect"
+	"github.com/curioswitch/cookchat/crawler/server/internal/crawljob"
+	"github.com/curioswitch/cookchat/crawler/server/internal/extractor"
+	"github.com/curioswitch/cookchat/crawler/server/internal/polite"
+)
+
+const (
+	// crawlConcurrency is the number of recipes crawled at the same time.
+	crawlConcurrency = 5
+
+	// maxSitemaps is the maximum number of sitemaps fetched for a sitemap index, to guard
+	// against huge or cyclic indexes.
+	maxSitemaps = 100
+
+	// crawlTimeout is the maximum time a sitemap crawl runs in the background.
+	crawlTimeout = 6 * time.Hour
+)
+
+func NewHandler(transport *polite.Transport, store *firestore.Client, crawlerClient crawlerapiconnect.CrawlerServiceClient) *Handler {
+	return &Handler{
+		httpClient:    &http.Client{Transport: transport},
+		store:         store,
+		crawlerClient: crawlerClient,
+	}
+}
+
+type Handler struct {
+	httpClient    *http.Client
+	store         *firestore.Client
+	crawlerClient crawlerapiconnect.CrawlerServiceClient
+}
+
+func (h *Handler) CrawlSitemap(ctx context.Context, req *crawlerapi.CrawlSitemapRequest) (*crawlerapi.CrawlSitemapResponse, error) {
+	job, err := crawljob.Start(ctx, h.store, cookchatdb.CrawlJob{
+		Type: cookchatdb.CrawlJobTypeSitemap,
+		URL:  req.GetUrl(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sitemap: starting crawl job: %w", err)
+	}
+
+	// Sitemaps can list far more recipes than can be crawled within a request, so the crawl
+	// continues after responding and its progress is recorded in the job.
+	go h.run(context.WithoutCancel(ctx), job, req)
+
+	return &crawlerapi.CrawlSitemapResponse{
+		JobId: job.ID(),
+	}, nil
+}
+
+// run discovers and crawls the recipes in the sitemap of req, recording the results in job.
+func (h *Handler) run(ctx context.Context, job *crawljob.Job, req *crawlerapi.CrawlSitemapRequest) {
+	ctx, cancel := context.WithTimeout(ctx, crawlTimeout)
+	defer cancel()
+
+	var cutoff time.Time
+	if req.GetModifiedAfter() != nil {
+		cutoff = req.GetModifiedAfter().AsTime()
+	}
+
+	recipeURLs, err := h.discover(ctx, job, req.GetUrl(), cutoff)
+	if err == nil {
+		if limit := int(req.GetLimit()); limit > 0 && len(recipeURLs) > limit {
+			recipeURLs = recipeURLs[:limit]
+		}
+		job.SetDiscovered(len(recipeURLs))
+		h.crawl(ctx, job, recipeURLs)
+		err = ctx.Err()
+	}
+
+	if _, err := job.Finish(ctx, err); err != nil {
+		slog.ErrorContext(ctx, "sitemap: finishing crawl job", "jobId", job.ID(), "error", err)
+	}
+}
+
+// discover returns the URLs of recipes with an extractor in the sitemap at sitemapURL,
+// following sitemap indexes. Child sitemaps that fail are recorded in job.
+func (h *Handler) discover(ctx context.Context, job *crawljob.Job, sitemapURL string, cutoff time.Time) ([]string, error) {
+	var recipeURLs []string
+	seen := map[string]struct{}{}
+
+	queue := []string{sitemapURL}
+	fetched := map[string]struct{}{}
+	for len(queue) > 0 && len(fetched) < maxSitemaps {
+		u := queue[0]
+		queue = queue[1:]
+		if _, ok := fetched[u]; ok {
+			continue
+		}
+		fetched[u] = struct{}{}
+
+		s, err := h.fetchSitemap(ctx, u)
+		if err != nil {
+			if u == sitemapURL {
+				return nil, err
+			}
+			// A broken child sitemap shouldn't prevent crawling the rest.
+			job.AddItem(cookchatdb.CrawlJobItem{
+				URL:    u,
+				Status: cookchatdb.CrawlJobItemStatusFailed,
+				Error:  err.Error(),
+			})
+			continue
+		}
+
+		for _, child := range s.Sitemaps {
+			if child.modifiedAfter(cutoff) {
+				queue = append(queue, strings.TrimSpace(child.Loc))
+			}
+		}
+		for _, page := range s.URLs {
+			loc := strings.TrimSpace(page.Loc)
+			if !page.modifiedAfter(cutoff) {
+				continue
+			}
+			ext, sourceID, ok := extractor.MatchSite(loc)
+			if !ok {
+				continue
+			}
+			// Normalize to the canonical URL to dedupe variants of the same recipe.
+			recipeURL := ext.URL(sourceID)
+			if _, ok := seen[recipeURL]; ok {
+				continue
+			}
+			seen[recipeURL] = struct{}{}
+			recipeURLs = append(recipeURLs, recipeURL)
+		}
+	}
+
+	return recipeURLs, nil
+}
+
+func (h *Handler) fetchSitemap(ctx context.Context, sitemapURL string) (*sitemap, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sitemapURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("sitemap: creating request: %w", err)
+	}
+	res, err := h.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sitemap: fetching sitemap: %w", err)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("sitemap: fetching sitemap %s: status %d", sitemapURL, res.StatusCode)
+	}
+	return parseSitemap(res.Body)
+}
+
+// crawl crawls the recipes at recipeURLs, recording the results in job.
+func (h *Handler) crawl(ctx context.Context, job *crawljob.Job, recipeURLs []string) {
+	var grp errgroup.Group
+	grp.SetLimit(crawlConcurrency)
+	for _, u := range recipeURLs {
+		grp.Go(func() error {
+			res, err := h.crawlerClient.CrawlRecipe(ctx, connect.NewRequest(&crawlerapi.CrawlRecipeRequest{
+				Url:         u,
+				ParentJobId: job.ID(),
+			}))
+			if err != nil {
+				job.AddItem(cookchatdb.CrawlJobItem{
+					URL:    u,
+					Status: cookchatdb.CrawlJobItemStatusFailed,
+					Error:  err.Error(),
+				})
+				return nil
+			}
+			item := cookchatdb.CrawlJobItem{
+				URL:      u,
+				Status:   cookchatdb.CrawlJobItemStatusCrawled,
+				RecipeID: res.Msg.GetRecipeId(),
+			}
+			if res.Msg.GetUnchanged() {
+				item.Status = cookchatdb.CrawlJobItemStatusSkipped
+			}
+			job.AddItem(item)
+			return nil
+		})
+	}
+	_ = grp.Wait()
+}
@@ -0,0 +1,82 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package sitemap
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// maxSitemapSize is the maximum uncompressed size of a sitemap allowed by the protocol.
+const maxSitemapSize = 50 << 20
+
+// lastmodLayouts are the W3C datetime formats allowed for lastmod.
+var lastmodLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02",
+	"2006-01",
+	"2006",
+}
+
+type entry struct {
+	Loc     string `xml:"loc"`
+	Lastmod string `xml:"lastmod"`
+}
+
+// sitemap is either a urlset or sitemapindex, only one of URLs or Sitemaps is populated.
+type sitemap struct {
+	XMLName  xml.Name
+	URLs     []entry `xml:"url"`
+	Sitemaps []entry `xml:"sitemap"`
+}
+
+// parseSitemap parses the sitemap or sitemap index in r, decompressing it if gzipped.
+func parseSitemap(r io.Reader) (*sitemap, error) {
+	br := bufio.NewReader(r)
+	if magic, _ := br.Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, fmt.Errorf("sitemap: decompressing sitemap: %w", err)
+		}
+		defer func() {
+			_ = zr.Close()
+		}()
+		r = zr
+	} else {
+		r = br
+	}
+
+	var s sitemap
+	if err := xml.NewDecoder(io.LimitReader(r, maxSitemapSize)).Decode(&s); err != nil {
+		return nil, fmt.Errorf("sitemap: parsing sitemap: %w", err)
+	}
+	switch s.XMLName.Local {
+	case "urlset", "sitemapindex":
+	default:
+		return nil, fmt.Errorf("sitemap: unexpected root element %q", s.XMLName.Local)
+	}
+	return &s, nil
+}
+
+// modifiedAfter returns whether e was modified after cutoff. Entries without a valid lastmod
+// are assumed to be modified.
+func (e entry) modifiedAfter(cutoff time.Time) bool {
+	if cutoff.IsZero() {
+		return true
+	}
+	lastmod := strings.TrimSpace(e.Lastmod)
+	for _, layout := range lastmodLayouts {
+		if t, err := time.Parse(layout, lastmod); err == nil {
+			return t.After(cutoff)
+		}
+	}
+	return true
+}
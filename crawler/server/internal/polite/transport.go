@@ -0,0 +1,312 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package polite limits crawling of sites to what they allow. All requests by the crawler to
+// sites, whether pages by colly or images, should go through the same Transport.
+package polite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/temoto/robotstxt"
+
+	"github.com/curioswitch/cookchat/crawler/server/internal/config"
+)
+
+const (
+	defaultConcurrency = 2
+	defaultDelay       = time.Second
+	defaultMaxRetries  = 3
+
+	// robotsTTL is how long a fetched robots.txt is used for.
+	robotsTTL = 24 * time.Hour
+	// robotsErrorTTL is how long a robots.txt that could not be fetched due to a server error
+	// is used for, during which the site is not crawled.
+	robotsErrorTTL = 10 * time.Minute
+
+	// maxBackoff is the longest time to wait before retrying a rate-limited request.
+	maxBackoff = 5 * time.Minute
+
+	// hostIdleTTL is how long the state of a host without requests is kept. It is longer than
+	// maxBackoff so backoffs are respected.
+	hostIdleTTL = 30 * time.Minute
+)
+
+// ErrDisallowed is returned for requests to URLs disallowed by the robots.txt of the site.
+var ErrDisallowed = errors.New("polite: disallowed by robots.txt")
+
+// NewTransport returns a Transport making requests with base, identifying as userAgent to
+// sites.
+func NewTransport(base http.RoundTripper, userAgent string, conf config.Politeness) *Transport {
+	t := &Transport{
+		base:        base,
+		userAgent:   userAgent,
+		concurrency: conf.Concurrency,
+		delay:       conf.Delay,
+		maxRetries:  conf.MaxRetries,
+		idleTTL:     hostIdleTTL,
+		hosts:       map[string]*host{},
+	}
+	if t.concurrency <= 0 {
+		t.concurrency = defaultConcurrency
+	}
+	if t.delay <= 0 {
+		t.delay = defaultDelay
+	}
+	if t.maxRetries <= 0 {
+		t.maxRetries = defaultMaxRetries
+	}
+	return t
+}
+
+// Transport is an http.RoundTripper that respects robots.txt, limits the concurrency and rate
+// of requests to each host, and backs off and retries when a host rate limits requests.
+type Transport struct {
+	base        http.RoundTripper
+	userAgent   string
+	concurrency int
+	delay       time.Duration
+	maxRetries  int
+	idleTTL     time.Duration
+
+	mu        sync.Mutex
+	hosts     map[string]*host
+	lastSweep time.Time
+}
+
+type host struct {
+	// active is the number of requests using the host and lastUsed the time the last one
+	// finished, guarded by the mu of the Transport. Hosts are evicted once idle for a while.
+	active   int
+	lastUsed time.Time
+
+	// slots limits the number of concurrent requests to the host.
+	slots chan struct{}
+
+	// robotsMu serializes fetching of robots.txt so it is only fetched once when expired.
+	robotsMu     sync.Mutex
+	robots       *robotstxt.RobotsData
+	robotsExpiry time.Time
+
+	// mu guards next.
+	mu sync.Mutex
+	// next is the earliest time the next request to the host can start.
+	next time.Time
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if req.Header.Get("User-Agent") == "" {
+		req = req.Clone(ctx)
+		req.Header.Set("User-Agent", t.userAgent)
+	}
+
+	h := t.acquire(req)
+	res, err := t.roundTrip(ctx, h, req)
+	if err != nil {
+		t.release(h)
+		return nil, err
+	}
+	return res, nil
+}
+
+// roundTrip makes req to the host h. The host is released when the body of the returned
+// response is closed.
+func (t *Transport) roundTrip(ctx context.Context, h *host, req *http.Request) (*http.Response, error) {
+	robots, err := t.fetchRobots(ctx, h, req)
+	if err != nil {
+		return nil, err
+	}
+	if !robots.TestAgent(req.URL.RequestURI(), t.userAgent) {
+		return nil, fmt.Errorf("%w: %s", ErrDisallowed, req.URL)
+	}
+	delay := max(t.delay, robots.FindGroup(t.userAgent).CrawlDelay)
+
+	for attempt := 0; ; attempt++ {
+		select {
+		case h.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		if err := h.wait(ctx, delay); err != nil {
+			<-h.slots
+			return nil, err
+		}
+
+		res, err := t.base.RoundTrip(req)
+		if err != nil {
+			<-h.slots
+			return nil, err
+		}
+
+		if (res.StatusCode != http.StatusTooManyRequests && res.StatusCode != http.StatusServiceUnavailable) ||
+			attempt >= t.maxRetries || (req.Body != nil && req.GetBody == nil) {
+			res.Body = &releaseBody{ReadCloser: res.Body, release: func() {
+				<-h.slots
+				t.release(h)
+			}}
+			return res, nil
+		}
+
+		backoff := retryAfter(res, delay<<attempt)
+		_, _ = io.Copy(io.Discard, res.Body)
+		_ = res.Body.Close()
+		<-h.slots
+
+		// Other requests to the host are held back too since the whole host is rate limited.
+		h.backoff(backoff)
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("polite: resetting request body: %w", err)
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+	}
+}
+
+// acquire returns the host of req for a request to it, which must be released when done. Hosts
+// idle for longer than idleTTL are evicted so crawling many sites doesn't grow memory forever.
+func (t *Transport) acquire(req *http.Request) *host {
+	key := req.URL.Scheme + "://" + req.URL.Host
+	now := time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if now.Sub(t.lastSweep) >= t.idleTTL {
+		for k, h := range t.hosts {
+			if h.active == 0 && now.Sub(h.lastUsed) >= t.idleTTL {
+				delete(t.hosts, k)
+			}
+		}
+		t.lastSweep = now
+	}
+
+	h, ok := t.hosts[key]
+	if !ok {
+		h = &host{
+			slots: make(chan struct{}, t.concurrency),
+		}
+		t.hosts[key] = h
+	}
+	h.active++
+	return h
+}
+
+// release marks a request to h acquired with acquire as finished.
+func (t *Transport) release(h *host) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	h.active--
+	h.lastUsed = time.Now()
+}
+
+// fetchRobots returns the robots.txt of the host of req, fetching it if it has not been yet or
+// has expired.
+func (t *Transport) fetchRobots(ctx context.Context, h *host, req *http.Request) (*robotstxt.RobotsData, error) {
+	h.robotsMu.Lock()
+	defer h.robotsMu.Unlock()
+
+	if h.robots != nil && time.Now().Before(h.robotsExpiry) {
+		return h.robots, nil
+	}
+
+	robotsURL := req.URL.Scheme + "://" + req.URL.Host + "/robots.txt"
+	robotsReq, err := http.NewRequestWithContext(ctx, http.MethodGet, robotsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("polite: creating robots.txt request: %w", err)
+	}
+	robotsReq.Header.Set("User-Agent", t.userAgent)
+
+	// Use a client to follow redirects, which are common for robots.txt.
+	res, err := (&http.Client{Transport: t.base}).Do(robotsReq)
+	if err != nil {
+		return nil, fmt.Errorf("polite: fetching robots.txt: %w", err)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	robots, err := robotstxt.FromResponse(res)
+	if err != nil {
+		return nil, fmt.Errorf("polite: parsing robots.txt: %w", err)
+	}
+
+	ttl := robotsTTL
+	if res.StatusCode >= http.StatusInternalServerError {
+		ttl = robotsErrorTTL
+	}
+	h.robots = robots
+	h.robotsExpiry = time.Now().Add(ttl)
+	return robots, nil
+}
+
+// wait waits until a request can be started to the host, with delay between requests.
+func (h *host) wait(ctx context.Context, delay time.Duration) error {
+	h.mu.Lock()
+	start := time.Now()
+	if h.next.After(start) {
+		start = h.next
+	}
+	h.next = start.Add(delay)
+	h.mu.Unlock()
+
+	timer := time.NewTimer(time.Until(start))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// backoff holds back requests to the host for d.
+func (h *host) backoff(d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if next := time.Now().Add(d); next.After(h.next) {
+		h.next = next
+	}
+}
+
+// retryAfter returns how long to wait before retrying the request for res as indicated by its
+// Retry-After header, or def if not present.
+func retryAfter(res *http.Response, def time.Duration) time.Duration {
+	d := def
+	if v := res.Header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil {
+			d = time.Duration(secs) * time.Second
+		} else if t, err := http.ParseTime(v); err == nil {
+			d = time.Until(t)
+		}
+	}
+	return min(max(d, 0), maxBackoff)
+}
+
+// releaseBody releases the concurrency slot of a request when its response body is closed.
+type releaseBody struct {
+	io.ReadCloser
+
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,244 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package polite
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/curioswitch/cookchat/crawler/server/internal/config"
+)
+
+const testUserAgent = "cookchat-test"
+
+// testSite is a site served by httptest recording the requests it receives.
+type testSite struct {
+	srv *httptest.Server
+
+	robotsRequests atomic.Int32
+	pageRequests   atomic.Int32
+	active         atomic.Int32
+	maxActive      atomic.Int32
+	userAgent      atomic.Value
+}
+
+// newTestSite returns a site with robots as its robots.txt, serving pages with page.
+func newTestSite(t *testing.T, robots string, page http.HandlerFunc) *testSite {
+	t.Helper()
+
+	s := &testSite{}
+	s.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			s.robotsRequests.Add(1)
+			_, _ = io.WriteString(w, robots)
+			return
+		}
+		s.pageRequests.Add(1)
+		s.userAgent.Store(r.Header.Get("User-Agent"))
+		active := s.active.Add(1)
+		defer s.active.Add(-1)
+		for {
+			prev := s.maxActive.Load()
+			if active <= prev || s.maxActive.CompareAndSwap(prev, active) {
+				break
+			}
+		}
+		page(w, r)
+	}))
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+func okPage(w http.ResponseWriter, _ *http.Request) {
+	_, _ = io.WriteString(w, "ok")
+}
+
+func newTestTransport(conf config.Politeness) *Transport {
+	if conf.Delay == 0 {
+		conf.Delay = time.Millisecond
+	}
+	return NewTransport(http.DefaultTransport, testUserAgent, conf)
+}
+
+// get requests url with transport, returning the response with its body read and closed.
+func get(t *testing.T, transport *Transport, url string) (*http.Response, error) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+	_ = res.Body.Close()
+	return res, nil
+}
+
+func TestTransportRobots(t *testing.T) {
+	site := newTestSite(t, "User-agent: *\nDisallow: /private/\n", okPage)
+	transport := newTestTransport(config.Politeness{})
+
+	res, err := get(t, transport, site.srv.URL+"/recipes/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got status %d, want 200", res.StatusCode)
+	}
+	if ua := site.userAgent.Load(); ua != testUserAgent {
+		t.Errorf("got user agent %v, want %s", ua, testUserAgent)
+	}
+
+	if _, err := get(t, transport, site.srv.URL+"/private/1"); !errors.Is(err, ErrDisallowed) {
+		t.Errorf("got error %v, want %v", err, ErrDisallowed)
+	}
+	if _, err := get(t, transport, site.srv.URL+"/recipes/2"); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := site.robotsRequests.Load(); n != 1 {
+		t.Errorf("got %d robots.txt requests, want 1", n)
+	}
+	if n := site.pageRequests.Load(); n != 2 {
+		t.Errorf("got %d page requests, want 2", n)
+	}
+}
+
+func TestTransportConcurrency(t *testing.T) {
+	site := newTestSite(t, "", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(10 * time.Millisecond)
+		okPage(w, r)
+	})
+	transport := newTestTransport(config.Politeness{Concurrency: 2})
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			if _, err := get(t, transport, site.srv.URL+"/recipes/1"); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if n := site.maxActive.Load(); n > 2 {
+		t.Errorf("got %d concurrent requests, want at most 2", n)
+	}
+	if n := site.pageRequests.Load(); n != 8 {
+		t.Errorf("got %d page requests, want 8", n)
+	}
+}
+
+func TestTransportDelay(t *testing.T) {
+	site := newTestSite(t, "", okPage)
+	transport := newTestTransport(config.Politeness{Delay: 50 * time.Millisecond})
+
+	start := time.Now()
+	for range 3 {
+		if _, err := get(t, transport, site.srv.URL+"/recipes/1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The first request starts right away, the others after the delay.
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("got 3 requests in %v, want at least 100ms", elapsed)
+	}
+}
+
+func TestTransportRetry(t *testing.T) {
+	var attempts atomic.Int32
+	site := newTestSite(t, "", func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) <= 2 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		okPage(w, r)
+	})
+	transport := newTestTransport(config.Politeness{})
+
+	res, err := get(t, transport, site.srv.URL+"/recipes/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("got status %d, want 200", res.StatusCode)
+	}
+	if n := attempts.Load(); n != 3 {
+		t.Errorf("got %d attempts, want 3", n)
+	}
+}
+
+func TestTransportRetryExhausted(t *testing.T) {
+	site := newTestSite(t, "", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	transport := newTestTransport(config.Politeness{MaxRetries: 2})
+
+	res, err := get(t, transport, site.srv.URL+"/recipes/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("got status %d, want 503", res.StatusCode)
+	}
+	if n := site.pageRequests.Load(); n != 3 {
+		t.Errorf("got %d requests, want 3", n)
+	}
+}
+
+func TestTransportEvictsIdleHosts(t *testing.T) {
+	idle := newTestSite(t, "", okPage)
+	busy := newTestSite(t, "", okPage)
+	other := newTestSite(t, "", okPage)
+	transport := newTestTransport(config.Politeness{})
+	transport.idleTTL = 10 * time.Millisecond
+
+	if _, err := get(t, transport, idle.srv.URL+"/recipes/1"); err != nil {
+		t.Fatal(err)
+	}
+	// Hosts are in use until the response body is closed.
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, busy.srv.URL+"/recipes/1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	if _, err := get(t, transport, other.srv.URL+"/recipes/1"); err != nil {
+		t.Fatal(err)
+	}
+
+	transport.mu.Lock()
+	_, idleKept := transport.hosts[idle.srv.URL]
+	_, busyKept := transport.hosts[busy.srv.URL]
+	_, otherKept := transport.hosts[other.srv.URL]
+	transport.mu.Unlock()
+	if idleKept || !busyKept || !otherKept {
+		t.Errorf("got idle %v, busy %v, other %v kept, want only busy and other", idleKept, busyKept, otherKept)
+	}
+
+	_ = res.Body.Close()
+
+	// Evicted hosts fetch robots.txt again.
+	if _, err := get(t, transport, idle.srv.URL+"/recipes/2"); err != nil {
+		t.Fatal(err)
+	}
+	if n := idle.robotsRequests.Load(); n != 2 {
+		t.Errorf("got %d robots.txt requests, want 2", n)
+	}
+}
@@ -20,12 +20,20 @@ import (
 	"github.com/gocolly/colly/v2"
 	"google.golang.org/genai"
 
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/file"
+	"github.com/curioswitch/cookchat/common/image"
+	"github.com/curioswitch/cookchat/common/recipegen"
 	crawlerapi "github.com/curioswitch/cookchat/crawler/api/go"
 	"github.com/curioswitch/cookchat/crawler/api/go/crawlerapiconnect"
 	"github.com/curioswitch/cookchat/crawler/server/internal/config"
-	cookpadrecipe "github.com/curioswitch/cookchat/crawler/server/internal/handler/cookpad/recipe"
+	"github.com/curioswitch/cookchat/crawler/server/internal/extractor"
 	"github.com/curioswitch/cookchat/crawler/server/internal/handler/cookpad/user"
+	"github.com/curioswitch/cookchat/crawler/server/internal/handler/job"
 	"github.com/curioswitch/cookchat/crawler/server/internal/handler/recipe"
+	"github.com/curioswitch/cookchat/crawler/server/internal/handler/recrawl"
+	"github.com/curioswitch/cookchat/crawler/server/internal/handler/sitemap"
+	"github.com/curioswitch/cookchat/crawler/server/internal/polite"
 )
 
 //go:embed conf/*.yaml
@@ -72,6 +80,8 @@ func setupServer(ctx context.Context, conf *config.Config, s *server.Server) err
 	baseCollector := colly.NewCollector(
 		colly.UserAgent("CurioBot/0.1"),
 	)
+	// All requests to sites share one transport to limit the load on each site.
+	transport := polite.NewTransport(http.DefaultTransport, baseCollector.UserAgent, conf.Politeness)
 
 	genAI, err := genai.NewClient(ctx, &genai.ClientConfig{
 		Backend:  genai.BackendVertexAI,
@@ -82,9 +92,19 @@ func setupServer(ctx context.Context, conf *config.Config, s *server.Server) err
 		return fmt.Errorf("creating genai client: %w", err)
 	}
 
+	baseURLs := extractor.BaseURLs{}
+	for source, baseURL := range conf.SiteURLs {
+		baseURLs[cookchatdb.RecipeSource(source)] = baseURL
+	}
+
+	images := image.NewWriter(file.NewIO(storage, publicBucket))
+	processor := recipegen.NewPostProcessor(genAI, firestore, images)
+
+	recipeHandler := recipe.NewHandler(baseURLs, transport, firestore, images, genAI, processor)
+
 	server.HandleConnectUnary(s,
 		crawlerapiconnect.CrawlerServiceCrawlRecipeProcedure,
-		recipe.NewHandler(baseCollector, firestore, storage, genAI, publicBucket).CrawlRecipe,
+		recipeHandler.CrawlRecipe,
 		[]*crawlerapi.CrawlRecipeRequest{
 			{
 				Url: "https://www.orangepage.net/recipes/300487",
@@ -97,7 +117,7 @@ func setupServer(ctx context.Context, conf *config.Config, s *server.Server) err
 
 	server.HandleConnectUnary(s,
 		crawlerapiconnect.CrawlerServiceCrawlCookpadRecipeProcedure,
-		cookpadrecipe.NewHandler(baseCollector, firestore, storage, genAI, publicBucket).CrawlCookpadRecipe,
+		recipeHandler.CrawlCookpadRecipe,
 		[]*crawlerapi.CrawlCookpadRecipeRequest{
 			{
 				RecipeId: "24664122",
@@ -107,7 +127,7 @@ func setupServer(ctx context.Context, conf *config.Config, s *server.Server) err
 
 	server.HandleConnectUnary(s,
 		crawlerapiconnect.CrawlerServiceCrawlCookpadUserProcedure,
-		user.NewHandler(baseCollector, crawlerClient).CrawlCookpadUser,
+		user.NewHandler(baseCollector, baseURLs, transport, firestore, crawlerClient).CrawlCookpadUser,
 		[]*crawlerapi.CrawlCookpadUserRequest{
 			{
 				UserId: "40054625",
@@ -115,6 +135,48 @@ func setupServer(ctx context.Context, conf *config.Config, s *server.Server) err
 		},
 	)
 
+	server.HandleConnectUnary(s,
+		crawlerapiconnect.CrawlerServiceCrawlSitemapProcedure,
+		sitemap.NewHandler(transport, firestore, crawlerClient).CrawlSitemap,
+		[]*crawlerapi.CrawlSitemapRequest{
+			{
+				Url:   "https://delishkitchen.tv/sitemap.xml",
+				Limit: 10,
+			},
+		},
+	)
+
+	server.HandleConnectUnary(s,
+		crawlerapiconnect.CrawlerServiceRecrawlRecipesProcedure,
+		recrawl.NewHandler(firestore, crawlerClient, conf.Recrawl).RecrawlRecipes,
+		[]*crawlerapi.RecrawlRecipesRequest{
+			{},
+		},
+	)
+
+	jobHandler := job.NewHandler(firestore)
+
+	server.HandleConnectUnary(s,
+		crawlerapiconnect.CrawlerServiceGetCrawlJobProcedure,
+		jobHandler.GetCrawlJob,
+		[]*crawlerapi.GetCrawlJobRequest{
+			{
+				JobId: "kq0W3rJb7TnC2xLm5sVa",
+			},
+		},
+	)
+
+	server.HandleConnectUnary(s,
+		crawlerapiconnect.CrawlerServiceListCrawlJobsProcedure,
+		jobHandler.ListCrawlJobs,
+		[]*crawlerapi.ListCrawlJobsRequest{
+			{},
+			{
+				Status: crawlerapi.CrawlJobStatus_CRAWL_JOB_STATUS_FAILED,
+			},
+		},
+	)
+
 	if err := server.Start(ctx, s); err != nil {
 		return fmt.Errorf("main: start server: %w", err)
 	}
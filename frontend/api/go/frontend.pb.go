@@ -10,6 +10,7 @@ import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -203,6 +204,8 @@ const (
 	RecipeStatus_RECIPE_STATUS_PROCESSING RecipeStatus = 1
 	// The recipe is active and can be used in chats and plans.
 	RecipeStatus_RECIPE_STATUS_ACTIVE RecipeStatus = 2
+	// Processing the recipe failed. It is processed again when a plan using it is retried.
+	RecipeStatus_RECIPE_STATUS_FAILED RecipeStatus = 3
 )
 
 // Enum value maps for RecipeStatus.
@@ -211,11 +214,13 @@ var (
 		0: "RECIPE_STATUS_UNSPECIFIED",
 		1: "RECIPE_STATUS_PROCESSING",
 		2: "RECIPE_STATUS_ACTIVE",
+		3: "RECIPE_STATUS_FAILED",
 	}
 	RecipeStatus_value = map[string]int32{
 		"RECIPE_STATUS_UNSPECIFIED": 0,
 		"RECIPE_STATUS_PROCESSING":  1,
 		"RECIPE_STATUS_ACTIVE":      2,
+		"RECIPE_STATUS_FAILED":      3,
 	}
 )
 
@@ -246,6 +251,117 @@ func (RecipeStatus) EnumDescriptor() ([]byte, []int) {
 	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{3}
 }
 
+// The generator of a meal plan.
+type PlanGenerator int32
+
+const (
+	// Generate with an LLM, falling back to the constraint-based generator if the LLM fails.
+	PlanGenerator_PLAN_GENERATOR_UNSPECIFIED PlanGenerator = 0
+	// Generate with an LLM only.
+	PlanGenerator_PLAN_GENERATOR_LLM PlanGenerator = 1
+	// Generate with the deterministic constraint-based generator, choosing a main dish, side dish,
+	// and soup for each day. Not supported for batch cooking.
+	PlanGenerator_PLAN_GENERATOR_CONSTRAINT PlanGenerator = 2
+)
+
+// Enum value maps for PlanGenerator.
+var (
+	PlanGenerator_name = map[int32]string{
+		0: "PLAN_GENERATOR_UNSPECIFIED",
+		1: "PLAN_GENERATOR_LLM",
+		2: "PLAN_GENERATOR_CONSTRAINT",
+	}
+	PlanGenerator_value = map[string]int32{
+		"PLAN_GENERATOR_UNSPECIFIED": 0,
+		"PLAN_GENERATOR_LLM":         1,
+		"PLAN_GENERATOR_CONSTRAINT":  2,
+	}
+)
+
+func (x PlanGenerator) Enum() *PlanGenerator {
+	p := new(PlanGenerator)
+	*p = x
+	return p
+}
+
+func (x PlanGenerator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanGenerator) Descriptor() protoreflect.EnumDescriptor {
+	return file_frontendapi_frontend_proto_enumTypes[4].Descriptor()
+}
+
+func (PlanGenerator) Type() protoreflect.EnumType {
+	return &file_frontendapi_frontend_proto_enumTypes[4]
+}
+
+func (x PlanGenerator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanGenerator.Descriptor instead.
+func (PlanGenerator) EnumDescriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{4}
+}
+
+// The type of a plan.
+type PlanType int32
+
+const (
+	// Unknown type.
+	PlanType_PLAN_TYPE_UNSPECIFIED PlanType = 0
+	// A plan cooking the meal for its day.
+	PlanType_PLAN_TYPE_DAILY PlanType = 1
+	// A batch-cooking session that cooks dishes to be eaten on later days.
+	PlanType_PLAN_TYPE_BATCH_PREP PlanType = 2
+	// A plan eating dishes cooked in a previous batch-cooking session.
+	PlanType_PLAN_TYPE_LEFTOVERS PlanType = 3
+)
+
+// Enum value maps for PlanType.
+var (
+	PlanType_name = map[int32]string{
+		0: "PLAN_TYPE_UNSPECIFIED",
+		1: "PLAN_TYPE_DAILY",
+		2: "PLAN_TYPE_BATCH_PREP",
+		3: "PLAN_TYPE_LEFTOVERS",
+	}
+	PlanType_value = map[string]int32{
+		"PLAN_TYPE_UNSPECIFIED": 0,
+		"PLAN_TYPE_DAILY":       1,
+		"PLAN_TYPE_BATCH_PREP":  2,
+		"PLAN_TYPE_LEFTOVERS":   3,
+	}
+)
+
+func (x PlanType) Enum() *PlanType {
+	p := new(PlanType)
+	*p = x
+	return p
+}
+
+func (x PlanType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanType) Descriptor() protoreflect.EnumDescriptor {
+	return file_frontendapi_frontend_proto_enumTypes[5].Descriptor()
+}
+
+func (PlanType) Type() protoreflect.EnumType {
+	return &file_frontendapi_frontend_proto_enumTypes[5]
+}
+
+func (x PlanType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanType.Descriptor instead.
+func (PlanType) EnumDescriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{5}
+}
+
 type PlanStatus int32
 
 const (
@@ -255,6 +371,8 @@ const (
 	PlanStatus_PLAN_STATUS_PROCESSING PlanStatus = 1
 	// The plan is active and can be viewed and cooked.
 	PlanStatus_PLAN_STATUS_ACTIVE PlanStatus = 2
+	// Processing the plan failed. It can be processed again with FrontendService.RetryPlan.
+	PlanStatus_PLAN_STATUS_FAILED PlanStatus = 3
 )
 
 // Enum value maps for PlanStatus.
@@ -263,11 +381,13 @@ var (
 		0: "PLAN_STATUS_UNSPECIFIED",
 		1: "PLAN_STATUS_PROCESSING",
 		2: "PLAN_STATUS_ACTIVE",
+		3: "PLAN_STATUS_FAILED",
 	}
 	PlanStatus_value = map[string]int32{
 		"PLAN_STATUS_UNSPECIFIED": 0,
 		"PLAN_STATUS_PROCESSING":  1,
 		"PLAN_STATUS_ACTIVE":      2,
+		"PLAN_STATUS_FAILED":      3,
 	}
 )
 
@@ -282,11 +402,11 @@ func (x PlanStatus) String() string {
 }
 
 func (PlanStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_frontendapi_frontend_proto_enumTypes[4].Descriptor()
+	return file_frontendapi_frontend_proto_enumTypes[6].Descriptor()
 }
 
 func (PlanStatus) Type() protoreflect.EnumType {
-	return &file_frontendapi_frontend_proto_enumTypes[4]
+	return &file_frontendapi_frontend_proto_enumTypes[6]
 }
 
 func (x PlanStatus) Number() protoreflect.EnumNumber {
@@ -295,7 +415,202 @@ func (x PlanStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PlanStatus.Descriptor instead.
 func (PlanStatus) EnumDescriptor() ([]byte, []int) {
-	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{4}
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{6}
+}
+
+// A stage of processing a plan.
+type PlanProgressStage int32
+
+const (
+	// Unknown stage.
+	PlanProgressStage_PLAN_PROGRESS_STAGE_UNSPECIFIED PlanProgressStage = 0
+	// Translating a recipe to other languages.
+	PlanProgressStage_PLAN_PROGRESS_STAGE_TRANSLATE PlanProgressStage = 1
+	// Rewriting a recipe for each language.
+	PlanProgressStage_PLAN_PROGRESS_STAGE_REWRITE PlanProgressStage = 2
+	// Generating the main image of a recipe.
+	PlanProgressStage_PLAN_PROGRESS_STAGE_IMAGE PlanProgressStage = 3
+	// Generating the images of the steps of a recipe.
+	PlanProgressStage_PLAN_PROGRESS_STAGE_STEP_IMAGES PlanProgressStage = 4
+	// Generating the execution plan of the plan.
+	PlanProgressStage_PLAN_PROGRESS_STAGE_EXECUTION_PLAN PlanProgressStage = 5
+)
+
+// Enum value maps for PlanProgressStage.
+var (
+	PlanProgressStage_name = map[int32]string{
+		0: "PLAN_PROGRESS_STAGE_UNSPECIFIED",
+		1: "PLAN_PROGRESS_STAGE_TRANSLATE",
+		2: "PLAN_PROGRESS_STAGE_REWRITE",
+		3: "PLAN_PROGRESS_STAGE_IMAGE",
+		4: "PLAN_PROGRESS_STAGE_STEP_IMAGES",
+		5: "PLAN_PROGRESS_STAGE_EXECUTION_PLAN",
+	}
+	PlanProgressStage_value = map[string]int32{
+		"PLAN_PROGRESS_STAGE_UNSPECIFIED":    0,
+		"PLAN_PROGRESS_STAGE_TRANSLATE":      1,
+		"PLAN_PROGRESS_STAGE_REWRITE":        2,
+		"PLAN_PROGRESS_STAGE_IMAGE":          3,
+		"PLAN_PROGRESS_STAGE_STEP_IMAGES":    4,
+		"PLAN_PROGRESS_STAGE_EXECUTION_PLAN": 5,
+	}
+)
+
+func (x PlanProgressStage) Enum() *PlanProgressStage {
+	p := new(PlanProgressStage)
+	*p = x
+	return p
+}
+
+func (x PlanProgressStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlanProgressStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_frontendapi_frontend_proto_enumTypes[7].Descriptor()
+}
+
+func (PlanProgressStage) Type() protoreflect.EnumType {
+	return &file_frontendapi_frontend_proto_enumTypes[7]
+}
+
+func (x PlanProgressStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlanProgressStage.Descriptor instead.
+func (PlanProgressStage) EnumDescriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{7}
+}
+
+// A day of the week.
+type DayOfWeek int32
+
+const (
+	// Unknown day.
+	DayOfWeek_DAY_OF_WEEK_UNSPECIFIED DayOfWeek = 0
+	// Monday.
+	DayOfWeek_DAY_OF_WEEK_MONDAY DayOfWeek = 1
+	// Tuesday.
+	DayOfWeek_DAY_OF_WEEK_TUESDAY DayOfWeek = 2
+	// Wednesday.
+	DayOfWeek_DAY_OF_WEEK_WEDNESDAY DayOfWeek = 3
+	// Thursday.
+	DayOfWeek_DAY_OF_WEEK_THURSDAY DayOfWeek = 4
+	// Friday.
+	DayOfWeek_DAY_OF_WEEK_FRIDAY DayOfWeek = 5
+	// Saturday.
+	DayOfWeek_DAY_OF_WEEK_SATURDAY DayOfWeek = 6
+	// Sunday.
+	DayOfWeek_DAY_OF_WEEK_SUNDAY DayOfWeek = 7
+)
+
+// Enum value maps for DayOfWeek.
+var (
+	DayOfWeek_name = map[int32]string{
+		0: "DAY_OF_WEEK_UNSPECIFIED",
+		1: "DAY_OF_WEEK_MONDAY",
+		2: "DAY_OF_WEEK_TUESDAY",
+		3: "DAY_OF_WEEK_WEDNESDAY",
+		4: "DAY_OF_WEEK_THURSDAY",
+		5: "DAY_OF_WEEK_FRIDAY",
+		6: "DAY_OF_WEEK_SATURDAY",
+		7: "DAY_OF_WEEK_SUNDAY",
+	}
+	DayOfWeek_value = map[string]int32{
+		"DAY_OF_WEEK_UNSPECIFIED": 0,
+		"DAY_OF_WEEK_MONDAY":      1,
+		"DAY_OF_WEEK_TUESDAY":     2,
+		"DAY_OF_WEEK_WEDNESDAY":   3,
+		"DAY_OF_WEEK_THURSDAY":    4,
+		"DAY_OF_WEEK_FRIDAY":      5,
+		"DAY_OF_WEEK_SATURDAY":    6,
+		"DAY_OF_WEEK_SUNDAY":      7,
+	}
+)
+
+func (x DayOfWeek) Enum() *DayOfWeek {
+	p := new(DayOfWeek)
+	*p = x
+	return p
+}
+
+func (x DayOfWeek) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DayOfWeek) Descriptor() protoreflect.EnumDescriptor {
+	return file_frontendapi_frontend_proto_enumTypes[8].Descriptor()
+}
+
+func (DayOfWeek) Type() protoreflect.EnumType {
+	return &file_frontendapi_frontend_proto_enumTypes[8]
+}
+
+func (x DayOfWeek) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DayOfWeek.Descriptor instead.
+func (DayOfWeek) EnumDescriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{8}
+}
+
+// The role of a member in a household.
+type HouseholdRole int32
+
+const (
+	// Unknown role.
+	HouseholdRole_HOUSEHOLD_ROLE_UNSPECIFIED HouseholdRole = 0
+	// Can manage members and invitations and edit shared data.
+	HouseholdRole_HOUSEHOLD_ROLE_OWNER HouseholdRole = 1
+	// Can edit shared data such as plans.
+	HouseholdRole_HOUSEHOLD_ROLE_EDITOR HouseholdRole = 2
+	// Can only view shared data.
+	HouseholdRole_HOUSEHOLD_ROLE_VIEWER HouseholdRole = 3
+)
+
+// Enum value maps for HouseholdRole.
+var (
+	HouseholdRole_name = map[int32]string{
+		0: "HOUSEHOLD_ROLE_UNSPECIFIED",
+		1: "HOUSEHOLD_ROLE_OWNER",
+		2: "HOUSEHOLD_ROLE_EDITOR",
+		3: "HOUSEHOLD_ROLE_VIEWER",
+	}
+	HouseholdRole_value = map[string]int32{
+		"HOUSEHOLD_ROLE_UNSPECIFIED": 0,
+		"HOUSEHOLD_ROLE_OWNER":       1,
+		"HOUSEHOLD_ROLE_EDITOR":      2,
+		"HOUSEHOLD_ROLE_VIEWER":      3,
+	}
+)
+
+func (x HouseholdRole) Enum() *HouseholdRole {
+	p := new(HouseholdRole)
+	*p = x
+	return p
+}
+
+func (x HouseholdRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HouseholdRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_frontendapi_frontend_proto_enumTypes[9].Descriptor()
+}
+
+func (HouseholdRole) Type() protoreflect.EnumType {
+	return &file_frontendapi_frontend_proto_enumTypes[9]
+}
+
+func (x HouseholdRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HouseholdRole.Descriptor instead.
+func (HouseholdRole) EnumDescriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{9}
 }
 
 type StartChatRequest_ModelProvider int32
@@ -334,11 +649,11 @@ func (x StartChatRequest_ModelProvider) String() string {
 }
 
 func (StartChatRequest_ModelProvider) Descriptor() protoreflect.EnumDescriptor {
-	return file_frontendapi_frontend_proto_enumTypes[5].Descriptor()
+	return file_frontendapi_frontend_proto_enumTypes[10].Descriptor()
 }
 
 func (StartChatRequest_ModelProvider) Type() protoreflect.EnumType {
-	return &file_frontendapi_frontend_proto_enumTypes[5]
+	return &file_frontendapi_frontend_proto_enumTypes[10]
 }
 
 func (x StartChatRequest_ModelProvider) Number() protoreflect.EnumNumber {
@@ -383,11 +698,11 @@ func (x ChatMessage_Role) String() string {
 }
 
 func (ChatMessage_Role) Descriptor() protoreflect.EnumDescriptor {
-	return file_frontendapi_frontend_proto_enumTypes[6].Descriptor()
+	return file_frontendapi_frontend_proto_enumTypes[11].Descriptor()
 }
 
 func (ChatMessage_Role) Type() protoreflect.EnumType {
-	return &file_frontendapi_frontend_proto_enumTypes[6]
+	return &file_frontendapi_frontend_proto_enumTypes[11]
 }
 
 func (x ChatMessage_Role) Number() protoreflect.EnumNumber {
@@ -396,7 +711,7 @@ func (x ChatMessage_Role) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ChatMessage_Role.Descriptor instead.
 func (ChatMessage_Role) EnumDescriptor() ([]byte, []int) {
-	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{36, 0}
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{112, 0}
 }
 
 // The content of a chat message.
@@ -817,7 +1132,9 @@ type Recipe struct {
 	// The serving size of the recipe as free-form text.
 	ServingSize string `protobuf:"bytes,11,opt,name=serving_size,json=servingSize,proto3" json:"serving_size,omitempty"`
 	// The language of the recipe.
-	Language      Language `protobuf:"varint,12,opt,name=language,proto3,enum=frontendapi.Language" json:"language,omitempty"`
+	Language Language `protobuf:"varint,12,opt,name=language,proto3,enum=frontendapi.Language" json:"language,omitempty"`
+	// The reason processing the recipe failed, if the status is failed.
+	FailureReason string `protobuf:"bytes,13,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -936,6 +1253,13 @@ func (x *Recipe) GetLanguage() Language {
 	return Language_LANGUAGE_UNSPECIFIED
 }
 
+func (x *Recipe) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
 // A request for FrontendService.GetRecipe.
 type GetRecipeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1110,7 +1434,9 @@ type RecipeSnippet struct {
 	// The summary of the recipe.
 	Summary string `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
 	// The image URL of the recipe.
-	ImageUrl      string `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	ImageUrl string `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	// The status of the recipe. Only set in plans.
+	Status        RecipeStatus `protobuf:"varint,5,opt,name=status,proto3,enum=frontendapi.RecipeStatus" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1173,6 +1499,13 @@ func (x *RecipeSnippet) GetImageUrl() string {
 	return ""
 }
 
+func (x *RecipeSnippet) GetStatus() RecipeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RecipeStatus_RECIPE_STATUS_UNSPECIFIED
+}
+
 // A request for FrontendService.ListRecipes.
 type ListRecipesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1753,7 +2086,15 @@ type GeneratePlanRequest struct {
 	// Genres to prioritize during planning.
 	Genres []RecipeGenre `protobuf:"varint,3,rep,packed,name=genres,proto3,enum=frontendapi.RecipeGenre" json:"genres,omitempty"`
 	// Recipe IDs to use as main dishes.
-	RecipeIds     []string `protobuf:"bytes,4,rep,name=recipe_ids,json=recipeIds,proto3" json:"recipe_ids,omitempty"`
+	RecipeIds []string `protobuf:"bytes,4,rep,name=recipe_ids,json=recipeIds,proto3" json:"recipe_ids,omitempty"`
+	// Whether to plan a batch-cooking (作り置き) session that cooks dishes eaten over the
+	// requested days as leftovers, instead of cooking every day.
+	BatchCooking bool `protobuf:"varint,5,opt,name=batch_cooking,json=batchCooking,proto3" json:"batch_cooking,omitempty"`
+	// The weekly food budget in yen. If set, the plan is chosen so the estimated cost of
+	// the planned days fits within the budget prorated to the number of days.
+	WeeklyBudget uint32 `protobuf:"varint,6,opt,name=weekly_budget,json=weeklyBudget,proto3" json:"weekly_budget,omitempty"`
+	// The generator to use for the plan.
+	Generator     PlanGenerator `protobuf:"varint,7,opt,name=generator,proto3,enum=frontendapi.PlanGenerator" json:"generator,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1816,6 +2157,27 @@ func (x *GeneratePlanRequest) GetRecipeIds() []string {
 	return nil
 }
 
+func (x *GeneratePlanRequest) GetBatchCooking() bool {
+	if x != nil {
+		return x.BatchCooking
+	}
+	return false
+}
+
+func (x *GeneratePlanRequest) GetWeeklyBudget() uint32 {
+	if x != nil {
+		return x.WeeklyBudget
+	}
+	return 0
+}
+
+func (x *GeneratePlanRequest) GetGenerator() PlanGenerator {
+	if x != nil {
+		return x.Generator
+	}
+	return PlanGenerator_PLAN_GENERATOR_UNSPECIFIED
+}
+
 // A response for FrontendService.GeneratePlan.
 type GeneratePlanResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1925,7 +2287,13 @@ type PlanSnippet struct {
 	// The date of the plan.
 	Date *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
 	// The recipes for the plan.
-	Recipes       []*RecipeSnippet `protobuf:"bytes,3,rep,name=recipes,proto3" json:"recipes,omitempty"`
+	Recipes []*RecipeSnippet `protobuf:"bytes,3,rep,name=recipes,proto3" json:"recipes,omitempty"`
+	// The type of the plan.
+	Type PlanType `protobuf:"varint,4,opt,name=type,proto3,enum=frontendapi.PlanType" json:"type,omitempty"`
+	// The status of the plan.
+	Status PlanStatus `protobuf:"varint,5,opt,name=status,proto3,enum=frontendapi.PlanStatus" json:"status,omitempty"`
+	// The local calendar date of the plan in YYYY-MM-DD format.
+	LocalDate     string `protobuf:"bytes,6,opt,name=local_date,json=localDate,proto3" json:"local_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1981,10 +2349,32 @@ func (x *PlanSnippet) GetRecipes() []*RecipeSnippet {
 	return nil
 }
 
+func (x *PlanSnippet) GetType() PlanType {
+	if x != nil {
+		return x.Type
+	}
+	return PlanType_PLAN_TYPE_UNSPECIFIED
+}
+
+func (x *PlanSnippet) GetStatus() PlanStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PlanStatus_PLAN_STATUS_UNSPECIFIED
+}
+
+func (x *PlanSnippet) GetLocalDate() string {
+	if x != nil {
+		return x.LocalDate
+	}
+	return ""
+}
+
 // A request for FrontendService.GetPlans.
 type GetPlansRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The start date for the plans to get.
+	// The start date for the plans to get. Only the calendar date in the time zone of the
+	// user is used.
 	StartDate *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
 	// The number of days from the start to fetch.
 	NumDays       uint32 `protobuf:"varint,2,opt,name=num_days,json=numDays,proto3" json:"num_days,omitempty"`
@@ -2093,9 +2483,33 @@ type Plan struct {
 	// The step groups for the plan.
 	StepGroups []*StepGroup `protobuf:"bytes,4,rep,name=step_groups,json=stepGroups,proto3" json:"step_groups,omitempty"`
 	// A list of notes to help cook the plan.
-	Notes         []string             `protobuf:"bytes,5,rep,name=notes,proto3" json:"notes,omitempty"`
-	Ingredients   []*IngredientSection `protobuf:"bytes,6,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
-	ServingSizes  []string             `protobuf:"bytes,7,rep,name=serving_sizes,json=servingSizes,proto3" json:"serving_sizes,omitempty"`
+	Notes        []string             `protobuf:"bytes,5,rep,name=notes,proto3" json:"notes,omitempty"`
+	Ingredients  []*IngredientSection `protobuf:"bytes,6,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	ServingSizes []string             `protobuf:"bytes,7,rep,name=serving_sizes,json=servingSizes,proto3" json:"serving_sizes,omitempty"`
+	// The type of the plan.
+	Type PlanType `protobuf:"varint,8,opt,name=type,proto3,enum=frontendapi.PlanType" json:"type,omitempty"`
+	// The dishes cooked in a batch-cooking plan, or the dishes eaten in a leftovers plan.
+	BatchDishes []*BatchDish `protobuf:"bytes,9,rep,name=batch_dishes,json=batchDishes,proto3" json:"batch_dishes,omitempty"`
+	// For a leftovers plan, the ID of the batch-cooking plan the dishes were cooked in.
+	BatchPlanId string `protobuf:"bytes,10,opt,name=batch_plan_id,json=batchPlanId,proto3" json:"batch_plan_id,omitempty"`
+	// The estimated cost of the ingredients of the plan in yen. Leftovers plans have no cost
+	// since their dishes were paid for in the batch-cooking plan.
+	EstimatedCost uint32 `protobuf:"varint,11,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"`
+	// The estimated cost of each recipe in yen, in the same order as recipes.
+	RecipeCosts []uint32 `protobuf:"varint,12,rep,packed,name=recipe_costs,json=recipeCosts,proto3" json:"recipe_costs,omitempty"`
+	// The reason processing the plan failed, if the status is failed.
+	FailureReason string `protobuf:"bytes,13,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	// The number of failed attempts to process the plan since it was created or last retried.
+	Attempts uint32 `protobuf:"varint,14,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// The local calendar date of the plan in YYYY-MM-DD format.
+	LocalDate string `protobuf:"bytes,15,opt,name=local_date,json=localDate,proto3" json:"local_date,omitempty"`
+	// The IANA time zone of local_date.
+	TimeZone string `protobuf:"bytes,16,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// The progress of the stages of processing the plan, ordered by recipe followed by the
+	// stages of the whole plan. Empty for plans processed before progress was recorded.
+	Progress []*PlanProgress `protobuf:"bytes,17,rep,name=progress,proto3" json:"progress,omitempty"`
+	// The time the plan was last updated. Pass to UpdatePlan to detect concurrent updates.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2179,29 +2593,116 @@ func (x *Plan) GetServingSizes() []string {
 	return nil
 }
 
-// A request for FrontendService.GetPlan.
-type GetPlanRequest struct {
+func (x *Plan) GetType() PlanType {
+	if x != nil {
+		return x.Type
+	}
+	return PlanType_PLAN_TYPE_UNSPECIFIED
+}
+
+func (x *Plan) GetBatchDishes() []*BatchDish {
+	if x != nil {
+		return x.BatchDishes
+	}
+	return nil
+}
+
+func (x *Plan) GetBatchPlanId() string {
+	if x != nil {
+		return x.BatchPlanId
+	}
+	return ""
+}
+
+func (x *Plan) GetEstimatedCost() uint32 {
+	if x != nil {
+		return x.EstimatedCost
+	}
+	return 0
+}
+
+func (x *Plan) GetRecipeCosts() []uint32 {
+	if x != nil {
+		return x.RecipeCosts
+	}
+	return nil
+}
+
+func (x *Plan) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *Plan) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Plan) GetLocalDate() string {
+	if x != nil {
+		return x.LocalDate
+	}
+	return ""
+}
+
+func (x *Plan) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Plan) GetProgress() []*PlanProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *Plan) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// The progress of a stage of processing a plan.
+type PlanProgress struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The ID of the plan to get.
-	PlanId        string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// The stage.
+	Stage PlanProgressStage `protobuf:"varint,1,opt,name=stage,proto3,enum=frontendapi.PlanProgressStage" json:"stage,omitempty"`
+	// The ID of the recipe the stage processes, or empty for stages of the whole plan.
+	RecipeId string `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	// The number of completed items of the stage, such as generated images.
+	Done uint32 `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
+	// The number of items of the stage.
+	Total uint32 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// The time the stage started.
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// The time the stage completed, unset if not yet complete.
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPlanRequest) Reset() {
-	*x = GetPlanRequest{}
+func (x *PlanProgress) Reset() {
+	*x = PlanProgress{}
 	mi := &file_frontendapi_frontend_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPlanRequest) String() string {
+func (x *PlanProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlanRequest) ProtoMessage() {}
+func (*PlanProgress) ProtoMessage() {}
 
-func (x *GetPlanRequest) ProtoReflect() protoreflect.Message {
+func (x *PlanProgress) ProtoReflect() protoreflect.Message {
 	mi := &file_frontendapi_frontend_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2213,20 +2714,183 @@ func (x *GetPlanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlanRequest.ProtoReflect.Descriptor instead.
-func (*GetPlanRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlanProgress.ProtoReflect.Descriptor instead.
+func (*PlanProgress) Descriptor() ([]byte, []int) {
 	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetPlanRequest) GetPlanId() string {
+func (x *PlanProgress) GetStage() PlanProgressStage {
 	if x != nil {
-		return x.PlanId
+		return x.Stage
+	}
+	return PlanProgressStage_PLAN_PROGRESS_STAGE_UNSPECIFIED
+}
+
+func (x *PlanProgress) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
 	}
 	return ""
 }
 
-// A response for FrontendService.GetPlan.
-type GetPlanResponse struct {
+func (x *PlanProgress) GetDone() uint32 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *PlanProgress) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PlanProgress) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *PlanProgress) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// A dish cooked in a batch-cooking session.
+type BatchDish struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the recipe of the dish.
+	RecipeId string `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	// The number of servings to cook.
+	Servings uint32 `protobuf:"varint,2,opt,name=servings,proto3" json:"servings,omitempty"`
+	// How to store the dish, e.g. refrigerator or freezer.
+	Storage string `protobuf:"bytes,3,opt,name=storage,proto3" json:"storage,omitempty"`
+	// The number of days the dish keeps when stored.
+	StorageDays uint32 `protobuf:"varint,4,opt,name=storage_days,json=storageDays,proto3" json:"storage_days,omitempty"`
+	// Instructions for reheating the dish before eating.
+	ReheatingNotes string `protobuf:"bytes,5,opt,name=reheating_notes,json=reheatingNotes,proto3" json:"reheating_notes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BatchDish) Reset() {
+	*x = BatchDish{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDish) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDish) ProtoMessage() {}
+
+func (x *BatchDish) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDish.ProtoReflect.Descriptor instead.
+func (*BatchDish) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BatchDish) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *BatchDish) GetServings() uint32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *BatchDish) GetStorage() string {
+	if x != nil {
+		return x.Storage
+	}
+	return ""
+}
+
+func (x *BatchDish) GetStorageDays() uint32 {
+	if x != nil {
+		return x.StorageDays
+	}
+	return 0
+}
+
+func (x *BatchDish) GetReheatingNotes() string {
+	if x != nil {
+		return x.ReheatingNotes
+	}
+	return ""
+}
+
+// A request for FrontendService.GetPlan.
+type GetPlanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the plan to get.
+	PlanId        string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlanRequest) Reset() {
+	*x = GetPlanRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanRequest) ProtoMessage() {}
+
+func (x *GetPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetPlanRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetPlanRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+// A response for FrontendService.GetPlan.
+type GetPlanResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Plan  *Plan                  `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
 	// The LLM prompt used to interact with the recipe.
@@ -2236,21 +2900,3878 @@ type GetPlanResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPlanResponse) Reset() {
-	*x = GetPlanResponse{}
-	mi := &file_frontendapi_frontend_proto_msgTypes[27]
+func (x *GetPlanResponse) Reset() {
+	*x = GetPlanResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanResponse) ProtoMessage() {}
+
+func (x *GetPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanResponse.ProtoReflect.Descriptor instead.
+func (*GetPlanResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetPlanResponse) GetPlan() *Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *GetPlanResponse) GetLlmPrompt() string {
+	if x != nil {
+		return x.LlmPrompt
+	}
+	return ""
+}
+
+// A request for FrontendService.WatchPlan.
+type WatchPlanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the plan to watch.
+	PlanId        string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPlanRequest) Reset() {
+	*x = WatchPlanRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPlanRequest) ProtoMessage() {}
+
+func (x *WatchPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPlanRequest.ProtoReflect.Descriptor instead.
+func (*WatchPlanRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WatchPlanRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+// A response for FrontendService.WatchPlan.
+type WatchPlanResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The current state of the plan. Recipes become active as they are post-processed and
+	// their images generated, and the plan becomes active when its execution plan is ready.
+	Plan          *Plan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPlanResponse) Reset() {
+	*x = WatchPlanResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPlanResponse) ProtoMessage() {}
+
+func (x *WatchPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPlanResponse.ProtoReflect.Descriptor instead.
+func (*WatchPlanResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *WatchPlanResponse) GetPlan() *Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// A request for FrontendService.ShareablePlanLink.
+type ShareablePlanLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the plan to share.
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// The number of days the link can be used for. Defaults to 7.
+	TtlDays       uint32 `protobuf:"varint,2,opt,name=ttl_days,json=ttlDays,proto3" json:"ttl_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareablePlanLinkRequest) Reset() {
+	*x = ShareablePlanLinkRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareablePlanLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareablePlanLinkRequest) ProtoMessage() {}
+
+func (x *ShareablePlanLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareablePlanLinkRequest.ProtoReflect.Descriptor instead.
+func (*ShareablePlanLinkRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ShareablePlanLinkRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *ShareablePlanLinkRequest) GetTtlDays() uint32 {
+	if x != nil {
+		return x.TtlDays
+	}
+	return 0
+}
+
+// A response for FrontendService.ShareablePlanLink.
+type ShareablePlanLinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The token of the link, to pass to GetSharedPlan.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The time after which the link can no longer be used.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareablePlanLinkResponse) Reset() {
+	*x = ShareablePlanLinkResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareablePlanLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareablePlanLinkResponse) ProtoMessage() {}
+
+func (x *ShareablePlanLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareablePlanLinkResponse.ProtoReflect.Descriptor instead.
+func (*ShareablePlanLinkResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ShareablePlanLinkResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ShareablePlanLinkResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// A request for FrontendService.RevokeShareablePlanLink.
+type RevokeShareablePlanLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The token of the link to revoke.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeShareablePlanLinkRequest) Reset() {
+	*x = RevokeShareablePlanLinkRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeShareablePlanLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShareablePlanLinkRequest) ProtoMessage() {}
+
+func (x *RevokeShareablePlanLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShareablePlanLinkRequest.ProtoReflect.Descriptor instead.
+func (*RevokeShareablePlanLinkRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RevokeShareablePlanLinkRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// A response for FrontendService.RevokeShareablePlanLink.
+type RevokeShareablePlanLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeShareablePlanLinkResponse) Reset() {
+	*x = RevokeShareablePlanLinkResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeShareablePlanLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShareablePlanLinkResponse) ProtoMessage() {}
+
+func (x *RevokeShareablePlanLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShareablePlanLinkResponse.ProtoReflect.Descriptor instead.
+func (*RevokeShareablePlanLinkResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{35}
+}
+
+// A request for FrontendService.GetSharedPlan.
+type GetSharedPlanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The token of the link the plan was shared with.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedPlanRequest) Reset() {
+	*x = GetSharedPlanRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedPlanRequest) ProtoMessage() {}
+
+func (x *GetSharedPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedPlanRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetSharedPlanRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// A response for FrontendService.GetSharedPlan.
+type GetSharedPlanResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The shared plan.
+	Plan          *Plan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedPlanResponse) Reset() {
+	*x = GetSharedPlanResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedPlanResponse) ProtoMessage() {}
+
+func (x *GetSharedPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedPlanResponse.ProtoReflect.Descriptor instead.
+func (*GetSharedPlanResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetSharedPlanResponse) GetPlan() *Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// A request for FrontendService.GetPlanTimeline.
+type GetPlanTimelineRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the plan to get the timeline for.
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// The time the meal should be ready to serve.
+	ServeAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=serve_at,json=serveAt,proto3" json:"serve_at,omitempty"`
+	// The IANA time zone of the user, e.g. Asia/Tokyo, used to determine
+	// calendar days. If unset, the time zone in the settings of the user is used.
+	TimeZone      string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlanTimelineRequest) Reset() {
+	*x = GetPlanTimelineRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlanTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanTimelineRequest) ProtoMessage() {}
+
+func (x *GetPlanTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetPlanTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetPlanTimelineRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *GetPlanTimelineRequest) GetServeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServeAt
+	}
+	return nil
+}
+
+func (x *GetPlanTimelineRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// A step within a plan timeline.
+type TimelineStep struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The step.
+	Step *RecipeStep `protobuf:"bytes,1,opt,name=step,proto3" json:"step,omitempty"`
+	// The time to start the step.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The time the step, including any passive waiting, is finished.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Whether the step must begin on the day before the serving day.
+	PreviousDay   bool `protobuf:"varint,4,opt,name=previous_day,json=previousDay,proto3" json:"previous_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineStep) Reset() {
+	*x = TimelineStep{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineStep) ProtoMessage() {}
+
+func (x *TimelineStep) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineStep.ProtoReflect.Descriptor instead.
+func (*TimelineStep) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TimelineStep) GetStep() *RecipeStep {
+	if x != nil {
+		return x.Step
+	}
+	return nil
+}
+
+func (x *TimelineStep) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *TimelineStep) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *TimelineStep) GetPreviousDay() bool {
+	if x != nil {
+		return x.PreviousDay
+	}
+	return false
+}
+
+// A step group within a plan timeline.
+type TimelineStepGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The label of the group.
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// Useful note for the group.
+	Note string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	// The time to start the group.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The time all steps in the group are finished.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The steps in the group.
+	Steps []*TimelineStep `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`
+	// Whether the group must begin on the day before the serving day.
+	PreviousDay   bool `protobuf:"varint,6,opt,name=previous_day,json=previousDay,proto3" json:"previous_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineStepGroup) Reset() {
+	*x = TimelineStepGroup{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineStepGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineStepGroup) ProtoMessage() {}
+
+func (x *TimelineStepGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineStepGroup.ProtoReflect.Descriptor instead.
+func (*TimelineStepGroup) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TimelineStepGroup) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TimelineStepGroup) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *TimelineStepGroup) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *TimelineStepGroup) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *TimelineStepGroup) GetSteps() []*TimelineStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *TimelineStepGroup) GetPreviousDay() bool {
+	if x != nil {
+		return x.PreviousDay
+	}
+	return false
+}
+
+// A response for FrontendService.GetPlanTimeline.
+type GetPlanTimelineResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The time to start cooking.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The time the meal is ready to serve.
+	ServeAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=serve_at,json=serveAt,proto3" json:"serve_at,omitempty"`
+	// The step groups of the plan with absolute times.
+	StepGroups    []*TimelineStepGroup `protobuf:"bytes,3,rep,name=step_groups,json=stepGroups,proto3" json:"step_groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlanTimelineResponse) Reset() {
+	*x = GetPlanTimelineResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlanTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanTimelineResponse) ProtoMessage() {}
+
+func (x *GetPlanTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetPlanTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetPlanTimelineResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetPlanTimelineResponse) GetServeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServeAt
+	}
+	return nil
+}
+
+func (x *GetPlanTimelineResponse) GetStepGroups() []*TimelineStepGroup {
+	if x != nil {
+		return x.StepGroups
+	}
+	return nil
+}
+
+// A request for FrontendService.UpdatePlan.
+type UpdatePlanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the plan to update.
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// The recipes for the plan. The step groups are regenerated for the recipes unless
+	// step_groups is also updated.
+	RecipeIds []string `protobuf:"bytes,2,rep,name=recipe_ids,json=recipeIds,proto3" json:"recipe_ids,omitempty"`
+	// The notes of the plan.
+	Notes []string `protobuf:"bytes,3,rep,name=notes,proto3" json:"notes,omitempty"`
+	// The step groups of the plan.
+	StepGroups []*StepGroup `protobuf:"bytes,4,rep,name=step_groups,json=stepGroups,proto3" json:"step_groups,omitempty"`
+	// Serving sizes to show instead of those of the recipes, keyed by recipe ID.
+	ServingSizes map[string]string `protobuf:"bytes,5,rep,name=serving_sizes,json=servingSizes,proto3" json:"serving_sizes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The fields to update, any of recipe_ids, notes, step_groups and serving_sizes.
+	// If empty, only recipe_ids is updated.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// The update_time of the plan the update was made to. If set and the plan has been
+	// updated since, the request fails with ABORTED. Required when update_mask contains
+	// step_groups. If unset when updating recipe_ids, which regenerates step groups, the
+	// request fails with ABORTED if the plan is updated while they are generated.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlanRequest) Reset() {
+	*x = UpdatePlanRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlanRequest) ProtoMessage() {}
+
+func (x *UpdatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlanRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdatePlanRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *UpdatePlanRequest) GetRecipeIds() []string {
+	if x != nil {
+		return x.RecipeIds
+	}
+	return nil
+}
+
+func (x *UpdatePlanRequest) GetNotes() []string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *UpdatePlanRequest) GetStepGroups() []*StepGroup {
+	if x != nil {
+		return x.StepGroups
+	}
+	return nil
+}
+
+func (x *UpdatePlanRequest) GetServingSizes() map[string]string {
+	if x != nil {
+		return x.ServingSizes
+	}
+	return nil
+}
+
+func (x *UpdatePlanRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdatePlanRequest) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type UpdatePlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *Plan                  `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlanResponse) Reset() {
+	*x = UpdatePlanResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlanResponse) ProtoMessage() {}
+
+func (x *UpdatePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlanResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePlanResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdatePlanResponse) GetPlan() *Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// A request for FrontendService.DeletePlan.
+type DeletePlanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the plan to delete.
+	PlanId        string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlanRequest) Reset() {
+	*x = DeletePlanRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlanRequest) ProtoMessage() {}
+
+func (x *DeletePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlanRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlanRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeletePlanRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+// A response for FrontendService.DeletePlan.
+type DeletePlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlanResponse) Reset() {
+	*x = DeletePlanResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlanResponse) ProtoMessage() {}
+
+func (x *DeletePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlanResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlanResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{45}
+}
+
+// A request for FrontendService.RestorePlan.
+type RestorePlanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the trashed plan to restore.
+	PlanId        string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePlanRequest) Reset() {
+	*x = RestorePlanRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePlanRequest) ProtoMessage() {}
+
+func (x *RestorePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePlanRequest.ProtoReflect.Descriptor instead.
+func (*RestorePlanRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RestorePlanRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+// A response for FrontendService.RestorePlan.
+type RestorePlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePlanResponse) Reset() {
+	*x = RestorePlanResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePlanResponse) ProtoMessage() {}
+
+func (x *RestorePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePlanResponse.ProtoReflect.Descriptor instead.
+func (*RestorePlanResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{47}
+}
+
+// A request for FrontendService.ListDeletedPlans.
+type ListDeletedPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedPlansRequest) Reset() {
+	*x = ListDeletedPlansRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedPlansRequest) ProtoMessage() {}
+
+func (x *ListDeletedPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedPlansRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{48}
+}
+
+// A plan in the trash.
+type DeletedPlan struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The plan.
+	Plan *PlanSnippet `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	// The time the plan was moved to the trash.
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// The time after which the plan is permanently deleted.
+	PurgeAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletedPlan) Reset() {
+	*x = DeletedPlan{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletedPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedPlan) ProtoMessage() {}
+
+func (x *DeletedPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedPlan.ProtoReflect.Descriptor instead.
+func (*DeletedPlan) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeletedPlan) GetPlan() *PlanSnippet {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *DeletedPlan) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *DeletedPlan) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+// A response for FrontendService.ListDeletedPlans.
+type ListDeletedPlansResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The plans in the trash, most recently deleted first.
+	Plans         []*DeletedPlan `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedPlansResponse) Reset() {
+	*x = ListDeletedPlansResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedPlansResponse) ProtoMessage() {}
+
+func (x *ListDeletedPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedPlansResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListDeletedPlansResponse) GetPlans() []*DeletedPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+// A request for FrontendService.RetryPlan.
+type RetryPlanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the plan to retry.
+	PlanId        string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryPlanRequest) Reset() {
+	*x = RetryPlanRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPlanRequest) ProtoMessage() {}
+
+func (x *RetryPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPlanRequest.ProtoReflect.Descriptor instead.
+func (*RetryPlanRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RetryPlanRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+// A response for FrontendService.RetryPlan.
+type RetryPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryPlanResponse) Reset() {
+	*x = RetryPlanResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPlanResponse) ProtoMessage() {}
+
+func (x *RetryPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPlanResponse.ProtoReflect.Descriptor instead.
+func (*RetryPlanResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{52}
+}
+
+// A request for FrontendService.SuggestAlternatives.
+type SuggestAlternativesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the plan.
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// The ID of the recipe in the plan to suggest alternatives for.
+	RecipeId      string `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestAlternativesRequest) Reset() {
+	*x = SuggestAlternativesRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestAlternativesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestAlternativesRequest) ProtoMessage() {}
+
+func (x *SuggestAlternativesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestAlternativesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestAlternativesRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SuggestAlternativesRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *SuggestAlternativesRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+// A response for FrontendService.SuggestAlternatives.
+type SuggestAlternativesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Recipes of the same type that can replace the recipe, best first.
+	Alternatives  []*RecipeSnippet `protobuf:"bytes,1,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestAlternativesResponse) Reset() {
+	*x = SuggestAlternativesResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestAlternativesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestAlternativesResponse) ProtoMessage() {}
+
+func (x *SuggestAlternativesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestAlternativesResponse.ProtoReflect.Descriptor instead.
+func (*SuggestAlternativesResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SuggestAlternativesResponse) GetAlternatives() []*RecipeSnippet {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+// A request for FrontendService.ReplacePlanRecipe.
+type ReplacePlanRecipeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the plan.
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// The ID of the recipe in the plan to replace.
+	RecipeId string `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	// The ID of the recipe to replace it with.
+	ReplacementRecipeId string `protobuf:"bytes,3,opt,name=replacement_recipe_id,json=replacementRecipeId,proto3" json:"replacement_recipe_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ReplacePlanRecipeRequest) Reset() {
+	*x = ReplacePlanRecipeRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplacePlanRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplacePlanRecipeRequest) ProtoMessage() {}
+
+func (x *ReplacePlanRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplacePlanRecipeRequest.ProtoReflect.Descriptor instead.
+func (*ReplacePlanRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReplacePlanRecipeRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *ReplacePlanRecipeRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *ReplacePlanRecipeRequest) GetReplacementRecipeId() string {
+	if x != nil {
+		return x.ReplacementRecipeId
+	}
+	return ""
+}
+
+// A response for FrontendService.ReplacePlanRecipe.
+type ReplacePlanRecipeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplacePlanRecipeResponse) Reset() {
+	*x = ReplacePlanRecipeResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplacePlanRecipeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplacePlanRecipeResponse) ProtoMessage() {}
+
+func (x *ReplacePlanRecipeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplacePlanRecipeResponse.ProtoReflect.Descriptor instead.
+func (*ReplacePlanRecipeResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{56}
+}
+
+// The recipes to cook on a day of the week in a plan template.
+type PlanTemplateSlot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The day of the week of the slot.
+	DayOfWeek DayOfWeek `protobuf:"varint,1,opt,name=day_of_week,json=dayOfWeek,proto3,enum=frontendapi.DayOfWeek" json:"day_of_week,omitempty"`
+	// The IDs of the recipes to cook on the day.
+	RecipeIds     []string `protobuf:"bytes,2,rep,name=recipe_ids,json=recipeIds,proto3" json:"recipe_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanTemplateSlot) Reset() {
+	*x = PlanTemplateSlot{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanTemplateSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanTemplateSlot) ProtoMessage() {}
+
+func (x *PlanTemplateSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanTemplateSlot.ProtoReflect.Descriptor instead.
+func (*PlanTemplateSlot) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PlanTemplateSlot) GetDayOfWeek() DayOfWeek {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return DayOfWeek_DAY_OF_WEEK_UNSPECIFIED
+}
+
+func (x *PlanTemplateSlot) GetRecipeIds() []string {
+	if x != nil {
+		return x.RecipeIds
+	}
+	return nil
+}
+
+// A request for FrontendService.SavePlanTemplate.
+type SavePlanTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of an existing template to overwrite. If unset, a new template is created.
+	TemplateId string `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// The name of the template.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The slots of the template. Each day of the week may only have one slot.
+	Slots         []*PlanTemplateSlot `protobuf:"bytes,3,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavePlanTemplateRequest) Reset() {
+	*x = SavePlanTemplateRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavePlanTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePlanTemplateRequest) ProtoMessage() {}
+
+func (x *SavePlanTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePlanTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SavePlanTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SavePlanTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *SavePlanTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavePlanTemplateRequest) GetSlots() []*PlanTemplateSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// A response for FrontendService.SavePlanTemplate.
+type SavePlanTemplateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the saved template.
+	TemplateId    string `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavePlanTemplateResponse) Reset() {
+	*x = SavePlanTemplateResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavePlanTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePlanTemplateResponse) ProtoMessage() {}
+
+func (x *SavePlanTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePlanTemplateResponse.ProtoReflect.Descriptor instead.
+func (*SavePlanTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SavePlanTemplateResponse) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+// A request for FrontendService.ApplyPlanTemplate.
+type ApplyPlanTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the template to apply.
+	TemplateId string `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// A time within the first day of the week to create plans for. Plans are created
+	// for the seven days starting from this day.
+	WeekStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	// The IANA time zone of the user, e.g. Asia/Tokyo, used to determine
+	// calendar days. If unset, the time zone in the settings of the user is used.
+	TimeZone      string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyPlanTemplateRequest) Reset() {
+	*x = ApplyPlanTemplateRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyPlanTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyPlanTemplateRequest) ProtoMessage() {}
+
+func (x *ApplyPlanTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyPlanTemplateRequest.ProtoReflect.Descriptor instead.
+func (*ApplyPlanTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ApplyPlanTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *ApplyPlanTemplateRequest) GetWeekStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WeekStart
+	}
+	return nil
+}
+
+func (x *ApplyPlanTemplateRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// A response for FrontendService.ApplyPlanTemplate.
+type ApplyPlanTemplateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The IDs of the created plans.
+	PlanIds []string `protobuf:"bytes,1,rep,name=plan_ids,json=planIds,proto3" json:"plan_ids,omitempty"`
+	// The days of the template that were skipped because they already have a plan.
+	SkippedDays   []DayOfWeek `protobuf:"varint,2,rep,packed,name=skipped_days,json=skippedDays,proto3,enum=frontendapi.DayOfWeek" json:"skipped_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyPlanTemplateResponse) Reset() {
+	*x = ApplyPlanTemplateResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyPlanTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyPlanTemplateResponse) ProtoMessage() {}
+
+func (x *ApplyPlanTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyPlanTemplateResponse.ProtoReflect.Descriptor instead.
+func (*ApplyPlanTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ApplyPlanTemplateResponse) GetPlanIds() []string {
+	if x != nil {
+		return x.PlanIds
+	}
+	return nil
+}
+
+func (x *ApplyPlanTemplateResponse) GetSkippedDays() []DayOfWeek {
+	if x != nil {
+		return x.SkippedDays
+	}
+	return nil
+}
+
+// The price of an ingredient used to estimate recipe costs.
+type IngredientPrice struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the ingredient.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The unit the price is for, e.g. g, ml, or 個.
+	Unit string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	// The price in yen of one unit of the ingredient.
+	Yen float64 `protobuf:"fixed64,3,opt,name=yen,proto3" json:"yen,omitempty"`
+	// Whether the price was set by the user rather than a default.
+	Custom        bool `protobuf:"varint,4,opt,name=custom,proto3" json:"custom,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngredientPrice) Reset() {
+	*x = IngredientPrice{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngredientPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngredientPrice) ProtoMessage() {}
+
+func (x *IngredientPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngredientPrice.ProtoReflect.Descriptor instead.
+func (*IngredientPrice) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *IngredientPrice) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IngredientPrice) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *IngredientPrice) GetYen() float64 {
+	if x != nil {
+		return x.Yen
+	}
+	return 0
+}
+
+func (x *IngredientPrice) GetCustom() bool {
+	if x != nil {
+		return x.Custom
+	}
+	return false
+}
+
+// A request for FrontendService.ListIngredientPrices.
+type ListIngredientPricesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIngredientPricesRequest) Reset() {
+	*x = ListIngredientPricesRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngredientPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngredientPricesRequest) ProtoMessage() {}
+
+func (x *ListIngredientPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngredientPricesRequest.ProtoReflect.Descriptor instead.
+func (*ListIngredientPricesRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{63}
+}
+
+// A response for FrontendService.ListIngredientPrices.
+type ListIngredientPricesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The prices of ingredients, including defaults and prices set by the user.
+	Prices        []*IngredientPrice `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIngredientPricesResponse) Reset() {
+	*x = ListIngredientPricesResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngredientPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngredientPricesResponse) ProtoMessage() {}
+
+func (x *ListIngredientPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngredientPricesResponse.ProtoReflect.Descriptor instead.
+func (*ListIngredientPricesResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListIngredientPricesResponse) GetPrices() []*IngredientPrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+// A request for FrontendService.SetIngredientPrice.
+type SetIngredientPriceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the ingredient.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The unit the price is for, e.g. g, ml, or 個.
+	Unit string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	// The price in yen of one unit of the ingredient.
+	Yen           float64 `protobuf:"fixed64,3,opt,name=yen,proto3" json:"yen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIngredientPriceRequest) Reset() {
+	*x = SetIngredientPriceRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIngredientPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIngredientPriceRequest) ProtoMessage() {}
+
+func (x *SetIngredientPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIngredientPriceRequest.ProtoReflect.Descriptor instead.
+func (*SetIngredientPriceRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SetIngredientPriceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetIngredientPriceRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *SetIngredientPriceRequest) GetYen() float64 {
+	if x != nil {
+		return x.Yen
+	}
+	return 0
+}
+
+// A response for FrontendService.SetIngredientPrice.
+type SetIngredientPriceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIngredientPriceResponse) Reset() {
+	*x = SetIngredientPriceResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIngredientPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIngredientPriceResponse) ProtoMessage() {}
+
+func (x *SetIngredientPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIngredientPriceResponse.ProtoReflect.Descriptor instead.
+func (*SetIngredientPriceResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{66}
+}
+
+// A request for FrontendService.DeleteIngredientPrice.
+type DeleteIngredientPriceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the ingredient.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The unit of the price to delete.
+	Unit          string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIngredientPriceRequest) Reset() {
+	*x = DeleteIngredientPriceRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIngredientPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIngredientPriceRequest) ProtoMessage() {}
+
+func (x *DeleteIngredientPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIngredientPriceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIngredientPriceRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteIngredientPriceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteIngredientPriceRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+// A response for FrontendService.DeleteIngredientPrice.
+type DeleteIngredientPriceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIngredientPriceResponse) Reset() {
+	*x = DeleteIngredientPriceResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIngredientPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIngredientPriceResponse) ProtoMessage() {}
+
+func (x *DeleteIngredientPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIngredientPriceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteIngredientPriceResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{68}
+}
+
+// A request for FrontendService.MarkCooked.
+type MarkCookedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// What was cooked.
+	//
+	// Types that are valid to be assigned to Target:
+	//
+	//	*MarkCookedRequest_RecipeId
+	//	*MarkCookedRequest_PlanId
+	Target isMarkCookedRequest_Target `protobuf_oneof:"target"`
+	// The time the recipe or plan was cooked. If unset, the current time is used.
+	CookedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=cooked_at,json=cookedAt,proto3" json:"cooked_at,omitempty"`
+	// The rating from 1 to 5, or 0 for no rating.
+	Rating uint32 `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	// The number of servings cooked.
+	Servings uint32 `protobuf:"varint,5,opt,name=servings,proto3" json:"servings,omitempty"`
+	// Free-form notes about cooking.
+	Notes         string `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkCookedRequest) Reset() {
+	*x = MarkCookedRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkCookedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkCookedRequest) ProtoMessage() {}
+
+func (x *MarkCookedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkCookedRequest.ProtoReflect.Descriptor instead.
+func (*MarkCookedRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *MarkCookedRequest) GetTarget() isMarkCookedRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *MarkCookedRequest) GetRecipeId() string {
+	if x != nil {
+		if x, ok := x.Target.(*MarkCookedRequest_RecipeId); ok {
+			return x.RecipeId
+		}
+	}
+	return ""
+}
+
+func (x *MarkCookedRequest) GetPlanId() string {
+	if x != nil {
+		if x, ok := x.Target.(*MarkCookedRequest_PlanId); ok {
+			return x.PlanId
+		}
+	}
+	return ""
+}
+
+func (x *MarkCookedRequest) GetCookedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CookedAt
+	}
+	return nil
+}
+
+func (x *MarkCookedRequest) GetRating() uint32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *MarkCookedRequest) GetServings() uint32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *MarkCookedRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type isMarkCookedRequest_Target interface {
+	isMarkCookedRequest_Target()
+}
+
+type MarkCookedRequest_RecipeId struct {
+	// The ID of a cooked recipe.
+	RecipeId string `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3,oneof"`
+}
+
+type MarkCookedRequest_PlanId struct {
+	// The ID of a cooked plan.
+	PlanId string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3,oneof"`
+}
+
+func (*MarkCookedRequest_RecipeId) isMarkCookedRequest_Target() {}
+
+func (*MarkCookedRequest_PlanId) isMarkCookedRequest_Target() {}
+
+// A response for FrontendService.MarkCooked.
+type MarkCookedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the created history entry.
+	EntryId       string `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkCookedResponse) Reset() {
+	*x = MarkCookedResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkCookedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkCookedResponse) ProtoMessage() {}
+
+func (x *MarkCookedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkCookedResponse.ProtoReflect.Descriptor instead.
+func (*MarkCookedResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *MarkCookedResponse) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+// An entry in the cooking history of a user.
+type CookingHistoryEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the entry.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The recipes cooked.
+	Recipes []*RecipeSnippet `protobuf:"bytes,2,rep,name=recipes,proto3" json:"recipes,omitempty"`
+	// The ID of the plan cooked, if a plan was cooked.
+	PlanId string `protobuf:"bytes,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// The time the recipes were cooked.
+	CookedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=cooked_at,json=cookedAt,proto3" json:"cooked_at,omitempty"`
+	// The rating from 1 to 5, or 0 if not rated.
+	Rating uint32 `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	// The number of servings cooked.
+	Servings uint32 `protobuf:"varint,6,opt,name=servings,proto3" json:"servings,omitempty"`
+	// Free-form notes about cooking.
+	Notes         string `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CookingHistoryEntry) Reset() {
+	*x = CookingHistoryEntry{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CookingHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CookingHistoryEntry) ProtoMessage() {}
+
+func (x *CookingHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CookingHistoryEntry.ProtoReflect.Descriptor instead.
+func (*CookingHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CookingHistoryEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CookingHistoryEntry) GetRecipes() []*RecipeSnippet {
+	if x != nil {
+		return x.Recipes
+	}
+	return nil
+}
+
+func (x *CookingHistoryEntry) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *CookingHistoryEntry) GetCookedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CookedAt
+	}
+	return nil
+}
+
+func (x *CookingHistoryEntry) GetRating() uint32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CookingHistoryEntry) GetServings() uint32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *CookingHistoryEntry) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+// A request for FrontendService.ListCookingHistory.
+type ListCookingHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pagination token for the next page of entries.
+	// If unset, the first page is returned.
+	Pagination    *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCookingHistoryRequest) Reset() {
+	*x = ListCookingHistoryRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCookingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCookingHistoryRequest) ProtoMessage() {}
+
+func (x *ListCookingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCookingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListCookingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListCookingHistoryRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// A response for FrontendService.ListCookingHistory.
+type ListCookingHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The history entries, most recently cooked first.
+	Entries []*CookingHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// The pagination token for the next page of entries.
+	Pagination    *Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCookingHistoryResponse) Reset() {
+	*x = ListCookingHistoryResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCookingHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCookingHistoryResponse) ProtoMessage() {}
+
+func (x *ListCookingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCookingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListCookingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListCookingHistoryResponse) GetEntries() []*CookingHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListCookingHistoryResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// A member of a household.
+type HouseholdMember struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the user.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The role of the member.
+	Role HouseholdRole `protobuf:"varint,2,opt,name=role,proto3,enum=frontendapi.HouseholdRole" json:"role,omitempty"`
+	// The time the member joined the household.
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HouseholdMember) Reset() {
+	*x = HouseholdMember{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HouseholdMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdMember) ProtoMessage() {}
+
+func (x *HouseholdMember) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdMember.ProtoReflect.Descriptor instead.
+func (*HouseholdMember) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *HouseholdMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HouseholdMember) GetRole() HouseholdRole {
+	if x != nil {
+		return x.Role
+	}
+	return HouseholdRole_HOUSEHOLD_ROLE_UNSPECIFIED
+}
+
+func (x *HouseholdMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+// A household sharing plans, bookmarks, and other data between members.
+type Household struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the household.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the household.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The members of the household.
+	Members       []*HouseholdMember `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Household) Reset() {
+	*x = Household{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Household) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Household) ProtoMessage() {}
+
+func (x *Household) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Household.ProtoReflect.Descriptor instead.
+func (*Household) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Household) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Household) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Household) GetMembers() []*HouseholdMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// A request for FrontendService.CreateHousehold.
+type CreateHouseholdRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the household.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHouseholdRequest) Reset() {
+	*x = CreateHouseholdRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHouseholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHouseholdRequest) ProtoMessage() {}
+
+func (x *CreateHouseholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHouseholdRequest.ProtoReflect.Descriptor instead.
+func (*CreateHouseholdRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreateHouseholdRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// A response for FrontendService.CreateHousehold.
+type CreateHouseholdResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the created household.
+	HouseholdId   string `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHouseholdResponse) Reset() {
+	*x = CreateHouseholdResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHouseholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHouseholdResponse) ProtoMessage() {}
+
+func (x *CreateHouseholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHouseholdResponse.ProtoReflect.Descriptor instead.
+func (*CreateHouseholdResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CreateHouseholdResponse) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+// A request for FrontendService.GetHousehold.
+type GetHouseholdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHouseholdRequest) Reset() {
+	*x = GetHouseholdRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHouseholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHouseholdRequest) ProtoMessage() {}
+
+func (x *GetHouseholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHouseholdRequest.ProtoReflect.Descriptor instead.
+func (*GetHouseholdRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{78}
+}
+
+// A response for FrontendService.GetHousehold.
+type GetHouseholdResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The household of the user, unset if the user is not in a household.
+	Household *Household `protobuf:"bytes,1,opt,name=household,proto3" json:"household,omitempty"`
+	// The role of the user in the household.
+	Role          HouseholdRole `protobuf:"varint,2,opt,name=role,proto3,enum=frontendapi.HouseholdRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHouseholdResponse) Reset() {
+	*x = GetHouseholdResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHouseholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHouseholdResponse) ProtoMessage() {}
+
+func (x *GetHouseholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHouseholdResponse.ProtoReflect.Descriptor instead.
+func (*GetHouseholdResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetHouseholdResponse) GetHousehold() *Household {
+	if x != nil {
+		return x.Household
+	}
+	return nil
+}
+
+func (x *GetHouseholdResponse) GetRole() HouseholdRole {
+	if x != nil {
+		return x.Role
+	}
+	return HouseholdRole_HOUSEHOLD_ROLE_UNSPECIFIED
+}
+
+// A request for FrontendService.CreateHouseholdInvitation.
+type CreateHouseholdInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The role to give the invitee.
+	Role          HouseholdRole `protobuf:"varint,1,opt,name=role,proto3,enum=frontendapi.HouseholdRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHouseholdInvitationRequest) Reset() {
+	*x = CreateHouseholdInvitationRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHouseholdInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHouseholdInvitationRequest) ProtoMessage() {}
+
+func (x *CreateHouseholdInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHouseholdInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateHouseholdInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CreateHouseholdInvitationRequest) GetRole() HouseholdRole {
+	if x != nil {
+		return x.Role
+	}
+	return HouseholdRole_HOUSEHOLD_ROLE_UNSPECIFIED
+}
+
+// A response for FrontendService.CreateHouseholdInvitation.
+type CreateHouseholdInvitationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the invitation, to share with the invitee.
+	InvitationId string `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	// The time after which the invitation can no longer be accepted.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHouseholdInvitationResponse) Reset() {
+	*x = CreateHouseholdInvitationResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHouseholdInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHouseholdInvitationResponse) ProtoMessage() {}
+
+func (x *CreateHouseholdInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHouseholdInvitationResponse.ProtoReflect.Descriptor instead.
+func (*CreateHouseholdInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateHouseholdInvitationResponse) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *CreateHouseholdInvitationResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// A request for FrontendService.AcceptHouseholdInvitation.
+type AcceptHouseholdInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the invitation to accept.
+	InvitationId  string `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptHouseholdInvitationRequest) Reset() {
+	*x = AcceptHouseholdInvitationRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptHouseholdInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptHouseholdInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptHouseholdInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptHouseholdInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptHouseholdInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *AcceptHouseholdInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+// A response for FrontendService.AcceptHouseholdInvitation.
+type AcceptHouseholdInvitationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the joined household.
+	HouseholdId   string `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptHouseholdInvitationResponse) Reset() {
+	*x = AcceptHouseholdInvitationResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptHouseholdInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptHouseholdInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptHouseholdInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptHouseholdInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptHouseholdInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AcceptHouseholdInvitationResponse) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+// A request for FrontendService.RemoveHouseholdMember.
+type RemoveHouseholdMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the user to remove. Owners can remove any other member, and other
+	// members can only remove themselves to leave the household.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveHouseholdMemberRequest) Reset() {
+	*x = RemoveHouseholdMemberRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveHouseholdMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveHouseholdMemberRequest) ProtoMessage() {}
+
+func (x *RemoveHouseholdMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveHouseholdMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveHouseholdMemberRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RemoveHouseholdMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// A response for FrontendService.RemoveHouseholdMember.
+type RemoveHouseholdMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveHouseholdMemberResponse) Reset() {
+	*x = RemoveHouseholdMemberResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveHouseholdMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveHouseholdMemberResponse) ProtoMessage() {}
+
+func (x *RemoveHouseholdMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveHouseholdMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveHouseholdMemberResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{85}
+}
+
+// Settings of a user.
+type UserSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The IANA time zone of the user, e.g. Asia/Tokyo, used to compute the calendar dates
+	// of plans. If unset, Asia/Tokyo is used.
+	TimeZone      string `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSettings) Reset() {
+	*x = UserSettings{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettings) ProtoMessage() {}
+
+func (x *UserSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettings.ProtoReflect.Descriptor instead.
+func (*UserSettings) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *UserSettings) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// A request for FrontendService.GetUserSettings.
+type GetUserSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSettingsRequest) Reset() {
+	*x = GetUserSettingsRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSettingsRequest) ProtoMessage() {}
+
+func (x *GetUserSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{87}
+}
+
+// A response for FrontendService.GetUserSettings.
+type GetUserSettingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The settings of the user.
+	Settings      *UserSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSettingsResponse) Reset() {
+	*x = GetUserSettingsResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSettingsResponse) ProtoMessage() {}
+
+func (x *GetUserSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetUserSettingsResponse) GetSettings() *UserSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// A request for FrontendService.UpdateUserSettings.
+type UpdateUserSettingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The settings to save.
+	Settings      *UserSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserSettingsRequest) Reset() {
+	*x = UpdateUserSettingsRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateUserSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *UpdateUserSettingsRequest) GetSettings() *UserSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// A response for FrontendService.UpdateUserSettings.
+type UpdateUserSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserSettingsResponse) Reset() {
+	*x = UpdateUserSettingsResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateUserSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{90}
+}
+
+// A request for FrontendService.AddBookmark.
+type AddBookmarkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the recipe to add a bookmark for.
+	RecipeId      string `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBookmarkRequest) Reset() {
+	*x = AddBookmarkRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBookmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBookmarkRequest) ProtoMessage() {}
+
+func (x *AddBookmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBookmarkRequest.ProtoReflect.Descriptor instead.
+func (*AddBookmarkRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *AddBookmarkRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+// A response for FrontendService.AddBookmark.
+type AddBookmarkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBookmarkResponse) Reset() {
+	*x = AddBookmarkResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBookmarkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBookmarkResponse) ProtoMessage() {}
+
+func (x *AddBookmarkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBookmarkResponse.ProtoReflect.Descriptor instead.
+func (*AddBookmarkResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{92}
+}
+
+// A request for FrontendService.RemoveBookmark.
+type RemoveBookmarkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the recipe to remove a bookmark for.
+	RecipeId      string `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBookmarkRequest) Reset() {
+	*x = RemoveBookmarkRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBookmarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBookmarkRequest) ProtoMessage() {}
+
+func (x *RemoveBookmarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBookmarkRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBookmarkRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RemoveBookmarkRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+// A response for FrontendService.RemoveBookmark.
+type RemoveBookmarkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBookmarkResponse) Reset() {
+	*x = RemoveBookmarkResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBookmarkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBookmarkResponse) ProtoMessage() {}
+
+func (x *RemoveBookmarkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBookmarkResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBookmarkResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{94}
+}
+
+// An ingredient in an entry of the shopping list.
+type ShoppingListIngredient struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the ingredient.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The quantity of the ingredient, e.g. 200g.
+	Quantity string `protobuf:"bytes,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Whether the ingredient has been bought.
+	Selected      bool `protobuf:"varint,3,opt,name=selected,proto3" json:"selected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShoppingListIngredient) Reset() {
+	*x = ShoppingListIngredient{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShoppingListIngredient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingListIngredient) ProtoMessage() {}
+
+func (x *ShoppingListIngredient) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingListIngredient.ProtoReflect.Descriptor instead.
+func (*ShoppingListIngredient) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ShoppingListIngredient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShoppingListIngredient) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *ShoppingListIngredient) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+// An entry of the shopping list, either the ingredients of a recipe or an
+// extra item added by hand.
+type ShoppingListEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the entry.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The ID of the recipe the ingredients are for, empty for an extra item.
+	RecipeId string `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	// The title of the recipe, or the name of an extra item.
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// The serving size of the recipe the ingredients are for.
+	ServingSize string `protobuf:"bytes,4,opt,name=serving_size,json=servingSize,proto3" json:"serving_size,omitempty"`
+	// The ingredients to buy for the recipe.
+	Ingredients   []*ShoppingListIngredient `protobuf:"bytes,5,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShoppingListEntry) Reset() {
+	*x = ShoppingListEntry{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShoppingListEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingListEntry) ProtoMessage() {}
+
+func (x *ShoppingListEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingListEntry.ProtoReflect.Descriptor instead.
+func (*ShoppingListEntry) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ShoppingListEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShoppingListEntry) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *ShoppingListEntry) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ShoppingListEntry) GetServingSize() string {
+	if x != nil {
+		return x.ServingSize
+	}
+	return ""
+}
+
+func (x *ShoppingListEntry) GetIngredients() []*ShoppingListIngredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+// A request for FrontendService.GetShoppingList.
+type GetShoppingListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShoppingListRequest) Reset() {
+	*x = GetShoppingListRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShoppingListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShoppingListRequest) ProtoMessage() {}
+
+func (x *GetShoppingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShoppingListRequest.ProtoReflect.Descriptor instead.
+func (*GetShoppingListRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{97}
+}
+
+// A response for FrontendService.GetShoppingList.
+type GetShoppingListResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The entries of the shopping list, in the order they were added.
+	Entries       []*ShoppingListEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShoppingListResponse) Reset() {
+	*x = GetShoppingListResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShoppingListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShoppingListResponse) ProtoMessage() {}
+
+func (x *GetShoppingListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShoppingListResponse.ProtoReflect.Descriptor instead.
+func (*GetShoppingListResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetShoppingListResponse) GetEntries() []*ShoppingListEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// A request for FrontendService.AddShoppingListEntry.
+type AddShoppingListEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the recipe to add the ingredients of, replacing any entry
+	// already added for it. If empty, an extra item is added.
+	RecipeId string `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	// The title of the recipe, or the name of an extra item.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The serving size of the recipe the ingredients are for.
+	ServingSize string `protobuf:"bytes,3,opt,name=serving_size,json=servingSize,proto3" json:"serving_size,omitempty"`
+	// The ingredients to buy for the recipe.
+	Ingredients   []*ShoppingListIngredient `protobuf:"bytes,4,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddShoppingListEntryRequest) Reset() {
+	*x = AddShoppingListEntryRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddShoppingListEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddShoppingListEntryRequest) ProtoMessage() {}
+
+func (x *AddShoppingListEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddShoppingListEntryRequest.ProtoReflect.Descriptor instead.
+func (*AddShoppingListEntryRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *AddShoppingListEntryRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *AddShoppingListEntryRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AddShoppingListEntryRequest) GetServingSize() string {
+	if x != nil {
+		return x.ServingSize
+	}
+	return ""
+}
+
+func (x *AddShoppingListEntryRequest) GetIngredients() []*ShoppingListIngredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+// A response for FrontendService.AddShoppingListEntry.
+type AddShoppingListEntryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the added entry.
+	EntryId       string `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddShoppingListEntryResponse) Reset() {
+	*x = AddShoppingListEntryResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddShoppingListEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddShoppingListEntryResponse) ProtoMessage() {}
+
+func (x *AddShoppingListEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddShoppingListEntryResponse.ProtoReflect.Descriptor instead.
+func (*AddShoppingListEntryResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *AddShoppingListEntryResponse) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+// A request for FrontendService.UpdateShoppingListIngredient.
+type UpdateShoppingListIngredientRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the entry the ingredient is in.
+	EntryId string `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	// The index of the ingredient in the entry.
+	IngredientIndex uint32 `protobuf:"varint,2,opt,name=ingredient_index,json=ingredientIndex,proto3" json:"ingredient_index,omitempty"`
+	// Whether the ingredient has been bought.
+	Selected      bool `protobuf:"varint,3,opt,name=selected,proto3" json:"selected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateShoppingListIngredientRequest) Reset() {
+	*x = UpdateShoppingListIngredientRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShoppingListIngredientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShoppingListIngredientRequest) ProtoMessage() {}
+
+func (x *UpdateShoppingListIngredientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShoppingListIngredientRequest.ProtoReflect.Descriptor instead.
+func (*UpdateShoppingListIngredientRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *UpdateShoppingListIngredientRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *UpdateShoppingListIngredientRequest) GetIngredientIndex() uint32 {
+	if x != nil {
+		return x.IngredientIndex
+	}
+	return 0
+}
+
+func (x *UpdateShoppingListIngredientRequest) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+// A response for FrontendService.UpdateShoppingListIngredient.
+type UpdateShoppingListIngredientResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateShoppingListIngredientResponse) Reset() {
+	*x = UpdateShoppingListIngredientResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPlanResponse) String() string {
+func (x *UpdateShoppingListIngredientResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlanResponse) ProtoMessage() {}
+func (*UpdateShoppingListIngredientResponse) ProtoMessage() {}
 
-func (x *GetPlanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_frontendapi_frontend_proto_msgTypes[27]
+func (x *UpdateShoppingListIngredientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2261,51 +6782,35 @@ func (x *GetPlanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlanResponse.ProtoReflect.Descriptor instead.
-func (*GetPlanResponse) Descriptor() ([]byte, []int) {
-	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *GetPlanResponse) GetPlan() *Plan {
-	if x != nil {
-		return x.Plan
-	}
-	return nil
-}
-
-func (x *GetPlanResponse) GetLlmPrompt() string {
-	if x != nil {
-		return x.LlmPrompt
-	}
-	return ""
+// Deprecated: Use UpdateShoppingListIngredientResponse.ProtoReflect.Descriptor instead.
+func (*UpdateShoppingListIngredientResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{102}
 }
 
-// A request for FrontendService.UpdatePlan.
-type UpdatePlanRequest struct {
+// A request for FrontendService.RemoveShoppingListEntry.
+type RemoveShoppingListEntryRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The ID of the plan to update.
-	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
-	// The recipes for the plan.
-	RecipeIds     []string `protobuf:"bytes,2,rep,name=recipe_ids,json=recipeIds,proto3" json:"recipe_ids,omitempty"`
+	// The ID of the entry to remove.
+	EntryId       string `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePlanRequest) Reset() {
-	*x = UpdatePlanRequest{}
-	mi := &file_frontendapi_frontend_proto_msgTypes[28]
+func (x *RemoveShoppingListEntryRequest) Reset() {
+	*x = RemoveShoppingListEntryRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePlanRequest) String() string {
+func (x *RemoveShoppingListEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePlanRequest) ProtoMessage() {}
+func (*RemoveShoppingListEntryRequest) ProtoMessage() {}
 
-func (x *UpdatePlanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_frontendapi_frontend_proto_msgTypes[28]
+func (x *RemoveShoppingListEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2316,47 +6821,40 @@ func (x *UpdatePlanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePlanRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePlanRequest) Descriptor() ([]byte, []int) {
-	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use RemoveShoppingListEntryRequest.ProtoReflect.Descriptor instead.
+func (*RemoveShoppingListEntryRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *UpdatePlanRequest) GetPlanId() string {
+func (x *RemoveShoppingListEntryRequest) GetEntryId() string {
 	if x != nil {
-		return x.PlanId
+		return x.EntryId
 	}
 	return ""
 }
 
-func (x *UpdatePlanRequest) GetRecipeIds() []string {
-	if x != nil {
-		return x.RecipeIds
-	}
-	return nil
-}
-
-type UpdatePlanResponse struct {
+// A response for FrontendService.RemoveShoppingListEntry.
+type RemoveShoppingListEntryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Plan          *Plan                  `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePlanResponse) Reset() {
-	*x = UpdatePlanResponse{}
-	mi := &file_frontendapi_frontend_proto_msgTypes[29]
+func (x *RemoveShoppingListEntryResponse) Reset() {
+	*x = RemoveShoppingListEntryResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePlanResponse) String() string {
+func (x *RemoveShoppingListEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePlanResponse) ProtoMessage() {}
+func (*RemoveShoppingListEntryResponse) ProtoMessage() {}
 
-func (x *UpdatePlanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_frontendapi_frontend_proto_msgTypes[29]
+func (x *RemoveShoppingListEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2367,42 +6865,37 @@ func (x *UpdatePlanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePlanResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePlanResponse) Descriptor() ([]byte, []int) {
-	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *UpdatePlanResponse) GetPlan() *Plan {
-	if x != nil {
-		return x.Plan
-	}
-	return nil
+// Deprecated: Use RemoveShoppingListEntryResponse.ProtoReflect.Descriptor instead.
+func (*RemoveShoppingListEntryResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{104}
 }
 
-// A request for FrontendService.DeletePlan.
-type DeletePlanRequest struct {
+// An ingredient kept in the pantry.
+type PantryItem struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The ID of the plan to delete.
-	PlanId        string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// The name of the ingredient.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The quantity of the ingredient on hand, e.g. 200g. Empty if unknown.
+	Quantity      string `protobuf:"bytes,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePlanRequest) Reset() {
-	*x = DeletePlanRequest{}
-	mi := &file_frontendapi_frontend_proto_msgTypes[30]
+func (x *PantryItem) Reset() {
+	*x = PantryItem{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePlanRequest) String() string {
+func (x *PantryItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePlanRequest) ProtoMessage() {}
+func (*PantryItem) ProtoMessage() {}
 
-func (x *DeletePlanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_frontendapi_frontend_proto_msgTypes[30]
+func (x *PantryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2413,40 +6906,47 @@ func (x *DeletePlanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePlanRequest.ProtoReflect.Descriptor instead.
-func (*DeletePlanRequest) Descriptor() ([]byte, []int) {
-	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use PantryItem.ProtoReflect.Descriptor instead.
+func (*PantryItem) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *DeletePlanRequest) GetPlanId() string {
+func (x *PantryItem) GetName() string {
 	if x != nil {
-		return x.PlanId
+		return x.Name
 	}
 	return ""
 }
 
-// A response for FrontendService.DeletePlan.
-type DeletePlanResponse struct {
+func (x *PantryItem) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+// A request for FrontendService.ListPantryItems.
+type ListPantryItemsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePlanResponse) Reset() {
-	*x = DeletePlanResponse{}
-	mi := &file_frontendapi_frontend_proto_msgTypes[31]
+func (x *ListPantryItemsRequest) Reset() {
+	*x = ListPantryItemsRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePlanResponse) String() string {
+func (x *ListPantryItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePlanResponse) ProtoMessage() {}
+func (*ListPantryItemsRequest) ProtoMessage() {}
 
-func (x *DeletePlanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_frontendapi_frontend_proto_msgTypes[31]
+func (x *ListPantryItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2457,35 +6957,35 @@ func (x *DeletePlanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePlanResponse.ProtoReflect.Descriptor instead.
-func (*DeletePlanResponse) Descriptor() ([]byte, []int) {
-	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ListPantryItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListPantryItemsRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{106}
 }
 
-// A request for FrontendService.AddBookmark.
-type AddBookmarkRequest struct {
+// A response for FrontendService.ListPantryItems.
+type ListPantryItemsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The ID of the recipe to add a bookmark for.
-	RecipeId      string `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	// The items in the pantry, sorted by name.
+	Items         []*PantryItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddBookmarkRequest) Reset() {
-	*x = AddBookmarkRequest{}
-	mi := &file_frontendapi_frontend_proto_msgTypes[32]
+func (x *ListPantryItemsResponse) Reset() {
+	*x = ListPantryItemsResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddBookmarkRequest) String() string {
+func (x *ListPantryItemsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddBookmarkRequest) ProtoMessage() {}
+func (*ListPantryItemsResponse) ProtoMessage() {}
 
-func (x *AddBookmarkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_frontendapi_frontend_proto_msgTypes[32]
+func (x *ListPantryItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2496,40 +6996,95 @@ func (x *AddBookmarkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddBookmarkRequest.ProtoReflect.Descriptor instead.
-func (*AddBookmarkRequest) Descriptor() ([]byte, []int) {
-	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ListPantryItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListPantryItemsResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *AddBookmarkRequest) GetRecipeId() string {
+func (x *ListPantryItemsResponse) GetItems() []*PantryItem {
 	if x != nil {
-		return x.RecipeId
+		return x.Items
+	}
+	return nil
+}
+
+// A request for FrontendService.SetPantryItem.
+type SetPantryItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the ingredient.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The quantity of the ingredient on hand, e.g. 200g. Empty if unknown.
+	Quantity      string `protobuf:"bytes,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPantryItemRequest) Reset() {
+	*x = SetPantryItemRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPantryItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPantryItemRequest) ProtoMessage() {}
+
+func (x *SetPantryItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPantryItemRequest.ProtoReflect.Descriptor instead.
+func (*SetPantryItemRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *SetPantryItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-// A response for FrontendService.AddBookmark.
-type AddBookmarkResponse struct {
+func (x *SetPantryItemRequest) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+// A response for FrontendService.SetPantryItem.
+type SetPantryItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddBookmarkResponse) Reset() {
-	*x = AddBookmarkResponse{}
-	mi := &file_frontendapi_frontend_proto_msgTypes[33]
+func (x *SetPantryItemResponse) Reset() {
+	*x = SetPantryItemResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddBookmarkResponse) String() string {
+func (x *SetPantryItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddBookmarkResponse) ProtoMessage() {}
+func (*SetPantryItemResponse) ProtoMessage() {}
 
-func (x *AddBookmarkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_frontendapi_frontend_proto_msgTypes[33]
+func (x *SetPantryItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2540,35 +7095,35 @@ func (x *AddBookmarkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddBookmarkResponse.ProtoReflect.Descriptor instead.
-func (*AddBookmarkResponse) Descriptor() ([]byte, []int) {
-	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use SetPantryItemResponse.ProtoReflect.Descriptor instead.
+func (*SetPantryItemResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{109}
 }
 
-// A request for FrontendService.RemoveBookmark.
-type RemoveBookmarkRequest struct {
+// A request for FrontendService.DeletePantryItem.
+type DeletePantryItemRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The ID of the recipe to remove a bookmark for.
-	RecipeId      string `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	// The name of the ingredient.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveBookmarkRequest) Reset() {
-	*x = RemoveBookmarkRequest{}
-	mi := &file_frontendapi_frontend_proto_msgTypes[34]
+func (x *DeletePantryItemRequest) Reset() {
+	*x = DeletePantryItemRequest{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveBookmarkRequest) String() string {
+func (x *DeletePantryItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveBookmarkRequest) ProtoMessage() {}
+func (*DeletePantryItemRequest) ProtoMessage() {}
 
-func (x *RemoveBookmarkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_frontendapi_frontend_proto_msgTypes[34]
+func (x *DeletePantryItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2579,40 +7134,40 @@ func (x *RemoveBookmarkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveBookmarkRequest.ProtoReflect.Descriptor instead.
-func (*RemoveBookmarkRequest) Descriptor() ([]byte, []int) {
-	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use DeletePantryItemRequest.ProtoReflect.Descriptor instead.
+func (*DeletePantryItemRequest) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *RemoveBookmarkRequest) GetRecipeId() string {
+func (x *DeletePantryItemRequest) GetName() string {
 	if x != nil {
-		return x.RecipeId
+		return x.Name
 	}
 	return ""
 }
 
-// A response for FrontendService.RemoveBookmark.
-type RemoveBookmarkResponse struct {
+// A response for FrontendService.DeletePantryItem.
+type DeletePantryItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveBookmarkResponse) Reset() {
-	*x = RemoveBookmarkResponse{}
-	mi := &file_frontendapi_frontend_proto_msgTypes[35]
+func (x *DeletePantryItemResponse) Reset() {
+	*x = DeletePantryItemResponse{}
+	mi := &file_frontendapi_frontend_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveBookmarkResponse) String() string {
+func (x *DeletePantryItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveBookmarkResponse) ProtoMessage() {}
+func (*DeletePantryItemResponse) ProtoMessage() {}
 
-func (x *RemoveBookmarkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_frontendapi_frontend_proto_msgTypes[35]
+func (x *DeletePantryItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_frontendapi_frontend_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2623,9 +7178,9 @@ func (x *RemoveBookmarkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveBookmarkResponse.ProtoReflect.Descriptor instead.
-func (*RemoveBookmarkResponse) Descriptor() ([]byte, []int) {
-	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use DeletePantryItemResponse.ProtoReflect.Descriptor instead.
+func (*DeletePantryItemResponse) Descriptor() ([]byte, []int) {
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{111}
 }
 
 type ChatMessage struct {
@@ -2644,7 +7199,7 @@ type ChatMessage struct {
 
 func (x *ChatMessage) Reset() {
 	*x = ChatMessage{}
-	mi := &file_frontendapi_frontend_proto_msgTypes[36]
+	mi := &file_frontendapi_frontend_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2656,7 +7211,7 @@ func (x *ChatMessage) String() string {
 func (*ChatMessage) ProtoMessage() {}
 
 func (x *ChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_frontendapi_frontend_proto_msgTypes[36]
+	mi := &file_frontendapi_frontend_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2669,7 +7224,7 @@ func (x *ChatMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
 func (*ChatMessage) Descriptor() ([]byte, []int) {
-	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{36}
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *ChatMessage) GetContent() string {
@@ -2711,14 +7266,17 @@ type ChatPlanRequest struct {
 	// The message from the user.
 	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	// Firebase Storage URLs of images attached to the message.
-	ImageUrls     []string `protobuf:"bytes,4,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	ImageUrls []string `protobuf:"bytes,4,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	// The weekly food budget in yen to plan within. If unset, any budget given earlier in the
+	// chat is used.
+	WeeklyBudget  uint32 `protobuf:"varint,5,opt,name=weekly_budget,json=weeklyBudget,proto3" json:"weekly_budget,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ChatPlanRequest) Reset() {
 	*x = ChatPlanRequest{}
-	mi := &file_frontendapi_frontend_proto_msgTypes[37]
+	mi := &file_frontendapi_frontend_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2730,7 +7288,7 @@ func (x *ChatPlanRequest) String() string {
 func (*ChatPlanRequest) ProtoMessage() {}
 
 func (x *ChatPlanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_frontendapi_frontend_proto_msgTypes[37]
+	mi := &file_frontendapi_frontend_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2743,7 +7301,7 @@ func (x *ChatPlanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChatPlanRequest.ProtoReflect.Descriptor instead.
 func (*ChatPlanRequest) Descriptor() ([]byte, []int) {
-	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{37}
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *ChatPlanRequest) GetChatId() string {
@@ -2774,6 +7332,13 @@ func (x *ChatPlanRequest) GetImageUrls() []string {
 	return nil
 }
 
+func (x *ChatPlanRequest) GetWeeklyBudget() uint32 {
+	if x != nil {
+		return x.WeeklyBudget
+	}
+	return 0
+}
+
 // A response for FrontendService.ChatPlan.
 type ChatPlanResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2789,7 +7354,7 @@ type ChatPlanResponse struct {
 
 func (x *ChatPlanResponse) Reset() {
 	*x = ChatPlanResponse{}
-	mi := &file_frontendapi_frontend_proto_msgTypes[38]
+	mi := &file_frontendapi_frontend_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2801,7 +7366,7 @@ func (x *ChatPlanResponse) String() string {
 func (*ChatPlanResponse) ProtoMessage() {}
 
 func (x *ChatPlanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_frontendapi_frontend_proto_msgTypes[38]
+	mi := &file_frontendapi_frontend_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2814,7 +7379,7 @@ func (x *ChatPlanResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChatPlanResponse.ProtoReflect.Descriptor instead.
 func (*ChatPlanResponse) Descriptor() ([]byte, []int) {
-	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{38}
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *ChatPlanResponse) GetChatId() string {
@@ -2847,7 +7412,7 @@ type GetChatMessagesRequest struct {
 
 func (x *GetChatMessagesRequest) Reset() {
 	*x = GetChatMessagesRequest{}
-	mi := &file_frontendapi_frontend_proto_msgTypes[39]
+	mi := &file_frontendapi_frontend_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2859,7 +7424,7 @@ func (x *GetChatMessagesRequest) String() string {
 func (*GetChatMessagesRequest) ProtoMessage() {}
 
 func (x *GetChatMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_frontendapi_frontend_proto_msgTypes[39]
+	mi := &file_frontendapi_frontend_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2872,7 +7437,7 @@ func (x *GetChatMessagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChatMessagesRequest.ProtoReflect.Descriptor instead.
 func (*GetChatMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{39}
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{115}
 }
 
 // A response for FrontendService.GetChatMessages.
@@ -2890,7 +7455,7 @@ type GetChatMessagesResponse struct {
 
 func (x *GetChatMessagesResponse) Reset() {
 	*x = GetChatMessagesResponse{}
-	mi := &file_frontendapi_frontend_proto_msgTypes[40]
+	mi := &file_frontendapi_frontend_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2902,7 +7467,7 @@ func (x *GetChatMessagesResponse) String() string {
 func (*GetChatMessagesResponse) ProtoMessage() {}
 
 func (x *GetChatMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_frontendapi_frontend_proto_msgTypes[40]
+	mi := &file_frontendapi_frontend_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2915,7 +7480,7 @@ func (x *GetChatMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChatMessagesResponse.ProtoReflect.Descriptor instead.
 func (*GetChatMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{40}
+	return file_frontendapi_frontend_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *GetChatMessagesResponse) GetChatId() string {
@@ -2951,7 +7516,7 @@ type AddRecipeRequest_AddRecipeStep struct {
 
 func (x *AddRecipeRequest_AddRecipeStep) Reset() {
 	*x = AddRecipeRequest_AddRecipeStep{}
-	mi := &file_frontendapi_frontend_proto_msgTypes[41]
+	mi := &file_frontendapi_frontend_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2963,7 +7528,7 @@ func (x *AddRecipeRequest_AddRecipeStep) String() string {
 func (*AddRecipeRequest_AddRecipeStep) ProtoMessage() {}
 
 func (x *AddRecipeRequest_AddRecipeStep) ProtoReflect() protoreflect.Message {
-	mi := &file_frontendapi_frontend_proto_msgTypes[41]
+	mi := &file_frontendapi_frontend_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2997,7 +7562,7 @@ var File_frontendapi_frontend_proto protoreflect.FileDescriptor
 
 const file_frontendapi_frontend_proto_rawDesc = "" +
 	"\n" +
-	"\x1afrontendapi/frontend.proto\x12\vfrontendapi\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"L\n" +
+	"\x1afrontendapi/frontend.proto\x12\vfrontendapi\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"L\n" +
 	"\vChatContent\x12\x1a\n" +
 	"\amessage\x18\x01 \x01(\tH\x00R\amessage\x12\x16\n" +
 	"\x05audio\x18\x02 \x01(\fH\x00R\x05audioB\t\n" +
@@ -3019,7 +7584,7 @@ const file_frontendapi_frontend_proto_rawDesc = "" +
 	"\timage_url\x18\x02 \x01(\tR\bimageUrl\"j\n" +
 	"\x11IngredientSection\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12?\n" +
-	"\vingredients\x18\x02 \x03(\v2\x1d.frontendapi.RecipeIngredientR\vingredients\"\x86\x04\n" +
+	"\vingredients\x18\x02 \x03(\v2\x1d.frontendapi.RecipeIngredientR\vingredients\"\xad\x04\n" +
 	"\x06Recipe\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
 	"\x06source\x18\x02 \x01(\x0e2\x19.frontendapi.RecipeSourceR\x06source\x121\n" +
@@ -3033,7 +7598,8 @@ const file_frontendapi_frontend_proto_rawDesc = "" +
 	"\x05notes\x18\n" +
 	" \x01(\tR\x05notes\x12!\n" +
 	"\fserving_size\x18\v \x01(\tR\vservingSize\x121\n" +
-	"\blanguage\x18\f \x01(\x0e2\x15.frontendapi.LanguageR\blanguage\"/\n" +
+	"\blanguage\x18\f \x01(\x0e2\x15.frontendapi.LanguageR\blanguage\x12%\n" +
+	"\x0efailure_reason\x18\r \x01(\tR\rfailureReason\"/\n" +
 	"\x10GetRecipeRequest\x12\x1b\n" +
 	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\"\x84\x01\n" +
 	"\x11GetRecipeResponse\x12+\n" +
@@ -3044,12 +7610,13 @@ const file_frontendapi_frontend_proto_rawDesc = "" +
 	"\n" +
 	"Pagination\x12\x17\n" +
 	"\alast_id\x18\x01 \x01(\tR\x06lastId\x120\n" +
-	"\x14last_timestamp_nanos\x18\x02 \x01(\x03R\x12lastTimestampNanos\"l\n" +
+	"\x14last_timestamp_nanos\x18\x02 \x01(\x03R\x12lastTimestampNanos\"\x9f\x01\n" +
 	"\rRecipeSnippet\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
 	"\asummary\x18\x03 \x01(\tR\asummary\x12\x1b\n" +
-	"\timage_url\x18\x04 \x01(\tR\bimageUrl\"\x81\x01\n" +
+	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x121\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x19.frontendapi.RecipeStatusR\x06status\"\x81\x01\n" +
 	"\x12ListRecipesRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1c\n" +
 	"\tbookmarks\x18\x03 \x01(\bR\tbookmarks\x127\n" +
@@ -3099,28 +7666,35 @@ const file_frontendapi_frontend_proto_rawDesc = "" +
 	"\x15GenerateRecipeRequest\x12\x16\n" +
 	"\x06prompt\x18\x01 \x01(\tR\x06prompt\"e\n" +
 	"\x16GenerateRecipeResponse\x12K\n" +
-	"\x12add_recipe_request\x18\x01 \x01(\v2\x1d.frontendapi.AddRecipeRequestR\x10addRecipeRequest\"\xa3\x01\n" +
+	"\x12add_recipe_request\x18\x01 \x01(\v2\x1d.frontendapi.AddRecipeRequestR\x10addRecipeRequest\"\xa7\x02\n" +
 	"\x13GeneratePlanRequest\x12\x19\n" +
 	"\bnum_days\x18\x01 \x01(\rR\anumDays\x12 \n" +
 	"\vingredients\x18\x02 \x03(\tR\vingredients\x120\n" +
 	"\x06genres\x18\x03 \x03(\x0e2\x18.frontendapi.RecipeGenreR\x06genres\x12\x1d\n" +
 	"\n" +
-	"recipe_ids\x18\x04 \x03(\tR\trecipeIds\"\x16\n" +
+	"recipe_ids\x18\x04 \x03(\tR\trecipeIds\x12#\n" +
+	"\rbatch_cooking\x18\x05 \x01(\bR\fbatchCooking\x12#\n" +
+	"\rweekly_budget\x18\x06 \x01(\rR\fweeklyBudget\x128\n" +
+	"\tgenerator\x18\a \x01(\x0e2\x1a.frontendapi.PlanGeneratorR\tgenerator\"\x16\n" +
 	"\x14GeneratePlanResponse\"d\n" +
 	"\tStepGroup\x12\x14\n" +
 	"\x05label\x18\x01 \x01(\tR\x05label\x12-\n" +
 	"\x05steps\x18\x02 \x03(\v2\x17.frontendapi.RecipeStepR\x05steps\x12\x12\n" +
-	"\x04note\x18\x03 \x01(\tR\x04note\"\x8b\x01\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\x86\x02\n" +
 	"\vPlanSnippet\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x126\n" +
 	"\x04date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x04date\x124\n" +
-	"\arecipes\x18\x03 \x03(\v2\x1a.frontendapi.RecipeSnippetR\arecipes\"w\n" +
+	"\arecipes\x18\x03 \x03(\v2\x1a.frontendapi.RecipeSnippetR\arecipes\x12)\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x15.frontendapi.PlanTypeR\x04type\x12/\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x17.frontendapi.PlanStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"local_date\x18\x06 \x01(\tR\tlocalDate\"w\n" +
 	"\x0fGetPlansRequest\x12A\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tstartDate\x12!\n" +
 	"\bnum_days\x18\x02 \x01(\rB\x06\xbaH\x03\xc8\x01\x01R\anumDays\"B\n" +
 	"\x10GetPlansResponse\x12.\n" +
-	"\x05plans\x18\x01 \x03(\v2\x18.frontendapi.PlanSnippetR\x05plans\"\xb3\x02\n" +
+	"\x05plans\x18\x01 \x03(\v2\x18.frontendapi.PlanSnippetR\x05plans\"\xfa\x05\n" +
 	"\x04Plan\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x17.frontendapi.PlanStatusR\x06status\x124\n" +
@@ -3129,28 +7703,281 @@ const file_frontendapi_frontend_proto_rawDesc = "" +
 	"stepGroups\x12\x14\n" +
 	"\x05notes\x18\x05 \x03(\tR\x05notes\x12@\n" +
 	"\vingredients\x18\x06 \x03(\v2\x1e.frontendapi.IngredientSectionR\vingredients\x12#\n" +
-	"\rserving_sizes\x18\a \x03(\tR\fservingSizes\")\n" +
-	"\x0eGetPlanRequest\x12\x17\n" +
-	"\aplan_id\x18\x01 \x01(\tR\x06planId\"_\n" +
+	"\rserving_sizes\x18\a \x03(\tR\fservingSizes\x12)\n" +
+	"\x04type\x18\b \x01(\x0e2\x15.frontendapi.PlanTypeR\x04type\x129\n" +
+	"\fbatch_dishes\x18\t \x03(\v2\x16.frontendapi.BatchDishR\vbatchDishes\x12\"\n" +
+	"\rbatch_plan_id\x18\n" +
+	" \x01(\tR\vbatchPlanId\x12%\n" +
+	"\x0eestimated_cost\x18\v \x01(\rR\restimatedCost\x12!\n" +
+	"\frecipe_costs\x18\f \x03(\rR\vrecipeCosts\x12%\n" +
+	"\x0efailure_reason\x18\r \x01(\tR\rfailureReason\x12\x1a\n" +
+	"\battempts\x18\x0e \x01(\rR\battempts\x12\x1d\n" +
+	"\n" +
+	"local_date\x18\x0f \x01(\tR\tlocalDate\x12\x1b\n" +
+	"\ttime_zone\x18\x10 \x01(\tR\btimeZone\x125\n" +
+	"\bprogress\x18\x11 \x03(\v2\x19.frontendapi.PlanProgressR\bprogress\x12;\n" +
+	"\vupdate_time\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\x85\x02\n" +
+	"\fPlanProgress\x124\n" +
+	"\x05stage\x18\x01 \x01(\x0e2\x1e.frontendapi.PlanProgressStageR\x05stage\x12\x1b\n" +
+	"\trecipe_id\x18\x02 \x01(\tR\brecipeId\x12\x12\n" +
+	"\x04done\x18\x03 \x01(\rR\x04done\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\rR\x05total\x129\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\xaa\x01\n" +
+	"\tBatchDish\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x1a\n" +
+	"\bservings\x18\x02 \x01(\rR\bservings\x12\x18\n" +
+	"\astorage\x18\x03 \x01(\tR\astorage\x12!\n" +
+	"\fstorage_days\x18\x04 \x01(\rR\vstorageDays\x12'\n" +
+	"\x0freheating_notes\x18\x05 \x01(\tR\x0ereheatingNotes\"2\n" +
+	"\x0eGetPlanRequest\x12 \n" +
+	"\aplan_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06planId\"_\n" +
 	"\x0fGetPlanResponse\x12-\n" +
 	"\x04plan\x18\x01 \x01(\v2\x11.frontendapi.PlanB\x06\xbaH\x03\xc8\x01\x01R\x04plan\x12\x1d\n" +
 	"\n" +
-	"llm_prompt\x18\x02 \x01(\tR\tllmPrompt\"K\n" +
-	"\x11UpdatePlanRequest\x12\x17\n" +
-	"\aplan_id\x18\x01 \x01(\tR\x06planId\x12\x1d\n" +
+	"llm_prompt\x18\x02 \x01(\tR\tllmPrompt\"4\n" +
+	"\x10WatchPlanRequest\x12 \n" +
+	"\aplan_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06planId\":\n" +
+	"\x11WatchPlanResponse\x12%\n" +
+	"\x04plan\x18\x01 \x01(\v2\x11.frontendapi.PlanR\x04plan\"`\n" +
+	"\x18ShareablePlanLinkRequest\x12 \n" +
+	"\aplan_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06planId\x12\"\n" +
+	"\bttl_days\x18\x02 \x01(\rB\a\xbaH\x04*\x02\x18\x1eR\attlDays\"l\n" +
+	"\x19ShareablePlanLinkResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"?\n" +
+	"\x1eRevokeShareablePlanLinkRequest\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05token\"!\n" +
+	"\x1fRevokeShareablePlanLinkResponse\"5\n" +
+	"\x14GetSharedPlanRequest\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05token\">\n" +
+	"\x15GetSharedPlanResponse\x12%\n" +
+	"\x04plan\x18\x01 \x01(\v2\x11.frontendapi.PlanR\x04plan\"\x96\x01\n" +
+	"\x16GetPlanTimelineRequest\x12 \n" +
+	"\aplan_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06planId\x12=\n" +
+	"\bserve_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\aserveAt\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\"\xd0\x01\n" +
+	"\fTimelineStep\x12+\n" +
+	"\x04step\x18\x01 \x01(\v2\x17.frontendapi.RecipeStepR\x04step\x129\n" +
 	"\n" +
-	"recipe_ids\x18\x02 \x03(\tR\trecipeIds\"C\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12!\n" +
+	"\fprevious_day\x18\x04 \x01(\bR\vpreviousDay\"\x83\x02\n" +
+	"\x11TimelineStepGroup\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12/\n" +
+	"\x05steps\x18\x05 \x03(\v2\x19.frontendapi.TimelineStepR\x05steps\x12!\n" +
+	"\fprevious_day\x18\x06 \x01(\bR\vpreviousDay\"\xcc\x01\n" +
+	"\x17GetPlanTimelineResponse\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bserve_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aserveAt\x12?\n" +
+	"\vstep_groups\x18\x03 \x03(\v2\x1e.frontendapi.TimelineStepGroupR\n" +
+	"stepGroups\"\xb5\x03\n" +
+	"\x11UpdatePlanRequest\x12 \n" +
+	"\aplan_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06planId\x12\x1d\n" +
+	"\n" +
+	"recipe_ids\x18\x02 \x03(\tR\trecipeIds\x12\x14\n" +
+	"\x05notes\x18\x03 \x03(\tR\x05notes\x127\n" +
+	"\vstep_groups\x18\x04 \x03(\v2\x16.frontendapi.StepGroupR\n" +
+	"stepGroups\x12U\n" +
+	"\rserving_sizes\x18\x05 \x03(\v20.frontendapi.UpdatePlanRequest.ServingSizesEntryR\fservingSizes\x12;\n" +
+	"\vupdate_mask\x18\x06 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12;\n" +
+	"\vupdate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x1a?\n" +
+	"\x11ServingSizesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"C\n" +
 	"\x12UpdatePlanResponse\x12-\n" +
-	"\x04plan\x18\x01 \x01(\v2\x11.frontendapi.PlanB\x06\xbaH\x03\xc8\x01\x01R\x04plan\",\n" +
-	"\x11DeletePlanRequest\x12\x17\n" +
-	"\aplan_id\x18\x01 \x01(\tR\x06planId\"\x14\n" +
-	"\x12DeletePlanResponse\"1\n" +
+	"\x04plan\x18\x01 \x01(\v2\x11.frontendapi.PlanB\x06\xbaH\x03\xc8\x01\x01R\x04plan\"5\n" +
+	"\x11DeletePlanRequest\x12 \n" +
+	"\aplan_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06planId\"\x14\n" +
+	"\x12DeletePlanResponse\"6\n" +
+	"\x12RestorePlanRequest\x12 \n" +
+	"\aplan_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06planId\"\x15\n" +
+	"\x13RestorePlanResponse\"\x19\n" +
+	"\x17ListDeletedPlansRequest\"\xad\x01\n" +
+	"\vDeletedPlan\x12,\n" +
+	"\x04plan\x18\x01 \x01(\v2\x18.frontendapi.PlanSnippetR\x04plan\x129\n" +
+	"\n" +
+	"deleted_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x125\n" +
+	"\bpurge_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\apurgeAt\"J\n" +
+	"\x18ListDeletedPlansResponse\x12.\n" +
+	"\x05plans\x18\x01 \x03(\v2\x18.frontendapi.DeletedPlanR\x05plans\"4\n" +
+	"\x10RetryPlanRequest\x12 \n" +
+	"\aplan_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06planId\"\x13\n" +
+	"\x11RetryPlanResponse\"[\n" +
+	"\x1aSuggestAlternativesRequest\x12 \n" +
+	"\aplan_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06planId\x12\x1b\n" +
+	"\trecipe_id\x18\x02 \x01(\tR\brecipeId\"]\n" +
+	"\x1bSuggestAlternativesResponse\x12>\n" +
+	"\falternatives\x18\x01 \x03(\v2\x1a.frontendapi.RecipeSnippetR\falternatives\"\x96\x01\n" +
+	"\x18ReplacePlanRecipeRequest\x12 \n" +
+	"\aplan_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06planId\x12\x1b\n" +
+	"\trecipe_id\x18\x02 \x01(\tR\brecipeId\x12;\n" +
+	"\x15replacement_recipe_id\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x13replacementRecipeId\"\x1b\n" +
+	"\x19ReplacePlanRecipeResponse\"\x81\x01\n" +
+	"\x10PlanTemplateSlot\x12B\n" +
+	"\vday_of_week\x18\x01 \x01(\x0e2\x16.frontendapi.DayOfWeekB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\tdayOfWeek\x12)\n" +
+	"\n" +
+	"recipe_ids\x18\x02 \x03(\tB\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x10\x03R\trecipeIds\"\x98\x01\n" +
+	"\x17SavePlanTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12?\n" +
+	"\x05slots\x18\x03 \x03(\v2\x1d.frontendapi.PlanTemplateSlotB\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x10\aR\x05slots\";\n" +
+	"\x18SavePlanTemplateResponse\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\"\xa4\x01\n" +
+	"\x18ApplyPlanTemplateRequest\x12(\n" +
+	"\vtemplate_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"templateId\x12A\n" +
+	"\n" +
+	"week_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tweekStart\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\"q\n" +
+	"\x19ApplyPlanTemplateResponse\x12\x19\n" +
+	"\bplan_ids\x18\x01 \x03(\tR\aplanIds\x129\n" +
+	"\fskipped_days\x18\x02 \x03(\x0e2\x16.frontendapi.DayOfWeekR\vskippedDays\"c\n" +
+	"\x0fIngredientPrice\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\x12\x10\n" +
+	"\x03yen\x18\x03 \x01(\x01R\x03yen\x12\x16\n" +
+	"\x06custom\x18\x04 \x01(\bR\x06custom\"\x1d\n" +
+	"\x1bListIngredientPricesRequest\"T\n" +
+	"\x1cListIngredientPricesResponse\x124\n" +
+	"\x06prices\x18\x01 \x03(\v2\x1c.frontendapi.IngredientPriceR\x06prices\"w\n" +
+	"\x19SetIngredientPriceRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12\x1b\n" +
+	"\x04unit\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04unit\x12 \n" +
+	"\x03yen\x18\x03 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x03yen\"\x1c\n" +
+	"\x1aSetIngredientPriceResponse\"X\n" +
+	"\x1cDeleteIngredientPriceRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12\x1b\n" +
+	"\x04unit\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04unit\"\x1f\n" +
+	"\x1dDeleteIngredientPriceResponse\"\xea\x01\n" +
+	"\x11MarkCookedRequest\x12\x1d\n" +
+	"\trecipe_id\x18\x01 \x01(\tH\x00R\brecipeId\x12\x19\n" +
+	"\aplan_id\x18\x02 \x01(\tH\x00R\x06planId\x127\n" +
+	"\tcooked_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bcookedAt\x12\x1f\n" +
+	"\x06rating\x18\x04 \x01(\rB\a\xbaH\x04*\x02\x18\x05R\x06rating\x12\x1a\n" +
+	"\bservings\x18\x05 \x01(\rR\bservings\x12\x14\n" +
+	"\x05notes\x18\x06 \x01(\tR\x05notesB\x0f\n" +
+	"\x06target\x12\x05\xbaH\x02\b\x01\"/\n" +
+	"\x12MarkCookedResponse\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\"\xf7\x01\n" +
+	"\x13CookingHistoryEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\arecipes\x18\x02 \x03(\v2\x1a.frontendapi.RecipeSnippetR\arecipes\x12\x17\n" +
+	"\aplan_id\x18\x03 \x01(\tR\x06planId\x127\n" +
+	"\tcooked_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bcookedAt\x12\x16\n" +
+	"\x06rating\x18\x05 \x01(\rR\x06rating\x12\x1a\n" +
+	"\bservings\x18\x06 \x01(\rR\bservings\x12\x14\n" +
+	"\x05notes\x18\a \x01(\tR\x05notes\"T\n" +
+	"\x19ListCookingHistoryRequest\x127\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x17.frontendapi.PaginationR\n" +
+	"pagination\"\x91\x01\n" +
+	"\x1aListCookingHistoryResponse\x12:\n" +
+	"\aentries\x18\x01 \x03(\v2 .frontendapi.CookingHistoryEntryR\aentries\x127\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x17.frontendapi.PaginationR\n" +
+	"pagination\"\x93\x01\n" +
+	"\x0fHouseholdMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12.\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x1a.frontendapi.HouseholdRoleR\x04role\x127\n" +
+	"\tjoined_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"g\n" +
+	"\tHousehold\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x126\n" +
+	"\amembers\x18\x03 \x03(\v2\x1c.frontendapi.HouseholdMemberR\amembers\"5\n" +
+	"\x16CreateHouseholdRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\"<\n" +
+	"\x17CreateHouseholdResponse\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\"\x15\n" +
+	"\x13GetHouseholdRequest\"|\n" +
+	"\x14GetHouseholdResponse\x124\n" +
+	"\thousehold\x18\x01 \x01(\v2\x16.frontendapi.HouseholdR\thousehold\x12.\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x1a.frontendapi.HouseholdRoleR\x04role\"^\n" +
+	" CreateHouseholdInvitationRequest\x12:\n" +
+	"\x04role\x18\x01 \x01(\x0e2\x1a.frontendapi.HouseholdRoleB\n" +
+	"\xbaH\a\x82\x01\x04\x18\x02\x18\x03R\x04role\"\x83\x01\n" +
+	"!CreateHouseholdInvitationResponse\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"P\n" +
+	" AcceptHouseholdInvitationRequest\x12,\n" +
+	"\rinvitation_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\finvitationId\"F\n" +
+	"!AcceptHouseholdInvitationResponse\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\"@\n" +
+	"\x1cRemoveHouseholdMemberRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\"\x1f\n" +
+	"\x1dRemoveHouseholdMemberResponse\"+\n" +
+	"\fUserSettings\x12\x1b\n" +
+	"\ttime_zone\x18\x01 \x01(\tR\btimeZone\"\x18\n" +
+	"\x16GetUserSettingsRequest\"P\n" +
+	"\x17GetUserSettingsResponse\x125\n" +
+	"\bsettings\x18\x01 \x01(\v2\x19.frontendapi.UserSettingsR\bsettings\"Z\n" +
+	"\x19UpdateUserSettingsRequest\x12=\n" +
+	"\bsettings\x18\x01 \x01(\v2\x19.frontendapi.UserSettingsB\x06\xbaH\x03\xc8\x01\x01R\bsettings\"\x1c\n" +
+	"\x1aUpdateUserSettingsResponse\"1\n" +
 	"\x12AddBookmarkRequest\x12\x1b\n" +
 	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\"\x15\n" +
 	"\x13AddBookmarkResponse\"4\n" +
 	"\x15RemoveBookmarkRequest\x12\x1b\n" +
 	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\"\x18\n" +
-	"\x16RemoveBookmarkResponse\"\xce\x01\n" +
+	"\x16RemoveBookmarkResponse\"d\n" +
+	"\x16ShoppingListIngredient\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\tR\bquantity\x12\x1a\n" +
+	"\bselected\x18\x03 \x01(\bR\bselected\"\xc0\x01\n" +
+	"\x11ShoppingListEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\trecipe_id\x18\x02 \x01(\tR\brecipeId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12!\n" +
+	"\fserving_size\x18\x04 \x01(\tR\vservingSize\x12E\n" +
+	"\vingredients\x18\x05 \x03(\v2#.frontendapi.ShoppingListIngredientR\vingredients\"\x18\n" +
+	"\x16GetShoppingListRequest\"S\n" +
+	"\x17GetShoppingListResponse\x128\n" +
+	"\aentries\x18\x01 \x03(\v2\x1e.frontendapi.ShoppingListEntryR\aentries\"\xc3\x01\n" +
+	"\x1bAddShoppingListEntryRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x1d\n" +
+	"\x05title\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x05title\x12!\n" +
+	"\fserving_size\x18\x03 \x01(\tR\vservingSize\x12E\n" +
+	"\vingredients\x18\x04 \x03(\v2#.frontendapi.ShoppingListIngredientR\vingredients\"9\n" +
+	"\x1cAddShoppingListEntryResponse\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\"\x90\x01\n" +
+	"#UpdateShoppingListIngredientRequest\x12\"\n" +
+	"\bentry_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aentryId\x12)\n" +
+	"\x10ingredient_index\x18\x02 \x01(\rR\x0fingredientIndex\x12\x1a\n" +
+	"\bselected\x18\x03 \x01(\bR\bselected\"&\n" +
+	"$UpdateShoppingListIngredientResponse\"D\n" +
+	"\x1eRemoveShoppingListEntryRequest\x12\"\n" +
+	"\bentry_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aentryId\"!\n" +
+	"\x1fRemoveShoppingListEntryResponse\"<\n" +
+	"\n" +
+	"PantryItem\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\tR\bquantity\"\x18\n" +
+	"\x16ListPantryItemsRequest\"H\n" +
+	"\x17ListPantryItemsResponse\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.frontendapi.PantryItemR\x05items\"O\n" +
+	"\x14SetPantryItemRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\tR\bquantity\"\x17\n" +
+	"\x15SetPantryItemResponse\"6\n" +
+	"\x17DeletePantryItemRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\"\x1a\n" +
+	"\x18DeletePantryItemResponse\"\xce\x01\n" +
 	"\vChatMessage\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\tR\acontent\x121\n" +
 	"\x04role\x18\x02 \x01(\x0e2\x1d.frontendapi.ChatMessage.RoleR\x04role\x12\x12\n" +
@@ -3160,13 +7987,14 @@ const file_frontendapi_frontend_proto_rawDesc = "" +
 	"\x04Role\x12\x14\n" +
 	"\x10ROLE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tROLE_USER\x10\x01\x12\x12\n" +
-	"\x0eROLE_ASSISTANT\x10\x02\"~\n" +
+	"\x0eROLE_ASSISTANT\x10\x02\"\xa3\x01\n" +
 	"\x0fChatPlanRequest\x12\x17\n" +
 	"\achat_id\x18\x01 \x01(\tR\x06chatId\x12\x19\n" +
 	"\bnew_chat\x18\x02 \x01(\bR\anewChat\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1d\n" +
 	"\n" +
-	"image_urls\x18\x04 \x03(\tR\timageUrls\"z\n" +
+	"image_urls\x18\x04 \x03(\tR\timageUrls\x12#\n" +
+	"\rweekly_budget\x18\x05 \x01(\rR\fweeklyBudget\"z\n" +
 	"\x10ChatPlanResponse\x12\x17\n" +
 	"\achat_id\x18\x01 \x01(\tR\x06chatId\x124\n" +
 	"\bmessages\x18\x02 \x03(\v2\x18.frontendapi.ChatMessageR\bmessages\x12\x17\n" +
@@ -3192,18 +8020,50 @@ const file_frontendapi_frontend_proto_rawDesc = "" +
 	"\x19RECIPE_SOURCE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15RECIPE_SOURCE_COOKPAD\x10\x01\x12\x1d\n" +
 	"\x19RECIPE_SOURCE_ORANGE_PAGE\x10\x02\x12 \n" +
-	"\x1cRECIPE_SOURCE_DELISH_KITCHEN\x10\x03*e\n" +
+	"\x1cRECIPE_SOURCE_DELISH_KITCHEN\x10\x03*\x7f\n" +
 	"\fRecipeStatus\x12\x1d\n" +
 	"\x19RECIPE_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18RECIPE_STATUS_PROCESSING\x10\x01\x12\x18\n" +
-	"\x14RECIPE_STATUS_ACTIVE\x10\x02*]\n" +
+	"\x14RECIPE_STATUS_ACTIVE\x10\x02\x12\x18\n" +
+	"\x14RECIPE_STATUS_FAILED\x10\x03*f\n" +
+	"\rPlanGenerator\x12\x1e\n" +
+	"\x1aPLAN_GENERATOR_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12PLAN_GENERATOR_LLM\x10\x01\x12\x1d\n" +
+	"\x19PLAN_GENERATOR_CONSTRAINT\x10\x02*m\n" +
+	"\bPlanType\x12\x19\n" +
+	"\x15PLAN_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fPLAN_TYPE_DAILY\x10\x01\x12\x18\n" +
+	"\x14PLAN_TYPE_BATCH_PREP\x10\x02\x12\x17\n" +
+	"\x13PLAN_TYPE_LEFTOVERS\x10\x03*u\n" +
 	"\n" +
 	"PlanStatus\x12\x1b\n" +
 	"\x17PLAN_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16PLAN_STATUS_PROCESSING\x10\x01\x12\x16\n" +
-	"\x12PLAN_STATUS_ACTIVE\x10\x022N\n" +
+	"\x12PLAN_STATUS_ACTIVE\x10\x02\x12\x16\n" +
+	"\x12PLAN_STATUS_FAILED\x10\x03*\xe8\x01\n" +
+	"\x11PlanProgressStage\x12#\n" +
+	"\x1fPLAN_PROGRESS_STAGE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dPLAN_PROGRESS_STAGE_TRANSLATE\x10\x01\x12\x1f\n" +
+	"\x1bPLAN_PROGRESS_STAGE_REWRITE\x10\x02\x12\x1d\n" +
+	"\x19PLAN_PROGRESS_STAGE_IMAGE\x10\x03\x12#\n" +
+	"\x1fPLAN_PROGRESS_STAGE_STEP_IMAGES\x10\x04\x12&\n" +
+	"\"PLAN_PROGRESS_STAGE_EXECUTION_PLAN\x10\x05*\xd8\x01\n" +
+	"\tDayOfWeek\x12\x1b\n" +
+	"\x17DAY_OF_WEEK_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12DAY_OF_WEEK_MONDAY\x10\x01\x12\x17\n" +
+	"\x13DAY_OF_WEEK_TUESDAY\x10\x02\x12\x19\n" +
+	"\x15DAY_OF_WEEK_WEDNESDAY\x10\x03\x12\x18\n" +
+	"\x14DAY_OF_WEEK_THURSDAY\x10\x04\x12\x16\n" +
+	"\x12DAY_OF_WEEK_FRIDAY\x10\x05\x12\x18\n" +
+	"\x14DAY_OF_WEEK_SATURDAY\x10\x06\x12\x16\n" +
+	"\x12DAY_OF_WEEK_SUNDAY\x10\a*\x7f\n" +
+	"\rHouseholdRole\x12\x1e\n" +
+	"\x1aHOUSEHOLD_ROLE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14HOUSEHOLD_ROLE_OWNER\x10\x01\x12\x19\n" +
+	"\x15HOUSEHOLD_ROLE_EDITOR\x10\x02\x12\x19\n" +
+	"\x15HOUSEHOLD_ROLE_VIEWER\x10\x032N\n" +
 	"\vChatService\x12?\n" +
-	"\x04Chat\x12\x18.frontendapi.ChatRequest\x1a\x19.frontendapi.ChatResponse(\x010\x012\xf8\b\n" +
+	"\x04Chat\x12\x18.frontendapi.ChatRequest\x1a\x19.frontendapi.ChatResponse(\x010\x012\xa0!\n" +
 	"\x0fFrontendService\x12J\n" +
 	"\tGetRecipe\x12\x1d.frontendapi.GetRecipeRequest\x1a\x1e.frontendapi.GetRecipeResponse\x12P\n" +
 	"\vListRecipes\x12\x1f.frontendapi.ListRecipesRequest\x1a .frontendapi.ListRecipesResponse\x12J\n" +
@@ -3214,13 +8074,45 @@ const file_frontendapi_frontend_proto_rawDesc = "" +
 	"\bChatPlan\x12\x1c.frontendapi.ChatPlanRequest\x1a\x1d.frontendapi.ChatPlanResponse\x12\\\n" +
 	"\x0fGetChatMessages\x12#.frontendapi.GetChatMessagesRequest\x1a$.frontendapi.GetChatMessagesResponse\x12G\n" +
 	"\bGetPlans\x12\x1c.frontendapi.GetPlansRequest\x1a\x1d.frontendapi.GetPlansResponse\x12D\n" +
-	"\aGetPlan\x12\x1b.frontendapi.GetPlanRequest\x1a\x1c.frontendapi.GetPlanResponse\x12M\n" +
+	"\aGetPlan\x12\x1b.frontendapi.GetPlanRequest\x1a\x1c.frontendapi.GetPlanResponse\x12b\n" +
+	"\x11ShareablePlanLink\x12%.frontendapi.ShareablePlanLinkRequest\x1a&.frontendapi.ShareablePlanLinkResponse\x12t\n" +
+	"\x17RevokeShareablePlanLink\x12+.frontendapi.RevokeShareablePlanLinkRequest\x1a,.frontendapi.RevokeShareablePlanLinkResponse\x12V\n" +
+	"\rGetSharedPlan\x12!.frontendapi.GetSharedPlanRequest\x1a\".frontendapi.GetSharedPlanResponse\x12L\n" +
+	"\tWatchPlan\x12\x1d.frontendapi.WatchPlanRequest\x1a\x1e.frontendapi.WatchPlanResponse0\x01\x12\\\n" +
+	"\x0fGetPlanTimeline\x12#.frontendapi.GetPlanTimelineRequest\x1a$.frontendapi.GetPlanTimelineResponse\x12M\n" +
 	"\n" +
-	"UpdatePlan\x12\x1e.frontendapi.UpdatePlanRequest\x1a\x1f.frontendapi.UpdatePlanResponse\x12M\n" +
+	"UpdatePlan\x12\x1e.frontendapi.UpdatePlanRequest\x1a\x1f.frontendapi.UpdatePlanResponse\x12h\n" +
+	"\x13SuggestAlternatives\x12'.frontendapi.SuggestAlternativesRequest\x1a(.frontendapi.SuggestAlternativesResponse\x12b\n" +
+	"\x11ReplacePlanRecipe\x12%.frontendapi.ReplacePlanRecipeRequest\x1a&.frontendapi.ReplacePlanRecipeResponse\x12M\n" +
 	"\n" +
 	"DeletePlan\x12\x1e.frontendapi.DeletePlanRequest\x1a\x1f.frontendapi.DeletePlanResponse\x12P\n" +
+	"\vRestorePlan\x12\x1f.frontendapi.RestorePlanRequest\x1a .frontendapi.RestorePlanResponse\x12_\n" +
+	"\x10ListDeletedPlans\x12$.frontendapi.ListDeletedPlansRequest\x1a%.frontendapi.ListDeletedPlansResponse\x12J\n" +
+	"\tRetryPlan\x12\x1d.frontendapi.RetryPlanRequest\x1a\x1e.frontendapi.RetryPlanResponse\x12_\n" +
+	"\x10SavePlanTemplate\x12$.frontendapi.SavePlanTemplateRequest\x1a%.frontendapi.SavePlanTemplateResponse\x12b\n" +
+	"\x11ApplyPlanTemplate\x12%.frontendapi.ApplyPlanTemplateRequest\x1a&.frontendapi.ApplyPlanTemplateResponse\x12k\n" +
+	"\x14ListIngredientPrices\x12(.frontendapi.ListIngredientPricesRequest\x1a).frontendapi.ListIngredientPricesResponse\x12e\n" +
+	"\x12SetIngredientPrice\x12&.frontendapi.SetIngredientPriceRequest\x1a'.frontendapi.SetIngredientPriceResponse\x12n\n" +
+	"\x15DeleteIngredientPrice\x12).frontendapi.DeleteIngredientPriceRequest\x1a*.frontendapi.DeleteIngredientPriceResponse\x12M\n" +
+	"\n" +
+	"MarkCooked\x12\x1e.frontendapi.MarkCookedRequest\x1a\x1f.frontendapi.MarkCookedResponse\x12e\n" +
+	"\x12ListCookingHistory\x12&.frontendapi.ListCookingHistoryRequest\x1a'.frontendapi.ListCookingHistoryResponse\x12\\\n" +
+	"\x0fCreateHousehold\x12#.frontendapi.CreateHouseholdRequest\x1a$.frontendapi.CreateHouseholdResponse\x12S\n" +
+	"\fGetHousehold\x12 .frontendapi.GetHouseholdRequest\x1a!.frontendapi.GetHouseholdResponse\x12z\n" +
+	"\x19CreateHouseholdInvitation\x12-.frontendapi.CreateHouseholdInvitationRequest\x1a..frontendapi.CreateHouseholdInvitationResponse\x12z\n" +
+	"\x19AcceptHouseholdInvitation\x12-.frontendapi.AcceptHouseholdInvitationRequest\x1a..frontendapi.AcceptHouseholdInvitationResponse\x12n\n" +
+	"\x15RemoveHouseholdMember\x12).frontendapi.RemoveHouseholdMemberRequest\x1a*.frontendapi.RemoveHouseholdMemberResponse\x12P\n" +
 	"\vAddBookmark\x12\x1f.frontendapi.AddBookmarkRequest\x1a .frontendapi.AddBookmarkResponse\x12Y\n" +
-	"\x0eRemoveBookmark\x12\".frontendapi.RemoveBookmarkRequest\x1a#.frontendapi.RemoveBookmarkResponseB=Z;github.com/curioswitch/cookchat/frontend/api/go;frontendapib\x06proto3"
+	"\x0eRemoveBookmark\x12\".frontendapi.RemoveBookmarkRequest\x1a#.frontendapi.RemoveBookmarkResponse\x12\\\n" +
+	"\x0fGetShoppingList\x12#.frontendapi.GetShoppingListRequest\x1a$.frontendapi.GetShoppingListResponse\x12k\n" +
+	"\x14AddShoppingListEntry\x12(.frontendapi.AddShoppingListEntryRequest\x1a).frontendapi.AddShoppingListEntryResponse\x12\x83\x01\n" +
+	"\x1cUpdateShoppingListIngredient\x120.frontendapi.UpdateShoppingListIngredientRequest\x1a1.frontendapi.UpdateShoppingListIngredientResponse\x12t\n" +
+	"\x17RemoveShoppingListEntry\x12+.frontendapi.RemoveShoppingListEntryRequest\x1a,.frontendapi.RemoveShoppingListEntryResponse\x12\\\n" +
+	"\x0fListPantryItems\x12#.frontendapi.ListPantryItemsRequest\x1a$.frontendapi.ListPantryItemsResponse\x12V\n" +
+	"\rSetPantryItem\x12!.frontendapi.SetPantryItemRequest\x1a\".frontendapi.SetPantryItemResponse\x12_\n" +
+	"\x10DeletePantryItem\x12$.frontendapi.DeletePantryItemRequest\x1a%.frontendapi.DeletePantryItemResponse\x12\\\n" +
+	"\x0fGetUserSettings\x12#.frontendapi.GetUserSettingsRequest\x1a$.frontendapi.GetUserSettingsResponse\x12e\n" +
+	"\x12UpdateUserSettings\x12&.frontendapi.UpdateUserSettingsRequest\x1a'.frontendapi.UpdateUserSettingsResponseB=Z;github.com/curioswitch/cookchat/frontend/api/go;frontendapib\x06proto3"
 
 var (
 	file_frontendapi_frontend_proto_rawDescOnce sync.Once
@@ -3234,130 +8126,332 @@ func file_frontendapi_frontend_proto_rawDescGZIP() []byte {
 	return file_frontendapi_frontend_proto_rawDescData
 }
 
-var file_frontendapi_frontend_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_frontendapi_frontend_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_frontendapi_frontend_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_frontendapi_frontend_proto_msgTypes = make([]protoimpl.MessageInfo, 119)
 var file_frontendapi_frontend_proto_goTypes = []any{
-	(Language)(0),                          // 0: frontendapi.Language
-	(RecipeGenre)(0),                       // 1: frontendapi.RecipeGenre
-	(RecipeSource)(0),                      // 2: frontendapi.RecipeSource
-	(RecipeStatus)(0),                      // 3: frontendapi.RecipeStatus
-	(PlanStatus)(0),                        // 4: frontendapi.PlanStatus
-	(StartChatRequest_ModelProvider)(0),    // 5: frontendapi.StartChatRequest.ModelProvider
-	(ChatMessage_Role)(0),                  // 6: frontendapi.ChatMessage.Role
-	(*ChatContent)(nil),                    // 7: frontendapi.ChatContent
-	(*ChatRequest)(nil),                    // 8: frontendapi.ChatRequest
-	(*ChatResponse)(nil),                   // 9: frontendapi.ChatResponse
-	(*RecipeIngredient)(nil),               // 10: frontendapi.RecipeIngredient
-	(*RecipeStep)(nil),                     // 11: frontendapi.RecipeStep
-	(*IngredientSection)(nil),              // 12: frontendapi.IngredientSection
-	(*Recipe)(nil),                         // 13: frontendapi.Recipe
-	(*GetRecipeRequest)(nil),               // 14: frontendapi.GetRecipeRequest
-	(*GetRecipeResponse)(nil),              // 15: frontendapi.GetRecipeResponse
-	(*Pagination)(nil),                     // 16: frontendapi.Pagination
-	(*RecipeSnippet)(nil),                  // 17: frontendapi.RecipeSnippet
-	(*ListRecipesRequest)(nil),             // 18: frontendapi.ListRecipesRequest
-	(*ListRecipesResponse)(nil),            // 19: frontendapi.ListRecipesResponse
-	(*StartChatRequest)(nil),               // 20: frontendapi.StartChatRequest
-	(*StartChatResponse)(nil),              // 21: frontendapi.StartChatResponse
-	(*AddRecipeRequest)(nil),               // 22: frontendapi.AddRecipeRequest
-	(*AddRecipeResponse)(nil),              // 23: frontendapi.AddRecipeResponse
-	(*GenerateRecipeRequest)(nil),          // 24: frontendapi.GenerateRecipeRequest
-	(*GenerateRecipeResponse)(nil),         // 25: frontendapi.GenerateRecipeResponse
-	(*GeneratePlanRequest)(nil),            // 26: frontendapi.GeneratePlanRequest
-	(*GeneratePlanResponse)(nil),           // 27: frontendapi.GeneratePlanResponse
-	(*StepGroup)(nil),                      // 28: frontendapi.StepGroup
-	(*PlanSnippet)(nil),                    // 29: frontendapi.PlanSnippet
-	(*GetPlansRequest)(nil),                // 30: frontendapi.GetPlansRequest
-	(*GetPlansResponse)(nil),               // 31: frontendapi.GetPlansResponse
-	(*Plan)(nil),                           // 32: frontendapi.Plan
-	(*GetPlanRequest)(nil),                 // 33: frontendapi.GetPlanRequest
-	(*GetPlanResponse)(nil),                // 34: frontendapi.GetPlanResponse
-	(*UpdatePlanRequest)(nil),              // 35: frontendapi.UpdatePlanRequest
-	(*UpdatePlanResponse)(nil),             // 36: frontendapi.UpdatePlanResponse
-	(*DeletePlanRequest)(nil),              // 37: frontendapi.DeletePlanRequest
-	(*DeletePlanResponse)(nil),             // 38: frontendapi.DeletePlanResponse
-	(*AddBookmarkRequest)(nil),             // 39: frontendapi.AddBookmarkRequest
-	(*AddBookmarkResponse)(nil),            // 40: frontendapi.AddBookmarkResponse
-	(*RemoveBookmarkRequest)(nil),          // 41: frontendapi.RemoveBookmarkRequest
-	(*RemoveBookmarkResponse)(nil),         // 42: frontendapi.RemoveBookmarkResponse
-	(*ChatMessage)(nil),                    // 43: frontendapi.ChatMessage
-	(*ChatPlanRequest)(nil),                // 44: frontendapi.ChatPlanRequest
-	(*ChatPlanResponse)(nil),               // 45: frontendapi.ChatPlanResponse
-	(*GetChatMessagesRequest)(nil),         // 46: frontendapi.GetChatMessagesRequest
-	(*GetChatMessagesResponse)(nil),        // 47: frontendapi.GetChatMessagesResponse
-	(*AddRecipeRequest_AddRecipeStep)(nil), // 48: frontendapi.AddRecipeRequest.AddRecipeStep
-	(*timestamppb.Timestamp)(nil),          // 49: google.protobuf.Timestamp
+	(Language)(0),                                // 0: frontendapi.Language
+	(RecipeGenre)(0),                             // 1: frontendapi.RecipeGenre
+	(RecipeSource)(0),                            // 2: frontendapi.RecipeSource
+	(RecipeStatus)(0),                            // 3: frontendapi.RecipeStatus
+	(PlanGenerator)(0),                           // 4: frontendapi.PlanGenerator
+	(PlanType)(0),                                // 5: frontendapi.PlanType
+	(PlanStatus)(0),                              // 6: frontendapi.PlanStatus
+	(PlanProgressStage)(0),                       // 7: frontendapi.PlanProgressStage
+	(DayOfWeek)(0),                               // 8: frontendapi.DayOfWeek
+	(HouseholdRole)(0),                           // 9: frontendapi.HouseholdRole
+	(StartChatRequest_ModelProvider)(0),          // 10: frontendapi.StartChatRequest.ModelProvider
+	(ChatMessage_Role)(0),                        // 11: frontendapi.ChatMessage.Role
+	(*ChatContent)(nil),                          // 12: frontendapi.ChatContent
+	(*ChatRequest)(nil),                          // 13: frontendapi.ChatRequest
+	(*ChatResponse)(nil),                         // 14: frontendapi.ChatResponse
+	(*RecipeIngredient)(nil),                     // 15: frontendapi.RecipeIngredient
+	(*RecipeStep)(nil),                           // 16: frontendapi.RecipeStep
+	(*IngredientSection)(nil),                    // 17: frontendapi.IngredientSection
+	(*Recipe)(nil),                               // 18: frontendapi.Recipe
+	(*GetRecipeRequest)(nil),                     // 19: frontendapi.GetRecipeRequest
+	(*GetRecipeResponse)(nil),                    // 20: frontendapi.GetRecipeResponse
+	(*Pagination)(nil),                           // 21: frontendapi.Pagination
+	(*RecipeSnippet)(nil),                        // 22: frontendapi.RecipeSnippet
+	(*ListRecipesRequest)(nil),                   // 23: frontendapi.ListRecipesRequest
+	(*ListRecipesResponse)(nil),                  // 24: frontendapi.ListRecipesResponse
+	(*StartChatRequest)(nil),                     // 25: frontendapi.StartChatRequest
+	(*StartChatResponse)(nil),                    // 26: frontendapi.StartChatResponse
+	(*AddRecipeRequest)(nil),                     // 27: frontendapi.AddRecipeRequest
+	(*AddRecipeResponse)(nil),                    // 28: frontendapi.AddRecipeResponse
+	(*GenerateRecipeRequest)(nil),                // 29: frontendapi.GenerateRecipeRequest
+	(*GenerateRecipeResponse)(nil),               // 30: frontendapi.GenerateRecipeResponse
+	(*GeneratePlanRequest)(nil),                  // 31: frontendapi.GeneratePlanRequest
+	(*GeneratePlanResponse)(nil),                 // 32: frontendapi.GeneratePlanResponse
+	(*StepGroup)(nil),                            // 33: frontendapi.StepGroup
+	(*PlanSnippet)(nil),                          // 34: frontendapi.PlanSnippet
+	(*GetPlansRequest)(nil),                      // 35: frontendapi.GetPlansRequest
+	(*GetPlansResponse)(nil),                     // 36: frontendapi.GetPlansResponse
+	(*Plan)(nil),                                 // 37: frontendapi.Plan
+	(*PlanProgress)(nil),                         // 38: frontendapi.PlanProgress
+	(*BatchDish)(nil),                            // 39: frontendapi.BatchDish
+	(*GetPlanRequest)(nil),                       // 40: frontendapi.GetPlanRequest
+	(*GetPlanResponse)(nil),                      // 41: frontendapi.GetPlanResponse
+	(*WatchPlanRequest)(nil),                     // 42: frontendapi.WatchPlanRequest
+	(*WatchPlanResponse)(nil),                    // 43: frontendapi.WatchPlanResponse
+	(*ShareablePlanLinkRequest)(nil),             // 44: frontendapi.ShareablePlanLinkRequest
+	(*ShareablePlanLinkResponse)(nil),            // 45: frontendapi.ShareablePlanLinkResponse
+	(*RevokeShareablePlanLinkRequest)(nil),       // 46: frontendapi.RevokeShareablePlanLinkRequest
+	(*RevokeShareablePlanLinkResponse)(nil),      // 47: frontendapi.RevokeShareablePlanLinkResponse
+	(*GetSharedPlanRequest)(nil),                 // 48: frontendapi.GetSharedPlanRequest
+	(*GetSharedPlanResponse)(nil),                // 49: frontendapi.GetSharedPlanResponse
+	(*GetPlanTimelineRequest)(nil),               // 50: frontendapi.GetPlanTimelineRequest
+	(*TimelineStep)(nil),                         // 51: frontendapi.TimelineStep
+	(*TimelineStepGroup)(nil),                    // 52: frontendapi.TimelineStepGroup
+	(*GetPlanTimelineResponse)(nil),              // 53: frontendapi.GetPlanTimelineResponse
+	(*UpdatePlanRequest)(nil),                    // 54: frontendapi.UpdatePlanRequest
+	(*UpdatePlanResponse)(nil),                   // 55: frontendapi.UpdatePlanResponse
+	(*DeletePlanRequest)(nil),                    // 56: frontendapi.DeletePlanRequest
+	(*DeletePlanResponse)(nil),                   // 57: frontendapi.DeletePlanResponse
+	(*RestorePlanRequest)(nil),                   // 58: frontendapi.RestorePlanRequest
+	(*RestorePlanResponse)(nil),                  // 59: frontendapi.RestorePlanResponse
+	(*ListDeletedPlansRequest)(nil),              // 60: frontendapi.ListDeletedPlansRequest
+	(*DeletedPlan)(nil),                          // 61: frontendapi.DeletedPlan
+	(*ListDeletedPlansResponse)(nil),             // 62: frontendapi.ListDeletedPlansResponse
+	(*RetryPlanRequest)(nil),                     // 63: frontendapi.RetryPlanRequest
+	(*RetryPlanResponse)(nil),                    // 64: frontendapi.RetryPlanResponse
+	(*SuggestAlternativesRequest)(nil),           // 65: frontendapi.SuggestAlternativesRequest
+	(*SuggestAlternativesResponse)(nil),          // 66: frontendapi.SuggestAlternativesResponse
+	(*ReplacePlanRecipeRequest)(nil),             // 67: frontendapi.ReplacePlanRecipeRequest
+	(*ReplacePlanRecipeResponse)(nil),            // 68: frontendapi.ReplacePlanRecipeResponse
+	(*PlanTemplateSlot)(nil),                     // 69: frontendapi.PlanTemplateSlot
+	(*SavePlanTemplateRequest)(nil),              // 70: frontendapi.SavePlanTemplateRequest
+	(*SavePlanTemplateResponse)(nil),             // 71: frontendapi.SavePlanTemplateResponse
+	(*ApplyPlanTemplateRequest)(nil),             // 72: frontendapi.ApplyPlanTemplateRequest
+	(*ApplyPlanTemplateResponse)(nil),            // 73: frontendapi.ApplyPlanTemplateResponse
+	(*IngredientPrice)(nil),                      // 74: frontendapi.IngredientPrice
+	(*ListIngredientPricesRequest)(nil),          // 75: frontendapi.ListIngredientPricesRequest
+	(*ListIngredientPricesResponse)(nil),         // 76: frontendapi.ListIngredientPricesResponse
+	(*SetIngredientPriceRequest)(nil),            // 77: frontendapi.SetIngredientPriceRequest
+	(*SetIngredientPriceResponse)(nil),           // 78: frontendapi.SetIngredientPriceResponse
+	(*DeleteIngredientPriceRequest)(nil),         // 79: frontendapi.DeleteIngredientPriceRequest
+	(*DeleteIngredientPriceResponse)(nil),        // 80: frontendapi.DeleteIngredientPriceResponse
+	(*MarkCookedRequest)(nil),                    // 81: frontendapi.MarkCookedRequest
+	(*MarkCookedResponse)(nil),                   // 82: frontendapi.MarkCookedResponse
+	(*CookingHistoryEntry)(nil),                  // 83: frontendapi.CookingHistoryEntry
+	(*ListCookingHistoryRequest)(nil),            // 84: frontendapi.ListCookingHistoryRequest
+	(*ListCookingHistoryResponse)(nil),           // 85: frontendapi.ListCookingHistoryResponse
+	(*HouseholdMember)(nil),                      // 86: frontendapi.HouseholdMember
+	(*Household)(nil),                            // 87: frontendapi.Household
+	(*CreateHouseholdRequest)(nil),               // 88: frontendapi.CreateHouseholdRequest
+	(*CreateHouseholdResponse)(nil),              // 89: frontendapi.CreateHouseholdResponse
+	(*GetHouseholdRequest)(nil),                  // 90: frontendapi.GetHouseholdRequest
+	(*GetHouseholdResponse)(nil),                 // 91: frontendapi.GetHouseholdResponse
+	(*CreateHouseholdInvitationRequest)(nil),     // 92: frontendapi.CreateHouseholdInvitationRequest
+	(*CreateHouseholdInvitationResponse)(nil),    // 93: frontendapi.CreateHouseholdInvitationResponse
+	(*AcceptHouseholdInvitationRequest)(nil),     // 94: frontendapi.AcceptHouseholdInvitationRequest
+	(*AcceptHouseholdInvitationResponse)(nil),    // 95: frontendapi.AcceptHouseholdInvitationResponse
+	(*RemoveHouseholdMemberRequest)(nil),         // 96: frontendapi.RemoveHouseholdMemberRequest
+	(*RemoveHouseholdMemberResponse)(nil),        // 97: frontendapi.RemoveHouseholdMemberResponse
+	(*UserSettings)(nil),                         // 98: frontendapi.UserSettings
+	(*GetUserSettingsRequest)(nil),               // 99: frontendapi.GetUserSettingsRequest
+	(*GetUserSettingsResponse)(nil),              // 100: frontendapi.GetUserSettingsResponse
+	(*UpdateUserSettingsRequest)(nil),            // 101: frontendapi.UpdateUserSettingsRequest
+	(*UpdateUserSettingsResponse)(nil),           // 102: frontendapi.UpdateUserSettingsResponse
+	(*AddBookmarkRequest)(nil),                   // 103: frontendapi.AddBookmarkRequest
+	(*AddBookmarkResponse)(nil),                  // 104: frontendapi.AddBookmarkResponse
+	(*RemoveBookmarkRequest)(nil),                // 105: frontendapi.RemoveBookmarkRequest
+	(*RemoveBookmarkResponse)(nil),               // 106: frontendapi.RemoveBookmarkResponse
+	(*ShoppingListIngredient)(nil),               // 107: frontendapi.ShoppingListIngredient
+	(*ShoppingListEntry)(nil),                    // 108: frontendapi.ShoppingListEntry
+	(*GetShoppingListRequest)(nil),               // 109: frontendapi.GetShoppingListRequest
+	(*GetShoppingListResponse)(nil),              // 110: frontendapi.GetShoppingListResponse
+	(*AddShoppingListEntryRequest)(nil),          // 111: frontendapi.AddShoppingListEntryRequest
+	(*AddShoppingListEntryResponse)(nil),         // 112: frontendapi.AddShoppingListEntryResponse
+	(*UpdateShoppingListIngredientRequest)(nil),  // 113: frontendapi.UpdateShoppingListIngredientRequest
+	(*UpdateShoppingListIngredientResponse)(nil), // 114: frontendapi.UpdateShoppingListIngredientResponse
+	(*RemoveShoppingListEntryRequest)(nil),       // 115: frontendapi.RemoveShoppingListEntryRequest
+	(*RemoveShoppingListEntryResponse)(nil),      // 116: frontendapi.RemoveShoppingListEntryResponse
+	(*PantryItem)(nil),                           // 117: frontendapi.PantryItem
+	(*ListPantryItemsRequest)(nil),               // 118: frontendapi.ListPantryItemsRequest
+	(*ListPantryItemsResponse)(nil),              // 119: frontendapi.ListPantryItemsResponse
+	(*SetPantryItemRequest)(nil),                 // 120: frontendapi.SetPantryItemRequest
+	(*SetPantryItemResponse)(nil),                // 121: frontendapi.SetPantryItemResponse
+	(*DeletePantryItemRequest)(nil),              // 122: frontendapi.DeletePantryItemRequest
+	(*DeletePantryItemResponse)(nil),             // 123: frontendapi.DeletePantryItemResponse
+	(*ChatMessage)(nil),                          // 124: frontendapi.ChatMessage
+	(*ChatPlanRequest)(nil),                      // 125: frontendapi.ChatPlanRequest
+	(*ChatPlanResponse)(nil),                     // 126: frontendapi.ChatPlanResponse
+	(*GetChatMessagesRequest)(nil),               // 127: frontendapi.GetChatMessagesRequest
+	(*GetChatMessagesResponse)(nil),              // 128: frontendapi.GetChatMessagesResponse
+	(*AddRecipeRequest_AddRecipeStep)(nil),       // 129: frontendapi.AddRecipeRequest.AddRecipeStep
+	nil,                                          // 130: frontendapi.UpdatePlanRequest.ServingSizesEntry
+	(*timestamppb.Timestamp)(nil),                // 131: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                // 132: google.protobuf.FieldMask
 }
 var file_frontendapi_frontend_proto_depIdxs = []int32{
-	7,  // 0: frontendapi.ChatRequest.content:type_name -> frontendapi.ChatContent
-	7,  // 1: frontendapi.ChatResponse.content:type_name -> frontendapi.ChatContent
-	10, // 2: frontendapi.IngredientSection.ingredients:type_name -> frontendapi.RecipeIngredient
-	2,  // 3: frontendapi.Recipe.source:type_name -> frontendapi.RecipeSource
-	3,  // 4: frontendapi.Recipe.status:type_name -> frontendapi.RecipeStatus
-	10, // 5: frontendapi.Recipe.ingredients:type_name -> frontendapi.RecipeIngredient
-	12, // 6: frontendapi.Recipe.additional_ingredients:type_name -> frontendapi.IngredientSection
-	11, // 7: frontendapi.Recipe.steps:type_name -> frontendapi.RecipeStep
-	0,  // 8: frontendapi.Recipe.language:type_name -> frontendapi.Language
-	13, // 9: frontendapi.GetRecipeResponse.recipe:type_name -> frontendapi.Recipe
-	16, // 10: frontendapi.ListRecipesRequest.pagination:type_name -> frontendapi.Pagination
-	17, // 11: frontendapi.ListRecipesResponse.recipes:type_name -> frontendapi.RecipeSnippet
-	16, // 12: frontendapi.ListRecipesResponse.pagination:type_name -> frontendapi.Pagination
-	5,  // 13: frontendapi.StartChatRequest.model_provider:type_name -> frontendapi.StartChatRequest.ModelProvider
-	10, // 14: frontendapi.AddRecipeRequest.ingredients:type_name -> frontendapi.RecipeIngredient
-	12, // 15: frontendapi.AddRecipeRequest.additional_ingredients:type_name -> frontendapi.IngredientSection
-	48, // 16: frontendapi.AddRecipeRequest.steps:type_name -> frontendapi.AddRecipeRequest.AddRecipeStep
-	0,  // 17: frontendapi.AddRecipeRequest.language:type_name -> frontendapi.Language
-	22, // 18: frontendapi.GenerateRecipeResponse.add_recipe_request:type_name -> frontendapi.AddRecipeRequest
-	1,  // 19: frontendapi.GeneratePlanRequest.genres:type_name -> frontendapi.RecipeGenre
-	11, // 20: frontendapi.StepGroup.steps:type_name -> frontendapi.RecipeStep
-	49, // 21: frontendapi.PlanSnippet.date:type_name -> google.protobuf.Timestamp
-	17, // 22: frontendapi.PlanSnippet.recipes:type_name -> frontendapi.RecipeSnippet
-	49, // 23: frontendapi.GetPlansRequest.start_date:type_name -> google.protobuf.Timestamp
-	29, // 24: frontendapi.GetPlansResponse.plans:type_name -> frontendapi.PlanSnippet
-	4,  // 25: frontendapi.Plan.status:type_name -> frontendapi.PlanStatus
-	17, // 26: frontendapi.Plan.recipes:type_name -> frontendapi.RecipeSnippet
-	28, // 27: frontendapi.Plan.step_groups:type_name -> frontendapi.StepGroup
-	12, // 28: frontendapi.Plan.ingredients:type_name -> frontendapi.IngredientSection
-	32, // 29: frontendapi.GetPlanResponse.plan:type_name -> frontendapi.Plan
-	32, // 30: frontendapi.UpdatePlanResponse.plan:type_name -> frontendapi.Plan
-	6,  // 31: frontendapi.ChatMessage.role:type_name -> frontendapi.ChatMessage.Role
-	43, // 32: frontendapi.ChatPlanResponse.messages:type_name -> frontendapi.ChatMessage
-	43, // 33: frontendapi.GetChatMessagesResponse.messages:type_name -> frontendapi.ChatMessage
-	8,  // 34: frontendapi.ChatService.Chat:input_type -> frontendapi.ChatRequest
-	14, // 35: frontendapi.FrontendService.GetRecipe:input_type -> frontendapi.GetRecipeRequest
-	18, // 36: frontendapi.FrontendService.ListRecipes:input_type -> frontendapi.ListRecipesRequest
-	20, // 37: frontendapi.FrontendService.StartChat:input_type -> frontendapi.StartChatRequest
-	22, // 38: frontendapi.FrontendService.AddRecipe:input_type -> frontendapi.AddRecipeRequest
-	24, // 39: frontendapi.FrontendService.GenerateRecipe:input_type -> frontendapi.GenerateRecipeRequest
-	26, // 40: frontendapi.FrontendService.GeneratePlan:input_type -> frontendapi.GeneratePlanRequest
-	44, // 41: frontendapi.FrontendService.ChatPlan:input_type -> frontendapi.ChatPlanRequest
-	46, // 42: frontendapi.FrontendService.GetChatMessages:input_type -> frontendapi.GetChatMessagesRequest
-	30, // 43: frontendapi.FrontendService.GetPlans:input_type -> frontendapi.GetPlansRequest
-	33, // 44: frontendapi.FrontendService.GetPlan:input_type -> frontendapi.GetPlanRequest
-	35, // 45: frontendapi.FrontendService.UpdatePlan:input_type -> frontendapi.UpdatePlanRequest
-	37, // 46: frontendapi.FrontendService.DeletePlan:input_type -> frontendapi.DeletePlanRequest
-	39, // 47: frontendapi.FrontendService.AddBookmark:input_type -> frontendapi.AddBookmarkRequest
-	41, // 48: frontendapi.FrontendService.RemoveBookmark:input_type -> frontendapi.RemoveBookmarkRequest
-	9,  // 49: frontendapi.ChatService.Chat:output_type -> frontendapi.ChatResponse
-	15, // 50: frontendapi.FrontendService.GetRecipe:output_type -> frontendapi.GetRecipeResponse
-	19, // 51: frontendapi.FrontendService.ListRecipes:output_type -> frontendapi.ListRecipesResponse
-	21, // 52: frontendapi.FrontendService.StartChat:output_type -> frontendapi.StartChatResponse
-	23, // 53: frontendapi.FrontendService.AddRecipe:output_type -> frontendapi.AddRecipeResponse
-	25, // 54: frontendapi.FrontendService.GenerateRecipe:output_type -> frontendapi.GenerateRecipeResponse
-	27, // 55: frontendapi.FrontendService.GeneratePlan:output_type -> frontendapi.GeneratePlanResponse
-	45, // 56: frontendapi.FrontendService.ChatPlan:output_type -> frontendapi.ChatPlanResponse
-	47, // 57: frontendapi.FrontendService.GetChatMessages:output_type -> frontendapi.GetChatMessagesResponse
-	31, // 58: frontendapi.FrontendService.GetPlans:output_type -> frontendapi.GetPlansResponse
-	34, // 59: frontendapi.FrontendService.GetPlan:output_type -> frontendapi.GetPlanResponse
-	36, // 60: frontendapi.FrontendService.UpdatePlan:output_type -> frontendapi.UpdatePlanResponse
-	38, // 61: frontendapi.FrontendService.DeletePlan:output_type -> frontendapi.DeletePlanResponse
-	40, // 62: frontendapi.FrontendService.AddBookmark:output_type -> frontendapi.AddBookmarkResponse
-	42, // 63: frontendapi.FrontendService.RemoveBookmark:output_type -> frontendapi.RemoveBookmarkResponse
-	49, // [49:64] is the sub-list for method output_type
-	34, // [34:49] is the sub-list for method input_type
-	34, // [34:34] is the sub-list for extension type_name
-	34, // [34:34] is the sub-list for extension extendee
-	0,  // [0:34] is the sub-list for field type_name
+	12,  // 0: frontendapi.ChatRequest.content:type_name -> frontendapi.ChatContent
+	12,  // 1: frontendapi.ChatResponse.content:type_name -> frontendapi.ChatContent
+	15,  // 2: frontendapi.IngredientSection.ingredients:type_name -> frontendapi.RecipeIngredient
+	2,   // 3: frontendapi.Recipe.source:type_name -> frontendapi.RecipeSource
+	3,   // 4: frontendapi.Recipe.status:type_name -> frontendapi.RecipeStatus
+	15,  // 5: frontendapi.Recipe.ingredients:type_name -> frontendapi.RecipeIngredient
+	17,  // 6: frontendapi.Recipe.additional_ingredients:type_name -> frontendapi.IngredientSection
+	16,  // 7: frontendapi.Recipe.steps:type_name -> frontendapi.RecipeStep
+	0,   // 8: frontendapi.Recipe.language:type_name -> frontendapi.Language
+	18,  // 9: frontendapi.GetRecipeResponse.recipe:type_name -> frontendapi.Recipe
+	3,   // 10: frontendapi.RecipeSnippet.status:type_name -> frontendapi.RecipeStatus
+	21,  // 11: frontendapi.ListRecipesRequest.pagination:type_name -> frontendapi.Pagination
+	22,  // 12: frontendapi.ListRecipesResponse.recipes:type_name -> frontendapi.RecipeSnippet
+	21,  // 13: frontendapi.ListRecipesResponse.pagination:type_name -> frontendapi.Pagination
+	10,  // 14: frontendapi.StartChatRequest.model_provider:type_name -> frontendapi.StartChatRequest.ModelProvider
+	15,  // 15: frontendapi.AddRecipeRequest.ingredients:type_name -> frontendapi.RecipeIngredient
+	17,  // 16: frontendapi.AddRecipeRequest.additional_ingredients:type_name -> frontendapi.IngredientSection
+	129, // 17: frontendapi.AddRecipeRequest.steps:type_name -> frontendapi.AddRecipeRequest.AddRecipeStep
+	0,   // 18: frontendapi.AddRecipeRequest.language:type_name -> frontendapi.Language
+	27,  // 19: frontendapi.GenerateRecipeResponse.add_recipe_request:type_name -> frontendapi.AddRecipeRequest
+	1,   // 20: frontendapi.GeneratePlanRequest.genres:type_name -> frontendapi.RecipeGenre
+	4,   // 21: frontendapi.GeneratePlanRequest.generator:type_name -> frontendapi.PlanGenerator
+	16,  // 22: frontendapi.StepGroup.steps:type_name -> frontendapi.RecipeStep
+	131, // 23: frontendapi.PlanSnippet.date:type_name -> google.protobuf.Timestamp
+	22,  // 24: frontendapi.PlanSnippet.recipes:type_name -> frontendapi.RecipeSnippet
+	5,   // 25: frontendapi.PlanSnippet.type:type_name -> frontendapi.PlanType
+	6,   // 26: frontendapi.PlanSnippet.status:type_name -> frontendapi.PlanStatus
+	131, // 27: frontendapi.GetPlansRequest.start_date:type_name -> google.protobuf.Timestamp
+	34,  // 28: frontendapi.GetPlansResponse.plans:type_name -> frontendapi.PlanSnippet
+	6,   // 29: frontendapi.Plan.status:type_name -> frontendapi.PlanStatus
+	22,  // 30: frontendapi.Plan.recipes:type_name -> frontendapi.RecipeSnippet
+	33,  // 31: frontendapi.Plan.step_groups:type_name -> frontendapi.StepGroup
+	17,  // 32: frontendapi.Plan.ingredients:type_name -> frontendapi.IngredientSection
+	5,   // 33: frontendapi.Plan.type:type_name -> frontendapi.PlanType
+	39,  // 34: frontendapi.Plan.batch_dishes:type_name -> frontendapi.BatchDish
+	38,  // 35: frontendapi.Plan.progress:type_name -> frontendapi.PlanProgress
+	131, // 36: frontendapi.Plan.update_time:type_name -> google.protobuf.Timestamp
+	7,   // 37: frontendapi.PlanProgress.stage:type_name -> frontendapi.PlanProgressStage
+	131, // 38: frontendapi.PlanProgress.started_at:type_name -> google.protobuf.Timestamp
+	131, // 39: frontendapi.PlanProgress.completed_at:type_name -> google.protobuf.Timestamp
+	37,  // 40: frontendapi.GetPlanResponse.plan:type_name -> frontendapi.Plan
+	37,  // 41: frontendapi.WatchPlanResponse.plan:type_name -> frontendapi.Plan
+	131, // 42: frontendapi.ShareablePlanLinkResponse.expires_at:type_name -> google.protobuf.Timestamp
+	37,  // 43: frontendapi.GetSharedPlanResponse.plan:type_name -> frontendapi.Plan
+	131, // 44: frontendapi.GetPlanTimelineRequest.serve_at:type_name -> google.protobuf.Timestamp
+	16,  // 45: frontendapi.TimelineStep.step:type_name -> frontendapi.RecipeStep
+	131, // 46: frontendapi.TimelineStep.start_time:type_name -> google.protobuf.Timestamp
+	131, // 47: frontendapi.TimelineStep.end_time:type_name -> google.protobuf.Timestamp
+	131, // 48: frontendapi.TimelineStepGroup.start_time:type_name -> google.protobuf.Timestamp
+	131, // 49: frontendapi.TimelineStepGroup.end_time:type_name -> google.protobuf.Timestamp
+	51,  // 50: frontendapi.TimelineStepGroup.steps:type_name -> frontendapi.TimelineStep
+	131, // 51: frontendapi.GetPlanTimelineResponse.start_time:type_name -> google.protobuf.Timestamp
+	131, // 52: frontendapi.GetPlanTimelineResponse.serve_at:type_name -> google.protobuf.Timestamp
+	52,  // 53: frontendapi.GetPlanTimelineResponse.step_groups:type_name -> frontendapi.TimelineStepGroup
+	33,  // 54: frontendapi.UpdatePlanRequest.step_groups:type_name -> frontendapi.StepGroup
+	130, // 55: frontendapi.UpdatePlanRequest.serving_sizes:type_name -> frontendapi.UpdatePlanRequest.ServingSizesEntry
+	132, // 56: frontendapi.UpdatePlanRequest.update_mask:type_name -> google.protobuf.FieldMask
+	131, // 57: frontendapi.UpdatePlanRequest.update_time:type_name -> google.protobuf.Timestamp
+	37,  // 58: frontendapi.UpdatePlanResponse.plan:type_name -> frontendapi.Plan
+	34,  // 59: frontendapi.DeletedPlan.plan:type_name -> frontendapi.PlanSnippet
+	131, // 60: frontendapi.DeletedPlan.deleted_at:type_name -> google.protobuf.Timestamp
+	131, // 61: frontendapi.DeletedPlan.purge_at:type_name -> google.protobuf.Timestamp
+	61,  // 62: frontendapi.ListDeletedPlansResponse.plans:type_name -> frontendapi.DeletedPlan
+	22,  // 63: frontendapi.SuggestAlternativesResponse.alternatives:type_name -> frontendapi.RecipeSnippet
+	8,   // 64: frontendapi.PlanTemplateSlot.day_of_week:type_name -> frontendapi.DayOfWeek
+	69,  // 65: frontendapi.SavePlanTemplateRequest.slots:type_name -> frontendapi.PlanTemplateSlot
+	131, // 66: frontendapi.ApplyPlanTemplateRequest.week_start:type_name -> google.protobuf.Timestamp
+	8,   // 67: frontendapi.ApplyPlanTemplateResponse.skipped_days:type_name -> frontendapi.DayOfWeek
+	74,  // 68: frontendapi.ListIngredientPricesResponse.prices:type_name -> frontendapi.IngredientPrice
+	131, // 69: frontendapi.MarkCookedRequest.cooked_at:type_name -> google.protobuf.Timestamp
+	22,  // 70: frontendapi.CookingHistoryEntry.recipes:type_name -> frontendapi.RecipeSnippet
+	131, // 71: frontendapi.CookingHistoryEntry.cooked_at:type_name -> google.protobuf.Timestamp
+	21,  // 72: frontendapi.ListCookingHistoryRequest.pagination:type_name -> frontendapi.Pagination
+	83,  // 73: frontendapi.ListCookingHistoryResponse.entries:type_name -> frontendapi.CookingHistoryEntry
+	21,  // 74: frontendapi.ListCookingHistoryResponse.pagination:type_name -> frontendapi.Pagination
+	9,   // 75: frontendapi.HouseholdMember.role:type_name -> frontendapi.HouseholdRole
+	131, // 76: frontendapi.HouseholdMember.joined_at:type_name -> google.protobuf.Timestamp
+	86,  // 77: frontendapi.Household.members:type_name -> frontendapi.HouseholdMember
+	87,  // 78: frontendapi.GetHouseholdResponse.household:type_name -> frontendapi.Household
+	9,   // 79: frontendapi.GetHouseholdResponse.role:type_name -> frontendapi.HouseholdRole
+	9,   // 80: frontendapi.CreateHouseholdInvitationRequest.role:type_name -> frontendapi.HouseholdRole
+	131, // 81: frontendapi.CreateHouseholdInvitationResponse.expires_at:type_name -> google.protobuf.Timestamp
+	98,  // 82: frontendapi.GetUserSettingsResponse.settings:type_name -> frontendapi.UserSettings
+	98,  // 83: frontendapi.UpdateUserSettingsRequest.settings:type_name -> frontendapi.UserSettings
+	107, // 84: frontendapi.ShoppingListEntry.ingredients:type_name -> frontendapi.ShoppingListIngredient
+	108, // 85: frontendapi.GetShoppingListResponse.entries:type_name -> frontendapi.ShoppingListEntry
+	107, // 86: frontendapi.AddShoppingListEntryRequest.ingredients:type_name -> frontendapi.ShoppingListIngredient
+	117, // 87: frontendapi.ListPantryItemsResponse.items:type_name -> frontendapi.PantryItem
+	11,  // 88: frontendapi.ChatMessage.role:type_name -> frontendapi.ChatMessage.Role
+	124, // 89: frontendapi.ChatPlanResponse.messages:type_name -> frontendapi.ChatMessage
+	124, // 90: frontendapi.GetChatMessagesResponse.messages:type_name -> frontendapi.ChatMessage
+	13,  // 91: frontendapi.ChatService.Chat:input_type -> frontendapi.ChatRequest
+	19,  // 92: frontendapi.FrontendService.GetRecipe:input_type -> frontendapi.GetRecipeRequest
+	23,  // 93: frontendapi.FrontendService.ListRecipes:input_type -> frontendapi.ListRecipesRequest
+	25,  // 94: frontendapi.FrontendService.StartChat:input_type -> frontendapi.StartChatRequest
+	27,  // 95: frontendapi.FrontendService.AddRecipe:input_type -> frontendapi.AddRecipeRequest
+	29,  // 96: frontendapi.FrontendService.GenerateRecipe:input_type -> frontendapi.GenerateRecipeRequest
+	31,  // 97: frontendapi.FrontendService.GeneratePlan:input_type -> frontendapi.GeneratePlanRequest
+	125, // 98: frontendapi.FrontendService.ChatPlan:input_type -> frontendapi.ChatPlanRequest
+	127, // 99: frontendapi.FrontendService.GetChatMessages:input_type -> frontendapi.GetChatMessagesRequest
+	35,  // 100: frontendapi.FrontendService.GetPlans:input_type -> frontendapi.GetPlansRequest
+	40,  // 101: frontendapi.FrontendService.GetPlan:input_type -> frontendapi.GetPlanRequest
+	44,  // 102: frontendapi.FrontendService.ShareablePlanLink:input_type -> frontendapi.ShareablePlanLinkRequest
+	46,  // 103: frontendapi.FrontendService.RevokeShareablePlanLink:input_type -> frontendapi.RevokeShareablePlanLinkRequest
+	48,  // 104: frontendapi.FrontendService.GetSharedPlan:input_type -> frontendapi.GetSharedPlanRequest
+	42,  // 105: frontendapi.FrontendService.WatchPlan:input_type -> frontendapi.WatchPlanRequest
+	50,  // 106: frontendapi.FrontendService.GetPlanTimeline:input_type -> frontendapi.GetPlanTimelineRequest
+	54,  // 107: frontendapi.FrontendService.UpdatePlan:input_type -> frontendapi.UpdatePlanRequest
+	65,  // 108: frontendapi.FrontendService.SuggestAlternatives:input_type -> frontendapi.SuggestAlternativesRequest
+	67,  // 109: frontendapi.FrontendService.ReplacePlanRecipe:input_type -> frontendapi.ReplacePlanRecipeRequest
+	56,  // 110: frontendapi.FrontendService.DeletePlan:input_type -> frontendapi.DeletePlanRequest
+	58,  // 111: frontendapi.FrontendService.RestorePlan:input_type -> frontendapi.RestorePlanRequest
+	60,  // 112: frontendapi.FrontendService.ListDeletedPlans:input_type -> frontendapi.ListDeletedPlansRequest
+	63,  // 113: frontendapi.FrontendService.RetryPlan:input_type -> frontendapi.RetryPlanRequest
+	70,  // 114: frontendapi.FrontendService.SavePlanTemplate:input_type -> frontendapi.SavePlanTemplateRequest
+	72,  // 115: frontendapi.FrontendService.ApplyPlanTemplate:input_type -> frontendapi.ApplyPlanTemplateRequest
+	75,  // 116: frontendapi.FrontendService.ListIngredientPrices:input_type -> frontendapi.ListIngredientPricesRequest
+	77,  // 117: frontendapi.FrontendService.SetIngredientPrice:input_type -> frontendapi.SetIngredientPriceRequest
+	79,  // 118: frontendapi.FrontendService.DeleteIngredientPrice:input_type -> frontendapi.DeleteIngredientPriceRequest
+	81,  // 119: frontendapi.FrontendService.MarkCooked:input_type -> frontendapi.MarkCookedRequest
+	84,  // 120: frontendapi.FrontendService.ListCookingHistory:input_type -> frontendapi.ListCookingHistoryRequest
+	88,  // 121: frontendapi.FrontendService.CreateHousehold:input_type -> frontendapi.CreateHouseholdRequest
+	90,  // 122: frontendapi.FrontendService.GetHousehold:input_type -> frontendapi.GetHouseholdRequest
+	92,  // 123: frontendapi.FrontendService.CreateHouseholdInvitation:input_type -> frontendapi.CreateHouseholdInvitationRequest
+	94,  // 124: frontendapi.FrontendService.AcceptHouseholdInvitation:input_type -> frontendapi.AcceptHouseholdInvitationRequest
+	96,  // 125: frontendapi.FrontendService.RemoveHouseholdMember:input_type -> frontendapi.RemoveHouseholdMemberRequest
+	103, // 126: frontendapi.FrontendService.AddBookmark:input_type -> frontendapi.AddBookmarkRequest
+	105, // 127: frontendapi.FrontendService.RemoveBookmark:input_type -> frontendapi.RemoveBookmarkRequest
+	109, // 128: frontendapi.FrontendService.GetShoppingList:input_type -> frontendapi.GetShoppingListRequest
+	111, // 129: frontendapi.FrontendService.AddShoppingListEntry:input_type -> frontendapi.AddShoppingListEntryRequest
+	113, // 130: frontendapi.FrontendService.UpdateShoppingListIngredient:input_type -> frontendapi.UpdateShoppingListIngredientRequest
+	115, // 131: frontendapi.FrontendService.RemoveShoppingListEntry:input_type -> frontendapi.RemoveShoppingListEntryRequest
+	118, // 132: frontendapi.FrontendService.ListPantryItems:input_type -> frontendapi.ListPantryItemsRequest
+	120, // 133: frontendapi.FrontendService.SetPantryItem:input_type -> frontendapi.SetPantryItemRequest
+	122, // 134: frontendapi.FrontendService.DeletePantryItem:input_type -> frontendapi.DeletePantryItemRequest
+	99,  // 135: frontendapi.FrontendService.GetUserSettings:input_type -> frontendapi.GetUserSettingsRequest
+	101, // 136: frontendapi.FrontendService.UpdateUserSettings:input_type -> frontendapi.UpdateUserSettingsRequest
+	14,  // 137: frontendapi.ChatService.Chat:output_type -> frontendapi.ChatResponse
+	20,  // 138: frontendapi.FrontendService.GetRecipe:output_type -> frontendapi.GetRecipeResponse
+	24,  // 139: frontendapi.FrontendService.ListRecipes:output_type -> frontendapi.ListRecipesResponse
+	26,  // 140: frontendapi.FrontendService.StartChat:output_type -> frontendapi.StartChatResponse
+	28,  // 141: frontendapi.FrontendService.AddRecipe:output_type -> frontendapi.AddRecipeResponse
+	30,  // 142: frontendapi.FrontendService.GenerateRecipe:output_type -> frontendapi.GenerateRecipeResponse
+	32,  // 143: frontendapi.FrontendService.GeneratePlan:output_type -> frontendapi.GeneratePlanResponse
+	126, // 144: frontendapi.FrontendService.ChatPlan:output_type -> frontendapi.ChatPlanResponse
+	128, // 145: frontendapi.FrontendService.GetChatMessages:output_type -> frontendapi.GetChatMessagesResponse
+	36,  // 146: frontendapi.FrontendService.GetPlans:output_type -> frontendapi.GetPlansResponse
+	41,  // 147: frontendapi.FrontendService.GetPlan:output_type -> frontendapi.GetPlanResponse
+	45,  // 148: frontendapi.FrontendService.ShareablePlanLink:output_type -> frontendapi.ShareablePlanLinkResponse
+	47,  // 149: frontendapi.FrontendService.RevokeShareablePlanLink:output_type -> frontendapi.RevokeShareablePlanLinkResponse
+	49,  // 150: frontendapi.FrontendService.GetSharedPlan:output_type -> frontendapi.GetSharedPlanResponse
+	43,  // 151: frontendapi.FrontendService.WatchPlan:output_type -> frontendapi.WatchPlanResponse
+	53,  // 152: frontendapi.FrontendService.GetPlanTimeline:output_type -> frontendapi.GetPlanTimelineResponse
+	55,  // 153: frontendapi.FrontendService.UpdatePlan:output_type -> frontendapi.UpdatePlanResponse
+	66,  // 154: frontendapi.FrontendService.SuggestAlternatives:output_type -> frontendapi.SuggestAlternativesResponse
+	68,  // 155: frontendapi.FrontendService.ReplacePlanRecipe:output_type -> frontendapi.ReplacePlanRecipeResponse
+	57,  // 156: frontendapi.FrontendService.DeletePlan:output_type -> frontendapi.DeletePlanResponse
+	59,  // 157: frontendapi.FrontendService.RestorePlan:output_type -> frontendapi.RestorePlanResponse
+	62,  // 158: frontendapi.FrontendService.ListDeletedPlans:output_type -> frontendapi.ListDeletedPlansResponse
+	64,  // 159: frontendapi.FrontendService.RetryPlan:output_type -> frontendapi.RetryPlanResponse
+	71,  // 160: frontendapi.FrontendService.SavePlanTemplate:output_type -> frontendapi.SavePlanTemplateResponse
+	73,  // 161: frontendapi.FrontendService.ApplyPlanTemplate:output_type -> frontendapi.ApplyPlanTemplateResponse
+	76,  // 162: frontendapi.FrontendService.ListIngredientPrices:output_type -> frontendapi.ListIngredientPricesResponse
+	78,  // 163: frontendapi.FrontendService.SetIngredientPrice:output_type -> frontendapi.SetIngredientPriceResponse
+	80,  // 164: frontendapi.FrontendService.DeleteIngredientPrice:output_type -> frontendapi.DeleteIngredientPriceResponse
+	82,  // 165: frontendapi.FrontendService.MarkCooked:output_type -> frontendapi.MarkCookedResponse
+	85,  // 166: frontendapi.FrontendService.ListCookingHistory:output_type -> frontendapi.ListCookingHistoryResponse
+	89,  // 167: frontendapi.FrontendService.CreateHousehold:output_type -> frontendapi.CreateHouseholdResponse
+	91,  // 168: frontendapi.FrontendService.GetHousehold:output_type -> frontendapi.GetHouseholdResponse
+	93,  // 169: frontendapi.FrontendService.CreateHouseholdInvitation:output_type -> frontendapi.CreateHouseholdInvitationResponse
+	95,  // 170: frontendapi.FrontendService.AcceptHouseholdInvitation:output_type -> frontendapi.AcceptHouseholdInvitationResponse
+	97,  // 171: frontendapi.FrontendService.RemoveHouseholdMember:output_type -> frontendapi.RemoveHouseholdMemberResponse
+	104, // 172: frontendapi.FrontendService.AddBookmark:output_type -> frontendapi.AddBookmarkResponse
+	106, // 173: frontendapi.FrontendService.RemoveBookmark:output_type -> frontendapi.RemoveBookmarkResponse
+	110, // 174: frontendapi.FrontendService.GetShoppingList:output_type -> frontendapi.GetShoppingListResponse
+	112, // 175: frontendapi.FrontendService.AddShoppingListEntry:output_type -> frontendapi.AddShoppingListEntryResponse
+	114, // 176: frontendapi.FrontendService.UpdateShoppingListIngredient:output_type -> frontendapi.UpdateShoppingListIngredientResponse
+	116, // 177: frontendapi.FrontendService.RemoveShoppingListEntry:output_type -> frontendapi.RemoveShoppingListEntryResponse
+	119, // 178: frontendapi.FrontendService.ListPantryItems:output_type -> frontendapi.ListPantryItemsResponse
+	121, // 179: frontendapi.FrontendService.SetPantryItem:output_type -> frontendapi.SetPantryItemResponse
+	123, // 180: frontendapi.FrontendService.DeletePantryItem:output_type -> frontendapi.DeletePantryItemResponse
+	100, // 181: frontendapi.FrontendService.GetUserSettings:output_type -> frontendapi.GetUserSettingsResponse
+	102, // 182: frontendapi.FrontendService.UpdateUserSettings:output_type -> frontendapi.UpdateUserSettingsResponse
+	137, // [137:183] is the sub-list for method output_type
+	91,  // [91:137] is the sub-list for method input_type
+	91,  // [91:91] is the sub-list for extension type_name
+	91,  // [91:91] is the sub-list for extension extendee
+	0,   // [0:91] is the sub-list for field type_name
 }
 
 func init() { file_frontendapi_frontend_proto_init() }
@@ -3378,13 +8472,17 @@ func file_frontendapi_frontend_proto_init() {
 		(*StartChatRequest_RecipeId)(nil),
 		(*StartChatRequest_PlanId)(nil),
 	}
+	file_frontendapi_frontend_proto_msgTypes[69].OneofWrappers = []any{
+		(*MarkCookedRequest_RecipeId)(nil),
+		(*MarkCookedRequest_PlanId)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_frontendapi_frontend_proto_rawDesc), len(file_frontendapi_frontend_proto_rawDesc)),
-			NumEnums:      7,
-			NumMessages:   42,
+			NumEnums:      12,
+			NumMessages:   119,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
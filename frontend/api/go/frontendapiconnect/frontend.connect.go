@@ -66,18 +66,111 @@ const (
 	FrontendServiceGetPlansProcedure = "/frontendapi.FrontendService/GetPlans"
 	// FrontendServiceGetPlanProcedure is the fully-qualified name of the FrontendService's GetPlan RPC.
 	FrontendServiceGetPlanProcedure = "/frontendapi.FrontendService/GetPlan"
+	// FrontendServiceShareablePlanLinkProcedure is the fully-qualified name of the FrontendService's
+	// ShareablePlanLink RPC.
+	FrontendServiceShareablePlanLinkProcedure = "/frontendapi.FrontendService/ShareablePlanLink"
+	// FrontendServiceRevokeShareablePlanLinkProcedure is the fully-qualified name of the
+	// FrontendService's RevokeShareablePlanLink RPC.
+	FrontendServiceRevokeShareablePlanLinkProcedure = "/frontendapi.FrontendService/RevokeShareablePlanLink"
+	// FrontendServiceGetSharedPlanProcedure is the fully-qualified name of the FrontendService's
+	// GetSharedPlan RPC.
+	FrontendServiceGetSharedPlanProcedure = "/frontendapi.FrontendService/GetSharedPlan"
+	// FrontendServiceWatchPlanProcedure is the fully-qualified name of the FrontendService's WatchPlan
+	// RPC.
+	FrontendServiceWatchPlanProcedure = "/frontendapi.FrontendService/WatchPlan"
+	// FrontendServiceGetPlanTimelineProcedure is the fully-qualified name of the FrontendService's
+	// GetPlanTimeline RPC.
+	FrontendServiceGetPlanTimelineProcedure = "/frontendapi.FrontendService/GetPlanTimeline"
 	// FrontendServiceUpdatePlanProcedure is the fully-qualified name of the FrontendService's
 	// UpdatePlan RPC.
 	FrontendServiceUpdatePlanProcedure = "/frontendapi.FrontendService/UpdatePlan"
+	// FrontendServiceSuggestAlternativesProcedure is the fully-qualified name of the FrontendService's
+	// SuggestAlternatives RPC.
+	FrontendServiceSuggestAlternativesProcedure = "/frontendapi.FrontendService/SuggestAlternatives"
+	// FrontendServiceReplacePlanRecipeProcedure is the fully-qualified name of the FrontendService's
+	// ReplacePlanRecipe RPC.
+	FrontendServiceReplacePlanRecipeProcedure = "/frontendapi.FrontendService/ReplacePlanRecipe"
 	// FrontendServiceDeletePlanProcedure is the fully-qualified name of the FrontendService's
 	// DeletePlan RPC.
 	FrontendServiceDeletePlanProcedure = "/frontendapi.FrontendService/DeletePlan"
+	// FrontendServiceRestorePlanProcedure is the fully-qualified name of the FrontendService's
+	// RestorePlan RPC.
+	FrontendServiceRestorePlanProcedure = "/frontendapi.FrontendService/RestorePlan"
+	// FrontendServiceListDeletedPlansProcedure is the fully-qualified name of the FrontendService's
+	// ListDeletedPlans RPC.
+	FrontendServiceListDeletedPlansProcedure = "/frontendapi.FrontendService/ListDeletedPlans"
+	// FrontendServiceRetryPlanProcedure is the fully-qualified name of the FrontendService's RetryPlan
+	// RPC.
+	FrontendServiceRetryPlanProcedure = "/frontendapi.FrontendService/RetryPlan"
+	// FrontendServiceSavePlanTemplateProcedure is the fully-qualified name of the FrontendService's
+	// SavePlanTemplate RPC.
+	FrontendServiceSavePlanTemplateProcedure = "/frontendapi.FrontendService/SavePlanTemplate"
+	// FrontendServiceApplyPlanTemplateProcedure is the fully-qualified name of the FrontendService's
+	// ApplyPlanTemplate RPC.
+	FrontendServiceApplyPlanTemplateProcedure = "/frontendapi.FrontendService/ApplyPlanTemplate"
+	// FrontendServiceListIngredientPricesProcedure is the fully-qualified name of the FrontendService's
+	// ListIngredientPrices RPC.
+	FrontendServiceListIngredientPricesProcedure = "/frontendapi.FrontendService/ListIngredientPrices"
+	// FrontendServiceSetIngredientPriceProcedure is the fully-qualified name of the FrontendService's
+	// SetIngredientPrice RPC.
+	FrontendServiceSetIngredientPriceProcedure = "/frontendapi.FrontendService/SetIngredientPrice"
+	// FrontendServiceDeleteIngredientPriceProcedure is the fully-qualified name of the
+	// FrontendService's DeleteIngredientPrice RPC.
+	FrontendServiceDeleteIngredientPriceProcedure = "/frontendapi.FrontendService/DeleteIngredientPrice"
+	// FrontendServiceMarkCookedProcedure is the fully-qualified name of the FrontendService's
+	// MarkCooked RPC.
+	FrontendServiceMarkCookedProcedure = "/frontendapi.FrontendService/MarkCooked"
+	// FrontendServiceListCookingHistoryProcedure is the fully-qualified name of the FrontendService's
+	// ListCookingHistory RPC.
+	FrontendServiceListCookingHistoryProcedure = "/frontendapi.FrontendService/ListCookingHistory"
+	// FrontendServiceCreateHouseholdProcedure is the fully-qualified name of the FrontendService's
+	// CreateHousehold RPC.
+	FrontendServiceCreateHouseholdProcedure = "/frontendapi.FrontendService/CreateHousehold"
+	// FrontendServiceGetHouseholdProcedure is the fully-qualified name of the FrontendService's
+	// GetHousehold RPC.
+	FrontendServiceGetHouseholdProcedure = "/frontendapi.FrontendService/GetHousehold"
+	// FrontendServiceCreateHouseholdInvitationProcedure is the fully-qualified name of the
+	// FrontendService's CreateHouseholdInvitation RPC.
+	FrontendServiceCreateHouseholdInvitationProcedure = "/frontendapi.FrontendService/CreateHouseholdInvitation"
+	// FrontendServiceAcceptHouseholdInvitationProcedure is the fully-qualified name of the
+	// FrontendService's AcceptHouseholdInvitation RPC.
+	FrontendServiceAcceptHouseholdInvitationProcedure = "/frontendapi.FrontendService/AcceptHouseholdInvitation"
+	// FrontendServiceRemoveHouseholdMemberProcedure is the fully-qualified name of the
+	// FrontendService's RemoveHouseholdMember RPC.
+	FrontendServiceRemoveHouseholdMemberProcedure = "/frontendapi.FrontendService/RemoveHouseholdMember"
 	// FrontendServiceAddBookmarkProcedure is the fully-qualified name of the FrontendService's
 	// AddBookmark RPC.
 	FrontendServiceAddBookmarkProcedure = "/frontendapi.FrontendService/AddBookmark"
 	// FrontendServiceRemoveBookmarkProcedure is the fully-qualified name of the FrontendService's
 	// RemoveBookmark RPC.
 	FrontendServiceRemoveBookmarkProcedure = "/frontendapi.FrontendService/RemoveBookmark"
+	// FrontendServiceGetShoppingListProcedure is the fully-qualified name of the FrontendService's
+	// GetShoppingList RPC.
+	FrontendServiceGetShoppingListProcedure = "/frontendapi.FrontendService/GetShoppingList"
+	// FrontendServiceAddShoppingListEntryProcedure is the fully-qualified name of the FrontendService's
+	// AddShoppingListEntry RPC.
+	FrontendServiceAddShoppingListEntryProcedure = "/frontendapi.FrontendService/AddShoppingListEntry"
+	// FrontendServiceUpdateShoppingListIngredientProcedure is the fully-qualified name of the
+	// FrontendService's UpdateShoppingListIngredient RPC.
+	FrontendServiceUpdateShoppingListIngredientProcedure = "/frontendapi.FrontendService/UpdateShoppingListIngredient"
+	// FrontendServiceRemoveShoppingListEntryProcedure is the fully-qualified name of the
+	// FrontendService's RemoveShoppingListEntry RPC.
+	FrontendServiceRemoveShoppingListEntryProcedure = "/frontendapi.FrontendService/RemoveShoppingListEntry"
+	// FrontendServiceListPantryItemsProcedure is the fully-qualified name of the FrontendService's
+	// ListPantryItems RPC.
+	FrontendServiceListPantryItemsProcedure = "/frontendapi.FrontendService/ListPantryItems"
+	// FrontendServiceSetPantryItemProcedure is the fully-qualified name of the FrontendService's
+	// SetPantryItem RPC.
+	FrontendServiceSetPantryItemProcedure = "/frontendapi.FrontendService/SetPantryItem"
+	// FrontendServiceDeletePantryItemProcedure is the fully-qualified name of the FrontendService's
+	// DeletePantryItem RPC.
+	FrontendServiceDeletePantryItemProcedure = "/frontendapi.FrontendService/DeletePantryItem"
+	// FrontendServiceGetUserSettingsProcedure is the fully-qualified name of the FrontendService's
+	// GetUserSettings RPC.
+	FrontendServiceGetUserSettingsProcedure = "/frontendapi.FrontendService/GetUserSettings"
+	// FrontendServiceUpdateUserSettingsProcedure is the fully-qualified name of the FrontendService's
+	// UpdateUserSettings RPC.
+	FrontendServiceUpdateUserSettingsProcedure = "/frontendapi.FrontendService/UpdateUserSettings"
 )
 
 // ChatServiceClient is a client for the frontendapi.ChatService service.
@@ -174,14 +267,79 @@ type FrontendServiceClient interface {
 	GetPlans(context.Context, *connect.Request[_go.GetPlansRequest]) (*connect.Response[_go.GetPlansResponse], error)
 	// Get the details of a plan.
 	GetPlan(context.Context, *connect.Request[_go.GetPlanRequest]) (*connect.Response[_go.GetPlanResponse], error)
+	// Create a link giving anyone with it read-only access to a plan until it expires or
+	// is revoked.
+	ShareablePlanLink(context.Context, *connect.Request[_go.ShareablePlanLinkRequest]) (*connect.Response[_go.ShareablePlanLinkResponse], error)
+	// Revoke a link created by ShareablePlanLink.
+	RevokeShareablePlanLink(context.Context, *connect.Request[_go.RevokeShareablePlanLinkRequest]) (*connect.Response[_go.RevokeShareablePlanLinkResponse], error)
+	// Get a plan shared with a link. Does not require signing in.
+	GetSharedPlan(context.Context, *connect.Request[_go.GetSharedPlanRequest]) (*connect.Response[_go.GetSharedPlanResponse], error)
+	// Watch a plan while it is processing. A snapshot of the plan is sent when watching starts
+	// and whenever it changes, and the stream ends once the plan is no longer processing.
+	// Streams may also end early, in which case clients should watch again.
+	WatchPlan(context.Context, *connect.Request[_go.WatchPlanRequest]) (*connect.ServerStreamForClient[_go.WatchPlanResponse], error)
+	// Get the timeline for cooking a plan to be served at a given time.
+	GetPlanTimeline(context.Context, *connect.Request[_go.GetPlanTimelineRequest]) (*connect.Response[_go.GetPlanTimelineResponse], error)
 	// Update the recipes in a plan.
 	UpdatePlan(context.Context, *connect.Request[_go.UpdatePlanRequest]) (*connect.Response[_go.UpdatePlanResponse], error)
-	// Delete a plan.
+	// Suggest recipes to replace a recipe in a plan.
+	SuggestAlternatives(context.Context, *connect.Request[_go.SuggestAlternativesRequest]) (*connect.Response[_go.SuggestAlternativesResponse], error)
+	// Replace a recipe in a plan. The plan is processed again to update its steps.
+	ReplacePlanRecipe(context.Context, *connect.Request[_go.ReplacePlanRecipeRequest]) (*connect.Response[_go.ReplacePlanRecipeResponse], error)
+	// Move a plan to the trash. Trashed plans are permanently deleted after 30 days.
 	DeletePlan(context.Context, *connect.Request[_go.DeletePlanRequest]) (*connect.Response[_go.DeletePlanResponse], error)
+	// Restore a plan from the trash.
+	RestorePlan(context.Context, *connect.Request[_go.RestorePlanRequest]) (*connect.Response[_go.RestorePlanResponse], error)
+	// List the plans in the trash.
+	ListDeletedPlans(context.Context, *connect.Request[_go.ListDeletedPlansRequest]) (*connect.Response[_go.ListDeletedPlansResponse], error)
+	// Retry processing a failed plan.
+	RetryPlan(context.Context, *connect.Request[_go.RetryPlanRequest]) (*connect.Response[_go.RetryPlanResponse], error)
+	// Save a recurring weekly plan template.
+	SavePlanTemplate(context.Context, *connect.Request[_go.SavePlanTemplateRequest]) (*connect.Response[_go.SavePlanTemplateResponse], error)
+	// Create plans for a week from a plan template.
+	ApplyPlanTemplate(context.Context, *connect.Request[_go.ApplyPlanTemplateRequest]) (*connect.Response[_go.ApplyPlanTemplateResponse], error)
+	// List the ingredient prices used to estimate recipe costs.
+	ListIngredientPrices(context.Context, *connect.Request[_go.ListIngredientPricesRequest]) (*connect.Response[_go.ListIngredientPricesResponse], error)
+	// Set the price of an ingredient, overriding any default.
+	SetIngredientPrice(context.Context, *connect.Request[_go.SetIngredientPriceRequest]) (*connect.Response[_go.SetIngredientPriceResponse], error)
+	// Delete a price set by the user, restoring any default.
+	DeleteIngredientPrice(context.Context, *connect.Request[_go.DeleteIngredientPriceRequest]) (*connect.Response[_go.DeleteIngredientPriceResponse], error)
+	// Record that the user cooked a recipe or plan.
+	MarkCooked(context.Context, *connect.Request[_go.MarkCookedRequest]) (*connect.Response[_go.MarkCookedResponse], error)
+	// List the cooking history of the user.
+	ListCookingHistory(context.Context, *connect.Request[_go.ListCookingHistoryRequest]) (*connect.Response[_go.ListCookingHistoryResponse], error)
+	// Create a household owned by the user.
+	CreateHousehold(context.Context, *connect.Request[_go.CreateHouseholdRequest]) (*connect.Response[_go.CreateHouseholdResponse], error)
+	// Get the household of the user.
+	GetHousehold(context.Context, *connect.Request[_go.GetHouseholdRequest]) (*connect.Response[_go.GetHouseholdResponse], error)
+	// Create an invitation to join the household of the user.
+	CreateHouseholdInvitation(context.Context, *connect.Request[_go.CreateHouseholdInvitationRequest]) (*connect.Response[_go.CreateHouseholdInvitationResponse], error)
+	// Accept an invitation to join a household.
+	AcceptHouseholdInvitation(context.Context, *connect.Request[_go.AcceptHouseholdInvitationRequest]) (*connect.Response[_go.AcceptHouseholdInvitationResponse], error)
+	// Remove a member from the household of the user.
+	RemoveHouseholdMember(context.Context, *connect.Request[_go.RemoveHouseholdMemberRequest]) (*connect.Response[_go.RemoveHouseholdMemberResponse], error)
 	// Add a bookmark for a recipe.
 	AddBookmark(context.Context, *connect.Request[_go.AddBookmarkRequest]) (*connect.Response[_go.AddBookmarkResponse], error)
 	// Remove a bookmark for a recipe.
 	RemoveBookmark(context.Context, *connect.Request[_go.RemoveBookmarkRequest]) (*connect.Response[_go.RemoveBookmarkResponse], error)
+	// Get the shopping list of the user.
+	GetShoppingList(context.Context, *connect.Request[_go.GetShoppingListRequest]) (*connect.Response[_go.GetShoppingListResponse], error)
+	// Add the ingredients of a recipe or an extra item to the shopping list.
+	AddShoppingListEntry(context.Context, *connect.Request[_go.AddShoppingListEntryRequest]) (*connect.Response[_go.AddShoppingListEntryResponse], error)
+	// Mark an ingredient in the shopping list as bought or not.
+	UpdateShoppingListIngredient(context.Context, *connect.Request[_go.UpdateShoppingListIngredientRequest]) (*connect.Response[_go.UpdateShoppingListIngredientResponse], error)
+	// Remove an entry from the shopping list.
+	RemoveShoppingListEntry(context.Context, *connect.Request[_go.RemoveShoppingListEntryRequest]) (*connect.Response[_go.RemoveShoppingListEntryResponse], error)
+	// List the ingredients kept in the pantry.
+	ListPantryItems(context.Context, *connect.Request[_go.ListPantryItemsRequest]) (*connect.Response[_go.ListPantryItemsResponse], error)
+	// Set an ingredient kept in the pantry.
+	SetPantryItem(context.Context, *connect.Request[_go.SetPantryItemRequest]) (*connect.Response[_go.SetPantryItemResponse], error)
+	// Delete an ingredient from the pantry.
+	DeletePantryItem(context.Context, *connect.Request[_go.DeletePantryItemRequest]) (*connect.Response[_go.DeletePantryItemResponse], error)
+	// Get the settings of the user.
+	GetUserSettings(context.Context, *connect.Request[_go.GetUserSettingsRequest]) (*connect.Response[_go.GetUserSettingsResponse], error)
+	// Update the settings of the user.
+	UpdateUserSettings(context.Context, *connect.Request[_go.UpdateUserSettingsRequest]) (*connect.Response[_go.UpdateUserSettingsResponse], error)
 }
 
 // NewFrontendServiceClient constructs a client for the frontendapi.FrontendService service. By
@@ -255,18 +413,150 @@ func NewFrontendServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(frontendServiceMethods.ByName("GetPlan")),
 			connect.WithClientOptions(opts...),
 		),
+		shareablePlanLink: connect.NewClient[_go.ShareablePlanLinkRequest, _go.ShareablePlanLinkResponse](
+			httpClient,
+			baseURL+FrontendServiceShareablePlanLinkProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("ShareablePlanLink")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeShareablePlanLink: connect.NewClient[_go.RevokeShareablePlanLinkRequest, _go.RevokeShareablePlanLinkResponse](
+			httpClient,
+			baseURL+FrontendServiceRevokeShareablePlanLinkProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("RevokeShareablePlanLink")),
+			connect.WithClientOptions(opts...),
+		),
+		getSharedPlan: connect.NewClient[_go.GetSharedPlanRequest, _go.GetSharedPlanResponse](
+			httpClient,
+			baseURL+FrontendServiceGetSharedPlanProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("GetSharedPlan")),
+			connect.WithClientOptions(opts...),
+		),
+		watchPlan: connect.NewClient[_go.WatchPlanRequest, _go.WatchPlanResponse](
+			httpClient,
+			baseURL+FrontendServiceWatchPlanProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("WatchPlan")),
+			connect.WithClientOptions(opts...),
+		),
+		getPlanTimeline: connect.NewClient[_go.GetPlanTimelineRequest, _go.GetPlanTimelineResponse](
+			httpClient,
+			baseURL+FrontendServiceGetPlanTimelineProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("GetPlanTimeline")),
+			connect.WithClientOptions(opts...),
+		),
 		updatePlan: connect.NewClient[_go.UpdatePlanRequest, _go.UpdatePlanResponse](
 			httpClient,
 			baseURL+FrontendServiceUpdatePlanProcedure,
 			connect.WithSchema(frontendServiceMethods.ByName("UpdatePlan")),
 			connect.WithClientOptions(opts...),
 		),
+		suggestAlternatives: connect.NewClient[_go.SuggestAlternativesRequest, _go.SuggestAlternativesResponse](
+			httpClient,
+			baseURL+FrontendServiceSuggestAlternativesProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("SuggestAlternatives")),
+			connect.WithClientOptions(opts...),
+		),
+		replacePlanRecipe: connect.NewClient[_go.ReplacePlanRecipeRequest, _go.ReplacePlanRecipeResponse](
+			httpClient,
+			baseURL+FrontendServiceReplacePlanRecipeProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("ReplacePlanRecipe")),
+			connect.WithClientOptions(opts...),
+		),
 		deletePlan: connect.NewClient[_go.DeletePlanRequest, _go.DeletePlanResponse](
 			httpClient,
 			baseURL+FrontendServiceDeletePlanProcedure,
 			connect.WithSchema(frontendServiceMethods.ByName("DeletePlan")),
 			connect.WithClientOptions(opts...),
 		),
+		restorePlan: connect.NewClient[_go.RestorePlanRequest, _go.RestorePlanResponse](
+			httpClient,
+			baseURL+FrontendServiceRestorePlanProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("RestorePlan")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeletedPlans: connect.NewClient[_go.ListDeletedPlansRequest, _go.ListDeletedPlansResponse](
+			httpClient,
+			baseURL+FrontendServiceListDeletedPlansProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("ListDeletedPlans")),
+			connect.WithClientOptions(opts...),
+		),
+		retryPlan: connect.NewClient[_go.RetryPlanRequest, _go.RetryPlanResponse](
+			httpClient,
+			baseURL+FrontendServiceRetryPlanProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("RetryPlan")),
+			connect.WithClientOptions(opts...),
+		),
+		savePlanTemplate: connect.NewClient[_go.SavePlanTemplateRequest, _go.SavePlanTemplateResponse](
+			httpClient,
+			baseURL+FrontendServiceSavePlanTemplateProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("SavePlanTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		applyPlanTemplate: connect.NewClient[_go.ApplyPlanTemplateRequest, _go.ApplyPlanTemplateResponse](
+			httpClient,
+			baseURL+FrontendServiceApplyPlanTemplateProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("ApplyPlanTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		listIngredientPrices: connect.NewClient[_go.ListIngredientPricesRequest, _go.ListIngredientPricesResponse](
+			httpClient,
+			baseURL+FrontendServiceListIngredientPricesProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("ListIngredientPrices")),
+			connect.WithClientOptions(opts...),
+		),
+		setIngredientPrice: connect.NewClient[_go.SetIngredientPriceRequest, _go.SetIngredientPriceResponse](
+			httpClient,
+			baseURL+FrontendServiceSetIngredientPriceProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("SetIngredientPrice")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteIngredientPrice: connect.NewClient[_go.DeleteIngredientPriceRequest, _go.DeleteIngredientPriceResponse](
+			httpClient,
+			baseURL+FrontendServiceDeleteIngredientPriceProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("DeleteIngredientPrice")),
+			connect.WithClientOptions(opts...),
+		),
+		markCooked: connect.NewClient[_go.MarkCookedRequest, _go.MarkCookedResponse](
+			httpClient,
+			baseURL+FrontendServiceMarkCookedProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("MarkCooked")),
+			connect.WithClientOptions(opts...),
+		),
+		listCookingHistory: connect.NewClient[_go.ListCookingHistoryRequest, _go.ListCookingHistoryResponse](
+			httpClient,
+			baseURL+FrontendServiceListCookingHistoryProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("ListCookingHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		createHousehold: connect.NewClient[_go.CreateHouseholdRequest, _go.CreateHouseholdResponse](
+			httpClient,
+			baseURL+FrontendServiceCreateHouseholdProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("CreateHousehold")),
+			connect.WithClientOptions(opts...),
+		),
+		getHousehold: connect.NewClient[_go.GetHouseholdRequest, _go.GetHouseholdResponse](
+			httpClient,
+			baseURL+FrontendServiceGetHouseholdProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("GetHousehold")),
+			connect.WithClientOptions(opts...),
+		),
+		createHouseholdInvitation: connect.NewClient[_go.CreateHouseholdInvitationRequest, _go.CreateHouseholdInvitationResponse](
+			httpClient,
+			baseURL+FrontendServiceCreateHouseholdInvitationProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("CreateHouseholdInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptHouseholdInvitation: connect.NewClient[_go.AcceptHouseholdInvitationRequest, _go.AcceptHouseholdInvitationResponse](
+			httpClient,
+			baseURL+FrontendServiceAcceptHouseholdInvitationProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("AcceptHouseholdInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+		removeHouseholdMember: connect.NewClient[_go.RemoveHouseholdMemberRequest, _go.RemoveHouseholdMemberResponse](
+			httpClient,
+			baseURL+FrontendServiceRemoveHouseholdMemberProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("RemoveHouseholdMember")),
+			connect.WithClientOptions(opts...),
+		),
 		addBookmark: connect.NewClient[_go.AddBookmarkRequest, _go.AddBookmarkResponse](
 			httpClient,
 			baseURL+FrontendServiceAddBookmarkProcedure,
@@ -279,25 +569,110 @@ func NewFrontendServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(frontendServiceMethods.ByName("RemoveBookmark")),
 			connect.WithClientOptions(opts...),
 		),
+		getShoppingList: connect.NewClient[_go.GetShoppingListRequest, _go.GetShoppingListResponse](
+			httpClient,
+			baseURL+FrontendServiceGetShoppingListProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("GetShoppingList")),
+			connect.WithClientOptions(opts...),
+		),
+		addShoppingListEntry: connect.NewClient[_go.AddShoppingListEntryRequest, _go.AddShoppingListEntryResponse](
+			httpClient,
+			baseURL+FrontendServiceAddShoppingListEntryProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("AddShoppingListEntry")),
+			connect.WithClientOptions(opts...),
+		),
+		updateShoppingListIngredient: connect.NewClient[_go.UpdateShoppingListIngredientRequest, _go.UpdateShoppingListIngredientResponse](
+			httpClient,
+			baseURL+FrontendServiceUpdateShoppingListIngredientProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("UpdateShoppingListIngredient")),
+			connect.WithClientOptions(opts...),
+		),
+		removeShoppingListEntry: connect.NewClient[_go.RemoveShoppingListEntryRequest, _go.RemoveShoppingListEntryResponse](
+			httpClient,
+			baseURL+FrontendServiceRemoveShoppingListEntryProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("RemoveShoppingListEntry")),
+			connect.WithClientOptions(opts...),
+		),
+		listPantryItems: connect.NewClient[_go.ListPantryItemsRequest, _go.ListPantryItemsResponse](
+			httpClient,
+			baseURL+FrontendServiceListPantryItemsProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("ListPantryItems")),
+			connect.WithClientOptions(opts...),
+		),
+		setPantryItem: connect.NewClient[_go.SetPantryItemRequest, _go.SetPantryItemResponse](
+			httpClient,
+			baseURL+FrontendServiceSetPantryItemProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("SetPantryItem")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePantryItem: connect.NewClient[_go.DeletePantryItemRequest, _go.DeletePantryItemResponse](
+			httpClient,
+			baseURL+FrontendServiceDeletePantryItemProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("DeletePantryItem")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserSettings: connect.NewClient[_go.GetUserSettingsRequest, _go.GetUserSettingsResponse](
+			httpClient,
+			baseURL+FrontendServiceGetUserSettingsProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("GetUserSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		updateUserSettings: connect.NewClient[_go.UpdateUserSettingsRequest, _go.UpdateUserSettingsResponse](
+			httpClient,
+			baseURL+FrontendServiceUpdateUserSettingsProcedure,
+			connect.WithSchema(frontendServiceMethods.ByName("UpdateUserSettings")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // frontendServiceClient implements FrontendServiceClient.
 type frontendServiceClient struct {
-	getRecipe       *connect.Client[_go.GetRecipeRequest, _go.GetRecipeResponse]
-	listRecipes     *connect.Client[_go.ListRecipesRequest, _go.ListRecipesResponse]
-	startChat       *connect.Client[_go.StartChatRequest, _go.StartChatResponse]
-	addRecipe       *connect.Client[_go.AddRecipeRequest, _go.AddRecipeResponse]
-	generateRecipe  *connect.Client[_go.GenerateRecipeRequest, _go.GenerateRecipeResponse]
-	generatePlan    *connect.Client[_go.GeneratePlanRequest, _go.GeneratePlanResponse]
-	chatPlan        *connect.Client[_go.ChatPlanRequest, _go.ChatPlanResponse]
-	getChatMessages *connect.Client[_go.GetChatMessagesRequest, _go.GetChatMessagesResponse]
-	getPlans        *connect.Client[_go.GetPlansRequest, _go.GetPlansResponse]
-	getPlan         *connect.Client[_go.GetPlanRequest, _go.GetPlanResponse]
-	updatePlan      *connect.Client[_go.UpdatePlanRequest, _go.UpdatePlanResponse]
-	deletePlan      *connect.Client[_go.DeletePlanRequest, _go.DeletePlanResponse]
-	addBookmark     *connect.Client[_go.AddBookmarkRequest, _go.AddBookmarkResponse]
-	removeBookmark  *connect.Client[_go.RemoveBookmarkRequest, _go.RemoveBookmarkResponse]
+	getRecipe                    *connect.Client[_go.GetRecipeRequest, _go.GetRecipeResponse]
+	listRecipes                  *connect.Client[_go.ListRecipesRequest, _go.ListRecipesResponse]
+	startChat                    *connect.Client[_go.StartChatRequest, _go.StartChatResponse]
+	addRecipe                    *connect.Client[_go.AddRecipeRequest, _go.AddRecipeResponse]
+	generateRecipe               *connect.Client[_go.GenerateRecipeRequest, _go.GenerateRecipeResponse]
+	generatePlan                 *connect.Client[_go.GeneratePlanRequest, _go.GeneratePlanResponse]
+	chatPlan                     *connect.Client[_go.ChatPlanRequest, _go.ChatPlanResponse]
+	getChatMessages              *connect.Client[_go.GetChatMessagesRequest, _go.GetChatMessagesResponse]
+	getPlans                     *connect.Client[_go.GetPlansRequest, _go.GetPlansResponse]
+	getPlan                      *connect.Client[_go.GetPlanRequest, _go.GetPlanResponse]
+	shareablePlanLink            *connect.Client[_go.ShareablePlanLinkRequest, _go.ShareablePlanLinkResponse]
+	revokeShareablePlanLink      *connect.Client[_go.RevokeShareablePlanLinkRequest, _go.RevokeShareablePlanLinkResponse]
+	getSharedPlan                *connect.Client[_go.GetSharedPlanRequest, _go.GetSharedPlanResponse]
+	watchPlan                    *connect.Client[_go.WatchPlanRequest, _go.WatchPlanResponse]
+	getPlanTimeline              *connect.Client[_go.GetPlanTimelineRequest, _go.GetPlanTimelineResponse]
+	updatePlan                   *connect.Client[_go.UpdatePlanRequest, _go.UpdatePlanResponse]
+	suggestAlternatives          *connect.Client[_go.SuggestAlternativesRequest, _go.SuggestAlternativesResponse]
+	replacePlanRecipe            *connect.Client[_go.ReplacePlanRecipeRequest, _go.ReplacePlanRecipeResponse]
+	deletePlan                   *connect.Client[_go.DeletePlanRequest, _go.DeletePlanResponse]
+	restorePlan                  *connect.Client[_go.RestorePlanRequest, _go.RestorePlanResponse]
+	listDeletedPlans             *connect.Client[_go.ListDeletedPlansRequest, _go.ListDeletedPlansResponse]
+	retryPlan                    *connect.Client[_go.RetryPlanRequest, _go.RetryPlanResponse]
+	savePlanTemplate             *connect.Client[_go.SavePlanTemplateRequest, _go.SavePlanTemplateResponse]
+	applyPlanTemplate            *connect.Client[_go.ApplyPlanTemplateRequest, _go.ApplyPlanTemplateResponse]
+	listIngredientPrices         *connect.Client[_go.ListIngredientPricesRequest, _go.ListIngredientPricesResponse]
+	setIngredientPrice           *connect.Client[_go.SetIngredientPriceRequest, _go.SetIngredientPriceResponse]
+	deleteIngredientPrice        *connect.Client[_go.DeleteIngredientPriceRequest, _go.DeleteIngredientPriceResponse]
+	markCooked                   *connect.Client[_go.MarkCookedRequest, _go.MarkCookedResponse]
+	listCookingHistory           *connect.Client[_go.ListCookingHistoryRequest, _go.ListCookingHistoryResponse]
+	createHousehold              *connect.Client[_go.CreateHouseholdRequest, _go.CreateHouseholdResponse]
+	getHousehold                 *connect.Client[_go.GetHouseholdRequest, _go.GetHouseholdResponse]
+	createHouseholdInvitation    *connect.Client[_go.CreateHouseholdInvitationRequest, _go.CreateHouseholdInvitationResponse]
+	acceptHouseholdInvitation    *connect.Client[_go.AcceptHouseholdInvitationRequest, _go.AcceptHouseholdInvitationResponse]
+	removeHouseholdMember        *connect.Client[_go.RemoveHouseholdMemberRequest, _go.RemoveHouseholdMemberResponse]
+	addBookmark                  *connect.Client[_go.AddBookmarkRequest, _go.AddBookmarkResponse]
+	removeBookmark               *connect.Client[_go.RemoveBookmarkRequest, _go.RemoveBookmarkResponse]
+	getShoppingList              *connect.Client[_go.GetShoppingListRequest, _go.GetShoppingListResponse]
+	addShoppingListEntry         *connect.Client[_go.AddShoppingListEntryRequest, _go.AddShoppingListEntryResponse]
+	updateShoppingListIngredient *connect.Client[_go.UpdateShoppingListIngredientRequest, _go.UpdateShoppingListIngredientResponse]
+	removeShoppingListEntry      *connect.Client[_go.RemoveShoppingListEntryRequest, _go.RemoveShoppingListEntryResponse]
+	listPantryItems              *connect.Client[_go.ListPantryItemsRequest, _go.ListPantryItemsResponse]
+	setPantryItem                *connect.Client[_go.SetPantryItemRequest, _go.SetPantryItemResponse]
+	deletePantryItem             *connect.Client[_go.DeletePantryItemRequest, _go.DeletePantryItemResponse]
+	getUserSettings              *connect.Client[_go.GetUserSettingsRequest, _go.GetUserSettingsResponse]
+	updateUserSettings           *connect.Client[_go.UpdateUserSettingsRequest, _go.UpdateUserSettingsResponse]
 }
 
 // GetRecipe calls frontendapi.FrontendService.GetRecipe.
@@ -350,16 +725,126 @@ func (c *frontendServiceClient) GetPlan(ctx context.Context, req *connect.Reques
 	return c.getPlan.CallUnary(ctx, req)
 }
 
+// ShareablePlanLink calls frontendapi.FrontendService.ShareablePlanLink.
+func (c *frontendServiceClient) ShareablePlanLink(ctx context.Context, req *connect.Request[_go.ShareablePlanLinkRequest]) (*connect.Response[_go.ShareablePlanLinkResponse], error) {
+	return c.shareablePlanLink.CallUnary(ctx, req)
+}
+
+// RevokeShareablePlanLink calls frontendapi.FrontendService.RevokeShareablePlanLink.
+func (c *frontendServiceClient) RevokeShareablePlanLink(ctx context.Context, req *connect.Request[_go.RevokeShareablePlanLinkRequest]) (*connect.Response[_go.RevokeShareablePlanLinkResponse], error) {
+	return c.revokeShareablePlanLink.CallUnary(ctx, req)
+}
+
+// GetSharedPlan calls frontendapi.FrontendService.GetSharedPlan.
+func (c *frontendServiceClient) GetSharedPlan(ctx context.Context, req *connect.Request[_go.GetSharedPlanRequest]) (*connect.Response[_go.GetSharedPlanResponse], error) {
+	return c.getSharedPlan.CallUnary(ctx, req)
+}
+
+// WatchPlan calls frontendapi.FrontendService.WatchPlan.
+func (c *frontendServiceClient) WatchPlan(ctx context.Context, req *connect.Request[_go.WatchPlanRequest]) (*connect.ServerStreamForClient[_go.WatchPlanResponse], error) {
+	return c.watchPlan.CallServerStream(ctx, req)
+}
+
+// GetPlanTimeline calls frontendapi.FrontendService.GetPlanTimeline.
+func (c *frontendServiceClient) GetPlanTimeline(ctx context.Context, req *connect.Request[_go.GetPlanTimelineRequest]) (*connect.Response[_go.GetPlanTimelineResponse], error) {
+	return c.getPlanTimeline.CallUnary(ctx, req)
+}
+
 // UpdatePlan calls frontendapi.FrontendService.UpdatePlan.
 func (c *frontendServiceClient) UpdatePlan(ctx context.Context, req *connect.Request[_go.UpdatePlanRequest]) (*connect.Response[_go.UpdatePlanResponse], error) {
 	return c.updatePlan.CallUnary(ctx, req)
 }
 
+// SuggestAlternatives calls frontendapi.FrontendService.SuggestAlternatives.
+func (c *frontendServiceClient) SuggestAlternatives(ctx context.Context, req *connect.Request[_go.SuggestAlternativesRequest]) (*connect.Response[_go.SuggestAlternativesResponse], error) {
+	return c.suggestAlternatives.CallUnary(ctx, req)
+}
+
+// ReplacePlanRecipe calls frontendapi.FrontendService.ReplacePlanRecipe.
+func (c *frontendServiceClient) ReplacePlanRecipe(ctx context.Context, req *connect.Request[_go.ReplacePlanRecipeRequest]) (*connect.Response[_go.ReplacePlanRecipeResponse], error) {
+	return c.replacePlanRecipe.CallUnary(ctx, req)
+}
+
 // DeletePlan calls frontendapi.FrontendService.DeletePlan.
 func (c *frontendServiceClient) DeletePlan(ctx context.Context, req *connect.Request[_go.DeletePlanRequest]) (*connect.Response[_go.DeletePlanResponse], error) {
 	return c.deletePlan.CallUnary(ctx, req)
 }
 
+// RestorePlan calls frontendapi.FrontendService.RestorePlan.
+func (c *frontendServiceClient) RestorePlan(ctx context.Context, req *connect.Request[_go.RestorePlanRequest]) (*connect.Response[_go.RestorePlanResponse], error) {
+	return c.restorePlan.CallUnary(ctx, req)
+}
+
+// ListDeletedPlans calls frontendapi.FrontendService.ListDeletedPlans.
+func (c *frontendServiceClient) ListDeletedPlans(ctx context.Context, req *connect.Request[_go.ListDeletedPlansRequest]) (*connect.Response[_go.ListDeletedPlansResponse], error) {
+	return c.listDeletedPlans.CallUnary(ctx, req)
+}
+
+// RetryPlan calls frontendapi.FrontendService.RetryPlan.
+func (c *frontendServiceClient) RetryPlan(ctx context.Context, req *connect.Request[_go.RetryPlanRequest]) (*connect.Response[_go.RetryPlanResponse], error) {
+	return c.retryPlan.CallUnary(ctx, req)
+}
+
+// SavePlanTemplate calls frontendapi.FrontendService.SavePlanTemplate.
+func (c *frontendServiceClient) SavePlanTemplate(ctx context.Context, req *connect.Request[_go.SavePlanTemplateRequest]) (*connect.Response[_go.SavePlanTemplateResponse], error) {
+	return c.savePlanTemplate.CallUnary(ctx, req)
+}
+
+// ApplyPlanTemplate calls frontendapi.FrontendService.ApplyPlanTemplate.
+func (c *frontendServiceClient) ApplyPlanTemplate(ctx context.Context, req *connect.Request[_go.ApplyPlanTemplateRequest]) (*connect.Response[_go.ApplyPlanTemplateResponse], error) {
+	return c.applyPlanTemplate.CallUnary(ctx, req)
+}
+
+// ListIngredientPrices calls frontendapi.FrontendService.ListIngredientPrices.
+func (c *frontendServiceClient) ListIngredientPrices(ctx context.Context, req *connect.Request[_go.ListIngredientPricesRequest]) (*connect.Response[_go.ListIngredientPricesResponse], error) {
+	return c.listIngredientPrices.CallUnary(ctx, req)
+}
+
+// SetIngredientPrice calls frontendapi.FrontendService.SetIngredientPrice.
+func (c *frontendServiceClient) SetIngredientPrice(ctx context.Context, req *connect.Request[_go.SetIngredientPriceRequest]) (*connect.Response[_go.SetIngredientPriceResponse], error) {
+	return c.setIngredientPrice.CallUnary(ctx, req)
+}
+
+// DeleteIngredientPrice calls frontendapi.FrontendService.DeleteIngredientPrice.
+func (c *frontendServiceClient) DeleteIngredientPrice(ctx context.Context, req *connect.Request[_go.DeleteIngredientPriceRequest]) (*connect.Response[_go.DeleteIngredientPriceResponse], error) {
+	return c.deleteIngredientPrice.CallUnary(ctx, req)
+}
+
+// MarkCooked calls frontendapi.FrontendService.MarkCooked.
+func (c *frontendServiceClient) MarkCooked(ctx context.Context, req *connect.Request[_go.MarkCookedRequest]) (*connect.Response[_go.MarkCookedResponse], error) {
+	return c.markCooked.CallUnary(ctx, req)
+}
+
+// ListCookingHistory calls frontendapi.FrontendService.ListCookingHistory.
+func (c *frontendServiceClient) ListCookingHistory(ctx context.Context, req *connect.Request[_go.ListCookingHistoryRequest]) (*connect.Response[_go.ListCookingHistoryResponse], error) {
+	return c.listCookingHistory.CallUnary(ctx, req)
+}
+
+// CreateHousehold calls frontendapi.FrontendService.CreateHousehold.
+func (c *frontendServiceClient) CreateHousehold(ctx context.Context, req *connect.Request[_go.CreateHouseholdRequest]) (*connect.Response[_go.CreateHouseholdResponse], error) {
+	return c.createHousehold.CallUnary(ctx, req)
+}
+
+// GetHousehold calls frontendapi.FrontendService.GetHousehold.
+func (c *frontendServiceClient) GetHousehold(ctx context.Context, req *connect.Request[_go.GetHouseholdRequest]) (*connect.Response[_go.GetHouseholdResponse], error) {
+	return c.getHousehold.CallUnary(ctx, req)
+}
+
+// CreateHouseholdInvitation calls frontendapi.FrontendService.CreateHouseholdInvitation.
+func (c *frontendServiceClient) CreateHouseholdInvitation(ctx context.Context, req *connect.Request[_go.CreateHouseholdInvitationRequest]) (*connect.Response[_go.CreateHouseholdInvitationResponse], error) {
+	return c.createHouseholdInvitation.CallUnary(ctx, req)
+}
+
+// AcceptHouseholdInvitation calls frontendapi.FrontendService.AcceptHouseholdInvitation.
+func (c *frontendServiceClient) AcceptHouseholdInvitation(ctx context.Context, req *connect.Request[_go.AcceptHouseholdInvitationRequest]) (*connect.Response[_go.AcceptHouseholdInvitationResponse], error) {
+	return c.acceptHouseholdInvitation.CallUnary(ctx, req)
+}
+
+// RemoveHouseholdMember calls frontendapi.FrontendService.RemoveHouseholdMember.
+func (c *frontendServiceClient) RemoveHouseholdMember(ctx context.Context, req *connect.Request[_go.RemoveHouseholdMemberRequest]) (*connect.Response[_go.RemoveHouseholdMemberResponse], error) {
+	return c.removeHouseholdMember.CallUnary(ctx, req)
+}
+
 // AddBookmark calls frontendapi.FrontendService.AddBookmark.
 func (c *frontendServiceClient) AddBookmark(ctx context.Context, req *connect.Request[_go.AddBookmarkRequest]) (*connect.Response[_go.AddBookmarkResponse], error) {
 	return c.addBookmark.CallUnary(ctx, req)
@@ -370,6 +855,51 @@ func (c *frontendServiceClient) RemoveBookmark(ctx context.Context, req *connect
 	return c.removeBookmark.CallUnary(ctx, req)
 }
 
+// GetShoppingList calls frontendapi.FrontendService.GetShoppingList.
+func (c *frontendServiceClient) GetShoppingList(ctx context.Context, req *connect.Request[_go.GetShoppingListRequest]) (*connect.Response[_go.GetShoppingListResponse], error) {
+	return c.getShoppingList.CallUnary(ctx, req)
+}
+
+// AddShoppingListEntry calls frontendapi.FrontendService.AddShoppingListEntry.
+func (c *frontendServiceClient) AddShoppingListEntry(ctx context.Context, req *connect.Request[_go.AddShoppingListEntryRequest]) (*connect.Response[_go.AddShoppingListEntryResponse], error) {
+	return c.addShoppingListEntry.CallUnary(ctx, req)
+}
+
+// UpdateShoppingListIngredient calls frontendapi.FrontendService.UpdateShoppingListIngredient.
+func (c *frontendServiceClient) UpdateShoppingListIngredient(ctx context.Context, req *connect.Request[_go.UpdateShoppingListIngredientRequest]) (*connect.Response[_go.UpdateShoppingListIngredientResponse], error) {
+	return c.updateShoppingListIngredient.CallUnary(ctx, req)
+}
+
+// RemoveShoppingListEntry calls frontendapi.FrontendService.RemoveShoppingListEntry.
+func (c *frontendServiceClient) RemoveShoppingListEntry(ctx context.Context, req *connect.Request[_go.RemoveShoppingListEntryRequest]) (*connect.Response[_go.RemoveShoppingListEntryResponse], error) {
+	return c.removeShoppingListEntry.CallUnary(ctx, req)
+}
+
+// ListPantryItems calls frontendapi.FrontendService.ListPantryItems.
+func (c *frontendServiceClient) ListPantryItems(ctx context.Context, req *connect.Request[_go.ListPantryItemsRequest]) (*connect.Response[_go.ListPantryItemsResponse], error) {
+	return c.listPantryItems.CallUnary(ctx, req)
+}
+
+// SetPantryItem calls frontendapi.FrontendService.SetPantryItem.
+func (c *frontendServiceClient) SetPantryItem(ctx context.Context, req *connect.Request[_go.SetPantryItemRequest]) (*connect.Response[_go.SetPantryItemResponse], error) {
+	return c.setPantryItem.CallUnary(ctx, req)
+}
+
+// DeletePantryItem calls frontendapi.FrontendService.DeletePantryItem.
+func (c *frontendServiceClient) DeletePantryItem(ctx context.Context, req *connect.Request[_go.DeletePantryItemRequest]) (*connect.Response[_go.DeletePantryItemResponse], error) {
+	return c.deletePantryItem.CallUnary(ctx, req)
+}
+
+// GetUserSettings calls frontendapi.FrontendService.GetUserSettings.
+func (c *frontendServiceClient) GetUserSettings(ctx context.Context, req *connect.Request[_go.GetUserSettingsRequest]) (*connect.Response[_go.GetUserSettingsResponse], error) {
+	return c.getUserSettings.CallUnary(ctx, req)
+}
+
+// UpdateUserSettings calls frontendapi.FrontendService.UpdateUserSettings.
+func (c *frontendServiceClient) UpdateUserSettings(ctx context.Context, req *connect.Request[_go.UpdateUserSettingsRequest]) (*connect.Response[_go.UpdateUserSettingsResponse], error) {
+	return c.updateUserSettings.CallUnary(ctx, req)
+}
+
 // FrontendServiceHandler is an implementation of the frontendapi.FrontendService service.
 type FrontendServiceHandler interface {
 	// Get the recipe for a given recipe ID.
@@ -392,14 +922,79 @@ type FrontendServiceHandler interface {
 	GetPlans(context.Context, *connect.Request[_go.GetPlansRequest]) (*connect.Response[_go.GetPlansResponse], error)
 	// Get the details of a plan.
 	GetPlan(context.Context, *connect.Request[_go.GetPlanRequest]) (*connect.Response[_go.GetPlanResponse], error)
+	// Create a link giving anyone with it read-only access to a plan until it expires or
+	// is revoked.
+	ShareablePlanLink(context.Context, *connect.Request[_go.ShareablePlanLinkRequest]) (*connect.Response[_go.ShareablePlanLinkResponse], error)
+	// Revoke a link created by ShareablePlanLink.
+	RevokeShareablePlanLink(context.Context, *connect.Request[_go.RevokeShareablePlanLinkRequest]) (*connect.Response[_go.RevokeShareablePlanLinkResponse], error)
+	// Get a plan shared with a link. Does not require signing in.
+	GetSharedPlan(context.Context, *connect.Request[_go.GetSharedPlanRequest]) (*connect.Response[_go.GetSharedPlanResponse], error)
+	// Watch a plan while it is processing. A snapshot of the plan is sent when watching starts
+	// and whenever it changes, and the stream ends once the plan is no longer processing.
+	// Streams may also end early, in which case clients should watch again.
+	WatchPlan(context.Context, *connect.Request[_go.WatchPlanRequest], *connect.ServerStream[_go.WatchPlanResponse]) error
+	// Get the timeline for cooking a plan to be served at a given time.
+	GetPlanTimeline(context.Context, *connect.Request[_go.GetPlanTimelineRequest]) (*connect.Response[_go.GetPlanTimelineResponse], error)
 	// Update the recipes in a plan.
 	UpdatePlan(context.Context, *connect.Request[_go.UpdatePlanRequest]) (*connect.Response[_go.UpdatePlanResponse], error)
-	// Delete a plan.
+	// Suggest recipes to replace a recipe in a plan.
+	SuggestAlternatives(context.Context, *connect.Request[_go.SuggestAlternativesRequest]) (*connect.Response[_go.SuggestAlternativesResponse], error)
+	// Replace a recipe in a plan. The plan is processed again to update its steps.
+	ReplacePlanRecipe(context.Context, *connect.Request[_go.ReplacePlanRecipeRequest]) (*connect.Response[_go.ReplacePlanRecipeResponse], error)
+	// Move a plan to the trash. Trashed plans are permanently deleted after 30 days.
 	DeletePlan(context.Context, *connect.Request[_go.DeletePlanRequest]) (*connect.Response[_go.DeletePlanResponse], error)
+	// Restore a plan from the trash.
+	RestorePlan(context.Context, *connect.Request[_go.RestorePlanRequest]) (*connect.Response[_go.RestorePlanResponse], error)
+	// List the plans in the trash.
+	ListDeletedPlans(context.Context, *connect.Request[_go.ListDeletedPlansRequest]) (*connect.Response[_go.ListDeletedPlansResponse], error)
+	// Retry processing a failed plan.
+	RetryPlan(context.Context, *connect.Request[_go.RetryPlanRequest]) (*connect.Response[_go.RetryPlanResponse], error)
+	// Save a recurring weekly plan template.
+	SavePlanTemplate(context.Context, *connect.Request[_go.SavePlanTemplateRequest]) (*connect.Response[_go.SavePlanTemplateResponse], error)
+	// Create plans for a week from a plan template.
+	ApplyPlanTemplate(context.Context, *connect.Request[_go.ApplyPlanTemplateRequest]) (*connect.Response[_go.ApplyPlanTemplateResponse], error)
+	// List the ingredient prices used to estimate recipe costs.
+	ListIngredientPrices(context.Context, *connect.Request[_go.ListIngredientPricesRequest]) (*connect.Response[_go.ListIngredientPricesResponse], error)
+	// Set the price of an ingredient, overriding any default.
+	SetIngredientPrice(context.Context, *connect.Request[_go.SetIngredientPriceRequest]) (*connect.Response[_go.SetIngredientPriceResponse], error)
+	// Delete a price set by the user, restoring any default.
+	DeleteIngredientPrice(context.Context, *connect.Request[_go.DeleteIngredientPriceRequest]) (*connect.Response[_go.DeleteIngredientPriceResponse], error)
+	// Record that the user cooked a recipe or plan.
+	MarkCooked(context.Context, *connect.Request[_go.MarkCookedRequest]) (*connect.Response[_go.MarkCookedResponse], error)
+	// List the cooking history of the user.
+	ListCookingHistory(context.Context, *connect.Request[_go.ListCookingHistoryRequest]) (*connect.Response[_go.ListCookingHistoryResponse], error)
+	// Create a household owned by the user.
+	CreateHousehold(context.Context, *connect.Request[_go.CreateHouseholdRequest]) (*connect.Response[_go.CreateHouseholdResponse], error)
+	// Get the household of the user.
+	GetHousehold(context.Context, *connect.Request[_go.GetHouseholdRequest]) (*connect.Response[_go.GetHouseholdResponse], error)
+	// Create an invitation to join the household of the user.
+	CreateHouseholdInvitation(context.Context, *connect.Request[_go.CreateHouseholdInvitationRequest]) (*connect.Response[_go.CreateHouseholdInvitationResponse], error)
+	// Accept an invitation to join a household.
+	AcceptHouseholdInvitation(context.Context, *connect.Request[_go.AcceptHouseholdInvitationRequest]) (*connect.Response[_go.AcceptHouseholdInvitationResponse], error)
+	// Remove a member from the household of the user.
+	RemoveHouseholdMember(context.Context, *connect.Request[_go.RemoveHouseholdMemberRequest]) (*connect.Response[_go.RemoveHouseholdMemberResponse], error)
 	// Add a bookmark for a recipe.
 	AddBookmark(context.Context, *connect.Request[_go.AddBookmarkRequest]) (*connect.Response[_go.AddBookmarkResponse], error)
 	// Remove a bookmark for a recipe.
 	RemoveBookmark(context.Context, *connect.Request[_go.RemoveBookmarkRequest]) (*connect.Response[_go.RemoveBookmarkResponse], error)
+	// Get the shopping list of the user.
+	GetShoppingList(context.Context, *connect.Request[_go.GetShoppingListRequest]) (*connect.Response[_go.GetShoppingListResponse], error)
+	// Add the ingredients of a recipe or an extra item to the shopping list.
+	AddShoppingListEntry(context.Context, *connect.Request[_go.AddShoppingListEntryRequest]) (*connect.Response[_go.AddShoppingListEntryResponse], error)
+	// Mark an ingredient in the shopping list as bought or not.
+	UpdateShoppingListIngredient(context.Context, *connect.Request[_go.UpdateShoppingListIngredientRequest]) (*connect.Response[_go.UpdateShoppingListIngredientResponse], error)
+	// Remove an entry from the shopping list.
+	RemoveShoppingListEntry(context.Context, *connect.Request[_go.RemoveShoppingListEntryRequest]) (*connect.Response[_go.RemoveShoppingListEntryResponse], error)
+	// List the ingredients kept in the pantry.
+	ListPantryItems(context.Context, *connect.Request[_go.ListPantryItemsRequest]) (*connect.Response[_go.ListPantryItemsResponse], error)
+	// Set an ingredient kept in the pantry.
+	SetPantryItem(context.Context, *connect.Request[_go.SetPantryItemRequest]) (*connect.Response[_go.SetPantryItemResponse], error)
+	// Delete an ingredient from the pantry.
+	DeletePantryItem(context.Context, *connect.Request[_go.DeletePantryItemRequest]) (*connect.Response[_go.DeletePantryItemResponse], error)
+	// Get the settings of the user.
+	GetUserSettings(context.Context, *connect.Request[_go.GetUserSettingsRequest]) (*connect.Response[_go.GetUserSettingsResponse], error)
+	// Update the settings of the user.
+	UpdateUserSettings(context.Context, *connect.Request[_go.UpdateUserSettingsRequest]) (*connect.Response[_go.UpdateUserSettingsResponse], error)
 }
 
 // NewFrontendServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -469,18 +1064,150 @@ func NewFrontendServiceHandler(svc FrontendServiceHandler, opts ...connect.Handl
 		connect.WithSchema(frontendServiceMethods.ByName("GetPlan")),
 		connect.WithHandlerOptions(opts...),
 	)
+	frontendServiceShareablePlanLinkHandler := connect.NewUnaryHandler(
+		FrontendServiceShareablePlanLinkProcedure,
+		svc.ShareablePlanLink,
+		connect.WithSchema(frontendServiceMethods.ByName("ShareablePlanLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceRevokeShareablePlanLinkHandler := connect.NewUnaryHandler(
+		FrontendServiceRevokeShareablePlanLinkProcedure,
+		svc.RevokeShareablePlanLink,
+		connect.WithSchema(frontendServiceMethods.ByName("RevokeShareablePlanLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceGetSharedPlanHandler := connect.NewUnaryHandler(
+		FrontendServiceGetSharedPlanProcedure,
+		svc.GetSharedPlan,
+		connect.WithSchema(frontendServiceMethods.ByName("GetSharedPlan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceWatchPlanHandler := connect.NewServerStreamHandler(
+		FrontendServiceWatchPlanProcedure,
+		svc.WatchPlan,
+		connect.WithSchema(frontendServiceMethods.ByName("WatchPlan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceGetPlanTimelineHandler := connect.NewUnaryHandler(
+		FrontendServiceGetPlanTimelineProcedure,
+		svc.GetPlanTimeline,
+		connect.WithSchema(frontendServiceMethods.ByName("GetPlanTimeline")),
+		connect.WithHandlerOptions(opts...),
+	)
 	frontendServiceUpdatePlanHandler := connect.NewUnaryHandler(
 		FrontendServiceUpdatePlanProcedure,
 		svc.UpdatePlan,
 		connect.WithSchema(frontendServiceMethods.ByName("UpdatePlan")),
 		connect.WithHandlerOptions(opts...),
 	)
+	frontendServiceSuggestAlternativesHandler := connect.NewUnaryHandler(
+		FrontendServiceSuggestAlternativesProcedure,
+		svc.SuggestAlternatives,
+		connect.WithSchema(frontendServiceMethods.ByName("SuggestAlternatives")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceReplacePlanRecipeHandler := connect.NewUnaryHandler(
+		FrontendServiceReplacePlanRecipeProcedure,
+		svc.ReplacePlanRecipe,
+		connect.WithSchema(frontendServiceMethods.ByName("ReplacePlanRecipe")),
+		connect.WithHandlerOptions(opts...),
+	)
 	frontendServiceDeletePlanHandler := connect.NewUnaryHandler(
 		FrontendServiceDeletePlanProcedure,
 		svc.DeletePlan,
 		connect.WithSchema(frontendServiceMethods.ByName("DeletePlan")),
 		connect.WithHandlerOptions(opts...),
 	)
+	frontendServiceRestorePlanHandler := connect.NewUnaryHandler(
+		FrontendServiceRestorePlanProcedure,
+		svc.RestorePlan,
+		connect.WithSchema(frontendServiceMethods.ByName("RestorePlan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceListDeletedPlansHandler := connect.NewUnaryHandler(
+		FrontendServiceListDeletedPlansProcedure,
+		svc.ListDeletedPlans,
+		connect.WithSchema(frontendServiceMethods.ByName("ListDeletedPlans")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceRetryPlanHandler := connect.NewUnaryHandler(
+		FrontendServiceRetryPlanProcedure,
+		svc.RetryPlan,
+		connect.WithSchema(frontendServiceMethods.ByName("RetryPlan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceSavePlanTemplateHandler := connect.NewUnaryHandler(
+		FrontendServiceSavePlanTemplateProcedure,
+		svc.SavePlanTemplate,
+		connect.WithSchema(frontendServiceMethods.ByName("SavePlanTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceApplyPlanTemplateHandler := connect.NewUnaryHandler(
+		FrontendServiceApplyPlanTemplateProcedure,
+		svc.ApplyPlanTemplate,
+		connect.WithSchema(frontendServiceMethods.ByName("ApplyPlanTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceListIngredientPricesHandler := connect.NewUnaryHandler(
+		FrontendServiceListIngredientPricesProcedure,
+		svc.ListIngredientPrices,
+		connect.WithSchema(frontendServiceMethods.ByName("ListIngredientPrices")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceSetIngredientPriceHandler := connect.NewUnaryHandler(
+		FrontendServiceSetIngredientPriceProcedure,
+		svc.SetIngredientPrice,
+		connect.WithSchema(frontendServiceMethods.ByName("SetIngredientPrice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceDeleteIngredientPriceHandler := connect.NewUnaryHandler(
+		FrontendServiceDeleteIngredientPriceProcedure,
+		svc.DeleteIngredientPrice,
+		connect.WithSchema(frontendServiceMethods.ByName("DeleteIngredientPrice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceMarkCookedHandler := connect.NewUnaryHandler(
+		FrontendServiceMarkCookedProcedure,
+		svc.MarkCooked,
+		connect.WithSchema(frontendServiceMethods.ByName("MarkCooked")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceListCookingHistoryHandler := connect.NewUnaryHandler(
+		FrontendServiceListCookingHistoryProcedure,
+		svc.ListCookingHistory,
+		connect.WithSchema(frontendServiceMethods.ByName("ListCookingHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceCreateHouseholdHandler := connect.NewUnaryHandler(
+		FrontendServiceCreateHouseholdProcedure,
+		svc.CreateHousehold,
+		connect.WithSchema(frontendServiceMethods.ByName("CreateHousehold")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceGetHouseholdHandler := connect.NewUnaryHandler(
+		FrontendServiceGetHouseholdProcedure,
+		svc.GetHousehold,
+		connect.WithSchema(frontendServiceMethods.ByName("GetHousehold")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceCreateHouseholdInvitationHandler := connect.NewUnaryHandler(
+		FrontendServiceCreateHouseholdInvitationProcedure,
+		svc.CreateHouseholdInvitation,
+		connect.WithSchema(frontendServiceMethods.ByName("CreateHouseholdInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceAcceptHouseholdInvitationHandler := connect.NewUnaryHandler(
+		FrontendServiceAcceptHouseholdInvitationProcedure,
+		svc.AcceptHouseholdInvitation,
+		connect.WithSchema(frontendServiceMethods.ByName("AcceptHouseholdInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceRemoveHouseholdMemberHandler := connect.NewUnaryHandler(
+		FrontendServiceRemoveHouseholdMemberProcedure,
+		svc.RemoveHouseholdMember,
+		connect.WithSchema(frontendServiceMethods.ByName("RemoveHouseholdMember")),
+		connect.WithHandlerOptions(opts...),
+	)
 	frontendServiceAddBookmarkHandler := connect.NewUnaryHandler(
 		FrontendServiceAddBookmarkProcedure,
 		svc.AddBookmark,
@@ -493,6 +1220,60 @@ func NewFrontendServiceHandler(svc FrontendServiceHandler, opts ...connect.Handl
 		connect.WithSchema(frontendServiceMethods.ByName("RemoveBookmark")),
 		connect.WithHandlerOptions(opts...),
 	)
+	frontendServiceGetShoppingListHandler := connect.NewUnaryHandler(
+		FrontendServiceGetShoppingListProcedure,
+		svc.GetShoppingList,
+		connect.WithSchema(frontendServiceMethods.ByName("GetShoppingList")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceAddShoppingListEntryHandler := connect.NewUnaryHandler(
+		FrontendServiceAddShoppingListEntryProcedure,
+		svc.AddShoppingListEntry,
+		connect.WithSchema(frontendServiceMethods.ByName("AddShoppingListEntry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceUpdateShoppingListIngredientHandler := connect.NewUnaryHandler(
+		FrontendServiceUpdateShoppingListIngredientProcedure,
+		svc.UpdateShoppingListIngredient,
+		connect.WithSchema(frontendServiceMethods.ByName("UpdateShoppingListIngredient")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceRemoveShoppingListEntryHandler := connect.NewUnaryHandler(
+		FrontendServiceRemoveShoppingListEntryProcedure,
+		svc.RemoveShoppingListEntry,
+		connect.WithSchema(frontendServiceMethods.ByName("RemoveShoppingListEntry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceListPantryItemsHandler := connect.NewUnaryHandler(
+		FrontendServiceListPantryItemsProcedure,
+		svc.ListPantryItems,
+		connect.WithSchema(frontendServiceMethods.ByName("ListPantryItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceSetPantryItemHandler := connect.NewUnaryHandler(
+		FrontendServiceSetPantryItemProcedure,
+		svc.SetPantryItem,
+		connect.WithSchema(frontendServiceMethods.ByName("SetPantryItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceDeletePantryItemHandler := connect.NewUnaryHandler(
+		FrontendServiceDeletePantryItemProcedure,
+		svc.DeletePantryItem,
+		connect.WithSchema(frontendServiceMethods.ByName("DeletePantryItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceGetUserSettingsHandler := connect.NewUnaryHandler(
+		FrontendServiceGetUserSettingsProcedure,
+		svc.GetUserSettings,
+		connect.WithSchema(frontendServiceMethods.ByName("GetUserSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	frontendServiceUpdateUserSettingsHandler := connect.NewUnaryHandler(
+		FrontendServiceUpdateUserSettingsProcedure,
+		svc.UpdateUserSettings,
+		connect.WithSchema(frontendServiceMethods.ByName("UpdateUserSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/frontendapi.FrontendService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case FrontendServiceGetRecipeProcedure:
@@ -515,14 +1296,76 @@ func NewFrontendServiceHandler(svc FrontendServiceHandler, opts ...connect.Handl
 			frontendServiceGetPlansHandler.ServeHTTP(w, r)
 		case FrontendServiceGetPlanProcedure:
 			frontendServiceGetPlanHandler.ServeHTTP(w, r)
+		case FrontendServiceShareablePlanLinkProcedure:
+			frontendServiceShareablePlanLinkHandler.ServeHTTP(w, r)
+		case FrontendServiceRevokeShareablePlanLinkProcedure:
+			frontendServiceRevokeShareablePlanLinkHandler.ServeHTTP(w, r)
+		case FrontendServiceGetSharedPlanProcedure:
+			frontendServiceGetSharedPlanHandler.ServeHTTP(w, r)
+		case FrontendServiceWatchPlanProcedure:
+			frontendServiceWatchPlanHandler.ServeHTTP(w, r)
+		case FrontendServiceGetPlanTimelineProcedure:
+			frontendServiceGetPlanTimelineHandler.ServeHTTP(w, r)
 		case FrontendServiceUpdatePlanProcedure:
 			frontendServiceUpdatePlanHandler.ServeHTTP(w, r)
+		case FrontendServiceSuggestAlternativesProcedure:
+			frontendServiceSuggestAlternativesHandler.ServeHTTP(w, r)
+		case FrontendServiceReplacePlanRecipeProcedure:
+			frontendServiceReplacePlanRecipeHandler.ServeHTTP(w, r)
 		case FrontendServiceDeletePlanProcedure:
 			frontendServiceDeletePlanHandler.ServeHTTP(w, r)
+		case FrontendServiceRestorePlanProcedure:
+			frontendServiceRestorePlanHandler.ServeHTTP(w, r)
+		case FrontendServiceListDeletedPlansProcedure:
+			frontendServiceListDeletedPlansHandler.ServeHTTP(w, r)
+		case FrontendServiceRetryPlanProcedure:
+			frontendServiceRetryPlanHandler.ServeHTTP(w, r)
+		case FrontendServiceSavePlanTemplateProcedure:
+			frontendServiceSavePlanTemplateHandler.ServeHTTP(w, r)
+		case FrontendServiceApplyPlanTemplateProcedure:
+			frontendServiceApplyPlanTemplateHandler.ServeHTTP(w, r)
+		case FrontendServiceListIngredientPricesProcedure:
+			frontendServiceListIngredientPricesHandler.ServeHTTP(w, r)
+		case FrontendServiceSetIngredientPriceProcedure:
+			frontendServiceSetIngredientPriceHandler.ServeHTTP(w, r)
+		case FrontendServiceDeleteIngredientPriceProcedure:
+			frontendServiceDeleteIngredientPriceHandler.ServeHTTP(w, r)
+		case FrontendServiceMarkCookedProcedure:
+			frontendServiceMarkCookedHandler.ServeHTTP(w, r)
+		case FrontendServiceListCookingHistoryProcedure:
+			frontendServiceListCookingHistoryHandler.ServeHTTP(w, r)
+		case FrontendServiceCreateHouseholdProcedure:
+			frontendServiceCreateHouseholdHandler.ServeHTTP(w, r)
+		case FrontendServiceGetHouseholdProcedure:
+			frontendServiceGetHouseholdHandler.ServeHTTP(w, r)
+		case FrontendServiceCreateHouseholdInvitationProcedure:
+			frontendServiceCreateHouseholdInvitationHandler.ServeHTTP(w, r)
+		case FrontendServiceAcceptHouseholdInvitationProcedure:
+			frontendServiceAcceptHouseholdInvitationHandler.ServeHTTP(w, r)
+		case FrontendServiceRemoveHouseholdMemberProcedure:
+			frontendServiceRemoveHouseholdMemberHandler.ServeHTTP(w, r)
 		case FrontendServiceAddBookmarkProcedure:
 			frontendServiceAddBookmarkHandler.ServeHTTP(w, r)
 		case FrontendServiceRemoveBookmarkProcedure:
 			frontendServiceRemoveBookmarkHandler.ServeHTTP(w, r)
+		case FrontendServiceGetShoppingListProcedure:
+			frontendServiceGetShoppingListHandler.ServeHTTP(w, r)
+		case FrontendServiceAddShoppingListEntryProcedure:
+			frontendServiceAddShoppingListEntryHandler.ServeHTTP(w, r)
+		case FrontendServiceUpdateShoppingListIngredientProcedure:
+			frontendServiceUpdateShoppingListIngredientHandler.ServeHTTP(w, r)
+		case FrontendServiceRemoveShoppingListEntryProcedure:
+			frontendServiceRemoveShoppingListEntryHandler.ServeHTTP(w, r)
+		case FrontendServiceListPantryItemsProcedure:
+			frontendServiceListPantryItemsHandler.ServeHTTP(w, r)
+		case FrontendServiceSetPantryItemProcedure:
+			frontendServiceSetPantryItemHandler.ServeHTTP(w, r)
+		case FrontendServiceDeletePantryItemProcedure:
+			frontendServiceDeletePantryItemHandler.ServeHTTP(w, r)
+		case FrontendServiceGetUserSettingsProcedure:
+			frontendServiceGetUserSettingsHandler.ServeHTTP(w, r)
+		case FrontendServiceUpdateUserSettingsProcedure:
+			frontendServiceUpdateUserSettingsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -572,14 +1415,102 @@ func (UnimplementedFrontendServiceHandler) GetPlan(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.GetPlan is not implemented"))
 }
 
+func (UnimplementedFrontendServiceHandler) ShareablePlanLink(context.Context, *connect.Request[_go.ShareablePlanLinkRequest]) (*connect.Response[_go.ShareablePlanLinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.ShareablePlanLink is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) RevokeShareablePlanLink(context.Context, *connect.Request[_go.RevokeShareablePlanLinkRequest]) (*connect.Response[_go.RevokeShareablePlanLinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.RevokeShareablePlanLink is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) GetSharedPlan(context.Context, *connect.Request[_go.GetSharedPlanRequest]) (*connect.Response[_go.GetSharedPlanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.GetSharedPlan is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) WatchPlan(context.Context, *connect.Request[_go.WatchPlanRequest], *connect.ServerStream[_go.WatchPlanResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.WatchPlan is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) GetPlanTimeline(context.Context, *connect.Request[_go.GetPlanTimelineRequest]) (*connect.Response[_go.GetPlanTimelineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.GetPlanTimeline is not implemented"))
+}
+
 func (UnimplementedFrontendServiceHandler) UpdatePlan(context.Context, *connect.Request[_go.UpdatePlanRequest]) (*connect.Response[_go.UpdatePlanResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.UpdatePlan is not implemented"))
 }
 
+func (UnimplementedFrontendServiceHandler) SuggestAlternatives(context.Context, *connect.Request[_go.SuggestAlternativesRequest]) (*connect.Response[_go.SuggestAlternativesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.SuggestAlternatives is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) ReplacePlanRecipe(context.Context, *connect.Request[_go.ReplacePlanRecipeRequest]) (*connect.Response[_go.ReplacePlanRecipeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.ReplacePlanRecipe is not implemented"))
+}
+
 func (UnimplementedFrontendServiceHandler) DeletePlan(context.Context, *connect.Request[_go.DeletePlanRequest]) (*connect.Response[_go.DeletePlanResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.DeletePlan is not implemented"))
 }
 
+func (UnimplementedFrontendServiceHandler) RestorePlan(context.Context, *connect.Request[_go.RestorePlanRequest]) (*connect.Response[_go.RestorePlanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.RestorePlan is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) ListDeletedPlans(context.Context, *connect.Request[_go.ListDeletedPlansRequest]) (*connect.Response[_go.ListDeletedPlansResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.ListDeletedPlans is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) RetryPlan(context.Context, *connect.Request[_go.RetryPlanRequest]) (*connect.Response[_go.RetryPlanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.RetryPlan is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) SavePlanTemplate(context.Context, *connect.Request[_go.SavePlanTemplateRequest]) (*connect.Response[_go.SavePlanTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.SavePlanTemplate is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) ApplyPlanTemplate(context.Context, *connect.Request[_go.ApplyPlanTemplateRequest]) (*connect.Response[_go.ApplyPlanTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.ApplyPlanTemplate is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) ListIngredientPrices(context.Context, *connect.Request[_go.ListIngredientPricesRequest]) (*connect.Response[_go.ListIngredientPricesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.ListIngredientPrices is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) SetIngredientPrice(context.Context, *connect.Request[_go.SetIngredientPriceRequest]) (*connect.Response[_go.SetIngredientPriceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.SetIngredientPrice is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) DeleteIngredientPrice(context.Context, *connect.Request[_go.DeleteIngredientPriceRequest]) (*connect.Response[_go.DeleteIngredientPriceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.DeleteIngredientPrice is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) MarkCooked(context.Context, *connect.Request[_go.MarkCookedRequest]) (*connect.Response[_go.MarkCookedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.MarkCooked is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) ListCookingHistory(context.Context, *connect.Request[_go.ListCookingHistoryRequest]) (*connect.Response[_go.ListCookingHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.ListCookingHistory is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) CreateHousehold(context.Context, *connect.Request[_go.CreateHouseholdRequest]) (*connect.Response[_go.CreateHouseholdResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.CreateHousehold is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) GetHousehold(context.Context, *connect.Request[_go.GetHouseholdRequest]) (*connect.Response[_go.GetHouseholdResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.GetHousehold is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) CreateHouseholdInvitation(context.Context, *connect.Request[_go.CreateHouseholdInvitationRequest]) (*connect.Response[_go.CreateHouseholdInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.CreateHouseholdInvitation is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) AcceptHouseholdInvitation(context.Context, *connect.Request[_go.AcceptHouseholdInvitationRequest]) (*connect.Response[_go.AcceptHouseholdInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.AcceptHouseholdInvitation is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) RemoveHouseholdMember(context.Context, *connect.Request[_go.RemoveHouseholdMemberRequest]) (*connect.Response[_go.RemoveHouseholdMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.RemoveHouseholdMember is not implemented"))
+}
+
 func (UnimplementedFrontendServiceHandler) AddBookmark(context.Context, *connect.Request[_go.AddBookmarkRequest]) (*connect.Response[_go.AddBookmarkResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.AddBookmark is not implemented"))
 }
@@ -587,3 +1518,39 @@ func (UnimplementedFrontendServiceHandler) AddBookmark(context.Context, *connect
 func (UnimplementedFrontendServiceHandler) RemoveBookmark(context.Context, *connect.Request[_go.RemoveBookmarkRequest]) (*connect.Response[_go.RemoveBookmarkResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.RemoveBookmark is not implemented"))
 }
+
+func (UnimplementedFrontendServiceHandler) GetShoppingList(context.Context, *connect.Request[_go.GetShoppingListRequest]) (*connect.Response[_go.GetShoppingListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.GetShoppingList is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) AddShoppingListEntry(context.Context, *connect.Request[_go.AddShoppingListEntryRequest]) (*connect.Response[_go.AddShoppingListEntryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.AddShoppingListEntry is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) UpdateShoppingListIngredient(context.Context, *connect.Request[_go.UpdateShoppingListIngredientRequest]) (*connect.Response[_go.UpdateShoppingListIngredientResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.UpdateShoppingListIngredient is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) RemoveShoppingListEntry(context.Context, *connect.Request[_go.RemoveShoppingListEntryRequest]) (*connect.Response[_go.RemoveShoppingListEntryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.RemoveShoppingListEntry is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) ListPantryItems(context.Context, *connect.Request[_go.ListPantryItemsRequest]) (*connect.Response[_go.ListPantryItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.ListPantryItems is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) SetPantryItem(context.Context, *connect.Request[_go.SetPantryItemRequest]) (*connect.Response[_go.SetPantryItemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.SetPantryItem is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) DeletePantryItem(context.Context, *connect.Request[_go.DeletePantryItemRequest]) (*connect.Response[_go.DeletePantryItemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.DeletePantryItem is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) GetUserSettings(context.Context, *connect.Request[_go.GetUserSettingsRequest]) (*connect.Response[_go.GetUserSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.GetUserSettings is not implemented"))
+}
+
+func (UnimplementedFrontendServiceHandler) UpdateUserSettings(context.Context, *connect.Request[_go.UpdateUserSettingsRequest]) (*connect.Response[_go.UpdateUserSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("frontendapi.FrontendService.UpdateUserSettings is not implemented"))
+}
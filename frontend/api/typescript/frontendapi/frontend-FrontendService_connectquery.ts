@@ -74,6 +74,35 @@ export const getPlans = FrontendService.method.getPlans;
  */
 export const getPlan = FrontendService.method.getPlan;
 
+/**
+ * Create a link giving anyone with it read-only access to a plan until it expires or
+ * is revoked.
+ *
+ * @generated from rpc frontendapi.FrontendService.ShareablePlanLink
+ */
+export const shareablePlanLink = FrontendService.method.shareablePlanLink;
+
+/**
+ * Revoke a link created by ShareablePlanLink.
+ *
+ * @generated from rpc frontendapi.FrontendService.RevokeShareablePlanLink
+ */
+export const revokeShareablePlanLink = FrontendService.method.revokeShareablePlanLink;
+
+/**
+ * Get a plan shared with a link. Does not require signing in.
+ *
+ * @generated from rpc frontendapi.FrontendService.GetSharedPlan
+ */
+export const getSharedPlan = FrontendService.method.getSharedPlan;
+
+/**
+ * Get the timeline for cooking a plan to be served at a given time.
+ *
+ * @generated from rpc frontendapi.FrontendService.GetPlanTimeline
+ */
+export const getPlanTimeline = FrontendService.method.getPlanTimeline;
+
 /**
  * Update the recipes in a plan.
  *
@@ -82,12 +111,131 @@ export const getPlan = FrontendService.method.getPlan;
 export const updatePlan = FrontendService.method.updatePlan;
 
 /**
- * Delete a plan.
+ * Suggest recipes to replace a recipe in a plan.
+ *
+ * @generated from rpc frontendapi.FrontendService.SuggestAlternatives
+ */
+export const suggestAlternatives = FrontendService.method.suggestAlternatives;
+
+/**
+ * Replace a recipe in a plan. The plan is processed again to update its steps.
+ *
+ * @generated from rpc frontendapi.FrontendService.ReplacePlanRecipe
+ */
+export const replacePlanRecipe = FrontendService.method.replacePlanRecipe;
+
+/**
+ * Move a plan to the trash. Trashed plans are permanently deleted after 30 days.
  *
  * @generated from rpc frontendapi.FrontendService.DeletePlan
  */
 export const deletePlan = FrontendService.method.deletePlan;
 
+/**
+ * Restore a plan from the trash.
+ *
+ * @generated from rpc frontendapi.FrontendService.RestorePlan
+ */
+export const restorePlan = FrontendService.method.restorePlan;
+
+/**
+ * List the plans in the trash.
+ *
+ * @generated from rpc frontendapi.FrontendService.ListDeletedPlans
+ */
+export const listDeletedPlans = FrontendService.method.listDeletedPlans;
+
+/**
+ * Retry processing a failed plan.
+ *
+ * @generated from rpc frontendapi.FrontendService.RetryPlan
+ */
+export const retryPlan = FrontendService.method.retryPlan;
+
+/**
+ * Save a recurring weekly plan template.
+ *
+ * @generated from rpc frontendapi.FrontendService.SavePlanTemplate
+ */
+export const savePlanTemplate = FrontendService.method.savePlanTemplate;
+
+/**
+ * Create plans for a week from a plan template.
+ *
+ * @generated from rpc frontendapi.FrontendService.ApplyPlanTemplate
+ */
+export const applyPlanTemplate = FrontendService.method.applyPlanTemplate;
+
+/**
+ * List the ingredient prices used to estimate recipe costs.
+ *
+ * @generated from rpc frontendapi.FrontendService.ListIngredientPrices
+ */
+export const listIngredientPrices = FrontendService.method.listIngredientPrices;
+
+/**
+ * Set the price of an ingredient, overriding any default.
+ *
+ * @generated from rpc frontendapi.FrontendService.SetIngredientPrice
+ */
+export const setIngredientPrice = FrontendService.method.setIngredientPrice;
+
+/**
+ * Delete a price set by the user, restoring any default.
+ *
+ * @generated from rpc frontendapi.FrontendService.DeleteIngredientPrice
+ */
+export const deleteIngredientPrice = FrontendService.method.deleteIngredientPrice;
+
+/**
+ * Record that the user cooked a recipe or plan.
+ *
+ * @generated from rpc frontendapi.FrontendService.MarkCooked
+ */
+export const markCooked = FrontendService.method.markCooked;
+
+/**
+ * List the cooking history of the user.
+ *
+ * @generated from rpc frontendapi.FrontendService.ListCookingHistory
+ */
+export const listCookingHistory = FrontendService.method.listCookingHistory;
+
+/**
+ * Create a household owned by the user.
+ *
+ * @generated from rpc frontendapi.FrontendService.CreateHousehold
+ */
+export const createHousehold = FrontendService.method.createHousehold;
+
+/**
+ * Get the household of the user.
+ *
+ * @generated from rpc frontendapi.FrontendService.GetHousehold
+ */
+export const getHousehold = FrontendService.method.getHousehold;
+
+/**
+ * Create an invitation to join the household of the user.
+ *
+ * @generated from rpc frontendapi.FrontendService.CreateHouseholdInvitation
+ */
+export const createHouseholdInvitation = FrontendService.method.createHouseholdInvitation;
+
+/**
+ * Accept an invitation to join a household.
+ *
+ * @generated from rpc frontendapi.FrontendService.AcceptHouseholdInvitation
+ */
+export const acceptHouseholdInvitation = FrontendService.method.acceptHouseholdInvitation;
+
+/**
+ * Remove a member from the household of the user.
+ *
+ * @generated from rpc frontendapi.FrontendService.RemoveHouseholdMember
+ */
+export const removeHouseholdMember = FrontendService.method.removeHouseholdMember;
+
 /**
  * Add a bookmark for a recipe.
  *
@@ -101,3 +249,66 @@ export const addBookmark = FrontendService.method.addBookmark;
  * @generated from rpc frontendapi.FrontendService.RemoveBookmark
  */
 export const removeBookmark = FrontendService.method.removeBookmark;
+
+/**
+ * Get the shopping list of the user.
+ *
+ * @generated from rpc frontendapi.FrontendService.GetShoppingList
+ */
+export const getShoppingList = FrontendService.method.getShoppingList;
+
+/**
+ * Add the ingredients of a recipe or an extra item to the shopping list.
+ *
+ * @generated from rpc frontendapi.FrontendService.AddShoppingListEntry
+ */
+export const addShoppingListEntry = FrontendService.method.addShoppingListEntry;
+
+/**
+ * Mark an ingredient in the shopping list as bought or not.
+ *
+ * @generated from rpc frontendapi.FrontendService.UpdateShoppingListIngredient
+ */
+export const updateShoppingListIngredient = FrontendService.method.updateShoppingListIngredient;
+
+/**
+ * Remove an entry from the shopping list.
+ *
+ * @generated from rpc frontendapi.FrontendService.RemoveShoppingListEntry
+ */
+export const removeShoppingListEntry = FrontendService.method.removeShoppingListEntry;
+
+/**
+ * List the ingredients kept in the pantry.
+ *
+ * @generated from rpc frontendapi.FrontendService.ListPantryItems
+ */
+export const listPantryItems = FrontendService.method.listPantryItems;
+
+/**
+ * Set an ingredient kept in the pantry.
+ *
+ * @generated from rpc frontendapi.FrontendService.SetPantryItem
+ */
+export const setPantryItem = FrontendService.method.setPantryItem;
+
+/**
+ * Delete an ingredient from the pantry.
+ *
+ * @generated from rpc frontendapi.FrontendService.DeletePantryItem
+ */
+export const deletePantryItem = FrontendService.method.deletePantryItem;
+
+/**
+ * Get the settings of the user.
+ *
+ * @generated from rpc frontendapi.FrontendService.GetUserSettings
+ */
+export const getUserSettings = FrontendService.method.getUserSettings;
+
+/**
+ * Update the settings of the user.
+ *
+ * @generated from rpc frontendapi.FrontendService.UpdateUserSettings
+ */
+export const updateUserSettings = FrontendService.method.updateUserSettings;
@@ -5,15 +5,15 @@
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_buf_validate_validate } from "../buf/validate/validate_pb";
-import type { Timestamp } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file frontendapi/frontend.proto.
  */
 export const file_frontendapi_frontend: GenFile = /*@__PURE__*/
-  fileDesc("Chpmcm9udGVuZGFwaS9mcm9udGVuZC5wcm90bxILZnJvbnRlbmRhcGkiPAoLQ2hhdENvbnRlbnQSEQoHbWVzc2FnZRgBIAEoCUgAEg8KBWF1ZGlvGAIgASgMSABCCQoHcGF5bG9hZCJuCgtDaGF0UmVxdWVzdBIpCgdjb250ZW50GAEgASgLMhguZnJvbnRlbmRhcGkuQ2hhdENvbnRlbnQSFQoLcmVjaXBlX3RleHQYAiABKAlIABITCglyZWNpcGVfaWQYAyABKAlIAEIICgZyZWNpcGUiOQoMQ2hhdFJlc3BvbnNlEikKB2NvbnRlbnQYASABKAsyGC5mcm9udGVuZGFwaS5DaGF0Q29udGVudCIyChBSZWNpcGVJbmdyZWRpZW50EgwKBG5hbWUYASABKAkSEAoIcXVhbnRpdHkYAiABKAkiNAoKUmVjaXBlU3RlcBITCgtkZXNjcmlwdGlvbhgBIAEoCRIRCglpbWFnZV91cmwYAiABKAkiVgoRSW5ncmVkaWVudFNlY3Rpb24SDQoFdGl0bGUYASABKAkSMgoLaW5ncmVkaWVudHMYAiADKAsyHS5mcm9udGVuZGFwaS5SZWNpcGVJbmdyZWRpZW50IosDCgZSZWNpcGUSCgoCaWQYASABKAkSKQoGc291cmNlGAIgASgOMhkuZnJvbnRlbmRhcGkuUmVjaXBlU291cmNlEikKBnN0YXR1cxgDIAEoDjIZLmZyb250ZW5kYXBpLlJlY2lwZVN0YXR1cxINCgV0aXRsZRgEIAEoCRIRCglpbWFnZV91cmwYBSABKAkSEwoLZGVzY3JpcHRpb24YBiABKAkSMgoLaW5ncmVkaWVudHMYByADKAsyHS5mcm9udGVuZGFwaS5SZWNpcGVJbmdyZWRpZW50Ej4KFmFkZGl0aW9uYWxfaW5ncmVkaWVudHMYCCADKAsyHi5mcm9udGVuZGFwaS5JbmdyZWRpZW50U2VjdGlvbhImCgVzdGVwcxgJIAMoCzIXLmZyb250ZW5kYXBpLlJlY2lwZVN0ZXASDQoFbm90ZXMYCiABKAkSFAoMc2VydmluZ19zaXplGAsgASgJEicKCGxhbmd1YWdlGAwgASgOMhUuZnJvbnRlbmRhcGkuTGFuZ3VhZ2UiJQoQR2V0UmVjaXBlUmVxdWVzdBIRCglyZWNpcGVfaWQYASABKAkiYwoRR2V0UmVjaXBlUmVzcG9uc2USIwoGcmVjaXBlGAEgASgLMhMuZnJvbnRlbmRhcGkuUmVjaXBlEhIKCmxsbV9wcm9tcHQYAiABKAkSFQoNaXNfYm9va21hcmtlZBgDIAEoCCI7CgpQYWdpbmF0aW9uEg8KB2xhc3RfaWQYASABKAkSHAoUbGFzdF90aW1lc3RhbXBfbmFub3MYAiABKAMiTgoNUmVjaXBlU25pcHBldBIKCgJpZBgBIAEoCRINCgV0aXRsZRgCIAEoCRIPCgdzdW1tYXJ5GAMgASgJEhEKCWltYWdlX3VybBgEIAEoCSJjChJMaXN0UmVjaXBlc1JlcXVlc3QSDQoFcXVlcnkYASABKAkSEQoJYm9va21hcmtzGAMgASgIEisKCnBhZ2luYXRpb24YAiABKAsyFy5mcm9udGVuZGFwaS5QYWdpbmF0aW9uIm8KE0xpc3RSZWNpcGVzUmVzcG9uc2USKwoHcmVjaXBlcxgBIAMoCzIaLmZyb250ZW5kYXBpLlJlY2lwZVNuaXBwZXQSKwoKcGFnaW5hdGlvbhgCIAEoCzIXLmZyb250ZW5kYXBpLlBhZ2luYXRpb24isAIKEFN0YXJ0Q2hhdFJlcXVlc3QSFQoLcmVjaXBlX3RleHQYAiABKAlIABITCglyZWNpcGVfaWQYAyABKAlIABIRCgdwbGFuX2lkGAYgASgJSAASQwoObW9kZWxfcHJvdmlkZXIYBCABKA4yKy5mcm9udGVuZGFwaS5TdGFydENoYXRSZXF1ZXN0Lk1vZGVsUHJvdmlkZXISEgoKbGxtX3Byb21wdBgFIAEoCRINCgVtb2RlbBgHIAEoCSJrCg1Nb2RlbFByb3ZpZGVyEh4KGk1PREVMX1BST1ZJREVSX1VOU1BFQ0lGSUVEEAASHwobTU9ERUxfUFJPVklERVJfR09PR0xFX0dFTkFJEAESGQoVTU9ERUxfUFJPVklERVJfT1BFTkFJEAJCCAoGcmVjaXBlIm8KEVN0YXJ0Q2hhdFJlc3BvbnNlEhQKDGNoYXRfYXBpX2tleRgBIAEoCRISCgpjaGF0X21vZGVsGAIgASgJEhkKEWNoYXRfaW5zdHJ1Y3Rpb25zGAMgASgJEhUKDXN0YXJ0X21lc3NhZ2UYBCABKAkigAMKEEFkZFJlY2lwZVJlcXVlc3QSDQoFdGl0bGUYASABKAkSGwoTbWFpbl9pbWFnZV9kYXRhX3VybBgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIyCgtpbmdyZWRpZW50cxgEIAMoCzIdLmZyb250ZW5kYXBpLlJlY2lwZUluZ3JlZGllbnQSPgoWYWRkaXRpb25hbF9pbmdyZWRpZW50cxgFIAMoCzIeLmZyb250ZW5kYXBpLkluZ3JlZGllbnRTZWN0aW9uEjoKBXN0ZXBzGAYgAygLMisuZnJvbnRlbmRhcGkuQWRkUmVjaXBlUmVxdWVzdC5BZGRSZWNpcGVTdGVwEhQKDHNlcnZpbmdfc2l6ZRgHIAEoCRInCghsYW5ndWFnZRgIIAEoDjIVLmZyb250ZW5kYXBpLkxhbmd1YWdlGjwKDUFkZFJlY2lwZVN0ZXASEwoLZGVzY3JpcHRpb24YASABKAkSFgoOaW1hZ2VfZGF0YV91cmwYAiABKAkiJgoRQWRkUmVjaXBlUmVzcG9uc2USEQoJcmVjaXBlX2lkGAEgASgJIicKFUdlbmVyYXRlUmVjaXBlUmVxdWVzdBIOCgZwcm9tcHQYASABKAkiUwoWR2VuZXJhdGVSZWNpcGVSZXNwb25zZRI5ChJhZGRfcmVjaXBlX3JlcXVlc3QYASABKAsyHS5mcm9udGVuZGFwaS5BZGRSZWNpcGVSZXF1ZXN0InoKE0dlbmVyYXRlUGxhblJlcXVlc3QSEAoIbnVtX2RheXMYASABKA0SEwoLaW5ncmVkaWVudHMYAiADKAkSKAoGZ2VucmVzGAMgAygOMhguZnJvbnRlbmRhcGkuUmVjaXBlR2VucmUSEgoKcmVjaXBlX2lkcxgEIAMoCSIWChRHZW5lcmF0ZVBsYW5SZXNwb25zZSJQCglTdGVwR3JvdXASDQoFbGFiZWwYASABKAkSJgoFc3RlcHMYAiADKAsyFy5mcm9udGVuZGFwaS5SZWNpcGVTdGVwEgwKBG5vdGUYAyABKAkieAoLUGxhblNuaXBwZXQSCgoCaWQYASABKAkSMAoEZGF0ZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARIrCgdyZWNpcGVzGAMgAygLMhouZnJvbnRlbmRhcGkuUmVjaXBlU25pcHBldCJjCg9HZXRQbGFuc1JlcXVlc3QSNgoKc3RhcnRfZGF0ZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARIYCghudW1fZGF5cxgCIAEoDUIGukgDyAEBIjsKEEdldFBsYW5zUmVzcG9uc2USJwoFcGxhbnMYASADKAsyGC5mcm9udGVuZGFwaS5QbGFuU25pcHBldCLwAQoEUGxhbhIKCgJpZBgBIAEoCRInCgZzdGF0dXMYAiABKA4yFy5mcm9udGVuZGFwaS5QbGFuU3RhdHVzEisKB3JlY2lwZXMYAyADKAsyGi5mcm9udGVuZGFwaS5SZWNpcGVTbmlwcGV0EisKC3N0ZXBfZ3JvdXBzGAQgAygLMhYuZnJvbnRlbmRhcGkuU3RlcEdyb3VwEg0KBW5vdGVzGAUgAygJEjMKC2luZ3JlZGllbnRzGAYgAygLMh4uZnJvbnRlbmRhcGkuSW5ncmVkaWVudFNlY3Rpb24SFQoNc2VydmluZ19zaXplcxgHIAMoCSIhCg5HZXRQbGFuUmVxdWVzdBIPCgdwbGFuX2lkGAEgASgJIk4KD0dldFBsYW5SZXNwb25zZRInCgRwbGFuGAEgASgLMhEuZnJvbnRlbmRhcGkuUGxhbkIGukgDyAEBEhIKCmxsbV9wcm9tcHQYAiABKAkiOAoRVXBkYXRlUGxhblJlcXVlc3QSDwoHcGxhbl9pZBgBIAEoCRISCgpyZWNpcGVfaWRzGAIgAygJIj0KElVwZGF0ZVBsYW5SZXNwb25zZRInCgRwbGFuGAEgASgLMhEuZnJvbnRlbmRhcGkuUGxhbkIGukgDyAEBIiQKEURlbGV0ZVBsYW5SZXF1ZXN0Eg8KB3BsYW5faWQYASABKAkiFAoSRGVsZXRlUGxhblJlc3BvbnNlIicKEkFkZEJvb2ttYXJrUmVxdWVzdBIRCglyZWNpcGVfaWQYASABKAkiFQoTQWRkQm9va21hcmtSZXNwb25zZSIqChVSZW1vdmVCb29rbWFya1JlcXVlc3QSEQoJcmVjaXBlX2lkGAEgASgJIhgKFlJlbW92ZUJvb2ttYXJrUmVzcG9uc2UirgEKC0NoYXRNZXNzYWdlEg8KB2NvbnRlbnQYASABKAkSKwoEcm9sZRgCIAEoDjIdLmZyb250ZW5kYXBpLkNoYXRNZXNzYWdlLlJvbGUSDAoEdXJscxgDIAMoCRISCgppbWFnZV91cmxzGAQgAygJIj8KBFJvbGUSFAoQUk9MRV9VTlNQRUNJRklFRBAAEg0KCVJPTEVfVVNFUhABEhIKDlJPTEVfQVNTSVNUQU5UEAIiWQoPQ2hhdFBsYW5SZXF1ZXN0Eg8KB2NoYXRfaWQYASABKAkSEAoIbmV3X2NoYXQYAiABKAgSDwoHbWVzc2FnZRgDIAEoCRISCgppbWFnZV91cmxzGAQgAygJImAKEENoYXRQbGFuUmVzcG9uc2USDwoHY2hhdF9pZBgBIAEoCRIqCghtZXNzYWdlcxgCIAMoCzIYLmZyb250ZW5kYXBpLkNoYXRNZXNzYWdlEg8KB3BsYW5faWQYAyABKAkiGAoWR2V0Q2hhdE1lc3NhZ2VzUmVxdWVzdCJnChdHZXRDaGF0TWVzc2FnZXNSZXNwb25zZRIPCgdjaGF0X2lkGAEgASgJEioKCG1lc3NhZ2VzGAIgAygLMhguZnJvbnRlbmRhcGkuQ2hhdE1lc3NhZ2USDwoHcGxhbl9pZBgDIAEoCSpRCghMYW5ndWFnZRIYChRMQU5HVUFHRV9VTlNQRUNJRklFRBAAEhQKEExBTkdVQUdFX0VOR0xJU0gQARIVChFMQU5HVUFHRV9KQVBBTkVTRRACKsYBCgtSZWNpcGVHZW5yZRIcChhSRUNJUEVfR0VOUkVfVU5TUEVDSUZJRUQQABIZChVSRUNJUEVfR0VOUkVfSkFQQU5FU0UQARIYChRSRUNJUEVfR0VOUkVfQ0hJTkVTRRACEhgKFFJFQ0lQRV9HRU5SRV9XRVNURVJOEAMSFwoTUkVDSVBFX0dFTlJFX0tPUkVBThAEEhgKFFJFQ0lQRV9HRU5SRV9JVEFMSUFOEAUSFwoTUkVDSVBFX0dFTlJFX0VUSE5JQxAGKokBCgxSZWNpcGVTb3VyY2USHQoZUkVDSVBFX1NPVVJDRV9VTlNQRUNJRklFRBAAEhkKFVJFQ0lQRV9TT1VSQ0VfQ09PS1BBRBABEh0KGVJFQ0lQRV9TT1VSQ0VfT1JBTkdFX1BBR0UQAhIgChxSRUNJUEVfU09VUkNFX0RFTElTSF9LSVRDSEVOEAMqZQoMUmVjaXBlU3RhdHVzEh0KGVJFQ0lQRV9TVEFUVVNfVU5TUEVDSUZJRUQQABIcChhSRUNJUEVfU1RBVFVTX1BST0NFU1NJTkcQARIYChRSRUNJUEVfU1RBVFVTX0FDVElWRRACKl0KClBsYW5TdGF0dXMSGwoXUExBTl9TVEFUVVNfVU5TUEVDSUZJRUQQABIaChZQTEFOX1NUQVRVU19QUk9DRVNTSU5HEAESFgoSUExBTl9TVEFUVVNfQUNUSVZFEAIyTgoLQ2hhdFNlcnZpY2USPwoEQ2hhdBIYLmZyb250ZW5kYXBpLkNoYXRSZXF1ZXN0GhkuZnJvbnRlbmRhcGkuQ2hhdFJlc3BvbnNlKAEwATL4CAoPRnJvbnRlbmRTZXJ2aWNlEkoKCUdldFJlY2lwZRIdLmZyb250ZW5kYXBpLkdldFJlY2lwZVJlcXVlc3QaHi5mcm9udGVuZGFwaS5HZXRSZWNpcGVSZXNwb25zZRJQCgtMaXN0UmVjaXBlcxIfLmZyb250ZW5kYXBpLkxpc3RSZWNpcGVzUmVxdWVzdBogLmZyb250ZW5kYXBpLkxpc3RSZWNpcGVzUmVzcG9uc2USSgoJU3RhcnRDaGF0Eh0uZnJvbnRlbmRhcGkuU3RhcnRDaGF0UmVxdWVzdBoeLmZyb250ZW5kYXBpLlN0YXJ0Q2hhdFJlc3BvbnNlEkoKCUFkZFJlY2lwZRIdLmZyb250ZW5kYXBpLkFkZFJlY2lwZVJlcXVlc3QaHi5mcm9udGVuZGFwaS5BZGRSZWNpcGVSZXNwb25zZRJZCg5HZW5lcmF0ZVJlY2lwZRIiLmZyb250ZW5kYXBpLkdlbmVyYXRlUmVjaXBlUmVxdWVzdBojLmZyb250ZW5kYXBpLkdlbmVyYXRlUmVjaXBlUmVzcG9uc2USUwoMR2VuZXJhdGVQbGFuEiAuZnJvbnRlbmRhcGkuR2VuZXJhdGVQbGFuUmVxdWVzdBohLmZyb250ZW5kYXBpLkdlbmVyYXRlUGxhblJlc3BvbnNlEkcKCENoYXRQbGFuEhwuZnJvbnRlbmRhcGkuQ2hhdFBsYW5SZXF1ZXN0Gh0uZnJvbnRlbmRhcGkuQ2hhdFBsYW5SZXNwb25zZRJcCg9HZXRDaGF0TWVzc2FnZXMSIy5mcm9udGVuZGFwaS5HZXRDaGF0TWVzc2FnZXNSZXF1ZXN0GiQuZnJvbnRlbmRhcGkuR2V0Q2hhdE1lc3NhZ2VzUmVzcG9uc2USRwoIR2V0UGxhbnMSHC5mcm9udGVuZGFwaS5HZXRQbGFuc1JlcXVlc3QaHS5mcm9udGVuZGFwaS5HZXRQbGFuc1Jlc3BvbnNlEkQKB0dldFBsYW4SGy5mcm9udGVuZGFwaS5HZXRQbGFuUmVxdWVzdBocLmZyb250ZW5kYXBpLkdldFBsYW5SZXNwb25zZRJNCgpVcGRhdGVQbGFuEh4uZnJvbnRlbmRhcGkuVXBkYXRlUGxhblJlcXVlc3QaHy5mcm9udGVuZGFwaS5VcGRhdGVQbGFuUmVzcG9uc2USTQoKRGVsZXRlUGxhbhIeLmZyb250ZW5kYXBpLkRlbGV0ZVBsYW5SZXF1ZXN0Gh8uZnJvbnRlbmRhcGkuRGVsZXRlUGxhblJlc3BvbnNlElAKC0FkZEJvb2ttYXJrEh8uZnJvbnRlbmRhcGkuQWRkQm9va21hcmtSZXF1ZXN0GiAuZnJvbnRlbmRhcGkuQWRkQm9va21hcmtSZXNwb25zZRJZCg5SZW1vdmVCb29rbWFyaxIiLmZyb250ZW5kYXBpLlJlbW92ZUJvb2ttYXJrUmVxdWVzdBojLmZyb250ZW5kYXBpLlJlbW92ZUJvb2ttYXJrUmVzcG9uc2VCPVo7Z2l0aHViLmNvbS9jdXJpb3N3aXRjaC9jb29rY2hhdC9mcm9udGVuZC9hcGkvZ287ZnJvbnRlbmRhcGliBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+  fileDesc("Chpmcm9udGVuZGFwaS9mcm9udGVuZC5wcm90bxILZnJvbnRlbmRhcGkiPAoLQ2hhdENvbnRlbnQSEQoHbWVzc2FnZRgBIAEoCUgAEg8KBWF1ZGlvGAIgASgMSABCCQoHcGF5bG9hZCJuCgtDaGF0UmVxdWVzdBIpCgdjb250ZW50GAEgASgLMhguZnJvbnRlbmRhcGkuQ2hhdENvbnRlbnQSFQoLcmVjaXBlX3RleHQYAiABKAlIABITCglyZWNpcGVfaWQYAyABKAlIAEIICgZyZWNpcGUiOQoMQ2hhdFJlc3BvbnNlEikKB2NvbnRlbnQYASABKAsyGC5mcm9udGVuZGFwaS5DaGF0Q29udGVudCIyChBSZWNpcGVJbmdyZWRpZW50EgwKBG5hbWUYASABKAkSEAoIcXVhbnRpdHkYAiABKAkiNAoKUmVjaXBlU3RlcBITCgtkZXNjcmlwdGlvbhgBIAEoCRIRCglpbWFnZV91cmwYAiABKAkiVgoRSW5ncmVkaWVudFNlY3Rpb24SDQoFdGl0bGUYASABKAkSMgoLaW5ncmVkaWVudHMYAiADKAsyHS5mcm9udGVuZGFwaS5SZWNpcGVJbmdyZWRpZW50IqMDCgZSZWNpcGUSCgoCaWQYASABKAkSKQoGc291cmNlGAIgASgOMhkuZnJvbnRlbmRhcGkuUmVjaXBlU291cmNlEikKBnN0YXR1cxgDIAEoDjIZLmZyb250ZW5kYXBpLlJlY2lwZVN0YXR1cxINCgV0aXRsZRgEIAEoCRIRCglpbWFnZV91cmwYBSABKAkSEwoLZGVzY3JpcHRpb24YBiABKAkSMgoLaW5ncmVkaWVudHMYByADKAsyHS5mcm9udGVuZGFwaS5SZWNpcGVJbmdyZWRpZW50Ej4KFmFkZGl0aW9uYWxfaW5ncmVkaWVudHMYCCADKAsyHi5mcm9udGVuZGFwaS5JbmdyZWRpZW50U2VjdGlvbhImCgVzdGVwcxgJIAMoCzIXLmZyb250ZW5kYXBpLlJlY2lwZVN0ZXASDQoFbm90ZXMYCiABKAkSFAoMc2VydmluZ19zaXplGAsgASgJEicKCGxhbmd1YWdlGAwgASgOMhUuZnJvbnRlbmRhcGkuTGFuZ3VhZ2USFgoOZmFpbHVyZV9yZWFzb24YDSABKAkiJQoQR2V0UmVjaXBlUmVxdWVzdBIRCglyZWNpcGVfaWQYASABKAkiYwoRR2V0UmVjaXBlUmVzcG9uc2USIwoGcmVjaXBlGAEgASgLMhMuZnJvbnRlbmRhcGkuUmVjaXBlEhIKCmxsbV9wcm9tcHQYAiABKAkSFQoNaXNfYm9va21hcmtlZBgDIAEoCCI7CgpQYWdpbmF0aW9uEg8KB2xhc3RfaWQYASABKAkSHAoUbGFzdF90aW1lc3RhbXBfbmFub3MYAiABKAMieQoNUmVjaXBlU25pcHBldBIKCgJpZBgBIAEoCRINCgV0aXRsZRgCIAEoCRIPCgdzdW1tYXJ5GAMgASgJEhEKCWltYWdlX3VybBgEIAEoCRIpCgZzdGF0dXMYBSABKA4yGS5mcm9udGVuZGFwaS5SZWNpcGVTdGF0dXMiYwoSTGlzdFJlY2lwZXNSZXF1ZXN0Eg0KBXF1ZXJ5GAEgASgJEhEKCWJvb2ttYXJrcxgDIAEoCBIrCgpwYWdpbmF0aW9uGAIgASgLMhcuZnJvbnRlbmRhcGkuUGFnaW5hdGlvbiJvChNMaXN0UmVjaXBlc1Jlc3BvbnNlEisKB3JlY2lwZXMYASADKAsyGi5mcm9udGVuZGFwaS5SZWNpcGVTbmlwcGV0EisKCnBhZ2luYXRpb24YAiABKAsyFy5mcm9udGVuZGFwaS5QYWdpbmF0aW9uIrACChBTdGFydENoYXRSZXF1ZXN0EhUKC3JlY2lwZV90ZXh0GAIgASgJSAASEwoJcmVjaXBlX2lkGAMgASgJSAASEQoHcGxhbl9pZBgGIAEoCUgAEkMKDm1vZGVsX3Byb3ZpZGVyGAQgASgOMisuZnJvbnRlbmRhcGkuU3RhcnRDaGF0UmVxdWVzdC5Nb2RlbFByb3ZpZGVyEhIKCmxsbV9wcm9tcHQYBSABKAkSDQoFbW9kZWwYByABKAkiawoNTW9kZWxQcm92aWRlchIeChpNT0RFTF9QUk9WSURFUl9VTlNQRUNJRklFRBAAEh8KG01PREVMX1BST1ZJREVSX0dPT0dMRV9HRU5BSRABEhkKFU1PREVMX1BST1ZJREVSX09QRU5BSRACQggKBnJlY2lwZSJvChFTdGFydENoYXRSZXNwb25zZRIUCgxjaGF0X2FwaV9rZXkYASABKAkSEgoKY2hhdF9tb2RlbBgCIAEoCRIZChFjaGF0X2luc3RydWN0aW9ucxgDIAEoCRIVCg1zdGFydF9tZXNzYWdlGAQgASgJIoADChBBZGRSZWNpcGVSZXF1ZXN0Eg0KBXRpdGxlGAEgASgJEhsKE21haW5faW1hZ2VfZGF0YV91cmwYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSMgoLaW5ncmVkaWVudHMYBCADKAsyHS5mcm9udGVuZGFwaS5SZWNpcGVJbmdyZWRpZW50Ej4KFmFkZGl0aW9uYWxfaW5ncmVkaWVudHMYBSADKAsyHi5mcm9udGVuZGFwaS5JbmdyZWRpZW50U2VjdGlvbhI6CgVzdGVwcxgGIAMoCzIrLmZyb250ZW5kYXBpLkFkZFJlY2lwZVJlcXVlc3QuQWRkUmVjaXBlU3RlcBIUCgxzZXJ2aW5nX3NpemUYByABKAkSJwoIbGFuZ3VhZ2UYCCABKA4yFS5mcm9udGVuZGFwaS5MYW5ndWFnZRo8Cg1BZGRSZWNpcGVTdGVwEhMKC2Rlc2NyaXB0aW9uGAEgASgJEhYKDmltYWdlX2RhdGFfdXJsGAIgASgJIiYKEUFkZFJlY2lwZVJlc3BvbnNlEhEKCXJlY2lwZV9pZBgBIAEoCSInChVHZW5lcmF0ZVJlY2lwZVJlcXVlc3QSDgoGcHJvbXB0GAEgASgJIlMKFkdlbmVyYXRlUmVjaXBlUmVzcG9uc2USOQoSYWRkX3JlY2lwZV9yZXF1ZXN0GAEgASgLMh0uZnJvbnRlbmRhcGkuQWRkUmVjaXBlUmVxdWVzdCLXAQoTR2VuZXJhdGVQbGFuUmVxdWVzdBIQCghudW1fZGF5cxgBIAEoDRITCgtpbmdyZWRpZW50cxgCIAMoCRIoCgZnZW5yZXMYAyADKA4yGC5mcm9udGVuZGFwaS5SZWNpcGVHZW5yZRISCgpyZWNpcGVfaWRzGAQgAygJEhUKDWJhdGNoX2Nvb2tpbmcYBSABKAgSFQoNd2Vla2x5X2J1ZGdldBgGIAEoDRItCglnZW5lcmF0b3IYByABKA4yGi5mcm9udGVuZGFwaS5QbGFuR2VuZXJhdG9yIhYKFEdlbmVyYXRlUGxhblJlc3BvbnNlIlAKCVN0ZXBHcm91cBINCgVsYWJlbBgBIAEoCRImCgVzdGVwcxgCIAMoCzIXLmZyb250ZW5kYXBpLlJlY2lwZVN0ZXASDAoEbm90ZRgDIAEoCSLaAQoLUGxhblNuaXBwZXQSCgoCaWQYASABKAkSMAoEZGF0ZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARIrCgdyZWNpcGVzGAMgAygLMhouZnJvbnRlbmRhcGkuUmVjaXBlU25pcHBldBIjCgR0eXBlGAQgASgOMhUuZnJvbnRlbmRhcGkuUGxhblR5cGUSJwoGc3RhdHVzGAUgASgOMhcuZnJvbnRlbmRhcGkuUGxhblN0YXR1cxISCgpsb2NhbF9kYXRlGAYgASgJImMKD0dldFBsYW5zUmVxdWVzdBI2CgpzdGFydF9kYXRlGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBEhgKCG51bV9kYXlzGAIgASgNQga6SAPIAQEiOwoQR2V0UGxhbnNSZXNwb25zZRInCgVwbGFucxgBIAMoCzIYLmZyb250ZW5kYXBpLlBsYW5TbmlwcGV0IrcECgRQbGFuEgoKAmlkGAEgASgJEicKBnN0YXR1cxgCIAEoDjIXLmZyb250ZW5kYXBpLlBsYW5TdGF0dXMSKwoHcmVjaXBlcxgDIAMoCzIaLmZyb250ZW5kYXBpLlJlY2lwZVNuaXBwZXQSKwoLc3RlcF9ncm91cHMYBCADKAsyFi5mcm9udGVuZGFwaS5TdGVwR3JvdXASDQoFbm90ZXMYBSADKAkSMwoLaW5ncmVkaWVudHMYBiADKAsyHi5mcm9udGVuZGFwaS5JbmdyZWRpZW50U2VjdGlvbhIVCg1zZXJ2aW5nX3NpemVzGAcgAygJEiMKBHR5cGUYCCABKA4yFS5mcm9udGVuZGFwaS5QbGFuVHlwZRIsCgxiYXRjaF9kaXNoZXMYCSADKAsyFi5mcm9udGVuZGFwaS5CYXRjaERpc2gSFQoNYmF0Y2hfcGxhbl9pZBgKIAEoCRIWCg5lc3RpbWF0ZWRfY29zdBgLIAEoDRIUCgxyZWNpcGVfY29zdHMYDCADKA0SFgoOZmFpbHVyZV9yZWFzb24YDSABKAkSEAoIYXR0ZW1wdHMYDiABKA0SEgoKbG9jYWxfZGF0ZRgPIAEoCRIRCgl0aW1lX3pvbmUYECABKAkSKwoIcHJvZ3Jlc3MYESADKAsyGS5mcm9udGVuZGFwaS5QbGFuUHJvZ3Jlc3MSLwoLdXBkYXRlX3RpbWUYEiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIs8BCgxQbGFuUHJvZ3Jlc3MSLQoFc3RhZ2UYASABKA4yHi5mcm9udGVuZGFwaS5QbGFuUHJvZ3Jlc3NTdGFnZRIRCglyZWNpcGVfaWQYAiABKAkSDAoEZG9uZRgDIAEoDRINCgV0b3RhbBgEIAEoDRIuCgpzdGFydGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxjb21wbGV0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wInAKCUJhdGNoRGlzaBIRCglyZWNpcGVfaWQYASABKAkSEAoIc2VydmluZ3MYAiABKA0SDwoHc3RvcmFnZRgDIAEoCRIUCgxzdG9yYWdlX2RheXMYBCABKA0SFwoPcmVoZWF0aW5nX25vdGVzGAUgASgJIioKDkdldFBsYW5SZXF1ZXN0EhgKB3BsYW5faWQYASABKAlCB7pIBHICEAEiTgoPR2V0UGxhblJlc3BvbnNlEicKBHBsYW4YASABKAsyES5mcm9udGVuZGFwaS5QbGFuQga6SAPIAQESEgoKbGxtX3Byb21wdBgCIAEoCSIsChBXYXRjaFBsYW5SZXF1ZXN0EhgKB3BsYW5faWQYASABKAlCB7pIBHICEAEiNAoRV2F0Y2hQbGFuUmVzcG9uc2USHwoEcGxhbhgBIAEoCzIRLmZyb250ZW5kYXBpLlBsYW4iTwoYU2hhcmVhYmxlUGxhbkxpbmtSZXF1ZXN0EhgKB3BsYW5faWQYASABKAlCB7pIBHICEAESGQoIdHRsX2RheXMYAiABKA1CB7pIBCoCGB4iWgoZU2hhcmVhYmxlUGxhbkxpbmtSZXNwb25zZRINCgV0b2tlbhgBIAEoCRIuCgpleHBpcmVzX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI4Ch5SZXZva2VTaGFyZWFibGVQbGFuTGlua1JlcXVlc3QSFgoFdG9rZW4YASABKAlCB7pIBHICEAEiIQofUmV2b2tlU2hhcmVhYmxlUGxhbkxpbmtSZXNwb25zZSIuChRHZXRTaGFyZWRQbGFuUmVxdWVzdBIWCgV0b2tlbhgBIAEoCUIHukgEcgIQASI4ChVHZXRTaGFyZWRQbGFuUmVzcG9uc2USHwoEcGxhbhgBIAEoCzIRLmZyb250ZW5kYXBpLlBsYW4iewoWR2V0UGxhblRpbWVsaW5lUmVxdWVzdBIYCgdwbGFuX2lkGAEgASgJQge6SARyAhABEjQKCHNlcnZlX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBEhEKCXRpbWVfem9uZRgDIAEoCSKpAQoMVGltZWxpbmVTdGVwEiUKBHN0ZXAYASABKAsyFy5mcm9udGVuZGFwaS5SZWNpcGVTdGVwEi4KCnN0YXJ0X3RpbWUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgxwcmV2aW91c19kYXkYBCABKAgizgEKEVRpbWVsaW5lU3RlcEdyb3VwEg0KBWxhYmVsGAEgASgJEgwKBG5vdGUYAiABKAkSLgoKc3RhcnRfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEigKBXN0ZXBzGAUgAygLMhkuZnJvbnRlbmRhcGkuVGltZWxpbmVTdGVwEhQKDHByZXZpb3VzX2RheRgGIAEoCCKsAQoXR2V0UGxhblRpbWVsaW5lUmVzcG9uc2USLgoKc3RhcnRfdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIc2VydmVfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjMKC3N0ZXBfZ3JvdXBzGAMgAygLMh4uZnJvbnRlbmRhcGkuVGltZWxpbmVTdGVwR3JvdXAi3QIKEVVwZGF0ZVBsYW5SZXF1ZXN0EhgKB3BsYW5faWQYASABKAlCB7pIBHICEAESEgoKcmVjaXBlX2lkcxgCIAMoCRINCgVub3RlcxgDIAMoCRIrCgtzdGVwX2dyb3VwcxgEIAMoCzIWLmZyb250ZW5kYXBpLlN0ZXBHcm91cBJHCg1zZXJ2aW5nX3NpemVzGAUgAygLMjAuZnJvbnRlbmRhcGkuVXBkYXRlUGxhblJlcXVlc3QuU2VydmluZ1NpemVzRW50cnkSLwoLdXBkYXRlX21hc2sYBiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrEi8KC3VwZGF0ZV90aW1lGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBozChFTZXJ2aW5nU2l6ZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIj0KElVwZGF0ZVBsYW5SZXNwb25zZRInCgRwbGFuGAEgASgLMhEuZnJvbnRlbmRhcGkuUGxhbkIGukgDyAEBIi0KEURlbGV0ZVBsYW5SZXF1ZXN0EhgKB3BsYW5faWQYASABKAlCB7pIBHICEAEiFAoSRGVsZXRlUGxhblJlc3BvbnNlIi4KElJlc3RvcmVQbGFuUmVxdWVzdBIYCgdwbGFuX2lkGAEgASgJQge6SARyAhABIhUKE1Jlc3RvcmVQbGFuUmVzcG9uc2UiGQoXTGlzdERlbGV0ZWRQbGFuc1JlcXVlc3QikwEKC0RlbGV0ZWRQbGFuEiYKBHBsYW4YASABKAsyGC5mcm9udGVuZGFwaS5QbGFuU25pcHBldBIuCgpkZWxldGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghwdXJnZV9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiQwoYTGlzdERlbGV0ZWRQbGFuc1Jlc3BvbnNlEicKBXBsYW5zGAEgAygLMhguZnJvbnRlbmRhcGkuRGVsZXRlZFBsYW4iLAoQUmV0cnlQbGFuUmVxdWVzdBIYCgdwbGFuX2lkGAEgASgJQge6SARyAhABIhMKEVJldHJ5UGxhblJlc3BvbnNlIkkKGlN1Z2dlc3RBbHRlcm5hdGl2ZXNSZXF1ZXN0EhgKB3BsYW5faWQYASABKAlCB7pIBHICEAESEQoJcmVjaXBlX2lkGAIgASgJIk8KG1N1Z2dlc3RBbHRlcm5hdGl2ZXNSZXNwb25zZRIwCgxhbHRlcm5hdGl2ZXMYASADKAsyGi5mcm9udGVuZGFwaS5SZWNpcGVTbmlwcGV0Im8KGFJlcGxhY2VQbGFuUmVjaXBlUmVxdWVzdBIYCgdwbGFuX2lkGAEgASgJQge6SARyAhABEhEKCXJlY2lwZV9pZBgCIAEoCRImChVyZXBsYWNlbWVudF9yZWNpcGVfaWQYAyABKAlCB7pIBHICEAEiGwoZUmVwbGFjZVBsYW5SZWNpcGVSZXNwb25zZSJrChBQbGFuVGVtcGxhdGVTbG90EjcKC2RheV9vZl93ZWVrGAEgASgOMhYuZnJvbnRlbmRhcGkuRGF5T2ZXZWVrQgq6SAeCAQQgABABEh4KCnJlY2lwZV9pZHMYAiADKAlCCrpIB5IBBAgBEAMifwoXU2F2ZVBsYW5UZW1wbGF0ZVJlcXVlc3QSEwoLdGVtcGxhdGVfaWQYASABKAkSFQoEbmFtZRgCIAEoCUIHukgEcgIQARI4CgVzbG90cxgDIAMoCzIdLmZyb250ZW5kYXBpLlBsYW5UZW1wbGF0ZVNsb3RCCrpIB5IBBAgBEAciLwoYU2F2ZVBsYW5UZW1wbGF0ZVJlc3BvbnNlEhMKC3RlbXBsYXRlX2lkGAEgASgJIoMBChhBcHBseVBsYW5UZW1wbGF0ZVJlcXVlc3QSHAoLdGVtcGxhdGVfaWQYASABKAlCB7pIBHICEAESNgoKd2Vla19zdGFydBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARIRCgl0aW1lX3pvbmUYAyABKAkiWwoZQXBwbHlQbGFuVGVtcGxhdGVSZXNwb25zZRIQCghwbGFuX2lkcxgBIAMoCRIsCgxza2lwcGVkX2RheXMYAiADKA4yFi5mcm9udGVuZGFwaS5EYXlPZldlZWsiSgoPSW5ncmVkaWVudFByaWNlEgwKBG5hbWUYASABKAkSDAoEdW5pdBgCIAEoCRILCgN5ZW4YAyABKAESDgoGY3VzdG9tGAQgASgIIh0KG0xpc3RJbmdyZWRpZW50UHJpY2VzUmVxdWVzdCJMChxMaXN0SW5ncmVkaWVudFByaWNlc1Jlc3BvbnNlEiwKBnByaWNlcxgBIAMoCzIcLmZyb250ZW5kYXBpLkluZ3JlZGllbnRQcmljZSJmChlTZXRJbmdyZWRpZW50UHJpY2VSZXF1ZXN0EhUKBG5hbWUYASABKAlCB7pIBHICEAESFQoEdW5pdBgCIAEoCUIHukgEcgIQARIbCgN5ZW4YAyABKAFCDrpICxIJIQAAAAAAAAAAIhwKGlNldEluZ3JlZGllbnRQcmljZVJlc3BvbnNlIkwKHERlbGV0ZUluZ3JlZGllbnRQcmljZVJlcXVlc3QSFQoEbmFtZRgBIAEoCUIHukgEcgIQARIVCgR1bml0GAIgASgJQge6SARyAhABIh8KHURlbGV0ZUluZ3JlZGllbnRQcmljZVJlc3BvbnNlIrUBChFNYXJrQ29va2VkUmVxdWVzdBITCglyZWNpcGVfaWQYASABKAlIABIRCgdwbGFuX2lkGAIgASgJSAASLQoJY29va2VkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIXCgZyYXRpbmcYBCABKA1CB7pIBCoCGAUSEAoIc2VydmluZ3MYBSABKA0SDQoFbm90ZXMYBiABKAlCDwoGdGFyZ2V0EgW6SAIIASImChJNYXJrQ29va2VkUmVzcG9uc2USEAoIZW50cnlfaWQYASABKAkivwEKE0Nvb2tpbmdIaXN0b3J5RW50cnkSCgoCaWQYASABKAkSKwoHcmVjaXBlcxgCIAMoCzIaLmZyb250ZW5kYXBpLlJlY2lwZVNuaXBwZXQSDwoHcGxhbl9pZBgDIAEoCRItCgljb29rZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg4KBnJhdGluZxgFIAEoDRIQCghzZXJ2aW5ncxgGIAEoDRINCgVub3RlcxgHIAEoCSJIChlMaXN0Q29va2luZ0hpc3RvcnlSZXF1ZXN0EisKCnBhZ2luYXRpb24YASABKAsyFy5mcm9udGVuZGFwaS5QYWdpbmF0aW9uInwKGkxpc3RDb29raW5nSGlzdG9yeVJlc3BvbnNlEjEKB2VudHJpZXMYASADKAsyIC5mcm9udGVuZGFwaS5Db29raW5nSGlzdG9yeUVudHJ5EisKCnBhZ2luYXRpb24YAiABKAsyFy5mcm9udGVuZGFwaS5QYWdpbmF0aW9uInsKD0hvdXNlaG9sZE1lbWJlchIPCgd1c2VyX2lkGAEgASgJEigKBHJvbGUYAiABKA4yGi5mcm9udGVuZGFwaS5Ib3VzZWhvbGRSb2xlEi0KCWpvaW5lZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiVAoJSG91c2Vob2xkEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSLQoHbWVtYmVycxgDIAMoCzIcLmZyb250ZW5kYXBpLkhvdXNlaG9sZE1lbWJlciIvChZDcmVhdGVIb3VzZWhvbGRSZXF1ZXN0EhUKBG5hbWUYASABKAlCB7pIBHICEAEiLwoXQ3JlYXRlSG91c2Vob2xkUmVzcG9uc2USFAoMaG91c2Vob2xkX2lkGAEgASgJIhUKE0dldEhvdXNlaG9sZFJlcXVlc3QiawoUR2V0SG91c2Vob2xkUmVzcG9uc2USKQoJaG91c2Vob2xkGAEgASgLMhYuZnJvbnRlbmRhcGkuSG91c2Vob2xkEigKBHJvbGUYAiABKA4yGi5mcm9udGVuZGFwaS5Ib3VzZWhvbGRSb2xlIlgKIENyZWF0ZUhvdXNlaG9sZEludml0YXRpb25SZXF1ZXN0EjQKBHJvbGUYASABKA4yGi5mcm9udGVuZGFwaS5Ib3VzZWhvbGRSb2xlQgq6SAeCAQQYAhgDImoKIUNyZWF0ZUhvdXNlaG9sZEludml0YXRpb25SZXNwb25zZRIVCg1pbnZpdGF0aW9uX2lkGAEgASgJEi4KCmV4cGlyZXNfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkIKIEFjY2VwdEhvdXNlaG9sZEludml0YXRpb25SZXF1ZXN0Eh4KDWludml0YXRpb25faWQYASABKAlCB7pIBHICEAEiOQohQWNjZXB0SG91c2Vob2xkSW52aXRhdGlvblJlc3BvbnNlEhQKDGhvdXNlaG9sZF9pZBgBIAEoCSI4ChxSZW1vdmVIb3VzZWhvbGRNZW1iZXJSZXF1ZXN0EhgKB3VzZXJfaWQYASABKAlCB7pIBHICEAEiHwodUmVtb3ZlSG91c2Vob2xkTWVtYmVyUmVzcG9uc2UiIQoMVXNlclNldHRpbmdzEhEKCXRpbWVfem9uZRgBIAEoCSIYChZHZXRVc2VyU2V0dGluZ3NSZXF1ZXN0IkYKF0dldFVzZXJTZXR0aW5nc1Jlc3BvbnNlEisKCHNldHRpbmdzGAEgASgLMhkuZnJvbnRlbmRhcGkuVXNlclNldHRpbmdzIlAKGVVwZGF0ZVVzZXJTZXR0aW5nc1JlcXVlc3QSMwoIc2V0dGluZ3MYASABKAsyGS5mcm9udGVuZGFwaS5Vc2VyU2V0dGluZ3NCBrpIA8gBASIcChpVcGRhdGVVc2VyU2V0dGluZ3NSZXNwb25zZSInChJBZGRCb29rbWFya1JlcXVlc3QSEQoJcmVjaXBlX2lkGAEgASgJIhUKE0FkZEJvb2ttYXJrUmVzcG9uc2UiKgoVUmVtb3ZlQm9va21hcmtSZXF1ZXN0EhEKCXJlY2lwZV9pZBgBIAEoCSIYChZSZW1vdmVCb29rbWFya1Jlc3BvbnNlIkoKFlNob3BwaW5nTGlzdEluZ3JlZGllbnQSDAoEbmFtZRgBIAEoCRIQCghxdWFudGl0eRgCIAEoCRIQCghzZWxlY3RlZBgDIAEoCCKRAQoRU2hvcHBpbmdMaXN0RW50cnkSCgoCaWQYASABKAkSEQoJcmVjaXBlX2lkGAIgASgJEg0KBXRpdGxlGAMgASgJEhQKDHNlcnZpbmdfc2l6ZRgEIAEoCRI4CgtpbmdyZWRpZW50cxgFIAMoCzIjLmZyb250ZW5kYXBpLlNob3BwaW5nTGlzdEluZ3JlZGllbnQiGAoWR2V0U2hvcHBpbmdMaXN0UmVxdWVzdCJKChdHZXRTaG9wcGluZ0xpc3RSZXNwb25zZRIvCgdlbnRyaWVzGAEgAygLMh4uZnJvbnRlbmRhcGkuU2hvcHBpbmdMaXN0RW50cnkimAEKG0FkZFNob3BwaW5nTGlzdEVudHJ5UmVxdWVzdBIRCglyZWNpcGVfaWQYASABKAkSFgoFdGl0bGUYAiABKAlCB7pIBHICEAESFAoMc2VydmluZ19zaXplGAMgASgJEjgKC2luZ3JlZGllbnRzGAQgAygLMiMuZnJvbnRlbmRhcGkuU2hvcHBpbmdMaXN0SW5ncmVkaWVudCIwChxBZGRTaG9wcGluZ0xpc3RFbnRyeVJlc3BvbnNlEhAKCGVudHJ5X2lkGAEgASgJImwKI1VwZGF0ZVNob3BwaW5nTGlzdEluZ3JlZGllbnRSZXF1ZXN0EhkKCGVudHJ5X2lkGAEgASgJQge6SARyAhABEhgKEGluZ3JlZGllbnRfaW5kZXgYAiABKA0SEAoIc2VsZWN0ZWQYAyABKAgiJgokVXBkYXRlU2hvcHBpbmdMaXN0SW5ncmVkaWVudFJlc3BvbnNlIjsKHlJlbW92ZVNob3BwaW5nTGlzdEVudHJ5UmVxdWVzdBIZCghlbnRyeV9pZBgBIAEoCUIHukgEcgIQASIhCh9SZW1vdmVTaG9wcGluZ0xpc3RFbnRyeVJlc3BvbnNlIiwKClBhbnRyeUl0ZW0SDAoEbmFtZRgBIAEoCRIQCghxdWFudGl0eRgCIAEoCSIYChZMaXN0UGFudHJ5SXRlbXNSZXF1ZXN0IkEKF0xpc3RQYW50cnlJdGVtc1Jlc3BvbnNlEiYKBWl0ZW1zGAEgAygLMhcuZnJvbnRlbmRhcGkuUGFudHJ5SXRlbSI/ChRTZXRQYW50cnlJdGVtUmVxdWVzdBIVCgRuYW1lGAEgASgJQge6SARyAhABEhAKCHF1YW50aXR5GAIgASgJIhcKFVNldFBhbnRyeUl0ZW1SZXNwb25zZSIwChdEZWxldGVQYW50cnlJdGVtUmVxdWVzdBIVCgRuYW1lGAEgASgJQge6SARyAhABIhoKGERlbGV0ZVBhbnRyeUl0ZW1SZXNwb25zZSKuAQoLQ2hhdE1lc3NhZ2USDwoHY29udGVudBgBIAEoCRIrCgRyb2xlGAIgASgOMh0uZnJvbnRlbmRhcGkuQ2hhdE1lc3NhZ2UuUm9sZRIMCgR1cmxzGAMgAygJEhIKCmltYWdlX3VybHMYBCADKAkiPwoEUm9sZRIUChBST0xFX1VOU1BFQ0lGSUVEEAASDQoJUk9MRV9VU0VSEAESEgoOUk9MRV9BU1NJU1RBTlQQAiJwCg9DaGF0UGxhblJlcXVlc3QSDwoHY2hhdF9pZBgBIAEoCRIQCghuZXdfY2hhdBgCIAEoCBIPCgdtZXNzYWdlGAMgASgJEhIKCmltYWdlX3VybHMYBCADKAkSFQoNd2Vla2x5X2J1ZGdldBgFIAEoDSJgChBDaGF0UGxhblJlc3BvbnNlEg8KB2NoYXRfaWQYASABKAkSKgoIbWVzc2FnZXMYAiADKAsyGC5mcm9udGVuZGFwaS5DaGF0TWVzc2FnZRIPCgdwbGFuX2lkGAMgASgJIhgKFkdldENoYXRNZXNzYWdlc1JlcXVlc3QiZwoXR2V0Q2hhdE1lc3NhZ2VzUmVzcG9uc2USDwoHY2hhdF9pZBgBIAEoCRIqCghtZXNzYWdlcxgCIAMoCzIYLmZyb250ZW5kYXBpLkNoYXRNZXNzYWdlEg8KB3BsYW5faWQYAyABKAkqUQoITGFuZ3VhZ2USGAoUTEFOR1VBR0VfVU5TUEVDSUZJRUQQABIUChBMQU5HVUFHRV9FTkdMSVNIEAESFQoRTEFOR1VBR0VfSkFQQU5FU0UQAirGAQoLUmVjaXBlR2VucmUSHAoYUkVDSVBFX0dFTlJFX1VOU1BFQ0lGSUVEEAASGQoVUkVDSVBFX0dFTlJFX0pBUEFORVNFEAESGAoUUkVDSVBFX0dFTlJFX0NISU5FU0UQAhIYChRSRUNJUEVfR0VOUkVfV0VTVEVSThADEhcKE1JFQ0lQRV9HRU5SRV9LT1JFQU4QBBIYChRSRUNJUEVfR0VOUkVfSVRBTElBThAFEhcKE1JFQ0lQRV9HRU5SRV9FVEhOSUMQBiqJAQoMUmVjaXBlU291cmNlEh0KGVJFQ0lQRV9TT1VSQ0VfVU5TUEVDSUZJRUQQABIZChVSRUNJUEVfU09VUkNFX0NPT0tQQUQQARIdChlSRUNJUEVfU09VUkNFX09SQU5HRV9QQUdFEAISIAocUkVDSVBFX1NPVVJDRV9ERUxJU0hfS0lUQ0hFThADKn8KDFJlY2lwZVN0YXR1cxIdChlSRUNJUEVfU1RBVFVTX1VOU1BFQ0lGSUVEEAASHAoYUkVDSVBFX1NUQVRVU19QUk9DRVNTSU5HEAESGAoUUkVDSVBFX1NUQVRVU19BQ1RJVkUQAhIYChRSRUNJUEVfU1RBVFVTX0ZBSUxFRBADKmYKDVBsYW5HZW5lcmF0b3ISHgoaUExBTl9HRU5FUkFUT1JfVU5TUEVDSUZJRUQQABIWChJQTEFOX0dFTkVSQVRPUl9MTE0QARIdChlQTEFOX0dFTkVSQVRPUl9DT05TVFJBSU5UEAIqbQoIUGxhblR5cGUSGQoVUExBTl9UWVBFX1VOU1BFQ0lGSUVEEAASEwoPUExBTl9UWVBFX0RBSUxZEAESGAoUUExBTl9UWVBFX0JBVENIX1BSRVAQAhIXChNQTEFOX1RZUEVfTEVGVE9WRVJTEAMqdQoKUGxhblN0YXR1cxIbChdQTEFOX1NUQVRVU19VTlNQRUNJRklFRBAAEhoKFlBMQU5fU1RBVFVTX1BST0NFU1NJTkcQARIWChJQTEFOX1NUQVRVU19BQ1RJVkUQAhIWChJQTEFOX1NUQVRVU19GQUlMRUQQAyroAQoRUGxhblByb2dyZXNzU3RhZ2USIwofUExBTl9QUk9HUkVTU19TVEFHRV9VTlNQRUNJRklFRBAAEiEKHVBMQU5fUFJPR1JFU1NfU1RBR0VfVFJBTlNMQVRFEAESHwobUExBTl9QUk9HUkVTU19TVEFHRV9SRVdSSVRFEAISHQoZUExBTl9QUk9HUkVTU19TVEFHRV9JTUFHRRADEiMKH1BMQU5fUFJPR1JFU1NfU1RBR0VfU1RFUF9JTUFHRVMQBBImCiJQTEFOX1BST0dSRVNTX1NUQUdFX0VYRUNVVElPTl9QTEFOEAUq2AEKCURheU9mV2VlaxIbChdEQVlfT0ZfV0VFS19VTlNQRUNJRklFRBAAEhYKEkRBWV9PRl9XRUVLX01PTkRBWRABEhcKE0RBWV9PRl9XRUVLX1RVRVNEQVkQAhIZChVEQVlfT0ZfV0VFS19XRURORVNEQVkQAxIYChREQVlfT0ZfV0VFS19USFVSU0RBWRAEEhYKEkRBWV9PRl9XRUVLX0ZSSURBWRAFEhgKFERBWV9PRl9XRUVLX1NBVFVSREFZEAYSFgoSREFZX09GX1dFRUtfU1VOREFZEAcqfwoNSG91c2Vob2xkUm9sZRIeChpIT1VTRUhPTERfUk9MRV9VTlNQRUNJRklFRBAAEhgKFEhPVVNFSE9MRF9ST0xFX09XTkVSEAESGQoVSE9VU0VIT0xEX1JPTEVfRURJVE9SEAISGQoVSE9VU0VIT0xEX1JPTEVfVklFV0VSEAMyTgoLQ2hhdFNlcnZpY2USPwoEQ2hhdBIYLmZyb250ZW5kYXBpLkNoYXRSZXF1ZXN0GhkuZnJvbnRlbmRhcGkuQ2hhdFJlc3BvbnNlKAEwATKgIQoPRnJvbnRlbmRTZXJ2aWNlEkoKCUdldFJlY2lwZRIdLmZyb250ZW5kYXBpLkdldFJlY2lwZVJlcXVlc3QaHi5mcm9udGVuZGFwaS5HZXRSZWNpcGVSZXNwb25zZRJQCgtMaXN0UmVjaXBlcxIfLmZyb250ZW5kYXBpLkxpc3RSZWNpcGVzUmVxdWVzdBogLmZyb250ZW5kYXBpLkxpc3RSZWNpcGVzUmVzcG9uc2USSgoJU3RhcnRDaGF0Eh0uZnJvbnRlbmRhcGkuU3RhcnRDaGF0UmVxdWVzdBoeLmZyb250ZW5kYXBpLlN0YXJ0Q2hhdFJlc3BvbnNlEkoKCUFkZFJlY2lwZRIdLmZyb250ZW5kYXBpLkFkZFJlY2lwZVJlcXVlc3QaHi5mcm9udGVuZGFwaS5BZGRSZWNpcGVSZXNwb25zZRJZCg5HZW5lcmF0ZVJlY2lwZRIiLmZyb250ZW5kYXBpLkdlbmVyYXRlUmVjaXBlUmVxdWVzdBojLmZyb250ZW5kYXBpLkdlbmVyYXRlUmVjaXBlUmVzcG9uc2USUwoMR2VuZXJhdGVQbGFuEiAuZnJvbnRlbmRhcGkuR2VuZXJhdGVQbGFuUmVxdWVzdBohLmZyb250ZW5kYXBpLkdlbmVyYXRlUGxhblJlc3BvbnNlEkcKCENoYXRQbGFuEhwuZnJvbnRlbmRhcGkuQ2hhdFBsYW5SZXF1ZXN0Gh0uZnJvbnRlbmRhcGkuQ2hhdFBsYW5SZXNwb25zZRJcCg9HZXRDaGF0TWVzc2FnZXMSIy5mcm9udGVuZGFwaS5HZXRDaGF0TWVzc2FnZXNSZXF1ZXN0GiQuZnJvbnRlbmRhcGkuR2V0Q2hhdE1lc3NhZ2VzUmVzcG9uc2USRwoIR2V0UGxhbnMSHC5mcm9udGVuZGFwaS5HZXRQbGFuc1JlcXVlc3QaHS5mcm9udGVuZGFwaS5HZXRQbGFuc1Jlc3BvbnNlEkQKB0dldFBsYW4SGy5mcm9udGVuZGFwaS5HZXRQbGFuUmVxdWVzdBocLmZyb250ZW5kYXBpLkdldFBsYW5SZXNwb25zZRJiChFTaGFyZWFibGVQbGFuTGluaxIlLmZyb250ZW5kYXBpLlNoYXJlYWJsZVBsYW5MaW5rUmVxdWVzdBomLmZyb250ZW5kYXBpLlNoYXJlYWJsZVBsYW5MaW5rUmVzcG9uc2USdAoXUmV2b2tlU2hhcmVhYmxlUGxhbkxpbmsSKy5mcm9udGVuZGFwaS5SZXZva2VTaGFyZWFibGVQbGFuTGlua1JlcXVlc3QaLC5mcm9udGVuZGFwaS5SZXZva2VTaGFyZWFibGVQbGFuTGlua1Jlc3BvbnNlElYKDUdldFNoYXJlZFBsYW4SIS5mcm9udGVuZGFwaS5HZXRTaGFyZWRQbGFuUmVxdWVzdBoiLmZyb250ZW5kYXBpLkdldFNoYXJlZFBsYW5SZXNwb25zZRJMCglXYXRjaFBsYW4SHS5mcm9udGVuZGFwaS5XYXRjaFBsYW5SZXF1ZXN0Gh4uZnJvbnRlbmRhcGkuV2F0Y2hQbGFuUmVzcG9uc2UwARJcCg9HZXRQbGFuVGltZWxpbmUSIy5mcm9udGVuZGFwaS5HZXRQbGFuVGltZWxpbmVSZXF1ZXN0GiQuZnJvbnRlbmRhcGkuR2V0UGxhblRpbWVsaW5lUmVzcG9uc2USTQoKVXBkYXRlUGxhbhIeLmZyb250ZW5kYXBpLlVwZGF0ZVBsYW5SZXF1ZXN0Gh8uZnJvbnRlbmRhcGkuVXBkYXRlUGxhblJlc3BvbnNlEmgKE1N1Z2dlc3RBbHRlcm5hdGl2ZXMSJy5mcm9udGVuZGFwaS5TdWdnZXN0QWx0ZXJuYXRpdmVzUmVxdWVzdBooLmZyb250ZW5kYXBpLlN1Z2dlc3RBbHRlcm5hdGl2ZXNSZXNwb25zZRJiChFSZXBsYWNlUGxhblJlY2lwZRIlLmZyb250ZW5kYXBpLlJlcGxhY2VQbGFuUmVjaXBlUmVxdWVzdBomLmZyb250ZW5kYXBpLlJlcGxhY2VQbGFuUmVjaXBlUmVzcG9uc2USTQoKRGVsZXRlUGxhbhIeLmZyb250ZW5kYXBpLkRlbGV0ZVBsYW5SZXF1ZXN0Gh8uZnJvbnRlbmRhcGkuRGVsZXRlUGxhblJlc3BvbnNlElAKC1Jlc3RvcmVQbGFuEh8uZnJvbnRlbmRhcGkuUmVzdG9yZVBsYW5SZXF1ZXN0GiAuZnJvbnRlbmRhcGkuUmVzdG9yZVBsYW5SZXNwb25zZRJfChBMaXN0RGVsZXRlZFBsYW5zEiQuZnJvbnRlbmRhcGkuTGlzdERlbGV0ZWRQbGFuc1JlcXVlc3QaJS5mcm9udGVuZGFwaS5MaXN0RGVsZXRlZFBsYW5zUmVzcG9uc2USSgoJUmV0cnlQbGFuEh0uZnJvbnRlbmRhcGkuUmV0cnlQbGFuUmVxdWVzdBoeLmZyb250ZW5kYXBpLlJldHJ5UGxhblJlc3BvbnNlEl8KEFNhdmVQbGFuVGVtcGxhdGUSJC5mcm9udGVuZGFwaS5TYXZlUGxhblRlbXBsYXRlUmVxdWVzdBolLmZyb250ZW5kYXBpLlNhdmVQbGFuVGVtcGxhdGVSZXNwb25zZRJiChFBcHBseVBsYW5UZW1wbGF0ZRIlLmZyb250ZW5kYXBpLkFwcGx5UGxhblRlbXBsYXRlUmVxdWVzdBomLmZyb250ZW5kYXBpLkFwcGx5UGxhblRlbXBsYXRlUmVzcG9uc2USawoUTGlzdEluZ3JlZGllbnRQcmljZXMSKC5mcm9udGVuZGFwaS5MaXN0SW5ncmVkaWVudFByaWNlc1JlcXVlc3QaKS5mcm9udGVuZGFwaS5MaXN0SW5ncmVkaWVudFByaWNlc1Jlc3BvbnNlEmUKElNldEluZ3JlZGllbnRQcmljZRImLmZyb250ZW5kYXBpLlNldEluZ3JlZGllbnRQcmljZVJlcXVlc3QaJy5mcm9udGVuZGFwaS5TZXRJbmdyZWRpZW50UHJpY2VSZXNwb25zZRJuChVEZWxldGVJbmdyZWRpZW50UHJpY2USKS5mcm9udGVuZGFwaS5EZWxldGVJbmdyZWRpZW50UHJpY2VSZXF1ZXN0GiouZnJvbnRlbmRhcGkuRGVsZXRlSW5ncmVkaWVudFByaWNlUmVzcG9uc2USTQoKTWFya0Nvb2tlZBIeLmZyb250ZW5kYXBpLk1hcmtDb29rZWRSZXF1ZXN0Gh8uZnJvbnRlbmRhcGkuTWFya0Nvb2tlZFJlc3BvbnNlEmUKEkxpc3RDb29raW5nSGlzdG9yeRImLmZyb250ZW5kYXBpLkxpc3RDb29raW5nSGlzdG9yeVJlcXVlc3QaJy5mcm9udGVuZGFwaS5MaXN0Q29va2luZ0hpc3RvcnlSZXNwb25zZRJcCg9DcmVhdGVIb3VzZWhvbGQSIy5mcm9udGVuZGFwaS5DcmVhdGVIb3VzZWhvbGRSZXF1ZXN0GiQuZnJvbnRlbmRhcGkuQ3JlYXRlSG91c2Vob2xkUmVzcG9uc2USUwoMR2V0SG91c2Vob2xkEiAuZnJvbnRlbmRhcGkuR2V0SG91c2Vob2xkUmVxdWVzdBohLmZyb250ZW5kYXBpLkdldEhvdXNlaG9sZFJlc3BvbnNlEnoKGUNyZWF0ZUhvdXNlaG9sZEludml0YXRpb24SLS5mcm9udGVuZGFwaS5DcmVhdGVIb3VzZWhvbGRJbnZpdGF0aW9uUmVxdWVzdBouLmZyb250ZW5kYXBpLkNyZWF0ZUhvdXNlaG9sZEludml0YXRpb25SZXNwb25zZRJ6ChlBY2NlcHRIb3VzZWhvbGRJbnZpdGF0aW9uEi0uZnJvbnRlbmRhcGkuQWNjZXB0SG91c2Vob2xkSW52aXRhdGlvblJlcXVlc3QaLi5mcm9udGVuZGFwaS5BY2NlcHRIb3VzZWhvbGRJbnZpdGF0aW9uUmVzcG9uc2USbgoVUmVtb3ZlSG91c2Vob2xkTWVtYmVyEikuZnJvbnRlbmRhcGkuUmVtb3ZlSG91c2Vob2xkTWVtYmVyUmVxdWVzdBoqLmZyb250ZW5kYXBpLlJlbW92ZUhvdXNlaG9sZE1lbWJlclJlc3BvbnNlElAKC0FkZEJvb2ttYXJrEh8uZnJvbnRlbmRhcGkuQWRkQm9va21hcmtSZXF1ZXN0GiAuZnJvbnRlbmRhcGkuQWRkQm9va21hcmtSZXNwb25zZRJZCg5SZW1vdmVCb29rbWFyaxIiLmZyb250ZW5kYXBpLlJlbW92ZUJvb2ttYXJrUmVxdWVzdBojLmZyb250ZW5kYXBpLlJlbW92ZUJvb2ttYXJrUmVzcG9uc2USXAoPR2V0U2hvcHBpbmdMaXN0EiMuZnJvbnRlbmRhcGkuR2V0U2hvcHBpbmdMaXN0UmVxdWVzdBokLmZyb250ZW5kYXBpLkdldFNob3BwaW5nTGlzdFJlc3BvbnNlEmsKFEFkZFNob3BwaW5nTGlzdEVudHJ5EiguZnJvbnRlbmRhcGkuQWRkU2hvcHBpbmdMaXN0RW50cnlSZXF1ZXN0GikuZnJvbnRlbmRhcGkuQWRkU2hvcHBpbmdMaXN0RW50cnlSZXNwb25zZRKDAQocVXBkYXRlU2hvcHBpbmdMaXN0SW5ncmVkaWVudBIwLmZyb250ZW5kYXBpLlVwZGF0ZVNob3BwaW5nTGlzdEluZ3JlZGllbnRSZXF1ZXN0GjEuZnJvbnRlbmRhcGkuVXBkYXRlU2hvcHBpbmdMaXN0SW5ncmVkaWVudFJlc3BvbnNlEnQKF1JlbW92ZVNob3BwaW5nTGlzdEVudHJ5EisuZnJvbnRlbmRhcGkuUmVtb3ZlU2hvcHBpbmdMaXN0RW50cnlSZXF1ZXN0GiwuZnJvbnRlbmRhcGkuUmVtb3ZlU2hvcHBpbmdMaXN0RW50cnlSZXNwb25zZRJcCg9MaXN0UGFudHJ5SXRlbXMSIy5mcm9udGVuZGFwaS5MaXN0UGFudHJ5SXRlbXNSZXF1ZXN0GiQuZnJvbnRlbmRhcGkuTGlzdFBhbnRyeUl0ZW1zUmVzcG9uc2USVgoNU2V0UGFudHJ5SXRlbRIhLmZyb250ZW5kYXBpLlNldFBhbnRyeUl0ZW1SZXF1ZXN0GiIuZnJvbnRlbmRhcGkuU2V0UGFudHJ5SXRlbVJlc3BvbnNlEl8KEERlbGV0ZVBhbnRyeUl0ZW0SJC5mcm9udGVuZGFwaS5EZWxldGVQYW50cnlJdGVtUmVxdWVzdBolLmZyb250ZW5kYXBpLkRlbGV0ZVBhbnRyeUl0ZW1SZXNwb25zZRJcCg9HZXRVc2VyU2V0dGluZ3MSIy5mcm9udGVuZGFwaS5HZXRVc2VyU2V0dGluZ3NSZXF1ZXN0GiQuZnJvbnRlbmRhcGkuR2V0VXNlclNldHRpbmdzUmVzcG9uc2USZQoSVXBkYXRlVXNlclNldHRpbmdzEiYuZnJvbnRlbmRhcGkuVXBkYXRlVXNlclNldHRpbmdzUmVxdWVzdBonLmZyb250ZW5kYXBpLlVwZGF0ZVVzZXJTZXR0aW5nc1Jlc3BvbnNlQj1aO2dpdGh1Yi5jb20vY3VyaW9zd2l0Y2gvY29va2NoYXQvZnJvbnRlbmQvYXBpL2dvO2Zyb250ZW5kYXBpYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
 
 /**
  * The content of a chat message.
@@ -300,6 +300,13 @@ export type Recipe = Message<"frontendapi.Recipe"> & {
    * @generated from field: frontendapi.Language language = 12;
    */
   language: Language;
+
+  /**
+   * The reason processing the recipe failed, if the status is failed.
+   *
+   * @generated from field: string failure_reason = 13;
+   */
+  failureReason: string;
 };
 
 export type RecipeValid = Recipe;
@@ -431,6 +438,13 @@ export type RecipeSnippet = Message<"frontendapi.RecipeSnippet"> & {
    * @generated from field: string image_url = 4;
    */
   imageUrl: string;
+
+  /**
+   * The status of the recipe. Only set in plans.
+   *
+   * @generated from field: frontendapi.RecipeStatus status = 5;
+   */
+  status: RecipeStatus;
 };
 
 export type RecipeSnippetValid = RecipeSnippet;
@@ -853,6 +867,29 @@ export type GeneratePlanRequest = Message<"frontendapi.GeneratePlanRequest"> & {
    * @generated from field: repeated string recipe_ids = 4;
    */
   recipeIds: string[];
+
+  /**
+   * Whether to plan a batch-cooking (作り置き) session that cooks dishes eaten over the
+   * requested days as leftovers, instead of cooking every day.
+   *
+   * @generated from field: bool batch_cooking = 5;
+   */
+  batchCooking: boolean;
+
+  /**
+   * The weekly food budget in yen. If set, the plan is chosen so the estimated cost of
+   * the planned days fits within the budget prorated to the number of days.
+   *
+   * @generated from field: uint32 weekly_budget = 6;
+   */
+  weeklyBudget: number;
+
+  /**
+   * The generator to use for the plan.
+   *
+   * @generated from field: frontendapi.PlanGenerator generator = 7;
+   */
+  generator: PlanGenerator;
 };
 
 export type GeneratePlanRequestValid = GeneratePlanRequest;
@@ -944,6 +981,27 @@ export type PlanSnippet = Message<"frontendapi.PlanSnippet"> & {
    * @generated from field: repeated frontendapi.RecipeSnippet recipes = 3;
    */
   recipes: RecipeSnippet[];
+
+  /**
+   * The type of the plan.
+   *
+   * @generated from field: frontendapi.PlanType type = 4;
+   */
+  type: PlanType;
+
+  /**
+   * The status of the plan.
+   *
+   * @generated from field: frontendapi.PlanStatus status = 5;
+   */
+  status: PlanStatus;
+
+  /**
+   * The local calendar date of the plan in YYYY-MM-DD format.
+   *
+   * @generated from field: string local_date = 6;
+   */
+  localDate: string;
 };
 
 /**
@@ -972,6 +1030,27 @@ export type PlanSnippetValid = Message<"frontendapi.PlanSnippet"> & {
    * @generated from field: repeated frontendapi.RecipeSnippet recipes = 3;
    */
   recipes: RecipeSnippetValid[];
+
+  /**
+   * The type of the plan.
+   *
+   * @generated from field: frontendapi.PlanType type = 4;
+   */
+  type: PlanType;
+
+  /**
+   * The status of the plan.
+   *
+   * @generated from field: frontendapi.PlanStatus status = 5;
+   */
+  status: PlanStatus;
+
+  /**
+   * The local calendar date of the plan in YYYY-MM-DD format.
+   *
+   * @generated from field: string local_date = 6;
+   */
+  localDate: string;
 };
 
 /**
@@ -988,7 +1067,8 @@ export const PlanSnippetSchema: GenMessage<PlanSnippet, {validType: PlanSnippetV
  */
 export type GetPlansRequest = Message<"frontendapi.GetPlansRequest"> & {
   /**
-   * The start date for the plans to get.
+   * The start date for the plans to get. Only the calendar date in the time zone of the
+   * user is used.
    *
    * @generated from field: google.protobuf.Timestamp start_date = 1;
    */
@@ -1009,7 +1089,8 @@ export type GetPlansRequest = Message<"frontendapi.GetPlansRequest"> & {
  */
 export type GetPlansRequestValid = Message<"frontendapi.GetPlansRequest"> & {
   /**
-   * The start date for the plans to get.
+   * The start date for the plans to get. Only the calendar date in the time zone of the
+   * user is used.
    *
    * @generated from field: google.protobuf.Timestamp start_date = 1;
    */
@@ -1111,6 +1192,85 @@ export type Plan = Message<"frontendapi.Plan"> & {
    * @generated from field: repeated string serving_sizes = 7;
    */
   servingSizes: string[];
+
+  /**
+   * The type of the plan.
+   *
+   * @generated from field: frontendapi.PlanType type = 8;
+   */
+  type: PlanType;
+
+  /**
+   * The dishes cooked in a batch-cooking plan, or the dishes eaten in a leftovers plan.
+   *
+   * @generated from field: repeated frontendapi.BatchDish batch_dishes = 9;
+   */
+  batchDishes: BatchDish[];
+
+  /**
+   * For a leftovers plan, the ID of the batch-cooking plan the dishes were cooked in.
+   *
+   * @generated from field: string batch_plan_id = 10;
+   */
+  batchPlanId: string;
+
+  /**
+   * The estimated cost of the ingredients of the plan in yen. Leftovers plans have no cost
+   * since their dishes were paid for in the batch-cooking plan.
+   *
+   * @generated from field: uint32 estimated_cost = 11;
+   */
+  estimatedCost: number;
+
+  /**
+   * The estimated cost of each recipe in yen, in the same order as recipes.
+   *
+   * @generated from field: repeated uint32 recipe_costs = 12;
+   */
+  recipeCosts: number[];
+
+  /**
+   * The reason processing the plan failed, if the status is failed.
+   *
+   * @generated from field: string failure_reason = 13;
+   */
+  failureReason: string;
+
+  /**
+   * The number of failed attempts to process the plan since it was created or last retried.
+   *
+   * @generated from field: uint32 attempts = 14;
+   */
+  attempts: number;
+
+  /**
+   * The local calendar date of the plan in YYYY-MM-DD format.
+   *
+   * @generated from field: string local_date = 15;
+   */
+  localDate: string;
+
+  /**
+   * The IANA time zone of local_date.
+   *
+   * @generated from field: string time_zone = 16;
+   */
+  timeZone: string;
+
+  /**
+   * The progress of the stages of processing the plan, ordered by recipe followed by the
+   * stages of the whole plan. Empty for plans processed before progress was recorded.
+   *
+   * @generated from field: repeated frontendapi.PlanProgress progress = 17;
+   */
+  progress: PlanProgress[];
+
+  /**
+   * The time the plan was last updated. Pass to UpdatePlan to detect concurrent updates.
+   *
+   * @generated from field: google.protobuf.Timestamp update_time = 18;
+   */
+  updateTime?: Timestamp | undefined;
 };
 
 export type PlanValid = Plan;
@@ -1122,6 +1282,115 @@ export type PlanValid = Plan;
 export const PlanSchema: GenMessage<Plan, {validType: PlanValid}> = /*@__PURE__*/
   messageDesc(file_frontendapi_frontend, 25);
 
+/**
+ * The progress of a stage of processing a plan.
+ *
+ * @generated from message frontendapi.PlanProgress
+ */
+export type PlanProgress = Message<"frontendapi.PlanProgress"> & {
+  /**
+   * The stage.
+   *
+   * @generated from field: frontendapi.PlanProgressStage stage = 1;
+   */
+  stage: PlanProgressStage;
+
+  /**
+   * The ID of the recipe the stage processes, or empty for stages of the whole plan.
+   *
+   * @generated from field: string recipe_id = 2;
+   */
+  recipeId: string;
+
+  /**
+   * The number of completed items of the stage, such as generated images.
+   *
+   * @generated from field: uint32 done = 3;
+   */
+  done: number;
+
+  /**
+   * The number of items of the stage.
+   *
+   * @generated from field: uint32 total = 4;
+   */
+  total: number;
+
+  /**
+   * The time the stage started.
+   *
+   * @generated from field: google.protobuf.Timestamp started_at = 5;
+   */
+  startedAt?: Timestamp | undefined;
+
+  /**
+   * The time the stage completed, unset if not yet complete.
+   *
+   * @generated from field: google.protobuf.Timestamp completed_at = 6;
+   */
+  completedAt?: Timestamp | undefined;
+};
+
+export type PlanProgressValid = PlanProgress;
+
+/**
+ * Describes the message frontendapi.PlanProgress.
+ * Use `create(PlanProgressSchema)` to create a new message.
+ */
+export const PlanProgressSchema: GenMessage<PlanProgress, {validType: PlanProgressValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 26);
+
+/**
+ * A dish cooked in a batch-cooking session.
+ *
+ * @generated from message frontendapi.BatchDish
+ */
+export type BatchDish = Message<"frontendapi.BatchDish"> & {
+  /**
+   * The ID of the recipe of the dish.
+   *
+   * @generated from field: string recipe_id = 1;
+   */
+  recipeId: string;
+
+  /**
+   * The number of servings to cook.
+   *
+   * @generated from field: uint32 servings = 2;
+   */
+  servings: number;
+
+  /**
+   * How to store the dish, e.g. refrigerator or freezer.
+   *
+   * @generated from field: string storage = 3;
+   */
+  storage: string;
+
+  /**
+   * The number of days the dish keeps when stored.
+   *
+   * @generated from field: uint32 storage_days = 4;
+   */
+  storageDays: number;
+
+  /**
+   * Instructions for reheating the dish before eating.
+   *
+   * @generated from field: string reheating_notes = 5;
+   */
+  reheatingNotes: string;
+};
+
+export type BatchDishValid = BatchDish;
+
+/**
+ * Describes the message frontendapi.BatchDish.
+ * Use `create(BatchDishSchema)` to create a new message.
+ */
+export const BatchDishSchema: GenMessage<BatchDish, {validType: BatchDishValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 27);
+
 /**
  * A request for FrontendService.GetPlan.
  *
@@ -1143,7 +1412,7 @@ export type GetPlanRequestValid = GetPlanRequest;
  * Use `create(GetPlanRequestSchema)` to create a new message.
  */
 export const GetPlanRequestSchema: GenMessage<GetPlanRequest, {validType: GetPlanRequestValid}> = /*@__PURE__*/
-  messageDesc(file_frontendapi_frontend, 26);
+  messageDesc(file_frontendapi_frontend, 28);
 
 /**
  * A response for FrontendService.GetPlan.
@@ -1190,184 +1459,2375 @@ export type GetPlanResponseValid = Message<"frontendapi.GetPlanResponse"> & {
  * Use `create(GetPlanResponseSchema)` to create a new message.
  */
 export const GetPlanResponseSchema: GenMessage<GetPlanResponse, {validType: GetPlanResponseValid}> = /*@__PURE__*/
-  messageDesc(file_frontendapi_frontend, 27);
+  messageDesc(file_frontendapi_frontend, 29);
 
 /**
- * A request for FrontendService.UpdatePlan.
+ * A request for FrontendService.WatchPlan.
  *
- * @generated from message frontendapi.UpdatePlanRequest
+ * @generated from message frontendapi.WatchPlanRequest
  */
-export type UpdatePlanRequest = Message<"frontendapi.UpdatePlanRequest"> & {
+export type WatchPlanRequest = Message<"frontendapi.WatchPlanRequest"> & {
   /**
-   * The ID of the plan to update.
+   * The ID of the plan to watch.
    *
    * @generated from field: string plan_id = 1;
    */
   planId: string;
-
-  /**
-   * The recipes for the plan.
-   *
-   * @generated from field: repeated string recipe_ids = 2;
-   */
-  recipeIds: string[];
 };
 
-export type UpdatePlanRequestValid = UpdatePlanRequest;
+export type WatchPlanRequestValid = WatchPlanRequest;
 
 /**
- * Describes the message frontendapi.UpdatePlanRequest.
- * Use `create(UpdatePlanRequestSchema)` to create a new message.
+ * Describes the message frontendapi.WatchPlanRequest.
+ * Use `create(WatchPlanRequestSchema)` to create a new message.
  */
-export const UpdatePlanRequestSchema: GenMessage<UpdatePlanRequest, {validType: UpdatePlanRequestValid}> = /*@__PURE__*/
-  messageDesc(file_frontendapi_frontend, 28);
+export const WatchPlanRequestSchema: GenMessage<WatchPlanRequest, {validType: WatchPlanRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 30);
 
 /**
- * @generated from message frontendapi.UpdatePlanResponse
+ * A response for FrontendService.WatchPlan.
+ *
+ * @generated from message frontendapi.WatchPlanResponse
  */
-export type UpdatePlanResponse = Message<"frontendapi.UpdatePlanResponse"> & {
+export type WatchPlanResponse = Message<"frontendapi.WatchPlanResponse"> & {
   /**
+   * The current state of the plan. Recipes become active as they are post-processed and
+   * their images generated, and the plan becomes active when its execution plan is ready.
+   *
    * @generated from field: frontendapi.Plan plan = 1;
    */
   plan?: Plan | undefined;
 };
 
-/**
- * @generated from message frontendapi.UpdatePlanResponse
- */
-export type UpdatePlanResponseValid = Message<"frontendapi.UpdatePlanResponse"> & {
-  /**
-   * @generated from field: frontendapi.Plan plan = 1;
-   */
-  plan: PlanValid;
-};
+export type WatchPlanResponseValid = WatchPlanResponse;
 
 /**
- * Describes the message frontendapi.UpdatePlanResponse.
- * Use `create(UpdatePlanResponseSchema)` to create a new message.
+ * Describes the message frontendapi.WatchPlanResponse.
+ * Use `create(WatchPlanResponseSchema)` to create a new message.
  */
-export const UpdatePlanResponseSchema: GenMessage<UpdatePlanResponse, {validType: UpdatePlanResponseValid}> = /*@__PURE__*/
-  messageDesc(file_frontendapi_frontend, 29);
+export const WatchPlanResponseSchema: GenMessage<WatchPlanResponse, {validType: WatchPlanResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 31);
 
 /**
- * A request for FrontendService.DeletePlan.
+ * A request for FrontendService.ShareablePlanLink.
  *
- * @generated from message frontendapi.DeletePlanRequest
+ * @generated from message frontendapi.ShareablePlanLinkRequest
  */
-export type DeletePlanRequest = Message<"frontendapi.DeletePlanRequest"> & {
+export type ShareablePlanLinkRequest = Message<"frontendapi.ShareablePlanLinkRequest"> & {
   /**
-   * The ID of the plan to delete.
+   * The ID of the plan to share.
    *
    * @generated from field: string plan_id = 1;
    */
   planId: string;
+
+  /**
+   * The number of days the link can be used for. Defaults to 7.
+   *
+   * @generated from field: uint32 ttl_days = 2;
+   */
+  ttlDays: number;
 };
 
-export type DeletePlanRequestValid = DeletePlanRequest;
+export type ShareablePlanLinkRequestValid = ShareablePlanLinkRequest;
 
 /**
- * Describes the message frontendapi.DeletePlanRequest.
- * Use `create(DeletePlanRequestSchema)` to create a new message.
+ * Describes the message frontendapi.ShareablePlanLinkRequest.
+ * Use `create(ShareablePlanLinkRequestSchema)` to create a new message.
  */
-export const DeletePlanRequestSchema: GenMessage<DeletePlanRequest, {validType: DeletePlanRequestValid}> = /*@__PURE__*/
-  messageDesc(file_frontendapi_frontend, 30);
+export const ShareablePlanLinkRequestSchema: GenMessage<ShareablePlanLinkRequest, {validType: ShareablePlanLinkRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 32);
 
 /**
- * A response for FrontendService.DeletePlan.
+ * A response for FrontendService.ShareablePlanLink.
  *
- * @generated from message frontendapi.DeletePlanResponse
+ * @generated from message frontendapi.ShareablePlanLinkResponse
  */
-export type DeletePlanResponse = Message<"frontendapi.DeletePlanResponse"> & {
+export type ShareablePlanLinkResponse = Message<"frontendapi.ShareablePlanLinkResponse"> & {
+  /**
+   * The token of the link, to pass to GetSharedPlan.
+   *
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * The time after which the link can no longer be used.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 2;
+   */
+  expiresAt?: Timestamp | undefined;
 };
 
-export type DeletePlanResponseValid = DeletePlanResponse;
+export type ShareablePlanLinkResponseValid = ShareablePlanLinkResponse;
 
 /**
- * Describes the message frontendapi.DeletePlanResponse.
- * Use `create(DeletePlanResponseSchema)` to create a new message.
+ * Describes the message frontendapi.ShareablePlanLinkResponse.
+ * Use `create(ShareablePlanLinkResponseSchema)` to create a new message.
  */
-export const DeletePlanResponseSchema: GenMessage<DeletePlanResponse, {validType: DeletePlanResponseValid}> = /*@__PURE__*/
-  messageDesc(file_frontendapi_frontend, 31);
+export const ShareablePlanLinkResponseSchema: GenMessage<ShareablePlanLinkResponse, {validType: ShareablePlanLinkResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 33);
 
 /**
- * A request for FrontendService.AddBookmark.
+ * A request for FrontendService.RevokeShareablePlanLink.
  *
- * @generated from message frontendapi.AddBookmarkRequest
+ * @generated from message frontendapi.RevokeShareablePlanLinkRequest
  */
-export type AddBookmarkRequest = Message<"frontendapi.AddBookmarkRequest"> & {
+export type RevokeShareablePlanLinkRequest = Message<"frontendapi.RevokeShareablePlanLinkRequest"> & {
   /**
-   * The ID of the recipe to add a bookmark for.
+   * The token of the link to revoke.
    *
-   * @generated from field: string recipe_id = 1;
+   * @generated from field: string token = 1;
    */
-  recipeId: string;
+  token: string;
 };
 
-export type AddBookmarkRequestValid = AddBookmarkRequest;
+export type RevokeShareablePlanLinkRequestValid = RevokeShareablePlanLinkRequest;
 
 /**
- * Describes the message frontendapi.AddBookmarkRequest.
- * Use `create(AddBookmarkRequestSchema)` to create a new message.
+ * Describes the message frontendapi.RevokeShareablePlanLinkRequest.
+ * Use `create(RevokeShareablePlanLinkRequestSchema)` to create a new message.
  */
-export const AddBookmarkRequestSchema: GenMessage<AddBookmarkRequest, {validType: AddBookmarkRequestValid}> = /*@__PURE__*/
-  messageDesc(file_frontendapi_frontend, 32);
+export const RevokeShareablePlanLinkRequestSchema: GenMessage<RevokeShareablePlanLinkRequest, {validType: RevokeShareablePlanLinkRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 34);
 
 /**
- * A response for FrontendService.AddBookmark.
+ * A response for FrontendService.RevokeShareablePlanLink.
  *
- * @generated from message frontendapi.AddBookmarkResponse
+ * @generated from message frontendapi.RevokeShareablePlanLinkResponse
  */
-export type AddBookmarkResponse = Message<"frontendapi.AddBookmarkResponse"> & {
+export type RevokeShareablePlanLinkResponse = Message<"frontendapi.RevokeShareablePlanLinkResponse"> & {
 };
 
-export type AddBookmarkResponseValid = AddBookmarkResponse;
+export type RevokeShareablePlanLinkResponseValid = RevokeShareablePlanLinkResponse;
 
 /**
- * Describes the message frontendapi.AddBookmarkResponse.
- * Use `create(AddBookmarkResponseSchema)` to create a new message.
+ * Describes the message frontendapi.RevokeShareablePlanLinkResponse.
+ * Use `create(RevokeShareablePlanLinkResponseSchema)` to create a new message.
  */
-export const AddBookmarkResponseSchema: GenMessage<AddBookmarkResponse, {validType: AddBookmarkResponseValid}> = /*@__PURE__*/
-  messageDesc(file_frontendapi_frontend, 33);
+export const RevokeShareablePlanLinkResponseSchema: GenMessage<RevokeShareablePlanLinkResponse, {validType: RevokeShareablePlanLinkResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 35);
 
 /**
- * A request for FrontendService.RemoveBookmark.
+ * A request for FrontendService.GetSharedPlan.
  *
- * @generated from message frontendapi.RemoveBookmarkRequest
+ * @generated from message frontendapi.GetSharedPlanRequest
  */
-export type RemoveBookmarkRequest = Message<"frontendapi.RemoveBookmarkRequest"> & {
+export type GetSharedPlanRequest = Message<"frontendapi.GetSharedPlanRequest"> & {
   /**
-   * The ID of the recipe to remove a bookmark for.
+   * The token of the link the plan was shared with.
    *
-   * @generated from field: string recipe_id = 1;
+   * @generated from field: string token = 1;
    */
-  recipeId: string;
+  token: string;
 };
 
-export type RemoveBookmarkRequestValid = RemoveBookmarkRequest;
+export type GetSharedPlanRequestValid = GetSharedPlanRequest;
 
 /**
- * Describes the message frontendapi.RemoveBookmarkRequest.
+ * Describes the message frontendapi.GetSharedPlanRequest.
+ * Use `create(GetSharedPlanRequestSchema)` to create a new message.
+ */
+export const GetSharedPlanRequestSchema: GenMessage<GetSharedPlanRequest, {validType: GetSharedPlanRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 36);
+
+/**
+ * A response for FrontendService.GetSharedPlan.
+ *
+ * @generated from message frontendapi.GetSharedPlanResponse
+ */
+export type GetSharedPlanResponse = Message<"frontendapi.GetSharedPlanResponse"> & {
+  /**
+   * The shared plan.
+   *
+   * @generated from field: frontendapi.Plan plan = 1;
+   */
+  plan?: Plan | undefined;
+};
+
+export type GetSharedPlanResponseValid = GetSharedPlanResponse;
+
+/**
+ * Describes the message frontendapi.GetSharedPlanResponse.
+ * Use `create(GetSharedPlanResponseSchema)` to create a new message.
+ */
+export const GetSharedPlanResponseSchema: GenMessage<GetSharedPlanResponse, {validType: GetSharedPlanResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 37);
+
+/**
+ * A request for FrontendService.GetPlanTimeline.
+ *
+ * @generated from message frontendapi.GetPlanTimelineRequest
+ */
+export type GetPlanTimelineRequest = Message<"frontendapi.GetPlanTimelineRequest"> & {
+  /**
+   * The ID of the plan to get the timeline for.
+   *
+   * @generated from field: string plan_id = 1;
+   */
+  planId: string;
+
+  /**
+   * The time the meal should be ready to serve.
+   *
+   * @generated from field: google.protobuf.Timestamp serve_at = 2;
+   */
+  serveAt?: Timestamp | undefined;
+
+  /**
+   * The IANA time zone of the user, e.g. Asia/Tokyo, used to determine
+   * calendar days. If unset, the time zone in the settings of the user is used.
+   *
+   * @generated from field: string time_zone = 3;
+   */
+  timeZone: string;
+};
+
+/**
+ * A request for FrontendService.GetPlanTimeline.
+ *
+ * @generated from message frontendapi.GetPlanTimelineRequest
+ */
+export type GetPlanTimelineRequestValid = Message<"frontendapi.GetPlanTimelineRequest"> & {
+  /**
+   * The ID of the plan to get the timeline for.
+   *
+   * @generated from field: string plan_id = 1;
+   */
+  planId: string;
+
+  /**
+   * The time the meal should be ready to serve.
+   *
+   * @generated from field: google.protobuf.Timestamp serve_at = 2;
+   */
+  serveAt: Timestamp;
+
+  /**
+   * The IANA time zone of the user, e.g. Asia/Tokyo, used to determine
+   * calendar days. If unset, the time zone in the settings of the user is used.
+   *
+   * @generated from field: string time_zone = 3;
+   */
+  timeZone: string;
+};
+
+/**
+ * Describes the message frontendapi.GetPlanTimelineRequest.
+ * Use `create(GetPlanTimelineRequestSchema)` to create a new message.
+ */
+export const GetPlanTimelineRequestSchema: GenMessage<GetPlanTimelineRequest, {validType: GetPlanTimelineRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 38);
+
+/**
+ * A step within a plan timeline.
+ *
+ * @generated from message frontendapi.TimelineStep
+ */
+export type TimelineStep = Message<"frontendapi.TimelineStep"> & {
+  /**
+   * The step.
+   *
+   * @generated from field: frontendapi.RecipeStep step = 1;
+   */
+  step?: RecipeStep | undefined;
+
+  /**
+   * The time to start the step.
+   *
+   * @generated from field: google.protobuf.Timestamp start_time = 2;
+   */
+  startTime?: Timestamp | undefined;
+
+  /**
+   * The time the step, including any passive waiting, is finished.
+   *
+   * @generated from field: google.protobuf.Timestamp end_time = 3;
+   */
+  endTime?: Timestamp | undefined;
+
+  /**
+   * Whether the step must begin on the day before the serving day.
+   *
+   * @generated from field: bool previous_day = 4;
+   */
+  previousDay: boolean;
+};
+
+export type TimelineStepValid = TimelineStep;
+
+/**
+ * Describes the message frontendapi.TimelineStep.
+ * Use `create(TimelineStepSchema)` to create a new message.
+ */
+export const TimelineStepSchema: GenMessage<TimelineStep, {validType: TimelineStepValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 39);
+
+/**
+ * A step group within a plan timeline.
+ *
+ * @generated from message frontendapi.TimelineStepGroup
+ */
+export type TimelineStepGroup = Message<"frontendapi.TimelineStepGroup"> & {
+  /**
+   * The label of the group.
+   *
+   * @generated from field: string label = 1;
+   */
+  label: string;
+
+  /**
+   * Useful note for the group.
+   *
+   * @generated from field: string note = 2;
+   */
+  note: string;
+
+  /**
+   * The time to start the group.
+   *
+   * @generated from field: google.protobuf.Timestamp start_time = 3;
+   */
+  startTime?: Timestamp | undefined;
+
+  /**
+   * The time all steps in the group are finished.
+   *
+   * @generated from field: google.protobuf.Timestamp end_time = 4;
+   */
+  endTime?: Timestamp | undefined;
+
+  /**
+   * The steps in the group.
+   *
+   * @generated from field: repeated frontendapi.TimelineStep steps = 5;
+   */
+  steps: TimelineStep[];
+
+  /**
+   * Whether the group must begin on the day before the serving day.
+   *
+   * @generated from field: bool previous_day = 6;
+   */
+  previousDay: boolean;
+};
+
+export type TimelineStepGroupValid = TimelineStepGroup;
+
+/**
+ * Describes the message frontendapi.TimelineStepGroup.
+ * Use `create(TimelineStepGroupSchema)` to create a new message.
+ */
+export const TimelineStepGroupSchema: GenMessage<TimelineStepGroup, {validType: TimelineStepGroupValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 40);
+
+/**
+ * A response for FrontendService.GetPlanTimeline.
+ *
+ * @generated from message frontendapi.GetPlanTimelineResponse
+ */
+export type GetPlanTimelineResponse = Message<"frontendapi.GetPlanTimelineResponse"> & {
+  /**
+   * The time to start cooking.
+   *
+   * @generated from field: google.protobuf.Timestamp start_time = 1;
+   */
+  startTime?: Timestamp | undefined;
+
+  /**
+   * The time the meal is ready to serve.
+   *
+   * @generated from field: google.protobuf.Timestamp serve_at = 2;
+   */
+  serveAt?: Timestamp | undefined;
+
+  /**
+   * The step groups of the plan with absolute times.
+   *
+   * @generated from field: repeated frontendapi.TimelineStepGroup step_groups = 3;
+   */
+  stepGroups: TimelineStepGroup[];
+};
+
+export type GetPlanTimelineResponseValid = GetPlanTimelineResponse;
+
+/**
+ * Describes the message frontendapi.GetPlanTimelineResponse.
+ * Use `create(GetPlanTimelineResponseSchema)` to create a new message.
+ */
+export const GetPlanTimelineResponseSchema: GenMessage<GetPlanTimelineResponse, {validType: GetPlanTimelineResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 41);
+
+/**
+ * A request for FrontendService.UpdatePlan.
+ *
+ * @generated from message frontendapi.UpdatePlanRequest
+ */
+export type UpdatePlanRequest = Message<"frontendapi.UpdatePlanRequest"> & {
+  /**
+   * The ID of the plan to update.
+   *
+   * @generated from field: string plan_id = 1;
+   */
+  planId: string;
+
+  /**
+   * The recipes for the plan. The step groups are regenerated for the recipes unless
+   * step_groups is also updated.
+   *
+   * @generated from field: repeated string recipe_ids = 2;
+   */
+  recipeIds: string[];
+
+  /**
+   * The notes of the plan.
+   *
+   * @generated from field: repeated string notes = 3;
+   */
+  notes: string[];
+
+  /**
+   * The step groups of the plan.
+   *
+   * @generated from field: repeated frontendapi.StepGroup step_groups = 4;
+   */
+  stepGroups: StepGroup[];
+
+  /**
+   * Serving sizes to show instead of those of the recipes, keyed by recipe ID.
+   *
+   * @generated from field: map<string, string> serving_sizes = 5;
+   */
+  servingSizes: { [key: string]: string };
+
+  /**
+   * The fields to update, any of recipe_ids, notes, step_groups and serving_sizes.
+   * If empty, only recipe_ids is updated.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 6;
+   */
+  updateMask?: FieldMask | undefined;
+
+  /**
+   * The update_time of the plan the update was made to. If set and the plan has been
+   * updated since, the request fails with ABORTED. Required when update_mask contains
+   * step_groups. If unset when updating recipe_ids, which regenerates step groups, the
+   * request fails with ABORTED if the plan is updated while they are generated.
+   *
+   * @generated from field: google.protobuf.Timestamp update_time = 7;
+   */
+  updateTime?: Timestamp | undefined;
+};
+
+export type UpdatePlanRequestValid = UpdatePlanRequest;
+
+/**
+ * Describes the message frontendapi.UpdatePlanRequest.
+ * Use `create(UpdatePlanRequestSchema)` to create a new message.
+ */
+export const UpdatePlanRequestSchema: GenMessage<UpdatePlanRequest, {validType: UpdatePlanRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 42);
+
+/**
+ * @generated from message frontendapi.UpdatePlanResponse
+ */
+export type UpdatePlanResponse = Message<"frontendapi.UpdatePlanResponse"> & {
+  /**
+   * @generated from field: frontendapi.Plan plan = 1;
+   */
+  plan?: Plan | undefined;
+};
+
+/**
+ * @generated from message frontendapi.UpdatePlanResponse
+ */
+export type UpdatePlanResponseValid = Message<"frontendapi.UpdatePlanResponse"> & {
+  /**
+   * @generated from field: frontendapi.Plan plan = 1;
+   */
+  plan: PlanValid;
+};
+
+/**
+ * Describes the message frontendapi.UpdatePlanResponse.
+ * Use `create(UpdatePlanResponseSchema)` to create a new message.
+ */
+export const UpdatePlanResponseSchema: GenMessage<UpdatePlanResponse, {validType: UpdatePlanResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 43);
+
+/**
+ * A request for FrontendService.DeletePlan.
+ *
+ * @generated from message frontendapi.DeletePlanRequest
+ */
+export type DeletePlanRequest = Message<"frontendapi.DeletePlanRequest"> & {
+  /**
+   * The ID of the plan to delete.
+   *
+   * @generated from field: string plan_id = 1;
+   */
+  planId: string;
+};
+
+export type DeletePlanRequestValid = DeletePlanRequest;
+
+/**
+ * Describes the message frontendapi.DeletePlanRequest.
+ * Use `create(DeletePlanRequestSchema)` to create a new message.
+ */
+export const DeletePlanRequestSchema: GenMessage<DeletePlanRequest, {validType: DeletePlanRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 44);
+
+/**
+ * A response for FrontendService.DeletePlan.
+ *
+ * @generated from message frontendapi.DeletePlanResponse
+ */
+export type DeletePlanResponse = Message<"frontendapi.DeletePlanResponse"> & {
+};
+
+export type DeletePlanResponseValid = DeletePlanResponse;
+
+/**
+ * Describes the message frontendapi.DeletePlanResponse.
+ * Use `create(DeletePlanResponseSchema)` to create a new message.
+ */
+export const DeletePlanResponseSchema: GenMessage<DeletePlanResponse, {validType: DeletePlanResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 45);
+
+/**
+ * A request for FrontendService.RestorePlan.
+ *
+ * @generated from message frontendapi.RestorePlanRequest
+ */
+export type RestorePlanRequest = Message<"frontendapi.RestorePlanRequest"> & {
+  /**
+   * The ID of the trashed plan to restore.
+   *
+   * @generated from field: string plan_id = 1;
+   */
+  planId: string;
+};
+
+export type RestorePlanRequestValid = RestorePlanRequest;
+
+/**
+ * Describes the message frontendapi.RestorePlanRequest.
+ * Use `create(RestorePlanRequestSchema)` to create a new message.
+ */
+export const RestorePlanRequestSchema: GenMessage<RestorePlanRequest, {validType: RestorePlanRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 46);
+
+/**
+ * A response for FrontendService.RestorePlan.
+ *
+ * @generated from message frontendapi.RestorePlanResponse
+ */
+export type RestorePlanResponse = Message<"frontendapi.RestorePlanResponse"> & {
+};
+
+export type RestorePlanResponseValid = RestorePlanResponse;
+
+/**
+ * Describes the message frontendapi.RestorePlanResponse.
+ * Use `create(RestorePlanResponseSchema)` to create a new message.
+ */
+export const RestorePlanResponseSchema: GenMessage<RestorePlanResponse, {validType: RestorePlanResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 47);
+
+/**
+ * A request for FrontendService.ListDeletedPlans.
+ *
+ * @generated from message frontendapi.ListDeletedPlansRequest
+ */
+export type ListDeletedPlansRequest = Message<"frontendapi.ListDeletedPlansRequest"> & {
+};
+
+export type ListDeletedPlansRequestValid = ListDeletedPlansRequest;
+
+/**
+ * Describes the message frontendapi.ListDeletedPlansRequest.
+ * Use `create(ListDeletedPlansRequestSchema)` to create a new message.
+ */
+export const ListDeletedPlansRequestSchema: GenMessage<ListDeletedPlansRequest, {validType: ListDeletedPlansRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 48);
+
+/**
+ * A plan in the trash.
+ *
+ * @generated from message frontendapi.DeletedPlan
+ */
+export type DeletedPlan = Message<"frontendapi.DeletedPlan"> & {
+  /**
+   * The plan.
+   *
+   * @generated from field: frontendapi.PlanSnippet plan = 1;
+   */
+  plan?: PlanSnippet | undefined;
+
+  /**
+   * The time the plan was moved to the trash.
+   *
+   * @generated from field: google.protobuf.Timestamp deleted_at = 2;
+   */
+  deletedAt?: Timestamp | undefined;
+
+  /**
+   * The time after which the plan is permanently deleted.
+   *
+   * @generated from field: google.protobuf.Timestamp purge_at = 3;
+   */
+  purgeAt?: Timestamp | undefined;
+};
+
+/**
+ * A plan in the trash.
+ *
+ * @generated from message frontendapi.DeletedPlan
+ */
+export type DeletedPlanValid = Message<"frontendapi.DeletedPlan"> & {
+  /**
+   * The plan.
+   *
+   * @generated from field: frontendapi.PlanSnippet plan = 1;
+   */
+  plan?: PlanSnippetValid | undefined;
+
+  /**
+   * The time the plan was moved to the trash.
+   *
+   * @generated from field: google.protobuf.Timestamp deleted_at = 2;
+   */
+  deletedAt?: Timestamp | undefined;
+
+  /**
+   * The time after which the plan is permanently deleted.
+   *
+   * @generated from field: google.protobuf.Timestamp purge_at = 3;
+   */
+  purgeAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message frontendapi.DeletedPlan.
+ * Use `create(DeletedPlanSchema)` to create a new message.
+ */
+export const DeletedPlanSchema: GenMessage<DeletedPlan, {validType: DeletedPlanValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 49);
+
+/**
+ * A response for FrontendService.ListDeletedPlans.
+ *
+ * @generated from message frontendapi.ListDeletedPlansResponse
+ */
+export type ListDeletedPlansResponse = Message<"frontendapi.ListDeletedPlansResponse"> & {
+  /**
+   * The plans in the trash, most recently deleted first.
+   *
+   * @generated from field: repeated frontendapi.DeletedPlan plans = 1;
+   */
+  plans: DeletedPlan[];
+};
+
+/**
+ * A response for FrontendService.ListDeletedPlans.
+ *
+ * @generated from message frontendapi.ListDeletedPlansResponse
+ */
+export type ListDeletedPlansResponseValid = Message<"frontendapi.ListDeletedPlansResponse"> & {
+  /**
+   * The plans in the trash, most recently deleted first.
+   *
+   * @generated from field: repeated frontendapi.DeletedPlan plans = 1;
+   */
+  plans: DeletedPlanValid[];
+};
+
+/**
+ * Describes the message frontendapi.ListDeletedPlansResponse.
+ * Use `create(ListDeletedPlansResponseSchema)` to create a new message.
+ */
+export const ListDeletedPlansResponseSchema: GenMessage<ListDeletedPlansResponse, {validType: ListDeletedPlansResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 50);
+
+/**
+ * A request for FrontendService.RetryPlan.
+ *
+ * @generated from message frontendapi.RetryPlanRequest
+ */
+export type RetryPlanRequest = Message<"frontendapi.RetryPlanRequest"> & {
+  /**
+   * The ID of the plan to retry.
+   *
+   * @generated from field: string plan_id = 1;
+   */
+  planId: string;
+};
+
+export type RetryPlanRequestValid = RetryPlanRequest;
+
+/**
+ * Describes the message frontendapi.RetryPlanRequest.
+ * Use `create(RetryPlanRequestSchema)` to create a new message.
+ */
+export const RetryPlanRequestSchema: GenMessage<RetryPlanRequest, {validType: RetryPlanRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 51);
+
+/**
+ * A response for FrontendService.RetryPlan.
+ *
+ * @generated from message frontendapi.RetryPlanResponse
+ */
+export type RetryPlanResponse = Message<"frontendapi.RetryPlanResponse"> & {
+};
+
+export type RetryPlanResponseValid = RetryPlanResponse;
+
+/**
+ * Describes the message frontendapi.RetryPlanResponse.
+ * Use `create(RetryPlanResponseSchema)` to create a new message.
+ */
+export const RetryPlanResponseSchema: GenMessage<RetryPlanResponse, {validType: RetryPlanResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 52);
+
+/**
+ * A request for FrontendService.SuggestAlternatives.
+ *
+ * @generated from message frontendapi.SuggestAlternativesRequest
+ */
+export type SuggestAlternativesRequest = Message<"frontendapi.SuggestAlternativesRequest"> & {
+  /**
+   * The ID of the plan.
+   *
+   * @generated from field: string plan_id = 1;
+   */
+  planId: string;
+
+  /**
+   * The ID of the recipe in the plan to suggest alternatives for.
+   *
+   * @generated from field: string recipe_id = 2;
+   */
+  recipeId: string;
+};
+
+export type SuggestAlternativesRequestValid = SuggestAlternativesRequest;
+
+/**
+ * Describes the message frontendapi.SuggestAlternativesRequest.
+ * Use `create(SuggestAlternativesRequestSchema)` to create a new message.
+ */
+export const SuggestAlternativesRequestSchema: GenMessage<SuggestAlternativesRequest, {validType: SuggestAlternativesRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 53);
+
+/**
+ * A response for FrontendService.SuggestAlternatives.
+ *
+ * @generated from message frontendapi.SuggestAlternativesResponse
+ */
+export type SuggestAlternativesResponse = Message<"frontendapi.SuggestAlternativesResponse"> & {
+  /**
+   * Recipes of the same type that can replace the recipe, best first.
+   *
+   * @generated from field: repeated frontendapi.RecipeSnippet alternatives = 1;
+   */
+  alternatives: RecipeSnippet[];
+};
+
+export type SuggestAlternativesResponseValid = SuggestAlternativesResponse;
+
+/**
+ * Describes the message frontendapi.SuggestAlternativesResponse.
+ * Use `create(SuggestAlternativesResponseSchema)` to create a new message.
+ */
+export const SuggestAlternativesResponseSchema: GenMessage<SuggestAlternativesResponse, {validType: SuggestAlternativesResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 54);
+
+/**
+ * A request for FrontendService.ReplacePlanRecipe.
+ *
+ * @generated from message frontendapi.ReplacePlanRecipeRequest
+ */
+export type ReplacePlanRecipeRequest = Message<"frontendapi.ReplacePlanRecipeRequest"> & {
+  /**
+   * The ID of the plan.
+   *
+   * @generated from field: string plan_id = 1;
+   */
+  planId: string;
+
+  /**
+   * The ID of the recipe in the plan to replace.
+   *
+   * @generated from field: string recipe_id = 2;
+   */
+  recipeId: string;
+
+  /**
+   * The ID of the recipe to replace it with.
+   *
+   * @generated from field: string replacement_recipe_id = 3;
+   */
+  replacementRecipeId: string;
+};
+
+export type ReplacePlanRecipeRequestValid = ReplacePlanRecipeRequest;
+
+/**
+ * Describes the message frontendapi.ReplacePlanRecipeRequest.
+ * Use `create(ReplacePlanRecipeRequestSchema)` to create a new message.
+ */
+export const ReplacePlanRecipeRequestSchema: GenMessage<ReplacePlanRecipeRequest, {validType: ReplacePlanRecipeRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 55);
+
+/**
+ * A response for FrontendService.ReplacePlanRecipe.
+ *
+ * @generated from message frontendapi.ReplacePlanRecipeResponse
+ */
+export type ReplacePlanRecipeResponse = Message<"frontendapi.ReplacePlanRecipeResponse"> & {
+};
+
+export type ReplacePlanRecipeResponseValid = ReplacePlanRecipeResponse;
+
+/**
+ * Describes the message frontendapi.ReplacePlanRecipeResponse.
+ * Use `create(ReplacePlanRecipeResponseSchema)` to create a new message.
+ */
+export const ReplacePlanRecipeResponseSchema: GenMessage<ReplacePlanRecipeResponse, {validType: ReplacePlanRecipeResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 56);
+
+/**
+ * The recipes to cook on a day of the week in a plan template.
+ *
+ * @generated from message frontendapi.PlanTemplateSlot
+ */
+export type PlanTemplateSlot = Message<"frontendapi.PlanTemplateSlot"> & {
+  /**
+   * The day of the week of the slot.
+   *
+   * @generated from field: frontendapi.DayOfWeek day_of_week = 1;
+   */
+  dayOfWeek: DayOfWeek;
+
+  /**
+   * The IDs of the recipes to cook on the day.
+   *
+   * @generated from field: repeated string recipe_ids = 2;
+   */
+  recipeIds: string[];
+};
+
+export type PlanTemplateSlotValid = PlanTemplateSlot;
+
+/**
+ * Describes the message frontendapi.PlanTemplateSlot.
+ * Use `create(PlanTemplateSlotSchema)` to create a new message.
+ */
+export const PlanTemplateSlotSchema: GenMessage<PlanTemplateSlot, {validType: PlanTemplateSlotValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 57);
+
+/**
+ * A request for FrontendService.SavePlanTemplate.
+ *
+ * @generated from message frontendapi.SavePlanTemplateRequest
+ */
+export type SavePlanTemplateRequest = Message<"frontendapi.SavePlanTemplateRequest"> & {
+  /**
+   * The ID of an existing template to overwrite. If unset, a new template is created.
+   *
+   * @generated from field: string template_id = 1;
+   */
+  templateId: string;
+
+  /**
+   * The name of the template.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * The slots of the template. Each day of the week may only have one slot.
+   *
+   * @generated from field: repeated frontendapi.PlanTemplateSlot slots = 3;
+   */
+  slots: PlanTemplateSlot[];
+};
+
+export type SavePlanTemplateRequestValid = SavePlanTemplateRequest;
+
+/**
+ * Describes the message frontendapi.SavePlanTemplateRequest.
+ * Use `create(SavePlanTemplateRequestSchema)` to create a new message.
+ */
+export const SavePlanTemplateRequestSchema: GenMessage<SavePlanTemplateRequest, {validType: SavePlanTemplateRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 58);
+
+/**
+ * A response for FrontendService.SavePlanTemplate.
+ *
+ * @generated from message frontendapi.SavePlanTemplateResponse
+ */
+export type SavePlanTemplateResponse = Message<"frontendapi.SavePlanTemplateResponse"> & {
+  /**
+   * The ID of the saved template.
+   *
+   * @generated from field: string template_id = 1;
+   */
+  templateId: string;
+};
+
+export type SavePlanTemplateResponseValid = SavePlanTemplateResponse;
+
+/**
+ * Describes the message frontendapi.SavePlanTemplateResponse.
+ * Use `create(SavePlanTemplateResponseSchema)` to create a new message.
+ */
+export const SavePlanTemplateResponseSchema: GenMessage<SavePlanTemplateResponse, {validType: SavePlanTemplateResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 59);
+
+/**
+ * A request for FrontendService.ApplyPlanTemplate.
+ *
+ * @generated from message frontendapi.ApplyPlanTemplateRequest
+ */
+export type ApplyPlanTemplateRequest = Message<"frontendapi.ApplyPlanTemplateRequest"> & {
+  /**
+   * The ID of the template to apply.
+   *
+   * @generated from field: string template_id = 1;
+   */
+  templateId: string;
+
+  /**
+   * A time within the first day of the week to create plans for. Plans are created
+   * for the seven days starting from this day.
+   *
+   * @generated from field: google.protobuf.Timestamp week_start = 2;
+   */
+  weekStart?: Timestamp | undefined;
+
+  /**
+   * The IANA time zone of the user, e.g. Asia/Tokyo, used to determine
+   * calendar days. If unset, the time zone in the settings of the user is used.
+   *
+   * @generated from field: string time_zone = 3;
+   */
+  timeZone: string;
+};
+
+/**
+ * A request for FrontendService.ApplyPlanTemplate.
+ *
+ * @generated from message frontendapi.ApplyPlanTemplateRequest
+ */
+export type ApplyPlanTemplateRequestValid = Message<"frontendapi.ApplyPlanTemplateRequest"> & {
+  /**
+   * The ID of the template to apply.
+   *
+   * @generated from field: string template_id = 1;
+   */
+  templateId: string;
+
+  /**
+   * A time within the first day of the week to create plans for. Plans are created
+   * for the seven days starting from this day.
+   *
+   * @generated from field: google.protobuf.Timestamp week_start = 2;
+   */
+  weekStart: Timestamp;
+
+  /**
+   * The IANA time zone of the user, e.g. Asia/Tokyo, used to determine
+   * calendar days. If unset, the time zone in the settings of the user is used.
+   *
+   * @generated from field: string time_zone = 3;
+   */
+  timeZone: string;
+};
+
+/**
+ * Describes the message frontendapi.ApplyPlanTemplateRequest.
+ * Use `create(ApplyPlanTemplateRequestSchema)` to create a new message.
+ */
+export const ApplyPlanTemplateRequestSchema: GenMessage<ApplyPlanTemplateRequest, {validType: ApplyPlanTemplateRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 60);
+
+/**
+ * A response for FrontendService.ApplyPlanTemplate.
+ *
+ * @generated from message frontendapi.ApplyPlanTemplateResponse
+ */
+export type ApplyPlanTemplateResponse = Message<"frontendapi.ApplyPlanTemplateResponse"> & {
+  /**
+   * The IDs of the created plans.
+   *
+   * @generated from field: repeated string plan_ids = 1;
+   */
+  planIds: string[];
+
+  /**
+   * The days of the template that were skipped because they already have a plan.
+   *
+   * @generated from field: repeated frontendapi.DayOfWeek skipped_days = 2;
+   */
+  skippedDays: DayOfWeek[];
+};
+
+export type ApplyPlanTemplateResponseValid = ApplyPlanTemplateResponse;
+
+/**
+ * Describes the message frontendapi.ApplyPlanTemplateResponse.
+ * Use `create(ApplyPlanTemplateResponseSchema)` to create a new message.
+ */
+export const ApplyPlanTemplateResponseSchema: GenMessage<ApplyPlanTemplateResponse, {validType: ApplyPlanTemplateResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 61);
+
+/**
+ * The price of an ingredient used to estimate recipe costs.
+ *
+ * @generated from message frontendapi.IngredientPrice
+ */
+export type IngredientPrice = Message<"frontendapi.IngredientPrice"> & {
+  /**
+   * The name of the ingredient.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The unit the price is for, e.g. g, ml, or 個.
+   *
+   * @generated from field: string unit = 2;
+   */
+  unit: string;
+
+  /**
+   * The price in yen of one unit of the ingredient.
+   *
+   * @generated from field: double yen = 3;
+   */
+  yen: number;
+
+  /**
+   * Whether the price was set by the user rather than a default.
+   *
+   * @generated from field: bool custom = 4;
+   */
+  custom: boolean;
+};
+
+export type IngredientPriceValid = IngredientPrice;
+
+/**
+ * Describes the message frontendapi.IngredientPrice.
+ * Use `create(IngredientPriceSchema)` to create a new message.
+ */
+export const IngredientPriceSchema: GenMessage<IngredientPrice, {validType: IngredientPriceValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 62);
+
+/**
+ * A request for FrontendService.ListIngredientPrices.
+ *
+ * @generated from message frontendapi.ListIngredientPricesRequest
+ */
+export type ListIngredientPricesRequest = Message<"frontendapi.ListIngredientPricesRequest"> & {
+};
+
+export type ListIngredientPricesRequestValid = ListIngredientPricesRequest;
+
+/**
+ * Describes the message frontendapi.ListIngredientPricesRequest.
+ * Use `create(ListIngredientPricesRequestSchema)` to create a new message.
+ */
+export const ListIngredientPricesRequestSchema: GenMessage<ListIngredientPricesRequest, {validType: ListIngredientPricesRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 63);
+
+/**
+ * A response for FrontendService.ListIngredientPrices.
+ *
+ * @generated from message frontendapi.ListIngredientPricesResponse
+ */
+export type ListIngredientPricesResponse = Message<"frontendapi.ListIngredientPricesResponse"> & {
+  /**
+   * The prices of ingredients, including defaults and prices set by the user.
+   *
+   * @generated from field: repeated frontendapi.IngredientPrice prices = 1;
+   */
+  prices: IngredientPrice[];
+};
+
+export type ListIngredientPricesResponseValid = ListIngredientPricesResponse;
+
+/**
+ * Describes the message frontendapi.ListIngredientPricesResponse.
+ * Use `create(ListIngredientPricesResponseSchema)` to create a new message.
+ */
+export const ListIngredientPricesResponseSchema: GenMessage<ListIngredientPricesResponse, {validType: ListIngredientPricesResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 64);
+
+/**
+ * A request for FrontendService.SetIngredientPrice.
+ *
+ * @generated from message frontendapi.SetIngredientPriceRequest
+ */
+export type SetIngredientPriceRequest = Message<"frontendapi.SetIngredientPriceRequest"> & {
+  /**
+   * The name of the ingredient.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The unit the price is for, e.g. g, ml, or 個.
+   *
+   * @generated from field: string unit = 2;
+   */
+  unit: string;
+
+  /**
+   * The price in yen of one unit of the ingredient.
+   *
+   * @generated from field: double yen = 3;
+   */
+  yen: number;
+};
+
+export type SetIngredientPriceRequestValid = SetIngredientPriceRequest;
+
+/**
+ * Describes the message frontendapi.SetIngredientPriceRequest.
+ * Use `create(SetIngredientPriceRequestSchema)` to create a new message.
+ */
+export const SetIngredientPriceRequestSchema: GenMessage<SetIngredientPriceRequest, {validType: SetIngredientPriceRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 65);
+
+/**
+ * A response for FrontendService.SetIngredientPrice.
+ *
+ * @generated from message frontendapi.SetIngredientPriceResponse
+ */
+export type SetIngredientPriceResponse = Message<"frontendapi.SetIngredientPriceResponse"> & {
+};
+
+export type SetIngredientPriceResponseValid = SetIngredientPriceResponse;
+
+/**
+ * Describes the message frontendapi.SetIngredientPriceResponse.
+ * Use `create(SetIngredientPriceResponseSchema)` to create a new message.
+ */
+export const SetIngredientPriceResponseSchema: GenMessage<SetIngredientPriceResponse, {validType: SetIngredientPriceResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 66);
+
+/**
+ * A request for FrontendService.DeleteIngredientPrice.
+ *
+ * @generated from message frontendapi.DeleteIngredientPriceRequest
+ */
+export type DeleteIngredientPriceRequest = Message<"frontendapi.DeleteIngredientPriceRequest"> & {
+  /**
+   * The name of the ingredient.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The unit of the price to delete.
+   *
+   * @generated from field: string unit = 2;
+   */
+  unit: string;
+};
+
+export type DeleteIngredientPriceRequestValid = DeleteIngredientPriceRequest;
+
+/**
+ * Describes the message frontendapi.DeleteIngredientPriceRequest.
+ * Use `create(DeleteIngredientPriceRequestSchema)` to create a new message.
+ */
+export const DeleteIngredientPriceRequestSchema: GenMessage<DeleteIngredientPriceRequest, {validType: DeleteIngredientPriceRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 67);
+
+/**
+ * A response for FrontendService.DeleteIngredientPrice.
+ *
+ * @generated from message frontendapi.DeleteIngredientPriceResponse
+ */
+export type DeleteIngredientPriceResponse = Message<"frontendapi.DeleteIngredientPriceResponse"> & {
+};
+
+export type DeleteIngredientPriceResponseValid = DeleteIngredientPriceResponse;
+
+/**
+ * Describes the message frontendapi.DeleteIngredientPriceResponse.
+ * Use `create(DeleteIngredientPriceResponseSchema)` to create a new message.
+ */
+export const DeleteIngredientPriceResponseSchema: GenMessage<DeleteIngredientPriceResponse, {validType: DeleteIngredientPriceResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 68);
+
+/**
+ * A request for FrontendService.MarkCooked.
+ *
+ * @generated from message frontendapi.MarkCookedRequest
+ */
+export type MarkCookedRequest = Message<"frontendapi.MarkCookedRequest"> & {
+  /**
+   * What was cooked.
+   *
+   * @generated from oneof frontendapi.MarkCookedRequest.target
+   */
+  target: {
+    /**
+     * The ID of a cooked recipe.
+     *
+     * @generated from field: string recipe_id = 1;
+     */
+    value: string;
+    case: "recipeId";
+  } | {
+    /**
+     * The ID of a cooked plan.
+     *
+     * @generated from field: string plan_id = 2;
+     */
+    value: string;
+    case: "planId";
+  } | { case: undefined; value?: undefined };
+
+  /**
+   * The time the recipe or plan was cooked. If unset, the current time is used.
+   *
+   * @generated from field: google.protobuf.Timestamp cooked_at = 3;
+   */
+  cookedAt?: Timestamp | undefined;
+
+  /**
+   * The rating from 1 to 5, or 0 for no rating.
+   *
+   * @generated from field: uint32 rating = 4;
+   */
+  rating: number;
+
+  /**
+   * The number of servings cooked.
+   *
+   * @generated from field: uint32 servings = 5;
+   */
+  servings: number;
+
+  /**
+   * Free-form notes about cooking.
+   *
+   * @generated from field: string notes = 6;
+   */
+  notes: string;
+};
+
+export type MarkCookedRequestValid = MarkCookedRequest;
+
+/**
+ * Describes the message frontendapi.MarkCookedRequest.
+ * Use `create(MarkCookedRequestSchema)` to create a new message.
+ */
+export const MarkCookedRequestSchema: GenMessage<MarkCookedRequest, {validType: MarkCookedRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 69);
+
+/**
+ * A response for FrontendService.MarkCooked.
+ *
+ * @generated from message frontendapi.MarkCookedResponse
+ */
+export type MarkCookedResponse = Message<"frontendapi.MarkCookedResponse"> & {
+  /**
+   * The ID of the created history entry.
+   *
+   * @generated from field: string entry_id = 1;
+   */
+  entryId: string;
+};
+
+export type MarkCookedResponseValid = MarkCookedResponse;
+
+/**
+ * Describes the message frontendapi.MarkCookedResponse.
+ * Use `create(MarkCookedResponseSchema)` to create a new message.
+ */
+export const MarkCookedResponseSchema: GenMessage<MarkCookedResponse, {validType: MarkCookedResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 70);
+
+/**
+ * An entry in the cooking history of a user.
+ *
+ * @generated from message frontendapi.CookingHistoryEntry
+ */
+export type CookingHistoryEntry = Message<"frontendapi.CookingHistoryEntry"> & {
+  /**
+   * The ID of the entry.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The recipes cooked.
+   *
+   * @generated from field: repeated frontendapi.RecipeSnippet recipes = 2;
+   */
+  recipes: RecipeSnippet[];
+
+  /**
+   * The ID of the plan cooked, if a plan was cooked.
+   *
+   * @generated from field: string plan_id = 3;
+   */
+  planId: string;
+
+  /**
+   * The time the recipes were cooked.
+   *
+   * @generated from field: google.protobuf.Timestamp cooked_at = 4;
+   */
+  cookedAt?: Timestamp | undefined;
+
+  /**
+   * The rating from 1 to 5, or 0 if not rated.
+   *
+   * @generated from field: uint32 rating = 5;
+   */
+  rating: number;
+
+  /**
+   * The number of servings cooked.
+   *
+   * @generated from field: uint32 servings = 6;
+   */
+  servings: number;
+
+  /**
+   * Free-form notes about cooking.
+   *
+   * @generated from field: string notes = 7;
+   */
+  notes: string;
+};
+
+export type CookingHistoryEntryValid = CookingHistoryEntry;
+
+/**
+ * Describes the message frontendapi.CookingHistoryEntry.
+ * Use `create(CookingHistoryEntrySchema)` to create a new message.
+ */
+export const CookingHistoryEntrySchema: GenMessage<CookingHistoryEntry, {validType: CookingHistoryEntryValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 71);
+
+/**
+ * A request for FrontendService.ListCookingHistory.
+ *
+ * @generated from message frontendapi.ListCookingHistoryRequest
+ */
+export type ListCookingHistoryRequest = Message<"frontendapi.ListCookingHistoryRequest"> & {
+  /**
+   * The pagination token for the next page of entries.
+   * If unset, the first page is returned.
+   *
+   * @generated from field: frontendapi.Pagination pagination = 1;
+   */
+  pagination?: Pagination | undefined;
+};
+
+export type ListCookingHistoryRequestValid = ListCookingHistoryRequest;
+
+/**
+ * Describes the message frontendapi.ListCookingHistoryRequest.
+ * Use `create(ListCookingHistoryRequestSchema)` to create a new message.
+ */
+export const ListCookingHistoryRequestSchema: GenMessage<ListCookingHistoryRequest, {validType: ListCookingHistoryRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 72);
+
+/**
+ * A response for FrontendService.ListCookingHistory.
+ *
+ * @generated from message frontendapi.ListCookingHistoryResponse
+ */
+export type ListCookingHistoryResponse = Message<"frontendapi.ListCookingHistoryResponse"> & {
+  /**
+   * The history entries, most recently cooked first.
+   *
+   * @generated from field: repeated frontendapi.CookingHistoryEntry entries = 1;
+   */
+  entries: CookingHistoryEntry[];
+
+  /**
+   * The pagination token for the next page of entries.
+   *
+   * @generated from field: frontendapi.Pagination pagination = 2;
+   */
+  pagination?: Pagination | undefined;
+};
+
+export type ListCookingHistoryResponseValid = ListCookingHistoryResponse;
+
+/**
+ * Describes the message frontendapi.ListCookingHistoryResponse.
+ * Use `create(ListCookingHistoryResponseSchema)` to create a new message.
+ */
+export const ListCookingHistoryResponseSchema: GenMessage<ListCookingHistoryResponse, {validType: ListCookingHistoryResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 73);
+
+/**
+ * A member of a household.
+ *
+ * @generated from message frontendapi.HouseholdMember
+ */
+export type HouseholdMember = Message<"frontendapi.HouseholdMember"> & {
+  /**
+   * The ID of the user.
+   *
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * The role of the member.
+   *
+   * @generated from field: frontendapi.HouseholdRole role = 2;
+   */
+  role: HouseholdRole;
+
+  /**
+   * The time the member joined the household.
+   *
+   * @generated from field: google.protobuf.Timestamp joined_at = 3;
+   */
+  joinedAt?: Timestamp | undefined;
+};
+
+export type HouseholdMemberValid = HouseholdMember;
+
+/**
+ * Describes the message frontendapi.HouseholdMember.
+ * Use `create(HouseholdMemberSchema)` to create a new message.
+ */
+export const HouseholdMemberSchema: GenMessage<HouseholdMember, {validType: HouseholdMemberValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 74);
+
+/**
+ * A household sharing plans, bookmarks, and other data between members.
+ *
+ * @generated from message frontendapi.Household
+ */
+export type Household = Message<"frontendapi.Household"> & {
+  /**
+   * The ID of the household.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The name of the household.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * The members of the household.
+   *
+   * @generated from field: repeated frontendapi.HouseholdMember members = 3;
+   */
+  members: HouseholdMember[];
+};
+
+export type HouseholdValid = Household;
+
+/**
+ * Describes the message frontendapi.Household.
+ * Use `create(HouseholdSchema)` to create a new message.
+ */
+export const HouseholdSchema: GenMessage<Household, {validType: HouseholdValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 75);
+
+/**
+ * A request for FrontendService.CreateHousehold.
+ *
+ * @generated from message frontendapi.CreateHouseholdRequest
+ */
+export type CreateHouseholdRequest = Message<"frontendapi.CreateHouseholdRequest"> & {
+  /**
+   * The name of the household.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+export type CreateHouseholdRequestValid = CreateHouseholdRequest;
+
+/**
+ * Describes the message frontendapi.CreateHouseholdRequest.
+ * Use `create(CreateHouseholdRequestSchema)` to create a new message.
+ */
+export const CreateHouseholdRequestSchema: GenMessage<CreateHouseholdRequest, {validType: CreateHouseholdRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 76);
+
+/**
+ * A response for FrontendService.CreateHousehold.
+ *
+ * @generated from message frontendapi.CreateHouseholdResponse
+ */
+export type CreateHouseholdResponse = Message<"frontendapi.CreateHouseholdResponse"> & {
+  /**
+   * The ID of the created household.
+   *
+   * @generated from field: string household_id = 1;
+   */
+  householdId: string;
+};
+
+export type CreateHouseholdResponseValid = CreateHouseholdResponse;
+
+/**
+ * Describes the message frontendapi.CreateHouseholdResponse.
+ * Use `create(CreateHouseholdResponseSchema)` to create a new message.
+ */
+export const CreateHouseholdResponseSchema: GenMessage<CreateHouseholdResponse, {validType: CreateHouseholdResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 77);
+
+/**
+ * A request for FrontendService.GetHousehold.
+ *
+ * @generated from message frontendapi.GetHouseholdRequest
+ */
+export type GetHouseholdRequest = Message<"frontendapi.GetHouseholdRequest"> & {
+};
+
+export type GetHouseholdRequestValid = GetHouseholdRequest;
+
+/**
+ * Describes the message frontendapi.GetHouseholdRequest.
+ * Use `create(GetHouseholdRequestSchema)` to create a new message.
+ */
+export const GetHouseholdRequestSchema: GenMessage<GetHouseholdRequest, {validType: GetHouseholdRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 78);
+
+/**
+ * A response for FrontendService.GetHousehold.
+ *
+ * @generated from message frontendapi.GetHouseholdResponse
+ */
+export type GetHouseholdResponse = Message<"frontendapi.GetHouseholdResponse"> & {
+  /**
+   * The household of the user, unset if the user is not in a household.
+   *
+   * @generated from field: frontendapi.Household household = 1;
+   */
+  household?: Household | undefined;
+
+  /**
+   * The role of the user in the household.
+   *
+   * @generated from field: frontendapi.HouseholdRole role = 2;
+   */
+  role: HouseholdRole;
+};
+
+export type GetHouseholdResponseValid = GetHouseholdResponse;
+
+/**
+ * Describes the message frontendapi.GetHouseholdResponse.
+ * Use `create(GetHouseholdResponseSchema)` to create a new message.
+ */
+export const GetHouseholdResponseSchema: GenMessage<GetHouseholdResponse, {validType: GetHouseholdResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 79);
+
+/**
+ * A request for FrontendService.CreateHouseholdInvitation.
+ *
+ * @generated from message frontendapi.CreateHouseholdInvitationRequest
+ */
+export type CreateHouseholdInvitationRequest = Message<"frontendapi.CreateHouseholdInvitationRequest"> & {
+  /**
+   * The role to give the invitee.
+   *
+   * @generated from field: frontendapi.HouseholdRole role = 1;
+   */
+  role: HouseholdRole;
+};
+
+export type CreateHouseholdInvitationRequestValid = CreateHouseholdInvitationRequest;
+
+/**
+ * Describes the message frontendapi.CreateHouseholdInvitationRequest.
+ * Use `create(CreateHouseholdInvitationRequestSchema)` to create a new message.
+ */
+export const CreateHouseholdInvitationRequestSchema: GenMessage<CreateHouseholdInvitationRequest, {validType: CreateHouseholdInvitationRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 80);
+
+/**
+ * A response for FrontendService.CreateHouseholdInvitation.
+ *
+ * @generated from message frontendapi.CreateHouseholdInvitationResponse
+ */
+export type CreateHouseholdInvitationResponse = Message<"frontendapi.CreateHouseholdInvitationResponse"> & {
+  /**
+   * The ID of the invitation, to share with the invitee.
+   *
+   * @generated from field: string invitation_id = 1;
+   */
+  invitationId: string;
+
+  /**
+   * The time after which the invitation can no longer be accepted.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 2;
+   */
+  expiresAt?: Timestamp | undefined;
+};
+
+export type CreateHouseholdInvitationResponseValid = CreateHouseholdInvitationResponse;
+
+/**
+ * Describes the message frontendapi.CreateHouseholdInvitationResponse.
+ * Use `create(CreateHouseholdInvitationResponseSchema)` to create a new message.
+ */
+export const CreateHouseholdInvitationResponseSchema: GenMessage<CreateHouseholdInvitationResponse, {validType: CreateHouseholdInvitationResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 81);
+
+/**
+ * A request for FrontendService.AcceptHouseholdInvitation.
+ *
+ * @generated from message frontendapi.AcceptHouseholdInvitationRequest
+ */
+export type AcceptHouseholdInvitationRequest = Message<"frontendapi.AcceptHouseholdInvitationRequest"> & {
+  /**
+   * The ID of the invitation to accept.
+   *
+   * @generated from field: string invitation_id = 1;
+   */
+  invitationId: string;
+};
+
+export type AcceptHouseholdInvitationRequestValid = AcceptHouseholdInvitationRequest;
+
+/**
+ * Describes the message frontendapi.AcceptHouseholdInvitationRequest.
+ * Use `create(AcceptHouseholdInvitationRequestSchema)` to create a new message.
+ */
+export const AcceptHouseholdInvitationRequestSchema: GenMessage<AcceptHouseholdInvitationRequest, {validType: AcceptHouseholdInvitationRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 82);
+
+/**
+ * A response for FrontendService.AcceptHouseholdInvitation.
+ *
+ * @generated from message frontendapi.AcceptHouseholdInvitationResponse
+ */
+export type AcceptHouseholdInvitationResponse = Message<"frontendapi.AcceptHouseholdInvitationResponse"> & {
+  /**
+   * The ID of the joined household.
+   *
+   * @generated from field: string household_id = 1;
+   */
+  householdId: string;
+};
+
+export type AcceptHouseholdInvitationResponseValid = AcceptHouseholdInvitationResponse;
+
+/**
+ * Describes the message frontendapi.AcceptHouseholdInvitationResponse.
+ * Use `create(AcceptHouseholdInvitationResponseSchema)` to create a new message.
+ */
+export const AcceptHouseholdInvitationResponseSchema: GenMessage<AcceptHouseholdInvitationResponse, {validType: AcceptHouseholdInvitationResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 83);
+
+/**
+ * A request for FrontendService.RemoveHouseholdMember.
+ *
+ * @generated from message frontendapi.RemoveHouseholdMemberRequest
+ */
+export type RemoveHouseholdMemberRequest = Message<"frontendapi.RemoveHouseholdMemberRequest"> & {
+  /**
+   * The ID of the user to remove. Owners can remove any other member, and other
+   * members can only remove themselves to leave the household.
+   *
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+export type RemoveHouseholdMemberRequestValid = RemoveHouseholdMemberRequest;
+
+/**
+ * Describes the message frontendapi.RemoveHouseholdMemberRequest.
+ * Use `create(RemoveHouseholdMemberRequestSchema)` to create a new message.
+ */
+export const RemoveHouseholdMemberRequestSchema: GenMessage<RemoveHouseholdMemberRequest, {validType: RemoveHouseholdMemberRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 84);
+
+/**
+ * A response for FrontendService.RemoveHouseholdMember.
+ *
+ * @generated from message frontendapi.RemoveHouseholdMemberResponse
+ */
+export type RemoveHouseholdMemberResponse = Message<"frontendapi.RemoveHouseholdMemberResponse"> & {
+};
+
+export type RemoveHouseholdMemberResponseValid = RemoveHouseholdMemberResponse;
+
+/**
+ * Describes the message frontendapi.RemoveHouseholdMemberResponse.
+ * Use `create(RemoveHouseholdMemberResponseSchema)` to create a new message.
+ */
+export const RemoveHouseholdMemberResponseSchema: GenMessage<RemoveHouseholdMemberResponse, {validType: RemoveHouseholdMemberResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 85);
+
+/**
+ * Settings of a user.
+ *
+ * @generated from message frontendapi.UserSettings
+ */
+export type UserSettings = Message<"frontendapi.UserSettings"> & {
+  /**
+   * The IANA time zone of the user, e.g. Asia/Tokyo, used to compute the calendar dates
+   * of plans. If unset, Asia/Tokyo is used.
+   *
+   * @generated from field: string time_zone = 1;
+   */
+  timeZone: string;
+};
+
+export type UserSettingsValid = UserSettings;
+
+/**
+ * Describes the message frontendapi.UserSettings.
+ * Use `create(UserSettingsSchema)` to create a new message.
+ */
+export const UserSettingsSchema: GenMessage<UserSettings, {validType: UserSettingsValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 86);
+
+/**
+ * A request for FrontendService.GetUserSettings.
+ *
+ * @generated from message frontendapi.GetUserSettingsRequest
+ */
+export type GetUserSettingsRequest = Message<"frontendapi.GetUserSettingsRequest"> & {
+};
+
+export type GetUserSettingsRequestValid = GetUserSettingsRequest;
+
+/**
+ * Describes the message frontendapi.GetUserSettingsRequest.
+ * Use `create(GetUserSettingsRequestSchema)` to create a new message.
+ */
+export const GetUserSettingsRequestSchema: GenMessage<GetUserSettingsRequest, {validType: GetUserSettingsRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 87);
+
+/**
+ * A response for FrontendService.GetUserSettings.
+ *
+ * @generated from message frontendapi.GetUserSettingsResponse
+ */
+export type GetUserSettingsResponse = Message<"frontendapi.GetUserSettingsResponse"> & {
+  /**
+   * The settings of the user.
+   *
+   * @generated from field: frontendapi.UserSettings settings = 1;
+   */
+  settings?: UserSettings | undefined;
+};
+
+export type GetUserSettingsResponseValid = GetUserSettingsResponse;
+
+/**
+ * Describes the message frontendapi.GetUserSettingsResponse.
+ * Use `create(GetUserSettingsResponseSchema)` to create a new message.
+ */
+export const GetUserSettingsResponseSchema: GenMessage<GetUserSettingsResponse, {validType: GetUserSettingsResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 88);
+
+/**
+ * A request for FrontendService.UpdateUserSettings.
+ *
+ * @generated from message frontendapi.UpdateUserSettingsRequest
+ */
+export type UpdateUserSettingsRequest = Message<"frontendapi.UpdateUserSettingsRequest"> & {
+  /**
+   * The settings to save.
+   *
+   * @generated from field: frontendapi.UserSettings settings = 1;
+   */
+  settings?: UserSettings | undefined;
+};
+
+/**
+ * A request for FrontendService.UpdateUserSettings.
+ *
+ * @generated from message frontendapi.UpdateUserSettingsRequest
+ */
+export type UpdateUserSettingsRequestValid = Message<"frontendapi.UpdateUserSettingsRequest"> & {
+  /**
+   * The settings to save.
+   *
+   * @generated from field: frontendapi.UserSettings settings = 1;
+   */
+  settings: UserSettingsValid;
+};
+
+/**
+ * Describes the message frontendapi.UpdateUserSettingsRequest.
+ * Use `create(UpdateUserSettingsRequestSchema)` to create a new message.
+ */
+export const UpdateUserSettingsRequestSchema: GenMessage<UpdateUserSettingsRequest, {validType: UpdateUserSettingsRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 89);
+
+/**
+ * A response for FrontendService.UpdateUserSettings.
+ *
+ * @generated from message frontendapi.UpdateUserSettingsResponse
+ */
+export type UpdateUserSettingsResponse = Message<"frontendapi.UpdateUserSettingsResponse"> & {
+};
+
+export type UpdateUserSettingsResponseValid = UpdateUserSettingsResponse;
+
+/**
+ * Describes the message frontendapi.UpdateUserSettingsResponse.
+ * Use `create(UpdateUserSettingsResponseSchema)` to create a new message.
+ */
+export const UpdateUserSettingsResponseSchema: GenMessage<UpdateUserSettingsResponse, {validType: UpdateUserSettingsResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 90);
+
+/**
+ * A request for FrontendService.AddBookmark.
+ *
+ * @generated from message frontendapi.AddBookmarkRequest
+ */
+export type AddBookmarkRequest = Message<"frontendapi.AddBookmarkRequest"> & {
+  /**
+   * The ID of the recipe to add a bookmark for.
+   *
+   * @generated from field: string recipe_id = 1;
+   */
+  recipeId: string;
+};
+
+export type AddBookmarkRequestValid = AddBookmarkRequest;
+
+/**
+ * Describes the message frontendapi.AddBookmarkRequest.
+ * Use `create(AddBookmarkRequestSchema)` to create a new message.
+ */
+export const AddBookmarkRequestSchema: GenMessage<AddBookmarkRequest, {validType: AddBookmarkRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 91);
+
+/**
+ * A response for FrontendService.AddBookmark.
+ *
+ * @generated from message frontendapi.AddBookmarkResponse
+ */
+export type AddBookmarkResponse = Message<"frontendapi.AddBookmarkResponse"> & {
+};
+
+export type AddBookmarkResponseValid = AddBookmarkResponse;
+
+/**
+ * Describes the message frontendapi.AddBookmarkResponse.
+ * Use `create(AddBookmarkResponseSchema)` to create a new message.
+ */
+export const AddBookmarkResponseSchema: GenMessage<AddBookmarkResponse, {validType: AddBookmarkResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 92);
+
+/**
+ * A request for FrontendService.RemoveBookmark.
+ *
+ * @generated from message frontendapi.RemoveBookmarkRequest
+ */
+export type RemoveBookmarkRequest = Message<"frontendapi.RemoveBookmarkRequest"> & {
+  /**
+   * The ID of the recipe to remove a bookmark for.
+   *
+   * @generated from field: string recipe_id = 1;
+   */
+  recipeId: string;
+};
+
+export type RemoveBookmarkRequestValid = RemoveBookmarkRequest;
+
+/**
+ * Describes the message frontendapi.RemoveBookmarkRequest.
  * Use `create(RemoveBookmarkRequestSchema)` to create a new message.
  */
-export const RemoveBookmarkRequestSchema: GenMessage<RemoveBookmarkRequest, {validType: RemoveBookmarkRequestValid}> = /*@__PURE__*/
-  messageDesc(file_frontendapi_frontend, 34);
+export const RemoveBookmarkRequestSchema: GenMessage<RemoveBookmarkRequest, {validType: RemoveBookmarkRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 93);
+
+/**
+ * A response for FrontendService.RemoveBookmark.
+ *
+ * @generated from message frontendapi.RemoveBookmarkResponse
+ */
+export type RemoveBookmarkResponse = Message<"frontendapi.RemoveBookmarkResponse"> & {
+};
+
+export type RemoveBookmarkResponseValid = RemoveBookmarkResponse;
+
+/**
+ * Describes the message frontendapi.RemoveBookmarkResponse.
+ * Use `create(RemoveBookmarkResponseSchema)` to create a new message.
+ */
+export const RemoveBookmarkResponseSchema: GenMessage<RemoveBookmarkResponse, {validType: RemoveBookmarkResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 94);
+
+/**
+ * An ingredient in an entry of the shopping list.
+ *
+ * @generated from message frontendapi.ShoppingListIngredient
+ */
+export type ShoppingListIngredient = Message<"frontendapi.ShoppingListIngredient"> & {
+  /**
+   * The name of the ingredient.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The quantity of the ingredient, e.g. 200g.
+   *
+   * @generated from field: string quantity = 2;
+   */
+  quantity: string;
+
+  /**
+   * Whether the ingredient has been bought.
+   *
+   * @generated from field: bool selected = 3;
+   */
+  selected: boolean;
+};
+
+export type ShoppingListIngredientValid = ShoppingListIngredient;
+
+/**
+ * Describes the message frontendapi.ShoppingListIngredient.
+ * Use `create(ShoppingListIngredientSchema)` to create a new message.
+ */
+export const ShoppingListIngredientSchema: GenMessage<ShoppingListIngredient, {validType: ShoppingListIngredientValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 95);
+
+/**
+ * An entry of the shopping list, either the ingredients of a recipe or an
+ * extra item added by hand.
+ *
+ * @generated from message frontendapi.ShoppingListEntry
+ */
+export type ShoppingListEntry = Message<"frontendapi.ShoppingListEntry"> & {
+  /**
+   * The ID of the entry.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The ID of the recipe the ingredients are for, empty for an extra item.
+   *
+   * @generated from field: string recipe_id = 2;
+   */
+  recipeId: string;
+
+  /**
+   * The title of the recipe, or the name of an extra item.
+   *
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * The serving size of the recipe the ingredients are for.
+   *
+   * @generated from field: string serving_size = 4;
+   */
+  servingSize: string;
+
+  /**
+   * The ingredients to buy for the recipe.
+   *
+   * @generated from field: repeated frontendapi.ShoppingListIngredient ingredients = 5;
+   */
+  ingredients: ShoppingListIngredient[];
+};
+
+export type ShoppingListEntryValid = ShoppingListEntry;
+
+/**
+ * Describes the message frontendapi.ShoppingListEntry.
+ * Use `create(ShoppingListEntrySchema)` to create a new message.
+ */
+export const ShoppingListEntrySchema: GenMessage<ShoppingListEntry, {validType: ShoppingListEntryValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 96);
+
+/**
+ * A request for FrontendService.GetShoppingList.
+ *
+ * @generated from message frontendapi.GetShoppingListRequest
+ */
+export type GetShoppingListRequest = Message<"frontendapi.GetShoppingListRequest"> & {
+};
+
+export type GetShoppingListRequestValid = GetShoppingListRequest;
+
+/**
+ * Describes the message frontendapi.GetShoppingListRequest.
+ * Use `create(GetShoppingListRequestSchema)` to create a new message.
+ */
+export const GetShoppingListRequestSchema: GenMessage<GetShoppingListRequest, {validType: GetShoppingListRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 97);
+
+/**
+ * A response for FrontendService.GetShoppingList.
+ *
+ * @generated from message frontendapi.GetShoppingListResponse
+ */
+export type GetShoppingListResponse = Message<"frontendapi.GetShoppingListResponse"> & {
+  /**
+   * The entries of the shopping list, in the order they were added.
+   *
+   * @generated from field: repeated frontendapi.ShoppingListEntry entries = 1;
+   */
+  entries: ShoppingListEntry[];
+};
+
+export type GetShoppingListResponseValid = GetShoppingListResponse;
+
+/**
+ * Describes the message frontendapi.GetShoppingListResponse.
+ * Use `create(GetShoppingListResponseSchema)` to create a new message.
+ */
+export const GetShoppingListResponseSchema: GenMessage<GetShoppingListResponse, {validType: GetShoppingListResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 98);
+
+/**
+ * A request for FrontendService.AddShoppingListEntry.
+ *
+ * @generated from message frontendapi.AddShoppingListEntryRequest
+ */
+export type AddShoppingListEntryRequest = Message<"frontendapi.AddShoppingListEntryRequest"> & {
+  /**
+   * The ID of the recipe to add the ingredients of, replacing any entry
+   * already added for it. If empty, an extra item is added.
+   *
+   * @generated from field: string recipe_id = 1;
+   */
+  recipeId: string;
+
+  /**
+   * The title of the recipe, or the name of an extra item.
+   *
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * The serving size of the recipe the ingredients are for.
+   *
+   * @generated from field: string serving_size = 3;
+   */
+  servingSize: string;
+
+  /**
+   * The ingredients to buy for the recipe.
+   *
+   * @generated from field: repeated frontendapi.ShoppingListIngredient ingredients = 4;
+   */
+  ingredients: ShoppingListIngredient[];
+};
+
+export type AddShoppingListEntryRequestValid = AddShoppingListEntryRequest;
+
+/**
+ * Describes the message frontendapi.AddShoppingListEntryRequest.
+ * Use `create(AddShoppingListEntryRequestSchema)` to create a new message.
+ */
+export const AddShoppingListEntryRequestSchema: GenMessage<AddShoppingListEntryRequest, {validType: AddShoppingListEntryRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 99);
+
+/**
+ * A response for FrontendService.AddShoppingListEntry.
+ *
+ * @generated from message frontendapi.AddShoppingListEntryResponse
+ */
+export type AddShoppingListEntryResponse = Message<"frontendapi.AddShoppingListEntryResponse"> & {
+  /**
+   * The ID of the added entry.
+   *
+   * @generated from field: string entry_id = 1;
+   */
+  entryId: string;
+};
+
+export type AddShoppingListEntryResponseValid = AddShoppingListEntryResponse;
+
+/**
+ * Describes the message frontendapi.AddShoppingListEntryResponse.
+ * Use `create(AddShoppingListEntryResponseSchema)` to create a new message.
+ */
+export const AddShoppingListEntryResponseSchema: GenMessage<AddShoppingListEntryResponse, {validType: AddShoppingListEntryResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 100);
+
+/**
+ * A request for FrontendService.UpdateShoppingListIngredient.
+ *
+ * @generated from message frontendapi.UpdateShoppingListIngredientRequest
+ */
+export type UpdateShoppingListIngredientRequest = Message<"frontendapi.UpdateShoppingListIngredientRequest"> & {
+  /**
+   * The ID of the entry the ingredient is in.
+   *
+   * @generated from field: string entry_id = 1;
+   */
+  entryId: string;
+
+  /**
+   * The index of the ingredient in the entry.
+   *
+   * @generated from field: uint32 ingredient_index = 2;
+   */
+  ingredientIndex: number;
+
+  /**
+   * Whether the ingredient has been bought.
+   *
+   * @generated from field: bool selected = 3;
+   */
+  selected: boolean;
+};
+
+export type UpdateShoppingListIngredientRequestValid = UpdateShoppingListIngredientRequest;
+
+/**
+ * Describes the message frontendapi.UpdateShoppingListIngredientRequest.
+ * Use `create(UpdateShoppingListIngredientRequestSchema)` to create a new message.
+ */
+export const UpdateShoppingListIngredientRequestSchema: GenMessage<UpdateShoppingListIngredientRequest, {validType: UpdateShoppingListIngredientRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 101);
+
+/**
+ * A response for FrontendService.UpdateShoppingListIngredient.
+ *
+ * @generated from message frontendapi.UpdateShoppingListIngredientResponse
+ */
+export type UpdateShoppingListIngredientResponse = Message<"frontendapi.UpdateShoppingListIngredientResponse"> & {
+};
+
+export type UpdateShoppingListIngredientResponseValid = UpdateShoppingListIngredientResponse;
+
+/**
+ * Describes the message frontendapi.UpdateShoppingListIngredientResponse.
+ * Use `create(UpdateShoppingListIngredientResponseSchema)` to create a new message.
+ */
+export const UpdateShoppingListIngredientResponseSchema: GenMessage<UpdateShoppingListIngredientResponse, {validType: UpdateShoppingListIngredientResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 102);
+
+/**
+ * A request for FrontendService.RemoveShoppingListEntry.
+ *
+ * @generated from message frontendapi.RemoveShoppingListEntryRequest
+ */
+export type RemoveShoppingListEntryRequest = Message<"frontendapi.RemoveShoppingListEntryRequest"> & {
+  /**
+   * The ID of the entry to remove.
+   *
+   * @generated from field: string entry_id = 1;
+   */
+  entryId: string;
+};
+
+export type RemoveShoppingListEntryRequestValid = RemoveShoppingListEntryRequest;
+
+/**
+ * Describes the message frontendapi.RemoveShoppingListEntryRequest.
+ * Use `create(RemoveShoppingListEntryRequestSchema)` to create a new message.
+ */
+export const RemoveShoppingListEntryRequestSchema: GenMessage<RemoveShoppingListEntryRequest, {validType: RemoveShoppingListEntryRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 103);
+
+/**
+ * A response for FrontendService.RemoveShoppingListEntry.
+ *
+ * @generated from message frontendapi.RemoveShoppingListEntryResponse
+ */
+export type RemoveShoppingListEntryResponse = Message<"frontendapi.RemoveShoppingListEntryResponse"> & {
+};
+
+export type RemoveShoppingListEntryResponseValid = RemoveShoppingListEntryResponse;
+
+/**
+ * Describes the message frontendapi.RemoveShoppingListEntryResponse.
+ * Use `create(RemoveShoppingListEntryResponseSchema)` to create a new message.
+ */
+export const RemoveShoppingListEntryResponseSchema: GenMessage<RemoveShoppingListEntryResponse, {validType: RemoveShoppingListEntryResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 104);
+
+/**
+ * An ingredient kept in the pantry.
+ *
+ * @generated from message frontendapi.PantryItem
+ */
+export type PantryItem = Message<"frontendapi.PantryItem"> & {
+  /**
+   * The name of the ingredient.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The quantity of the ingredient on hand, e.g. 200g. Empty if unknown.
+   *
+   * @generated from field: string quantity = 2;
+   */
+  quantity: string;
+};
+
+export type PantryItemValid = PantryItem;
+
+/**
+ * Describes the message frontendapi.PantryItem.
+ * Use `create(PantryItemSchema)` to create a new message.
+ */
+export const PantryItemSchema: GenMessage<PantryItem, {validType: PantryItemValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 105);
+
+/**
+ * A request for FrontendService.ListPantryItems.
+ *
+ * @generated from message frontendapi.ListPantryItemsRequest
+ */
+export type ListPantryItemsRequest = Message<"frontendapi.ListPantryItemsRequest"> & {
+};
+
+export type ListPantryItemsRequestValid = ListPantryItemsRequest;
+
+/**
+ * Describes the message frontendapi.ListPantryItemsRequest.
+ * Use `create(ListPantryItemsRequestSchema)` to create a new message.
+ */
+export const ListPantryItemsRequestSchema: GenMessage<ListPantryItemsRequest, {validType: ListPantryItemsRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 106);
+
+/**
+ * A response for FrontendService.ListPantryItems.
+ *
+ * @generated from message frontendapi.ListPantryItemsResponse
+ */
+export type ListPantryItemsResponse = Message<"frontendapi.ListPantryItemsResponse"> & {
+  /**
+   * The items in the pantry, sorted by name.
+   *
+   * @generated from field: repeated frontendapi.PantryItem items = 1;
+   */
+  items: PantryItem[];
+};
+
+export type ListPantryItemsResponseValid = ListPantryItemsResponse;
+
+/**
+ * Describes the message frontendapi.ListPantryItemsResponse.
+ * Use `create(ListPantryItemsResponseSchema)` to create a new message.
+ */
+export const ListPantryItemsResponseSchema: GenMessage<ListPantryItemsResponse, {validType: ListPantryItemsResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 107);
+
+/**
+ * A request for FrontendService.SetPantryItem.
+ *
+ * @generated from message frontendapi.SetPantryItemRequest
+ */
+export type SetPantryItemRequest = Message<"frontendapi.SetPantryItemRequest"> & {
+  /**
+   * The name of the ingredient.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The quantity of the ingredient on hand, e.g. 200g. Empty if unknown.
+   *
+   * @generated from field: string quantity = 2;
+   */
+  quantity: string;
+};
+
+export type SetPantryItemRequestValid = SetPantryItemRequest;
+
+/**
+ * Describes the message frontendapi.SetPantryItemRequest.
+ * Use `create(SetPantryItemRequestSchema)` to create a new message.
+ */
+export const SetPantryItemRequestSchema: GenMessage<SetPantryItemRequest, {validType: SetPantryItemRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 108);
 
 /**
- * A response for FrontendService.RemoveBookmark.
+ * A response for FrontendService.SetPantryItem.
  *
- * @generated from message frontendapi.RemoveBookmarkResponse
+ * @generated from message frontendapi.SetPantryItemResponse
  */
-export type RemoveBookmarkResponse = Message<"frontendapi.RemoveBookmarkResponse"> & {
+export type SetPantryItemResponse = Message<"frontendapi.SetPantryItemResponse"> & {
 };
 
-export type RemoveBookmarkResponseValid = RemoveBookmarkResponse;
+export type SetPantryItemResponseValid = SetPantryItemResponse;
 
 /**
- * Describes the message frontendapi.RemoveBookmarkResponse.
- * Use `create(RemoveBookmarkResponseSchema)` to create a new message.
+ * Describes the message frontendapi.SetPantryItemResponse.
+ * Use `create(SetPantryItemResponseSchema)` to create a new message.
  */
-export const RemoveBookmarkResponseSchema: GenMessage<RemoveBookmarkResponse, {validType: RemoveBookmarkResponseValid}> = /*@__PURE__*/
-  messageDesc(file_frontendapi_frontend, 35);
+export const SetPantryItemResponseSchema: GenMessage<SetPantryItemResponse, {validType: SetPantryItemResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 109);
+
+/**
+ * A request for FrontendService.DeletePantryItem.
+ *
+ * @generated from message frontendapi.DeletePantryItemRequest
+ */
+export type DeletePantryItemRequest = Message<"frontendapi.DeletePantryItemRequest"> & {
+  /**
+   * The name of the ingredient.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+export type DeletePantryItemRequestValid = DeletePantryItemRequest;
+
+/**
+ * Describes the message frontendapi.DeletePantryItemRequest.
+ * Use `create(DeletePantryItemRequestSchema)` to create a new message.
+ */
+export const DeletePantryItemRequestSchema: GenMessage<DeletePantryItemRequest, {validType: DeletePantryItemRequestValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 110);
+
+/**
+ * A response for FrontendService.DeletePantryItem.
+ *
+ * @generated from message frontendapi.DeletePantryItemResponse
+ */
+export type DeletePantryItemResponse = Message<"frontendapi.DeletePantryItemResponse"> & {
+};
+
+export type DeletePantryItemResponseValid = DeletePantryItemResponse;
+
+/**
+ * Describes the message frontendapi.DeletePantryItemResponse.
+ * Use `create(DeletePantryItemResponseSchema)` to create a new message.
+ */
+export const DeletePantryItemResponseSchema: GenMessage<DeletePantryItemResponse, {validType: DeletePantryItemResponseValid}> = /*@__PURE__*/
+  messageDesc(file_frontendapi_frontend, 111);
 
 /**
  * @generated from message frontendapi.ChatMessage
@@ -1409,7 +3869,7 @@ export type ChatMessageValid = ChatMessage;
  * Use `create(ChatMessageSchema)` to create a new message.
  */
 export const ChatMessageSchema: GenMessage<ChatMessage, {validType: ChatMessageValid}> = /*@__PURE__*/
-  messageDesc(file_frontendapi_frontend, 36);
+  messageDesc(file_frontendapi_frontend, 112);
 
 /**
  * @generated from enum frontendapi.ChatMessage.Role
@@ -1435,7 +3895,7 @@ export enum ChatMessage_Role {
  * Describes the enum frontendapi.ChatMessage.Role.
  */
 export const ChatMessage_RoleSchema: GenEnum<ChatMessage_Role> = /*@__PURE__*/
-  enumDesc(file_frontendapi_frontend, 36, 0);
+  enumDesc(file_frontendapi_frontend, 112, 0);
 
 /**
  * A request for FrontendService.ChatPlan.
@@ -1471,6 +3931,14 @@ export type ChatPlanRequest = Message<"frontendapi.ChatPlanRequest"> & {
    * @generated from field: repeated string image_urls = 4;
    */
   imageUrls: string[];
+
+  /**
+   * The weekly food budget in yen to plan within. If unset, any budget given earlier in the
+   * chat is used.
+   *
+   * @generated from field: uint32 weekly_budget = 5;
+   */
+  weeklyBudget: number;
 };
 
 export type ChatPlanRequestValid = ChatPlanRequest;
@@ -1480,7 +3948,7 @@ export type ChatPlanRequestValid = ChatPlanRequest;
  * Use `create(ChatPlanRequestSchema)` to create a new message.
  */
 export const ChatPlanRequestSchema: GenMessage<ChatPlanRequest, {validType: ChatPlanRequestValid}> = /*@__PURE__*/
-  messageDesc(file_frontendapi_frontend, 37);
+  messageDesc(file_frontendapi_frontend, 113);
 
 /**
  * A response for FrontendService.ChatPlan.
@@ -1517,7 +3985,7 @@ export type ChatPlanResponseValid = ChatPlanResponse;
  * Use `create(ChatPlanResponseSchema)` to create a new message.
  */
 export const ChatPlanResponseSchema: GenMessage<ChatPlanResponse, {validType: ChatPlanResponseValid}> = /*@__PURE__*/
-  messageDesc(file_frontendapi_frontend, 38);
+  messageDesc(file_frontendapi_frontend, 114);
 
 /**
  * A request for FrontendService.GetChatMessages.
@@ -1534,7 +4002,7 @@ export type GetChatMessagesRequestValid = GetChatMessagesRequest;
  * Use `create(GetChatMessagesRequestSchema)` to create a new message.
  */
 export const GetChatMessagesRequestSchema: GenMessage<GetChatMessagesRequest, {validType: GetChatMessagesRequestValid}> = /*@__PURE__*/
-  messageDesc(file_frontendapi_frontend, 39);
+  messageDesc(file_frontendapi_frontend, 115);
 
 /**
  * A response for FrontendService.GetChatMessages.
@@ -1571,7 +4039,7 @@ export type GetChatMessagesResponseValid = GetChatMessagesResponse;
  * Use `create(GetChatMessagesResponseSchema)` to create a new message.
  */
 export const GetChatMessagesResponseSchema: GenMessage<GetChatMessagesResponse, {validType: GetChatMessagesResponseValid}> = /*@__PURE__*/
-  messageDesc(file_frontendapi_frontend, 40);
+  messageDesc(file_frontendapi_frontend, 116);
 
 /**
  * @generated from enum frontendapi.Language
@@ -1585,178 +4053,433 @@ export enum Language {
   UNSPECIFIED = 0,
 
   /**
-   * English.
+   * English.
+   *
+   * @generated from enum value: LANGUAGE_ENGLISH = 1;
+   */
+  ENGLISH = 1,
+
+  /**
+   * Japanese.
+   *
+   * @generated from enum value: LANGUAGE_JAPANESE = 2;
+   */
+  JAPANESE = 2,
+}
+
+/**
+ * Describes the enum frontendapi.Language.
+ */
+export const LanguageSchema: GenEnum<Language> = /*@__PURE__*/
+  enumDesc(file_frontendapi_frontend, 0);
+
+/**
+ * @generated from enum frontendapi.RecipeGenre
+ */
+export enum RecipeGenre {
+  /**
+   * @generated from enum value: RECIPE_GENRE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: RECIPE_GENRE_JAPANESE = 1;
+   */
+  JAPANESE = 1,
+
+  /**
+   * @generated from enum value: RECIPE_GENRE_CHINESE = 2;
+   */
+  CHINESE = 2,
+
+  /**
+   * @generated from enum value: RECIPE_GENRE_WESTERN = 3;
+   */
+  WESTERN = 3,
+
+  /**
+   * @generated from enum value: RECIPE_GENRE_KOREAN = 4;
+   */
+  KOREAN = 4,
+
+  /**
+   * @generated from enum value: RECIPE_GENRE_ITALIAN = 5;
+   */
+  ITALIAN = 5,
+
+  /**
+   * @generated from enum value: RECIPE_GENRE_ETHNIC = 6;
+   */
+  ETHNIC = 6,
+}
+
+/**
+ * Describes the enum frontendapi.RecipeGenre.
+ */
+export const RecipeGenreSchema: GenEnum<RecipeGenre> = /*@__PURE__*/
+  enumDesc(file_frontendapi_frontend, 1);
+
+/**
+ * The source of a recipe.
+ *
+ * @generated from enum frontendapi.RecipeSource
+ */
+export enum RecipeSource {
+  /**
+   * Unknown source.
+   *
+   * @generated from enum value: RECIPE_SOURCE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Recipe from cookpad.
+   *
+   * @generated from enum value: RECIPE_SOURCE_COOKPAD = 1;
+   */
+  COOKPAD = 1,
+
+  /**
+   * Recipe from orange page.
+   *
+   * @generated from enum value: RECIPE_SOURCE_ORANGE_PAGE = 2;
+   */
+  ORANGE_PAGE = 2,
+
+  /**
+   * Recipe from delish kitchen.
+   *
+   * @generated from enum value: RECIPE_SOURCE_DELISH_KITCHEN = 3;
+   */
+  DELISH_KITCHEN = 3,
+}
+
+/**
+ * Describes the enum frontendapi.RecipeSource.
+ */
+export const RecipeSourceSchema: GenEnum<RecipeSource> = /*@__PURE__*/
+  enumDesc(file_frontendapi_frontend, 2);
+
+/**
+ * The status of a recipe.
+ *
+ * @generated from enum frontendapi.RecipeStatus
+ */
+export enum RecipeStatus {
+  /**
+   * Unknown status.
+   *
+   * @generated from enum value: RECIPE_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The recipe is being processed by the system, and is not yet ready to be used in chats or plans.
+   *
+   * @generated from enum value: RECIPE_STATUS_PROCESSING = 1;
+   */
+  PROCESSING = 1,
+
+  /**
+   * The recipe is active and can be used in chats and plans.
+   *
+   * @generated from enum value: RECIPE_STATUS_ACTIVE = 2;
+   */
+  ACTIVE = 2,
+
+  /**
+   * Processing the recipe failed. It is processed again when a plan using it is retried.
+   *
+   * @generated from enum value: RECIPE_STATUS_FAILED = 3;
+   */
+  FAILED = 3,
+}
+
+/**
+ * Describes the enum frontendapi.RecipeStatus.
+ */
+export const RecipeStatusSchema: GenEnum<RecipeStatus> = /*@__PURE__*/
+  enumDesc(file_frontendapi_frontend, 3);
+
+/**
+ * The generator of a meal plan.
+ *
+ * @generated from enum frontendapi.PlanGenerator
+ */
+export enum PlanGenerator {
+  /**
+   * Generate with an LLM, falling back to the constraint-based generator if the LLM fails.
+   *
+   * @generated from enum value: PLAN_GENERATOR_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Generate with an LLM only.
+   *
+   * @generated from enum value: PLAN_GENERATOR_LLM = 1;
+   */
+  LLM = 1,
+
+  /**
+   * Generate with the deterministic constraint-based generator, choosing a main dish, side dish,
+   * and soup for each day. Not supported for batch cooking.
+   *
+   * @generated from enum value: PLAN_GENERATOR_CONSTRAINT = 2;
+   */
+  CONSTRAINT = 2,
+}
+
+/**
+ * Describes the enum frontendapi.PlanGenerator.
+ */
+export const PlanGeneratorSchema: GenEnum<PlanGenerator> = /*@__PURE__*/
+  enumDesc(file_frontendapi_frontend, 4);
+
+/**
+ * The type of a plan.
+ *
+ * @generated from enum frontendapi.PlanType
+ */
+export enum PlanType {
+  /**
+   * Unknown type.
+   *
+   * @generated from enum value: PLAN_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * A plan cooking the meal for its day.
+   *
+   * @generated from enum value: PLAN_TYPE_DAILY = 1;
+   */
+  DAILY = 1,
+
+  /**
+   * A batch-cooking session that cooks dishes to be eaten on later days.
+   *
+   * @generated from enum value: PLAN_TYPE_BATCH_PREP = 2;
+   */
+  BATCH_PREP = 2,
+
+  /**
+   * A plan eating dishes cooked in a previous batch-cooking session.
+   *
+   * @generated from enum value: PLAN_TYPE_LEFTOVERS = 3;
+   */
+  LEFTOVERS = 3,
+}
+
+/**
+ * Describes the enum frontendapi.PlanType.
+ */
+export const PlanTypeSchema: GenEnum<PlanType> = /*@__PURE__*/
+  enumDesc(file_frontendapi_frontend, 5);
+
+/**
+ * @generated from enum frontendapi.PlanStatus
+ */
+export enum PlanStatus {
+  /**
+   * Unknown status.
+   *
+   * @generated from enum value: PLAN_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The plan is being processed, and is not yet ready to be viewed or cooked.
+   *
+   * @generated from enum value: PLAN_STATUS_PROCESSING = 1;
+   */
+  PROCESSING = 1,
+
+  /**
+   * The plan is active and can be viewed and cooked.
    *
-   * @generated from enum value: LANGUAGE_ENGLISH = 1;
+   * @generated from enum value: PLAN_STATUS_ACTIVE = 2;
    */
-  ENGLISH = 1,
+  ACTIVE = 2,
 
   /**
-   * Japanese.
+   * Processing the plan failed. It can be processed again with FrontendService.RetryPlan.
    *
-   * @generated from enum value: LANGUAGE_JAPANESE = 2;
+   * @generated from enum value: PLAN_STATUS_FAILED = 3;
    */
-  JAPANESE = 2,
+  FAILED = 3,
 }
 
 /**
- * Describes the enum frontendapi.Language.
+ * Describes the enum frontendapi.PlanStatus.
  */
-export const LanguageSchema: GenEnum<Language> = /*@__PURE__*/
-  enumDesc(file_frontendapi_frontend, 0);
+export const PlanStatusSchema: GenEnum<PlanStatus> = /*@__PURE__*/
+  enumDesc(file_frontendapi_frontend, 6);
 
 /**
- * @generated from enum frontendapi.RecipeGenre
+ * A stage of processing a plan.
+ *
+ * @generated from enum frontendapi.PlanProgressStage
  */
-export enum RecipeGenre {
+export enum PlanProgressStage {
   /**
-   * @generated from enum value: RECIPE_GENRE_UNSPECIFIED = 0;
+   * Unknown stage.
+   *
+   * @generated from enum value: PLAN_PROGRESS_STAGE_UNSPECIFIED = 0;
    */
   UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: RECIPE_GENRE_JAPANESE = 1;
-   */
-  JAPANESE = 1,
-
-  /**
-   * @generated from enum value: RECIPE_GENRE_CHINESE = 2;
+   * Translating a recipe to other languages.
+   *
+   * @generated from enum value: PLAN_PROGRESS_STAGE_TRANSLATE = 1;
    */
-  CHINESE = 2,
+  TRANSLATE = 1,
 
   /**
-   * @generated from enum value: RECIPE_GENRE_WESTERN = 3;
+   * Rewriting a recipe for each language.
+   *
+   * @generated from enum value: PLAN_PROGRESS_STAGE_REWRITE = 2;
    */
-  WESTERN = 3,
+  REWRITE = 2,
 
   /**
-   * @generated from enum value: RECIPE_GENRE_KOREAN = 4;
+   * Generating the main image of a recipe.
+   *
+   * @generated from enum value: PLAN_PROGRESS_STAGE_IMAGE = 3;
    */
-  KOREAN = 4,
+  IMAGE = 3,
 
   /**
-   * @generated from enum value: RECIPE_GENRE_ITALIAN = 5;
+   * Generating the images of the steps of a recipe.
+   *
+   * @generated from enum value: PLAN_PROGRESS_STAGE_STEP_IMAGES = 4;
    */
-  ITALIAN = 5,
+  STEP_IMAGES = 4,
 
   /**
-   * @generated from enum value: RECIPE_GENRE_ETHNIC = 6;
+   * Generating the execution plan of the plan.
+   *
+   * @generated from enum value: PLAN_PROGRESS_STAGE_EXECUTION_PLAN = 5;
    */
-  ETHNIC = 6,
+  EXECUTION_PLAN = 5,
 }
 
 /**
- * Describes the enum frontendapi.RecipeGenre.
+ * Describes the enum frontendapi.PlanProgressStage.
  */
-export const RecipeGenreSchema: GenEnum<RecipeGenre> = /*@__PURE__*/
-  enumDesc(file_frontendapi_frontend, 1);
+export const PlanProgressStageSchema: GenEnum<PlanProgressStage> = /*@__PURE__*/
+  enumDesc(file_frontendapi_frontend, 7);
 
 /**
- * The source of a recipe.
+ * A day of the week.
  *
- * @generated from enum frontendapi.RecipeSource
+ * @generated from enum frontendapi.DayOfWeek
  */
-export enum RecipeSource {
+export enum DayOfWeek {
   /**
-   * Unknown source.
+   * Unknown day.
    *
-   * @generated from enum value: RECIPE_SOURCE_UNSPECIFIED = 0;
+   * @generated from enum value: DAY_OF_WEEK_UNSPECIFIED = 0;
    */
   UNSPECIFIED = 0,
 
   /**
-   * Recipe from cookpad.
+   * Monday.
    *
-   * @generated from enum value: RECIPE_SOURCE_COOKPAD = 1;
+   * @generated from enum value: DAY_OF_WEEK_MONDAY = 1;
    */
-  COOKPAD = 1,
+  MONDAY = 1,
 
   /**
-   * Recipe from orange page.
+   * Tuesday.
    *
-   * @generated from enum value: RECIPE_SOURCE_ORANGE_PAGE = 2;
+   * @generated from enum value: DAY_OF_WEEK_TUESDAY = 2;
    */
-  ORANGE_PAGE = 2,
+  TUESDAY = 2,
 
   /**
-   * Recipe from delish kitchen.
+   * Wednesday.
    *
-   * @generated from enum value: RECIPE_SOURCE_DELISH_KITCHEN = 3;
+   * @generated from enum value: DAY_OF_WEEK_WEDNESDAY = 3;
    */
-  DELISH_KITCHEN = 3,
-}
+  WEDNESDAY = 3,
 
-/**
- * Describes the enum frontendapi.RecipeSource.
- */
-export const RecipeSourceSchema: GenEnum<RecipeSource> = /*@__PURE__*/
-  enumDesc(file_frontendapi_frontend, 2);
+  /**
+   * Thursday.
+   *
+   * @generated from enum value: DAY_OF_WEEK_THURSDAY = 4;
+   */
+  THURSDAY = 4,
 
-/**
- * The status of a recipe.
- *
- * @generated from enum frontendapi.RecipeStatus
- */
-export enum RecipeStatus {
   /**
-   * Unknown status.
+   * Friday.
    *
-   * @generated from enum value: RECIPE_STATUS_UNSPECIFIED = 0;
+   * @generated from enum value: DAY_OF_WEEK_FRIDAY = 5;
    */
-  UNSPECIFIED = 0,
+  FRIDAY = 5,
 
   /**
-   * The recipe is being processed by the system, and is not yet ready to be used in chats or plans.
+   * Saturday.
    *
-   * @generated from enum value: RECIPE_STATUS_PROCESSING = 1;
+   * @generated from enum value: DAY_OF_WEEK_SATURDAY = 6;
    */
-  PROCESSING = 1,
+  SATURDAY = 6,
 
   /**
-   * The recipe is active and can be used in chats and plans.
+   * Sunday.
    *
-   * @generated from enum value: RECIPE_STATUS_ACTIVE = 2;
+   * @generated from enum value: DAY_OF_WEEK_SUNDAY = 7;
    */
-  ACTIVE = 2,
+  SUNDAY = 7,
 }
 
 /**
- * Describes the enum frontendapi.RecipeStatus.
+ * Describes the enum frontendapi.DayOfWeek.
  */
-export const RecipeStatusSchema: GenEnum<RecipeStatus> = /*@__PURE__*/
-  enumDesc(file_frontendapi_frontend, 3);
+export const DayOfWeekSchema: GenEnum<DayOfWeek> = /*@__PURE__*/
+  enumDesc(file_frontendapi_frontend, 8);
 
 /**
- * @generated from enum frontendapi.PlanStatus
+ * The role of a member in a household.
+ *
+ * @generated from enum frontendapi.HouseholdRole
  */
-export enum PlanStatus {
+export enum HouseholdRole {
   /**
-   * Unknown status.
+   * Unknown role.
    *
-   * @generated from enum value: PLAN_STATUS_UNSPECIFIED = 0;
+   * @generated from enum value: HOUSEHOLD_ROLE_UNSPECIFIED = 0;
    */
   UNSPECIFIED = 0,
 
   /**
-   * The plan is being processed, and is not yet ready to be viewed or cooked.
+   * Can manage members and invitations and edit shared data.
    *
-   * @generated from enum value: PLAN_STATUS_PROCESSING = 1;
+   * @generated from enum value: HOUSEHOLD_ROLE_OWNER = 1;
    */
-  PROCESSING = 1,
+  OWNER = 1,
 
   /**
-   * The plan is active and can be viewed and cooked.
+   * Can edit shared data such as plans.
    *
-   * @generated from enum value: PLAN_STATUS_ACTIVE = 2;
+   * @generated from enum value: HOUSEHOLD_ROLE_EDITOR = 2;
    */
-  ACTIVE = 2,
+  EDITOR = 2,
+
+  /**
+   * Can only view shared data.
+   *
+   * @generated from enum value: HOUSEHOLD_ROLE_VIEWER = 3;
+   */
+  VIEWER = 3,
 }
 
 /**
- * Describes the enum frontendapi.PlanStatus.
+ * Describes the enum frontendapi.HouseholdRole.
  */
-export const PlanStatusSchema: GenEnum<PlanStatus> = /*@__PURE__*/
-  enumDesc(file_frontendapi_frontend, 4);
+export const HouseholdRoleSchema: GenEnum<HouseholdRole> = /*@__PURE__*/
+  enumDesc(file_frontendapi_frontend, 9);
 
 /**
  * A chat service.
@@ -1881,6 +4604,59 @@ export const FrontendService: GenService<{
     input: typeof GetPlanRequestSchema;
     output: typeof GetPlanResponseSchema;
   },
+  /**
+   * Create a link giving anyone with it read-only access to a plan until it expires or
+   * is revoked.
+   *
+   * @generated from rpc frontendapi.FrontendService.ShareablePlanLink
+   */
+  shareablePlanLink: {
+    methodKind: "unary";
+    input: typeof ShareablePlanLinkRequestSchema;
+    output: typeof ShareablePlanLinkResponseSchema;
+  },
+  /**
+   * Revoke a link created by ShareablePlanLink.
+   *
+   * @generated from rpc frontendapi.FrontendService.RevokeShareablePlanLink
+   */
+  revokeShareablePlanLink: {
+    methodKind: "unary";
+    input: typeof RevokeShareablePlanLinkRequestSchema;
+    output: typeof RevokeShareablePlanLinkResponseSchema;
+  },
+  /**
+   * Get a plan shared with a link. Does not require signing in.
+   *
+   * @generated from rpc frontendapi.FrontendService.GetSharedPlan
+   */
+  getSharedPlan: {
+    methodKind: "unary";
+    input: typeof GetSharedPlanRequestSchema;
+    output: typeof GetSharedPlanResponseSchema;
+  },
+  /**
+   * Watch a plan while it is processing. A snapshot of the plan is sent when watching starts
+   * and whenever it changes, and the stream ends once the plan is no longer processing.
+   * Streams may also end early, in which case clients should watch again.
+   *
+   * @generated from rpc frontendapi.FrontendService.WatchPlan
+   */
+  watchPlan: {
+    methodKind: "server_streaming";
+    input: typeof WatchPlanRequestSchema;
+    output: typeof WatchPlanResponseSchema;
+  },
+  /**
+   * Get the timeline for cooking a plan to be served at a given time.
+   *
+   * @generated from rpc frontendapi.FrontendService.GetPlanTimeline
+   */
+  getPlanTimeline: {
+    methodKind: "unary";
+    input: typeof GetPlanTimelineRequestSchema;
+    output: typeof GetPlanTimelineResponseSchema;
+  },
   /**
    * Update the recipes in a plan.
    *
@@ -1892,7 +4668,27 @@ export const FrontendService: GenService<{
     output: typeof UpdatePlanResponseSchema;
   },
   /**
-   * Delete a plan.
+   * Suggest recipes to replace a recipe in a plan.
+   *
+   * @generated from rpc frontendapi.FrontendService.SuggestAlternatives
+   */
+  suggestAlternatives: {
+    methodKind: "unary";
+    input: typeof SuggestAlternativesRequestSchema;
+    output: typeof SuggestAlternativesResponseSchema;
+  },
+  /**
+   * Replace a recipe in a plan. The plan is processed again to update its steps.
+   *
+   * @generated from rpc frontendapi.FrontendService.ReplacePlanRecipe
+   */
+  replacePlanRecipe: {
+    methodKind: "unary";
+    input: typeof ReplacePlanRecipeRequestSchema;
+    output: typeof ReplacePlanRecipeResponseSchema;
+  },
+  /**
+   * Move a plan to the trash. Trashed plans are permanently deleted after 30 days.
    *
    * @generated from rpc frontendapi.FrontendService.DeletePlan
    */
@@ -1901,6 +4697,156 @@ export const FrontendService: GenService<{
     input: typeof DeletePlanRequestSchema;
     output: typeof DeletePlanResponseSchema;
   },
+  /**
+   * Restore a plan from the trash.
+   *
+   * @generated from rpc frontendapi.FrontendService.RestorePlan
+   */
+  restorePlan: {
+    methodKind: "unary";
+    input: typeof RestorePlanRequestSchema;
+    output: typeof RestorePlanResponseSchema;
+  },
+  /**
+   * List the plans in the trash.
+   *
+   * @generated from rpc frontendapi.FrontendService.ListDeletedPlans
+   */
+  listDeletedPlans: {
+    methodKind: "unary";
+    input: typeof ListDeletedPlansRequestSchema;
+    output: typeof ListDeletedPlansResponseSchema;
+  },
+  /**
+   * Retry processing a failed plan.
+   *
+   * @generated from rpc frontendapi.FrontendService.RetryPlan
+   */
+  retryPlan: {
+    methodKind: "unary";
+    input: typeof RetryPlanRequestSchema;
+    output: typeof RetryPlanResponseSchema;
+  },
+  /**
+   * Save a recurring weekly plan template.
+   *
+   * @generated from rpc frontendapi.FrontendService.SavePlanTemplate
+   */
+  savePlanTemplate: {
+    methodKind: "unary";
+    input: typeof SavePlanTemplateRequestSchema;
+    output: typeof SavePlanTemplateResponseSchema;
+  },
+  /**
+   * Create plans for a week from a plan template.
+   *
+   * @generated from rpc frontendapi.FrontendService.ApplyPlanTemplate
+   */
+  applyPlanTemplate: {
+    methodKind: "unary";
+    input: typeof ApplyPlanTemplateRequestSchema;
+    output: typeof ApplyPlanTemplateResponseSchema;
+  },
+  /**
+   * List the ingredient prices used to estimate recipe costs.
+   *
+   * @generated from rpc frontendapi.FrontendService.ListIngredientPrices
+   */
+  listIngredientPrices: {
+    methodKind: "unary";
+    input: typeof ListIngredientPricesRequestSchema;
+    output: typeof ListIngredientPricesResponseSchema;
+  },
+  /**
+   * Set the price of an ingredient, overriding any default.
+   *
+   * @generated from rpc frontendapi.FrontendService.SetIngredientPrice
+   */
+  setIngredientPrice: {
+    methodKind: "unary";
+    input: typeof SetIngredientPriceRequestSchema;
+    output: typeof SetIngredientPriceResponseSchema;
+  },
+  /**
+   * Delete a price set by the user, restoring any default.
+   *
+   * @generated from rpc frontendapi.FrontendService.DeleteIngredientPrice
+   */
+  deleteIngredientPrice: {
+    methodKind: "unary";
+    input: typeof DeleteIngredientPriceRequestSchema;
+    output: typeof DeleteIngredientPriceResponseSchema;
+  },
+  /**
+   * Record that the user cooked a recipe or plan.
+   *
+   * @generated from rpc frontendapi.FrontendService.MarkCooked
+   */
+  markCooked: {
+    methodKind: "unary";
+    input: typeof MarkCookedRequestSchema;
+    output: typeof MarkCookedResponseSchema;
+  },
+  /**
+   * List the cooking history of the user.
+   *
+   * @generated from rpc frontendapi.FrontendService.ListCookingHistory
+   */
+  listCookingHistory: {
+    methodKind: "unary";
+    input: typeof ListCookingHistoryRequestSchema;
+    output: typeof ListCookingHistoryResponseSchema;
+  },
+  /**
+   * Create a household owned by the user.
+   *
+   * @generated from rpc frontendapi.FrontendService.CreateHousehold
+   */
+  createHousehold: {
+    methodKind: "unary";
+    input: typeof CreateHouseholdRequestSchema;
+    output: typeof CreateHouseholdResponseSchema;
+  },
+  /**
+   * Get the household of the user.
+   *
+   * @generated from rpc frontendapi.FrontendService.GetHousehold
+   */
+  getHousehold: {
+    methodKind: "unary";
+    input: typeof GetHouseholdRequestSchema;
+    output: typeof GetHouseholdResponseSchema;
+  },
+  /**
+   * Create an invitation to join the household of the user.
+   *
+   * @generated from rpc frontendapi.FrontendService.CreateHouseholdInvitation
+   */
+  createHouseholdInvitation: {
+    methodKind: "unary";
+    input: typeof CreateHouseholdInvitationRequestSchema;
+    output: typeof CreateHouseholdInvitationResponseSchema;
+  },
+  /**
+   * Accept an invitation to join a household.
+   *
+   * @generated from rpc frontendapi.FrontendService.AcceptHouseholdInvitation
+   */
+  acceptHouseholdInvitation: {
+    methodKind: "unary";
+    input: typeof AcceptHouseholdInvitationRequestSchema;
+    output: typeof AcceptHouseholdInvitationResponseSchema;
+  },
+  /**
+   * Remove a member from the household of the user.
+   *
+   * @generated from rpc frontendapi.FrontendService.RemoveHouseholdMember
+   */
+  removeHouseholdMember: {
+    methodKind: "unary";
+    input: typeof RemoveHouseholdMemberRequestSchema;
+    output: typeof RemoveHouseholdMemberResponseSchema;
+  },
   /**
    * Add a bookmark for a recipe.
    *
@@ -1921,6 +4867,96 @@ export const FrontendService: GenService<{
     input: typeof RemoveBookmarkRequestSchema;
     output: typeof RemoveBookmarkResponseSchema;
   },
+  /**
+   * Get the shopping list of the user.
+   *
+   * @generated from rpc frontendapi.FrontendService.GetShoppingList
+   */
+  getShoppingList: {
+    methodKind: "unary";
+    input: typeof GetShoppingListRequestSchema;
+    output: typeof GetShoppingListResponseSchema;
+  },
+  /**
+   * Add the ingredients of a recipe or an extra item to the shopping list.
+   *
+   * @generated from rpc frontendapi.FrontendService.AddShoppingListEntry
+   */
+  addShoppingListEntry: {
+    methodKind: "unary";
+    input: typeof AddShoppingListEntryRequestSchema;
+    output: typeof AddShoppingListEntryResponseSchema;
+  },
+  /**
+   * Mark an ingredient in the shopping list as bought or not.
+   *
+   * @generated from rpc frontendapi.FrontendService.UpdateShoppingListIngredient
+   */
+  updateShoppingListIngredient: {
+    methodKind: "unary";
+    input: typeof UpdateShoppingListIngredientRequestSchema;
+    output: typeof UpdateShoppingListIngredientResponseSchema;
+  },
+  /**
+   * Remove an entry from the shopping list.
+   *
+   * @generated from rpc frontendapi.FrontendService.RemoveShoppingListEntry
+   */
+  removeShoppingListEntry: {
+    methodKind: "unary";
+    input: typeof RemoveShoppingListEntryRequestSchema;
+    output: typeof RemoveShoppingListEntryResponseSchema;
+  },
+  /**
+   * List the ingredients kept in the pantry.
+   *
+   * @generated from rpc frontendapi.FrontendService.ListPantryItems
+   */
+  listPantryItems: {
+    methodKind: "unary";
+    input: typeof ListPantryItemsRequestSchema;
+    output: typeof ListPantryItemsResponseSchema;
+  },
+  /**
+   * Set an ingredient kept in the pantry.
+   *
+   * @generated from rpc frontendapi.FrontendService.SetPantryItem
+   */
+  setPantryItem: {
+    methodKind: "unary";
+    input: typeof SetPantryItemRequestSchema;
+    output: typeof SetPantryItemResponseSchema;
+  },
+  /**
+   * Delete an ingredient from the pantry.
+   *
+   * @generated from rpc frontendapi.FrontendService.DeletePantryItem
+   */
+  deletePantryItem: {
+    methodKind: "unary";
+    input: typeof DeletePantryItemRequestSchema;
+    output: typeof DeletePantryItemResponseSchema;
+  },
+  /**
+   * Get the settings of the user.
+   *
+   * @generated from rpc frontendapi.FrontendService.GetUserSettings
+   */
+  getUserSettings: {
+    methodKind: "unary";
+    input: typeof GetUserSettingsRequestSchema;
+    output: typeof GetUserSettingsResponseSchema;
+  },
+  /**
+   * Update the settings of the user.
+   *
+   * @generated from rpc frontendapi.FrontendService.UpdateUserSettings
+   */
+  updateUserSettings: {
+    methodKind: "unary";
+    input: typeof UpdateUserSettingsRequestSchema;
+    output: typeof UpdateUserSettingsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_frontendapi_frontend, 1);
 
@@ -0,0 +1,110 @@
+import { useMutation } from "@connectrpc/connect-query";
+import {
+  addShoppingListEntry,
+  type Recipe,
+  removeShoppingListEntry,
+  updateShoppingListIngredient,
+} from "@cookchat/frontend-api";
+import { useQuery, useQueryClient } from "@tanstack/react-query";
+import { useCallback, useMemo } from "react";
+
+import { useFrontendQueries } from "../rpc";
+
+// The cart is the shopping list of the user's household, shared with the
+// other members, so it is read from and saved to the server rather than local
+// storage.
+export function useCart() {
+  const queries = useFrontendQueries();
+  const shoppingListQuery = useMemo(() => queries.getShoppingList(), [queries]);
+  const { data, isPending } = useQuery(shoppingListQuery);
+
+  const queryClient = useQueryClient();
+  const onSuccess = useCallback(() => {
+    queryClient.invalidateQueries({
+      queryKey: shoppingListQuery.queryKey,
+    });
+  }, [queryClient, shoppingListQuery]);
+
+  const { mutate: doAddEntry } = useMutation(addShoppingListEntry, {
+    onSuccess,
+  });
+  const { mutate: doRemoveEntry } = useMutation(removeShoppingListEntry, {
+    onSuccess,
+  });
+  const { mutate: doUpdateIngredient } = useMutation(
+    updateShoppingListIngredient,
+    { onSuccess },
+  );
+
+  const recipes = useMemo(
+    () => (data?.entries ?? []).filter((entry) => entry.recipeId),
+    [data],
+  );
+  const extraItems = useMemo(
+    () => (data?.entries ?? []).filter((entry) => !entry.recipeId),
+    [data],
+  );
+
+  const addRecipe = useCallback(
+    (recipe: Recipe) => {
+      const ingredients = [
+        ...recipe.ingredients,
+        ...recipe.additionalIngredients.flatMap(
+          (section) => section.ingredients,
+        ),
+      ];
+      doAddEntry({
+        recipeId: recipe.id,
+        title: recipe.title,
+        servingSize: recipe.servingSize,
+        ingredients: ingredients.map((ingredient) => ({
+          name: ingredient.name,
+          quantity: ingredient.quantity,
+        })),
+      });
+    },
+    [doAddEntry],
+  );
+
+  const removeRecipe = useCallback(
+    (recipeId: string) => {
+      const entry = recipes.find((r) => r.recipeId === recipeId);
+      if (entry) {
+        doRemoveEntry({ entryId: entry.id });
+      }
+    },
+    [recipes, doRemoveEntry],
+  );
+
+  const addExtraItem = useCallback(
+    (item: string) => {
+      doAddEntry({ title: item });
+    },
+    [doAddEntry],
+  );
+
+  const removeEntry = useCallback(
+    (entryId: string) => {
+      doRemoveEntry({ entryId });
+    },
+    [doRemoveEntry],
+  );
+
+  const setIngredientSelected = useCallback(
+    (entryId: string, ingredientIndex: number, selected: boolean) => {
+      doUpdateIngredient({ entryId, ingredientIndex, selected });
+    },
+    [doUpdateIngredient],
+  );
+
+  return {
+    recipes,
+    extraItems,
+    isPending,
+    addRecipe,
+    removeRecipe,
+    addExtraItem,
+    removeEntry,
+    setIngredientSelected,
+  };
+}
@@ -18,6 +18,7 @@ import {
   getChatMessages,
   getPlans,
   getRecipe,
+  getShoppingList,
   listRecipes,
   PaginationSchema,
   type StartChatRequestSchema,
@@ -94,6 +95,12 @@ class FrontendQueries {
     );
   }
 
+  getShoppingList() {
+    return queryOptions(
+      createQueryOptions(getShoppingList, {}, { transport: this.transport }),
+    );
+  }
+
   listRecipes(query: string) {
     return infiniteQueryOptions(
       createInfiniteQueryOptions(
@@ -12,8 +12,9 @@ import { twMerge } from "tailwind-merge";
 
 import { BackButton } from "../components/BackButton";
 import { ChatButton } from "../components/ChatButton";
+import { useCart } from "../hooks/cart";
 import { m } from "../paraglide/messages";
-import { useCartStore, useChatStore } from "../stores";
+import { useChatStore } from "../stores";
 
 function getPageTitle(path: string) {
   if (path === "/bookmarks") {
@@ -54,7 +55,7 @@ export default function Layout({ children }: { children: React.ReactNode }) {
   const isCart = path === "/cart";
   const isBookmarks = path === "/bookmarks";
 
-  const cart = useCartStore();
+  const cart = useCart();
   const chatStore = useChatStore();
   const title = getPageTitle(path);
 
@@ -64,7 +65,7 @@ export default function Layout({ children }: { children: React.ReactNode }) {
       texts.push(
         `
 ${recipe.title}
-${import.meta.env.VITE_URL_BASE}recipes/${recipe.id}
+${import.meta.env.VITE_URL_BASE}recipes/${recipe.recipeId}
 
 ${recipe.ingredients
   .filter((ingredient) => !ingredient.selected)
@@ -73,12 +74,12 @@ ${recipe.ingredients
 `.trim(),
       );
     }
-    if (cart.extraItems) {
+    if (cart.extraItems.length > 0) {
       texts.push(
         `
 ${m.cart_extra_items_title()}:
 
-${cart.extraItems.join("\n")}
+${cart.extraItems.map((item) => item.title).join("\n")}
       `.trim(),
       );
     }
@@ -1,34 +1,37 @@
+import type {
+  ShoppingListEntry,
+  ShoppingListIngredient,
+} from "@cookchat/frontend-api";
 import { Button, Checkbox, Input, TextField } from "@heroui/react";
 import { createFileRoute, Link } from "@tanstack/react-router";
 import { useCallback, useEffect, useRef, useState } from "react";
 import { HiCheck, HiTrash } from "react-icons/hi";
 
+import { useCart } from "../../hooks/cart";
 import { m } from "../../paraglide/messages";
-import {
-  addExtraItemToCart,
-  type CartIngredient,
-  removeExtraItemFromCart,
-  removeRecipeFromCart,
-  toggleCartIngredientSelection,
-  useCartStore,
-} from "../../stores";
 
 function IngredientSelect({
   ingredient,
-  recipeId,
+  entryId,
   ingredientIndex,
+  setIngredientSelected,
 }: {
-  ingredient: CartIngredient;
-  recipeId: string;
+  ingredient: ShoppingListIngredient;
+  entryId: string;
   ingredientIndex: number;
+  setIngredientSelected: (
+    entryId: string,
+    ingredientIndex: number,
+    selected: boolean,
+  ) => void;
 }) {
   const onValueChange = useCallback(
     (selected: boolean) => {
       if (selected !== ingredient.selected) {
-        toggleCartIngredientSelection(recipeId, ingredientIndex);
+        setIngredientSelected(entryId, ingredientIndex, selected);
       }
     },
-    [ingredient.selected, recipeId, ingredientIndex],
+    [ingredient.selected, entryId, ingredientIndex, setIngredientSelected],
   );
 
   return (
@@ -57,28 +60,37 @@ function IngredientSelect({
   );
 }
 
-function ExtraItem({ item, idx }: { item: string; idx: number }) {
+function ExtraItem({
+  item,
+  removeEntry,
+}: {
+  item: ShoppingListEntry;
+  removeEntry: (entryId: string) => void;
+}) {
   const onRemoveClick = useCallback(() => {
-    removeExtraItemFromCart(idx);
-  }, [idx]);
+    removeEntry(item.id);
+  }, [item.id, removeEntry]);
 
   return (
     <div className="flex items-center gap-2">
       <HiTrash className="h-6 w-6" onClick={onRemoveClick} />
-      {item}
+      {item.title}
     </div>
   );
 }
 
 function SwipeableRecipeCard({
   recipe,
+  removeEntry,
+  setIngredientSelected,
 }: {
-  recipe: {
-    id: string;
-    title: string;
-    servingSize: string;
-    ingredients: CartIngredient[];
-  };
+  recipe: ShoppingListEntry;
+  removeEntry: (entryId: string) => void;
+  setIngredientSelected: (
+    entryId: string,
+    ingredientIndex: number,
+    selected: boolean,
+  ) => void;
 }) {
   const [swipeOffset, setSwipeOffset] = useState(0);
   const [isSwiping, setIsSwiping] = useState(false);
@@ -115,8 +127,8 @@ function SwipeableRecipeCard({
   }, [swipeOffset]);
 
   const handleDelete = useCallback(() => {
-    removeRecipeFromCart(recipe.id);
-  }, [recipe.id]);
+    removeEntry(recipe.id);
+  }, [recipe.id, removeEntry]);
 
   return (
     <>
@@ -136,7 +148,7 @@ function SwipeableRecipeCard({
         >
           <Link
             to="/recipes/$id"
-            params={{ id: recipe.id }}
+            params={{ id: recipe.recipeId }}
             className="text-gray-600 w-full block"
           >
             <div className="bg-amber-50 p-4 rounded-lg w-full">
@@ -161,8 +173,9 @@ function SwipeableRecipeCard({
         <IngredientSelect
           key={ingredient.name}
           ingredient={ingredient}
-          recipeId={recipe.id}
+          entryId={recipe.id}
           ingredientIndex={i}
+          setIngredientSelected={setIngredientSelected}
         />
       ))}
     </>
@@ -174,7 +187,7 @@ export const Route = createFileRoute("/cart/")({
 });
 
 function Page() {
-  const cart = useCartStore();
+  const cart = useCart();
 
   const [addingItem, setAddingItem] = useState(false);
   const [extraItem, setExtraItem] = useState("");
@@ -188,10 +201,10 @@ function Page() {
     setAddingItem(false);
     const item = extraItem.trim();
     if (item) {
-      addExtraItemToCart(item);
+      cart.addExtraItem(item);
     }
     setExtraItem("");
-  }, [extraItem]);
+  }, [extraItem, cart.addExtraItem]);
 
   useEffect(() => {
     if (itemInput.current) {
@@ -205,23 +218,27 @@ function Page() {
 
   return (
     <div className="p-4">
-      {cart.recipes.length === 0 && (
+      {cart.isPending && <div className="p-4">{m.common_loading()}</div>}
+      {!cart.isPending && cart.recipes.length === 0 && (
         <div className="p-4">{m.cart_empty_state()}</div>
       )}
       {cart.recipes.map((recipe) => (
         <div key={recipe.id} className="mt-4">
-          <SwipeableRecipeCard recipe={recipe} />
+          <SwipeableRecipeCard
+            recipe={recipe}
+            removeEntry={cart.removeEntry}
+            setIngredientSelected={cart.setIngredientSelected}
+          />
         </div>
       ))}
-      {cart.extraItems && (
+      {cart.extraItems.length > 0 && (
         <div className="mt-4">
           <h4 className="text-gray-600">{m.cart_extra_items_title()}</h4>
-          {cart.extraItems.map((ingredient, i) => (
+          {cart.extraItems.map((item) => (
             <ExtraItem
-              // biome-ignore lint/suspicious/noArrayIndexKey: free form array so index is the key
-              key={i}
-              item={ingredient}
-              idx={i}
+              key={item.id}
+              item={item}
+              removeEntry={cart.removeEntry}
             />
           ))}
         </div>
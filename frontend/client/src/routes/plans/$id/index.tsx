@@ -16,9 +16,9 @@ import { FaCheck, FaLightbulb, FaStar } from "react-icons/fa";
 import { HiAdjustments, HiShoppingCart } from "react-icons/hi";
 import { twMerge } from "tailwind-merge";
 
+import { useCart } from "../../../hooks/cart";
 import { m } from "../../../paraglide/messages";
 import {
-  addRecipeToCart,
   resetChat,
   setCurrentPlan,
   setPrompt,
@@ -196,6 +196,8 @@ function Page() {
     }
   }, [editPrompt, planRes]);
 
+  const cart = useCart();
+
   const addToCart = useCallback(() => {
     if (!planRes?.plan) {
       return;
@@ -204,7 +206,7 @@ function Page() {
     planRes.plan.recipes.forEach((recipe, i) => {
       const servingSize = planRes.plan?.servingSizes?.[i] ?? "";
       const ingredients = planRes.plan?.ingredients?.[i]?.ingredients ?? [];
-      addRecipeToCart(
+      cart.addRecipe(
         create(RecipeSchema, {
           id: recipe.id,
           title: recipe.title,
@@ -213,7 +215,7 @@ function Page() {
         }),
       );
     });
-  }, [planRes]);
+  }, [planRes, cart.addRecipe]);
 
   if (isPending) {
     return <div>{m.common_loading()}</div>;
@@ -15,17 +15,15 @@ import { FaBookmark, FaLightbulb, FaRegBookmark } from "react-icons/fa";
 import { FiUsers } from "react-icons/fi";
 import { HiAdjustments, HiShoppingCart } from "react-icons/hi";
 
+import { useCart } from "../../../hooks/cart";
 import { useFrontendQueries } from "../../../hooks/rpc";
 import { m } from "../../../paraglide/messages";
 import {
   addPlanRecipe,
-  addRecipeToCart,
   addRecipeToEditPlan,
-  removeRecipeFromCart,
   resetChat,
   setCurrentRecipe,
   setPrompt,
-  useCartStore,
   useChatStore,
   useEditPlanStore,
 } from "../../../stores";
@@ -94,8 +92,8 @@ function Page() {
 
   const { editing: editingPlan, planId } = useEditPlanStore();
 
-  const cart = useCartStore();
-  const inCart = cart.recipes.some((recipe) => recipe.id === recipeId);
+  const cart = useCart();
+  const inCart = cart.recipes.some((recipe) => recipe.recipeId === recipeId);
 
   const stepRefs = useRef<Array<HTMLElement | null>>([]);
   const ingredientsRef = useRef<HTMLDivElement | null>(null);
@@ -188,11 +186,11 @@ function Page() {
     }
 
     if (inCart) {
-      removeRecipeFromCart(recipe.id);
+      cart.removeRecipe(recipe.id);
     } else {
-      addRecipeToCart(recipe);
+      cart.addRecipe(recipe);
     }
-  }, [recipeRes, inCart]);
+  }, [recipeRes, inCart, cart.removeRecipe, cart.addRecipe]);
 
   if (isPending) {
     return <div>{m.common_loading()}</div>;
@@ -0,0 +1,59 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package filltask enqueues the tasks that fill in the details of saved plans.
+package filltask
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	cloudtasks "cloud.google.com/go/cloudtasks/apiv2"
+	taskspb "cloud.google.com/go/cloudtasks/apiv2/cloudtaskspb"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/curioswitch/cookchat/frontend/server/internal/config"
+	tasksapi "github.com/curioswitch/cookchat/tasks/api/go"
+)
+
+// Enqueue enqueues a task to fill the plan with planID. The task runs as the user making the
+// request in ctx.
+func Enqueue(ctx context.Context, tasks *cloudtasks.Client, conf config.Tasks, planID string) error {
+	fillPlanReq, err := proto.Marshal(&tasksapi.FillPlanRequest{
+		PlanId: planID,
+	})
+	if err != nil {
+		return fmt.Errorf("filltask: marshaling fill plan request: %w", err)
+	}
+
+	fbTok := firebaseauth.RawTokenFromContext(ctx)
+
+	task := &taskspb.CreateTaskRequest{
+		Parent: conf.Queue,
+		Task: &taskspb.Task{
+			MessageType: &taskspb.Task_HttpRequest{
+				HttpRequest: &taskspb.HttpRequest{
+					HttpMethod: taskspb.HttpMethod_POST,
+					Url:        conf.URL + "/tasksapi.TasksService/FillPlan",
+					Headers: map[string]string{
+						"Content-Type":             "application/proto",
+						"Content-Length":           strconv.Itoa(len(fillPlanReq)),
+						"X-Original-Authorization": "Bearer " + fbTok,
+					},
+					Body: fillPlanReq,
+					AuthorizationHeader: &taskspb.HttpRequest_OidcToken{
+						OidcToken: &taskspb.OidcToken{
+							ServiceAccountEmail: conf.Invoker,
+						},
+					},
+				},
+			},
+		},
+	}
+	if _, err := tasks.CreateTask(ctx, task); err != nil {
+		return fmt.Errorf("filltask: creating task: %w", err)
+	}
+	return nil
+}
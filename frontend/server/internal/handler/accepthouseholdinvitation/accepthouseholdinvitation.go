@@ -0,0 +1,120 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package accepthouseholdinvitation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+var (
+	errInvitationNotFound = errors.New("invitation not found")
+	errInvitationUsed     = errors.New("invitation already accepted or expired")
+	errAlreadyInHousehold = errors.New("user is already in a household")
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) AcceptHouseholdInvitation(ctx context.Context, req *frontendapi.AcceptHouseholdInvitationRequest) (*frontendapi.AcceptHouseholdInvitationResponse, error) {
+	userID := firebaseauth.TokenFromContext(ctx).UID
+	userDoc := h.store.Collection("users").Doc(userID)
+	invitationDoc := h.store.Collection("householdInvitations").Doc(req.GetInvitationId())
+
+	var joined cookchatdb.HouseholdInvitation
+	if err := h.store.RunTransaction(ctx, func(_ context.Context, t *firestore.Transaction) error {
+		doc, err := t.Get(invitationDoc)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return connect.NewError(connect.CodeNotFound, errInvitationNotFound)
+			}
+			return fmt.Errorf("accepthouseholdinvitation: fetching invitation: %w", err)
+		}
+		invitation, err := validInvitation(doc)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+
+		doc, err = t.Get(userDoc)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return fmt.Errorf("accepthouseholdinvitation: fetching user: %w", err)
+		}
+		if doc.Exists() {
+			var user cookchatdb.User
+			if err := doc.DataTo(&user); err != nil {
+				return fmt.Errorf("accepthouseholdinvitation: decoding user: %w", err)
+			}
+			if user.HouseholdID != "" {
+				return connect.NewError(connect.CodeFailedPrecondition, errAlreadyInHousehold)
+			}
+		}
+
+		householdDoc := h.store.Collection("households").Doc(invitation.HouseholdID)
+		if err := t.Set(householdDoc.Collection("members").Doc(userID), cookchatdb.HouseholdMember{
+			UserID:   userID,
+			Role:     invitation.Role,
+			JoinedAt: now,
+		}); err != nil {
+			return fmt.Errorf("accepthouseholdinvitation: saving member: %w", err)
+		}
+		if err := t.Set(userDoc, map[string]any{"householdId": invitation.HouseholdID}, firestore.MergeAll); err != nil {
+			return fmt.Errorf("accepthouseholdinvitation: saving user: %w", err)
+		}
+		if err := t.Update(invitationDoc, []firestore.Update{{Path: "acceptedBy", Value: userID}}); err != nil {
+			return fmt.Errorf("accepthouseholdinvitation: saving invitation: %w", err)
+		}
+		joined = invitation
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("accepthouseholdinvitation: accepting invitation: %w", err)
+	}
+
+	// Copy the user's data into the household only once they are a member, and only if their
+	// role can write household data. Personal data is kept, so failing to copy loses nothing
+	// and does not fail joining.
+	if (household.Scope{Role: joined.Role}).CanEdit() {
+		conflicts, err := household.CopyPersonal(ctx, h.store, userID, joined.HouseholdID)
+		if err != nil {
+			slog.ErrorContext(ctx, "accepthouseholdinvitation: copying personal data", "error", err)
+		}
+		for _, c := range conflicts {
+			slog.WarnContext(ctx, "accepthouseholdinvitation: kept household values of personal data", "path", c.Path, "fields", c.Fields)
+		}
+	}
+
+	return &frontendapi.AcceptHouseholdInvitationResponse{HouseholdId: joined.HouseholdID}, nil
+}
+
+// validInvitation returns the invitation in doc, failing if it can no longer be accepted.
+func validInvitation(doc *firestore.DocumentSnapshot) (cookchatdb.HouseholdInvitation, error) {
+	var invitation cookchatdb.HouseholdInvitation
+	if err := doc.DataTo(&invitation); err != nil {
+		return invitation, fmt.Errorf("accepthouseholdinvitation: decoding invitation: %w", err)
+	}
+	if invitation.AcceptedBy != "" || time.Now().After(invitation.ExpiresAt) {
+		return invitation, connect.NewError(connect.CodeFailedPrecondition, errInvitationUsed)
+	}
+	return invitation, nil
+}
@@ -9,9 +9,11 @@ import (
 	"time"
 
 	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
 	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
 
 	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
 	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
 )
 
@@ -26,12 +28,20 @@ type Handler struct {
 }
 
 func (h *Handler) AddBookmark(ctx context.Context, req *frontendapi.AddBookmarkRequest) (*frontendapi.AddBookmarkResponse, error) {
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("addbookmark: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
+
 	bookmark := cookchatdb.RecipeBookmark{
 		RecipeID:  req.GetRecipeId(),
 		CreatedAt: time.Now(),
 	}
 
-	doc := h.store.Collection("users").Doc(firebaseauth.TokenFromContext(ctx).UID).Collection("bookmarks").Doc("recipe-" + req.GetRecipeId())
+	doc := scope.Bookmarks().Doc("recipe-" + req.GetRecipeId())
 	if _, err := doc.Set(ctx, bookmark); err != nil {
 		return nil, fmt.Errorf("addbookmark: saving bookmark: %w", err)
 	}
@@ -0,0 +1,65 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package addshoppinglistentry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) AddShoppingListEntry(ctx context.Context, req *frontendapi.AddShoppingListEntryRequest) (*frontendapi.AddShoppingListEntryResponse, error) {
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("addshoppinglistentry: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
+
+	entry := cookchatdb.ShoppingListEntry{
+		RecipeID:    req.GetRecipeId(),
+		Title:       req.GetTitle(),
+		ServingSize: req.GetServingSize(),
+		Ingredients: make([]cookchatdb.ShoppingListIngredient, len(req.GetIngredients())),
+		AddedAt:     time.Now(),
+	}
+	for i, ing := range req.GetIngredients() {
+		entry.Ingredients[i] = cookchatdb.ShoppingListIngredient{
+			Name:     ing.GetName(),
+			Quantity: ing.GetQuantity(),
+			Selected: ing.GetSelected(),
+		}
+	}
+
+	// A recipe has at most one entry, so adding it again replaces its ingredients.
+	doc := scope.ShoppingList().NewDoc()
+	if entry.RecipeID != "" {
+		doc = scope.ShoppingList().Doc("recipe-" + entry.RecipeID)
+	}
+	if _, err := doc.Set(ctx, entry); err != nil {
+		return nil, fmt.Errorf("addshoppinglistentry: saving entry: %w", err)
+	}
+	return &frontendapi.AddShoppingListEntryResponse{
+		EntryId: doc.ID,
+	}, nil
+}
@@ -0,0 +1,173 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package applyplantemplate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	cloudtasks "cloud.google.com/go/cloudtasks/apiv2"
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+	"github.com/curioswitch/cookchat/frontend/server/internal/config"
+	"github.com/curioswitch/cookchat/frontend/server/internal/filltask"
+	"github.com/curioswitch/cookchat/frontend/server/internal/localdate"
+)
+
+var (
+	errTemplateNotFound = errors.New("plan template not found")
+	errInvalidTimeZone  = errors.New("invalid time zone")
+)
+
+func NewHandler(store *firestore.Client, tasks *cloudtasks.Client, tasksConfig config.Tasks) *Handler {
+	return &Handler{
+		store:       store,
+		tasks:       tasks,
+		tasksConfig: tasksConfig,
+	}
+}
+
+type Handler struct {
+	store       *firestore.Client
+	tasks       *cloudtasks.Client
+	tasksConfig config.Tasks
+}
+
+func (h *Handler) ApplyPlanTemplate(ctx context.Context, req *frontendapi.ApplyPlanTemplateRequest) (*frontendapi.ApplyPlanTemplateResponse, error) {
+	userID := firebaseauth.TokenFromContext(ctx).UID
+
+	var loc *time.Location
+	if tz := req.GetTimeZone(); tz != "" {
+		l, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errInvalidTimeZone)
+		}
+		loc = l
+	} else {
+		l, err := localdate.Location(ctx, h.store, userID)
+		if err != nil {
+			return nil, fmt.Errorf("applyplantemplate: resolving time zone: %w", err)
+		}
+		loc = l
+	}
+
+	scope, err := household.Resolve(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("applyplantemplate: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
+
+	doc, err := scope.PlanTemplates().Doc(req.GetTemplateId()).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, errTemplateNotFound)
+		}
+		return nil, fmt.Errorf("applyplantemplate: fetching template: %w", err)
+	}
+	var template cookchatdb.PlanTemplate
+	if err := doc.DataTo(&template); err != nil {
+		return nil, fmt.Errorf("applyplantemplate: decoding template: %w", err)
+	}
+	slots := make(map[time.Weekday][]string, len(template.Slots))
+	for _, slot := range template.Slots {
+		slots[slot.DayOfWeek] = slot.Recipes
+	}
+
+	plansCol := scope.Plans()
+	now := time.Now()
+	weekStart := localdate.StartOfDay(req.GetWeekStart().AsTime().In(loc))
+
+	var res *frontendapi.ApplyPlanTemplateResponse
+	// Days are checked and filled in one transaction so concurrent applies can't both fill the
+	// same day.
+	if err := h.store.RunTransaction(ctx, func(_ context.Context, t *firestore.Transaction) error {
+		res = &frontendapi.ApplyPlanTemplateResponse{}
+		var plans []cookchatdb.Plan
+		for i := range 7 {
+			dayStart := weekStart.AddDate(0, 0, i)
+			recipes, ok := slots[dayStart.Weekday()]
+			if !ok {
+				continue
+			}
+
+			hasPlan, err := hasPlanOnDay(t, plansCol, dayStart)
+			if err != nil {
+				return err
+			}
+			if hasPlan {
+				res.SkippedDays = append(res.SkippedDays, toDayOfWeek(dayStart.Weekday()))
+				continue
+			}
+
+			plan := cookchatdb.Plan{
+				ID:        plansCol.NewDoc().ID,
+				Recipes:   recipes,
+				CreatedAt: now,
+				Status:    cookchatdb.PlanStatusProcessing,
+				Type:      cookchatdb.PlanTypeDaily,
+			}
+			localdate.Schedule(&plan, dayStart)
+			plans = append(plans, plan)
+		}
+
+		// Transactions require all reads to happen before writes.
+		for _, plan := range plans {
+			if err := t.Create(plansCol.Doc(plan.ID), plan); err != nil {
+				return fmt.Errorf("applyplantemplate: creating plan document: %w", err)
+			}
+			res.PlanIds = append(res.PlanIds, plan.ID)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, planID := range res.GetPlanIds() {
+		if err := filltask.Enqueue(ctx, h.tasks, h.tasksConfig, planID); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func hasPlanOnDay(t *firestore.Transaction, plansCol *firestore.CollectionRef, dayStart time.Time) (bool, error) {
+	iter := t.Documents(plansCol.Where("scheduledAt", ">=", dayStart).
+		Where("scheduledAt", "<", dayStart.AddDate(0, 0, 1)))
+	defer iter.Stop()
+	for {
+		doc, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("applyplantemplate: querying existing plans: %w", err)
+		}
+		var plan cookchatdb.Plan
+		if err := doc.DataTo(&plan); err != nil {
+			return false, fmt.Errorf("applyplantemplate: decoding existing plan: %w", err)
+		}
+		// Trashed plans don't occupy the day.
+		if plan.DeletedAt.IsZero() {
+			return true, nil
+		}
+	}
+}
+
+func toDayOfWeek(day time.Weekday) frontendapi.DayOfWeek {
+	// DayOfWeek starts from Monday while time.Weekday starts from Sunday.
+	return frontendapi.DayOfWeek((int(day)+6)%7 + 1) //nolint:gosec // always between 1 and 7
+}
@@ -14,14 +14,13 @@ import (
 	"net/http"
 	"net/url"
 	"slices"
-	"strconv"
 	"strings"
 	"time"
 
 	cloudtasks "cloud.google.com/go/cloudtasks/apiv2"
-	taskspb "cloud.google.com/go/cloudtasks/apiv2/cloudtaskspb"
 	discoveryengine "cloud.google.com/go/discoveryengine/apiv1"
 	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
 	"github.com/cenkalti/backoff/v7"
 	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
 	"golang.org/x/sync/errgroup"
@@ -29,14 +28,15 @@ import (
 	"google.golang.org/genai"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/proto"
 
 	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
 	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
 	"github.com/curioswitch/cookchat/frontend/server/internal/config"
+	"github.com/curioswitch/cookchat/frontend/server/internal/filltask"
 	"github.com/curioswitch/cookchat/frontend/server/internal/i18n"
 	"github.com/curioswitch/cookchat/frontend/server/internal/llm"
-	tasksapi "github.com/curioswitch/cookchat/tasks/api/go"
+	"github.com/curioswitch/cookchat/frontend/server/internal/localdate"
 )
 
 func NewHandler(genAI *genai.Client, store *firestore.Client, search *discoveryengine.SearchClient, tasks *cloudtasks.Client, tasksConfig config.Tasks, filesBucket string) *Handler {
@@ -81,7 +81,12 @@ func (h *Handler) ChatPlan(ctx context.Context, req *frontendapi.ChatPlanRequest
 		}
 	}
 
-	recentRecipes, err := h.getRecentRecipes(ctx, userID)
+	loc, err := localdate.Location(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("chatplan: resolving time zone: %w", err)
+	}
+
+	recentRecipes, err := h.getRecentRecipes(ctx, userID, loc)
 	if err != nil {
 		return nil, fmt.Errorf("chatplan: getting recent recipes: %w", err)
 	}
@@ -123,6 +128,9 @@ func (h *Handler) ChatPlan(ctx context.Context, req *frontendapi.ChatPlanRequest
 		}
 	}
 	chat.UpdatedAt = now
+	if b := req.GetWeeklyBudget(); b > 0 {
+		chat.WeeklyBudget = int(b)
+	}
 	chat.Messages = append(chat.Messages, cookchatdb.ChatMessage{
 		Role:      cookchatdb.ChatRoleUser,
 		Content:   req.GetMessage(),
@@ -154,7 +162,7 @@ func (h *Handler) ChatPlan(ctx context.Context, req *frontendapi.ChatPlanRequest
 
 	res, err := backoff.Retry(ctx, func() (*genai.GenerateContentResponse, error) {
 		res, err := h.genAI.Models.GenerateContent(ctx, "gemini-3.6-flash", content, &genai.GenerateContentConfig{
-			SystemInstruction: genai.NewContentFromText(llm.ChatPlanPrompt(strings.Join(recentRecipes, ", ")), genai.RoleModel),
+			SystemInstruction: genai.NewContentFromText(llm.ChatPlanPrompt(strings.Join(recentRecipes, ", "), chat.WeeklyBudget), genai.RoleModel),
 			ThinkingConfig: &genai.ThinkingConfig{
 				ThinkingLevel: genai.ThinkingLevelMinimal,
 			},
@@ -182,72 +190,33 @@ func (h *Handler) ChatPlan(ctx context.Context, req *frontendapi.ChatPlanRequest
 	}
 
 	resText := strings.TrimSpace(res.Candidates[0].Content.Parts[0].Text)
+	if _, resJSON, ok := strings.Cut(resText, "GENERATED BATCH COOKING PLAN\n"); ok {
+		var batch batchPlanContent
+		if err := json.Unmarshal([]byte(resJSON), &batch); err != nil {
+			return nil, fmt.Errorf("chatplan: error deserializing LLM JSON response: %w", err)
+		}
+		planIDs, err := h.saveBatchPlans(ctx, batch, time.Now(), localdate.Today(loc))
+		if err != nil {
+			return nil, err
+		}
+		return h.completeChat(ctx, chats, chat, planIDs)
+	}
 	if _, resJSON, ok := strings.Cut(resText, "GENERATED MEAL PLAN\n"); ok {
 		var plans [][]cookchatdb.RecipeContent
 		if err := json.Unmarshal([]byte(resJSON), &plans); err != nil {
 			return nil, fmt.Errorf("chatplan: error deserializing LLM JSON response: %w", err)
 		}
 		now := time.Now()
+		today := localdate.Today(loc)
+		planIDs := make([]string, len(plans))
 		for i, planContent := range plans {
-			plan, err := h.savePlan(ctx, planContent, now, i)
+			plan, err := h.savePlan(ctx, planContent, now, today.AddDate(0, 0, i))
 			if err != nil {
 				return nil, err
 			}
-			planID := plan.ID
-
-			fillPlanReq, err := proto.Marshal(&tasksapi.FillPlanRequest{
-				PlanId: planID,
-			})
-			if err != nil {
-				return nil, fmt.Errorf("chatplan: marshaling fill plan request: %w", err)
-			}
-
-			fbTok := firebaseauth.RawTokenFromContext(ctx)
-
-			task := &taskspb.CreateTaskRequest{
-				Parent: h.tasksConfig.Queue,
-				Task: &taskspb.Task{
-					MessageType: &taskspb.Task_HttpRequest{
-						HttpRequest: &taskspb.HttpRequest{
-							HttpMethod: taskspb.HttpMethod_POST,
-							Url:        h.tasksConfig.URL + "/tasksapi.TasksService/FillPlan",
-							Headers: map[string]string{
-								"Content-Type":             "application/proto",
-								"Content-Length":           strconv.Itoa(len(fillPlanReq)),
-								"X-Original-Authorization": "Bearer " + fbTok,
-							},
-							Body: fillPlanReq,
-							AuthorizationHeader: &taskspb.HttpRequest_OidcToken{
-								OidcToken: &taskspb.OidcToken{
-									ServiceAccountEmail: h.tasksConfig.Invoker,
-								},
-							},
-						},
-					},
-				},
-			}
-			if _, err := h.tasks.CreateTask(ctx, task); err != nil {
-				return nil, fmt.Errorf("chatplan: creating task: %w", err)
-			}
-
-			if chat.PlanID == "" {
-				chat.PlanID = planID
-			}
-			// TODO: Do something better
-			switch i18n.UserLanguage(ctx) {
-			case "ja":
-				chat.Messages[len(chat.Messages)-1].Content = "あなたの献立を作成しました。"
-			default:
-				chat.Messages[len(chat.Messages)-1].Content = "Created your meal plan."
-			}
-			if _, err := chats.Doc(chat.ID).Set(ctx, chat); err != nil {
-				return nil, fmt.Errorf("chatplan: saving chat plan ID: %w", err)
-			}
+			planIDs[i] = plan.ID
 		}
-		return &frontendapi.ChatPlanResponse{
-			ChatId: chat.ID,
-			PlanId: chat.PlanID,
-		}, nil
+		return h.completeChat(ctx, chats, chat, planIDs)
 	}
 
 	chat.Messages[len(chat.Messages)-1].Content = resText
@@ -294,6 +263,35 @@ func (h *Handler) ChatPlan(ctx context.Context, req *frontendapi.ChatPlanRequest
 	}, nil
 }
 
+// completeChat enqueues processing of the plans created by the chat and records the
+// first plan as the result of the chat.
+func (h *Handler) completeChat(ctx context.Context, chats *firestore.CollectionRef, chat cookchatdb.Chat, planIDs []string) (*frontendapi.ChatPlanResponse, error) {
+	for _, planID := range planIDs {
+		if err := filltask.Enqueue(ctx, h.tasks, h.tasksConfig, planID); err != nil {
+			return nil, err
+		}
+		if chat.PlanID == "" {
+			chat.PlanID = planID
+		}
+	}
+
+	// TODO: Do something better
+	switch i18n.UserLanguage(ctx) {
+	case "ja":
+		chat.Messages[len(chat.Messages)-1].Content = "あなたの献立を作成しました。"
+	default:
+		chat.Messages[len(chat.Messages)-1].Content = "Created your meal plan."
+	}
+	if _, err := chats.Doc(chat.ID).Set(ctx, chat); err != nil {
+		return nil, fmt.Errorf("chatplan: saving chat plan ID: %w", err)
+	}
+
+	return &frontendapi.ChatPlanResponse{
+		ChatId: chat.ID,
+		PlanId: chat.PlanID,
+	}, nil
+}
+
 const maxAttachedImageBytes = 5 << 20
 
 var firebaseStorageEndpoint = "https://firebasestorage.googleapis.com"
@@ -351,22 +349,30 @@ func downloadFirebaseImage(ctx context.Context, imageURL, filesBucket, userID st
 	return data, mimeType, nil
 }
 
-func (h *Handler) savePlan(ctx context.Context, recipeContents []cookchatdb.RecipeContent, now time.Time, index int) (cookchatdb.Plan, error) {
+// batchPlanContent is the LLM response for a batch-cooking plan.
+type batchPlanContent struct {
+	// Dishes are the recipes cooked in the prep session.
+	Dishes []cookchatdb.RecipeContent `json:"dishes"`
+
+	// Days are the indexes into Dishes eaten on each day after the prep session.
+	Days [][]int `json:"days"`
+}
+
+func (h *Handler) saveRecipes(ctx context.Context, recipeContents []cookchatdb.RecipeContent) ([]string, error) {
 	language := i18n.UserLanguage(ctx)
 	var grp errgroup.Group
-	plan := cookchatdb.Plan{
-		Recipes: make([]string, 0, len(recipeContents)),
-	}
-	for _, content := range recipeContents {
+	recipeIDs := make([]string, len(recipeContents))
+	for i, content := range recipeContents {
 		grp.Go(func() error {
 			recipeID := h.store.Collection("recipes").NewDoc().ID
 			docID := "chatplan-" + recipeID
 			recipe := cookchatdb.Recipe{
-				ID:           recipeID,
-				Source:       cookchatdb.RecipeSourceAI,
-				Status:       cookchatdb.RecipeStatusProcessing,
-				Content:      content,
-				LanguageCode: language,
+				ID:                  recipeID,
+				Source:              cookchatdb.RecipeSourceAI,
+				Status:              cookchatdb.RecipeStatusProcessing,
+				Content:             content,
+				LanguageCode:        language,
+				ProcessingStartedAt: time.Now(),
 			}
 
 			if url := content.SourceURL; url != "" {
@@ -389,7 +395,7 @@ func (h *Handler) savePlan(ctx context.Context, recipeContents []cookchatdb.Reci
 			if existing, err := h.store.Collection("recipes").Doc(docID).Get(ctx); status.Code(err) != codes.NotFound {
 				idAny, _ := existing.DataAt("id")
 				if idStr, ok := idAny.(string); ok {
-					plan.Recipes = append(plan.Recipes, idStr)
+					recipeIDs[i] = idStr
 					return nil
 				}
 			}
@@ -397,23 +403,49 @@ func (h *Handler) savePlan(ctx context.Context, recipeContents []cookchatdb.Reci
 			if _, err := rDoc.Create(ctx, recipe); err != nil {
 				return fmt.Errorf("chatplan: saving recipe %q: %w", recipe.ID, err)
 			}
-			plan.Recipes = append(plan.Recipes, recipeID)
+			recipeIDs[i] = recipeID
 
 			return nil
 		})
 	}
 	if err := grp.Wait(); err != nil {
-		return cookchatdb.Plan{}, err
+		return nil, err
+	}
+	return recipeIDs, nil
+}
+
+// plansCollection returns the plans collection of the user's household, failing if the user
+// is not allowed to add plans to it.
+func (h *Handler) plansCollection(ctx context.Context) (*firestore.CollectionRef, error) {
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("chatplan: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
 	}
+	return scope.Plans(), nil
+}
 
-	userID := firebaseauth.TokenFromContext(ctx).UID
+func (h *Handler) savePlan(ctx context.Context, recipeContents []cookchatdb.RecipeContent, now time.Time, day time.Time) (cookchatdb.Plan, error) {
+	plansCol, err := h.plansCollection(ctx)
+	if err != nil {
+		return cookchatdb.Plan{}, err
+	}
+	recipeIDs, err := h.saveRecipes(ctx, recipeContents)
+	if err != nil {
+		return cookchatdb.Plan{}, err
+	}
 
-	plansCol := h.store.Collection("users").Doc(userID).Collection("plans")
 	planDoc := plansCol.NewDoc()
-	plan.ID = planDoc.ID
-	plan.ScheduledAt = now.Add(time.Duration(index) * 24 * time.Hour)
-	plan.CreatedAt = now
-	plan.Status = cookchatdb.PlanStatusProcessing
+	plan := cookchatdb.Plan{
+		ID:        planDoc.ID,
+		Recipes:   recipeIDs,
+		CreatedAt: now,
+		Status:    cookchatdb.PlanStatusProcessing,
+		Type:      cookchatdb.PlanTypeDaily,
+	}
+	localdate.Schedule(&plan, day)
 	if _, err := planDoc.Set(ctx, plan); err != nil {
 		return plan, fmt.Errorf("chatplan: failed to set plan document: %w", err)
 	}
@@ -421,26 +453,103 @@ func (h *Handler) savePlan(ctx context.Context, recipeContents []cookchatdb.Reci
 	return plan, nil
 }
 
-func (h *Handler) getRecentRecipes(ctx context.Context, userID string) ([]string, error) {
-	now := time.Now()
-	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
-	start := today.Add(-2 * 7 * 24 * time.Hour)
-
-	plansCol := h.store.Collection("users").Doc(userID).Collection("plans")
-	iter := plansCol.Query.WhereEntity(firestore.AndFilter{
-		Filters: []firestore.EntityFilter{
-			firestore.PropertyFilter{
-				Path:     firestore.DocumentID,
-				Operator: ">=",
-				Value:    plansCol.Doc(start.Format(time.DateOnly)),
-			},
-			firestore.PropertyFilter{
-				Path:     firestore.DocumentID,
-				Operator: "<=",
-				Value:    plansCol.Doc(today.Format(time.DateOnly)),
-			},
-		},
-	}).Documents(ctx)
+// saveBatchPlans saves a batch-prep plan cooking all dishes today, followed by a leftovers plan
+// for each following day. The IDs of all saved plans are returned, starting with the batch-prep plan.
+// The batch is validated before anything is saved, and the plans are saved together so an
+// invalid batch leaves no plans behind.
+func (h *Handler) saveBatchPlans(ctx context.Context, batch batchPlanContent, now time.Time, today time.Time) ([]string, error) {
+	if err := validateBatch(batch); err != nil {
+		return nil, err
+	}
+	plansCol, err := h.plansCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recipeIDs, err := h.saveRecipes(ctx, batch.Dishes)
+	if err != nil {
+		return nil, err
+	}
+
+	prepDoc := plansCol.NewDoc()
+	prep := cookchatdb.Plan{
+		ID:          prepDoc.ID,
+		Recipes:     recipeIDs,
+		CreatedAt:   now,
+		Status:      cookchatdb.PlanStatusProcessing,
+		Type:        cookchatdb.PlanTypeBatchPrep,
+		BatchDishes: make([]cookchatdb.BatchDish, len(recipeIDs)),
+	}
+	for i, recipeID := range recipeIDs {
+		prep.BatchDishes[i] = cookchatdb.BatchDish{RecipeID: recipeID}
+	}
+	localdate.Schedule(&prep, today)
+
+	var planIDs []string
+	if err := h.store.RunTransaction(ctx, func(_ context.Context, t *firestore.Transaction) error {
+		// The transaction may be retried.
+		planIDs = []string{prep.ID}
+		if err := t.Set(prepDoc, prep); err != nil {
+			return fmt.Errorf("chatplan: failed to set batch prep plan document: %w", err)
+		}
+
+		for i, day := range batch.Days {
+			planDoc := plansCol.NewDoc()
+			plan := cookchatdb.Plan{
+				ID:          planDoc.ID,
+				CreatedAt:   now,
+				Status:      cookchatdb.PlanStatusProcessing,
+				Type:        cookchatdb.PlanTypeLeftovers,
+				BatchPlanID: prep.ID,
+			}
+			localdate.Schedule(&plan, today.AddDate(0, 0, i+1))
+			for _, dish := range day {
+				plan.Recipes = append(plan.Recipes, recipeIDs[dish])
+			}
+			if err := t.Set(planDoc, plan); err != nil {
+				return fmt.Errorf("chatplan: failed to set leftovers plan document: %w", err)
+			}
+			planIDs = append(planIDs, plan.ID)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return planIDs, nil
+}
+
+// validateBatch returns an error if batch has no dishes, or a day after the prep session does
+// not eat any of them.
+func validateBatch(batch batchPlanContent) error {
+	if len(batch.Dishes) == 0 {
+		return errors.New("chatplan: no dishes in batch cooking plan")
+	}
+	for i, day := range batch.Days {
+		if len(day) == 0 {
+			return fmt.Errorf("chatplan: no dishes on day %d of batch cooking plan", i+1)
+		}
+		for _, dish := range day {
+			if dish < 0 || dish >= len(batch.Dishes) {
+				return fmt.Errorf("chatplan: unknown dish %d in batch cooking plan", dish)
+			}
+		}
+	}
+	return nil
+}
+
+// getRecentRecipes returns the titles of recipes the user has actually cooked in the last
+// two weeks of calendar days in loc, according to their cooking history. Plans that were
+// since moved to the trash are ignored.
+func (h *Handler) getRecentRecipes(ctx context.Context, userID string, loc *time.Location) ([]string, error) {
+	start := localdate.Today(loc).AddDate(0, 0, -2*7)
+
+	trashed, err := h.trashedPlanIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	historyCol := h.store.Collection("users").Doc(userID).Collection("cookingHistory")
+	iter := historyCol.Query.Where("cookedAt", ">=", start).Documents(ctx)
 	defer iter.Stop()
 
 	recipeIDs := make(map[string]struct{})
@@ -450,45 +559,65 @@ func (h *Handler) getRecentRecipes(ctx context.Context, userID string) ([]string
 			break
 		}
 		if err != nil {
-			return nil, fmt.Errorf("chatplan: fetching plan: %w", err)
+			return nil, fmt.Errorf("chatplan: fetching cooking history: %w", err)
 		}
 
-		var plan cookchatdb.Plan
-		if err := doc.DataTo(&plan); err != nil {
-			return nil, fmt.Errorf("chatplan: decoding plan: %w", err)
+		var entry cookchatdb.CookingHistoryEntry
+		if err := doc.DataTo(&entry); err != nil {
+			return nil, fmt.Errorf("chatplan: decoding cooking history: %w", err)
 		}
-		for _, recipeID := range plan.Recipes {
+		if _, ok := trashed[entry.PlanID]; ok {
+			continue
+		}
+		for _, recipeID := range entry.RecipeIDs {
 			recipeIDs[recipeID] = struct{}{}
 		}
 	}
 
 	var recipeTitles []string
-	if len(recipeIDs) == 0 {
-		return recipeTitles, nil
-	}
-
+	ids := slices.Collect(maps.Keys(recipeIDs))
 	recipesCol := h.store.Collection("recipes")
-	iter = recipesCol.Query.WhereEntity(firestore.PropertyFilter{
-		Path:     "id",
-		Operator: "in",
-		Value:    slices.Collect(maps.Keys(recipeIDs)),
-	}).Documents(ctx)
-	defer iter.Stop()
-
-	for {
-		doc, err := iter.Next()
-		if errors.Is(err, iterator.Done) {
-			break
+	for len(ids) > 0 {
+		batch := ids
+		if len(batch) > 30 {
+			batch = batch[:30]
 		}
+		ids = ids[len(batch):]
+
+		docs, err := recipesCol.Query.WhereEntity(firestore.PropertyFilter{
+			Path:     "id",
+			Operator: "in",
+			Value:    batch,
+		}).Documents(ctx).GetAll()
 		if err != nil {
 			return nil, fmt.Errorf("chatplan: fetching recipe: %w", err)
 		}
-		var recipe cookchatdb.Recipe
-		if err := doc.DataTo(&recipe); err != nil {
-			return nil, fmt.Errorf("chatplan: decoding recipe: %w", err)
+		for _, doc := range docs {
+			var recipe cookchatdb.Recipe
+			if err := doc.DataTo(&recipe); err != nil {
+				return nil, fmt.Errorf("chatplan: decoding recipe: %w", err)
+			}
+			recipeTitles = append(recipeTitles, recipe.Content.Title)
 		}
-		recipeTitles = append(recipeTitles, recipe.Content.Title)
 	}
 
 	return recipeTitles, nil
 }
+
+// trashedPlanIDs returns the IDs of the plans of the user that are in the trash.
+func (h *Handler) trashedPlanIDs(ctx context.Context, userID string) (map[string]struct{}, error) {
+	scope, err := household.Resolve(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("chatplan: resolving household: %w", err)
+	}
+	// Plans that are not trashed have no deletedAt and are not matched.
+	docs, err := scope.Plans().Where("deletedAt", ">", time.Time{}).Select().Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("chatplan: fetching trashed plans: %w", err)
+	}
+	ids := make(map[string]struct{}, len(docs))
+	for _, doc := range docs {
+		ids[doc.Ref.ID] = struct{}{}
+	}
+	return ids, nil
+}
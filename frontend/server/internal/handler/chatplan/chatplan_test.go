@@ -0,0 +1,54 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package chatplan
+
+import (
+	"testing"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+func TestValidateBatch(t *testing.T) {
+	dishes := []cookchatdb.RecipeContent{{Title: "Curry"}, {Title: "Salad"}}
+
+	tests := []struct {
+		name    string
+		batch   batchPlanContent
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			batch: batchPlanContent{Dishes: dishes, Days: [][]int{{0}, {0, 1}}},
+		},
+		{
+			name:    "no dishes",
+			batch:   batchPlanContent{Days: [][]int{{0}}},
+			wantErr: true,
+		},
+		{
+			name:    "day without dishes",
+			batch:   batchPlanContent{Dishes: dishes, Days: [][]int{{0}, {}}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown dish",
+			batch:   batchPlanContent{Dishes: dishes, Days: [][]int{{2}}},
+			wantErr: true,
+		},
+		{
+			name:    "negative dish",
+			batch:   batchPlanContent{Dishes: dishes, Days: [][]int{{-1}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateBatch(tc.batch)
+			if tc.wantErr != (err != nil) {
+				t.Errorf("got error %v, want error %v", err, tc.wantErr)
+			}
+		})
+	}
+}
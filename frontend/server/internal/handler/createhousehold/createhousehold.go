@@ -0,0 +1,95 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package createhousehold
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+var errAlreadyInHousehold = errors.New("user is already in a household")
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) CreateHousehold(ctx context.Context, req *frontendapi.CreateHouseholdRequest) (*frontendapi.CreateHouseholdResponse, error) {
+	userID := firebaseauth.TokenFromContext(ctx).UID
+	userDoc := h.store.Collection("users").Doc(userID)
+	householdDoc := h.store.Collection("households").NewDoc()
+
+	// Copy the user's data into the household before creating it, so it never appears empty to
+	// the user. The user is checked again when creating.
+	scope, err := household.Resolve(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("createhousehold: resolving household: %w", err)
+	}
+	if scope.HouseholdID != "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errAlreadyInHousehold)
+	}
+	// The household is new, so there is no data to conflict with.
+	if _, err := household.CopyPersonal(ctx, h.store, userID, householdDoc.ID); err != nil {
+		return nil, fmt.Errorf("createhousehold: copying personal data: %w", err)
+	}
+
+	if err := h.store.RunTransaction(ctx, func(_ context.Context, t *firestore.Transaction) error {
+		doc, err := t.Get(userDoc)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return fmt.Errorf("createhousehold: fetching user: %w", err)
+		}
+		if doc.Exists() {
+			var user cookchatdb.User
+			if err := doc.DataTo(&user); err != nil {
+				return fmt.Errorf("createhousehold: decoding user: %w", err)
+			}
+			if user.HouseholdID != "" {
+				return connect.NewError(connect.CodeFailedPrecondition, errAlreadyInHousehold)
+			}
+		}
+
+		now := time.Now()
+		created := cookchatdb.Household{
+			ID:        householdDoc.ID,
+			Name:      req.GetName(),
+			OwnerID:   userID,
+			CreatedAt: now,
+		}
+		if err := t.Create(householdDoc, created); err != nil {
+			return fmt.Errorf("createhousehold: saving household: %w", err)
+		}
+		if err := t.Create(householdDoc.Collection("members").Doc(userID), cookchatdb.HouseholdMember{
+			UserID:   userID,
+			Role:     cookchatdb.HouseholdRoleOwner,
+			JoinedAt: now,
+		}); err != nil {
+			return fmt.Errorf("createhousehold: saving owner: %w", err)
+		}
+		if err := t.Set(userDoc, map[string]any{"householdId": created.ID}, firestore.MergeAll); err != nil {
+			return fmt.Errorf("createhousehold: saving user: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("createhousehold: creating household: %w", err)
+	}
+
+	return &frontendapi.CreateHouseholdResponse{HouseholdId: householdDoc.ID}, nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package createhouseholdinvitation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+// invitationTTL is how long an invitation can be accepted after creation.
+const invitationTTL = 7 * 24 * time.Hour
+
+var (
+	errNotInHousehold   = errors.New("user is not in a household")
+	errPermissionDenied = errors.New("only owners can invite members")
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) CreateHouseholdInvitation(ctx context.Context, req *frontendapi.CreateHouseholdInvitationRequest) (*frontendapi.CreateHouseholdInvitationResponse, error) {
+	userID := firebaseauth.TokenFromContext(ctx).UID
+	scope, err := household.Resolve(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("createhouseholdinvitation: resolving household: %w", err)
+	}
+	if scope.HouseholdID == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errNotInHousehold)
+	}
+	if scope.Role != cookchatdb.HouseholdRoleOwner {
+		return nil, connect.NewError(connect.CodePermissionDenied, errPermissionDenied)
+	}
+
+	role := cookchatdb.HouseholdRoleViewer
+	if req.GetRole() == frontendapi.HouseholdRole_HOUSEHOLD_ROLE_EDITOR {
+		role = cookchatdb.HouseholdRoleEditor
+	}
+
+	now := time.Now()
+	invitationDoc := h.store.Collection("householdInvitations").NewDoc()
+	invitation := cookchatdb.HouseholdInvitation{
+		ID:          invitationDoc.ID,
+		HouseholdID: scope.HouseholdID,
+		Role:        role,
+		CreatedBy:   userID,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(invitationTTL),
+	}
+	if _, err := invitationDoc.Create(ctx, invitation); err != nil {
+		return nil, fmt.Errorf("createhouseholdinvitation: saving invitation: %w", err)
+	}
+
+	return &frontendapi.CreateHouseholdInvitationResponse{
+		InvitationId: invitation.ID,
+		ExpiresAt:    timestamppb.New(invitation.ExpiresAt),
+	}, nil
+}
@@ -0,0 +1,55 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package deleteingredientprice
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+
+	"github.com/curioswitch/cookchat/common/household"
+	"github.com/curioswitch/cookchat/common/pricing"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+func NewHandler(store *firestore.Client, prices *pricing.Cache) *Handler {
+	return &Handler{
+		store:  store,
+		prices: prices,
+	}
+}
+
+type Handler struct {
+	store  *firestore.Client
+	prices *pricing.Cache
+}
+
+func (h *Handler) DeleteIngredientPrice(ctx context.Context, req *frontendapi.DeleteIngredientPriceRequest) (*frontendapi.DeleteIngredientPriceResponse, error) {
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("deleteingredientprice: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
+
+	docs, err := scope.IngredientPrices().
+		Where("name", "==", pricing.CanonicalName(req.GetName())).
+		Where("unit", "==", pricing.NormalizeUnit(req.GetUnit())).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("deleteingredientprice: fetching prices: %w", err)
+	}
+	for _, doc := range docs {
+		if _, err := doc.Ref.Delete(ctx); err != nil {
+			return nil, fmt.Errorf("deleteingredientprice: deleting price: %w", err)
+		}
+	}
+	h.prices.Invalidate(scope.IngredientPrices())
+
+	return &frontendapi.DeleteIngredientPriceResponse{}, nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package deletepantryitem
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+
+	"github.com/curioswitch/cookchat/common/household"
+	"github.com/curioswitch/cookchat/common/pricing"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) DeletePantryItem(ctx context.Context, req *frontendapi.DeletePantryItemRequest) (*frontendapi.DeletePantryItemResponse, error) {
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("deletepantryitem: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
+
+	docs, err := scope.Pantry().
+		Where("name", "==", pricing.CanonicalName(req.GetName())).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("deletepantryitem: fetching items: %w", err)
+	}
+	for _, doc := range docs {
+		if _, err := doc.Ref.Delete(ctx); err != nil {
+			return nil, fmt.Errorf("deletepantryitem: deleting item: %w", err)
+		}
+	}
+
+	return &frontendapi.DeletePantryItemResponse{}, nil
+}
@@ -6,12 +6,16 @@ package deleteplan
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
 	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
 	"google.golang.org/genai"
 
+	"github.com/curioswitch/cookchat/common/household"
 	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+	"github.com/curioswitch/cookchat/frontend/server/internal/planstore"
 )
 
 func NewHandler(genAI *genai.Client, store *firestore.Client) *Handler {
@@ -28,12 +32,26 @@ type Handler struct {
 
 func (h *Handler) DeletePlan(ctx context.Context, req *frontendapi.DeletePlanRequest) (*frontendapi.DeletePlanResponse, error) {
 	userID := firebaseauth.TokenFromContext(ctx).UID
-	plansCol := h.store.Collection("users").Doc(userID).Collection("plans")
+	scope, err := household.Resolve(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("deleteplan: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
 
-	planID := req.GetPlanId()
-	planDoc := plansCol.Doc(planID)
-	if _, err := planDoc.Delete(ctx); err != nil {
-		return nil, fmt.Errorf("deleteplan: failed to delete plan document: %w", err)
+	// Plans are moved to the trash and purged later so they can be restored. Plans already in
+	// the trash are not found, so deleting again does not delay the purge.
+	if err := h.store.RunTransaction(ctx, func(_ context.Context, tx *firestore.Transaction) error {
+		doc, _, err := planstore.GetInTransaction(tx, scope, req.GetPlanId())
+		if err != nil {
+			return fmt.Errorf("deleteplan: %w", err)
+		}
+		return tx.Update(doc.Ref, []firestore.Update{
+			{Path: "deletedAt", Value: time.Now()},
+		})
+	}); err != nil {
+		return nil, fmt.Errorf("deleteplan: failed to trash plan document: %w", err)
 	}
 
 	return &frontendapi.DeletePlanResponse{}, nil
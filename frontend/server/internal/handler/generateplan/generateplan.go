@@ -8,24 +8,36 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"strconv"
+	"log/slog"
+	"slices"
 	"time"
 
 	cloudtasks "cloud.google.com/go/cloudtasks/apiv2"
-	taskspb "cloud.google.com/go/cloudtasks/apiv2/cloudtaskspb"
 	discoveryengine "cloud.google.com/go/discoveryengine/apiv1"
 	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
 	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
 	"google.golang.org/api/iterator"
 	"google.golang.org/genai"
 	"google.golang.org/protobuf/encoding/protojson"
-	"google.golang.org/protobuf/proto"
 
 	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	"github.com/curioswitch/cookchat/common/pricing"
 	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
 	"github.com/curioswitch/cookchat/frontend/server/internal/config"
+	"github.com/curioswitch/cookchat/frontend/server/internal/filltask"
 	"github.com/curioswitch/cookchat/frontend/server/internal/llm"
-	tasksapi "github.com/curioswitch/cookchat/tasks/api/go"
+	"github.com/curioswitch/cookchat/frontend/server/internal/localdate"
+	"github.com/curioswitch/cookchat/frontend/server/internal/planner"
+)
+
+// maxBudgetAttempts is the number of times to generate a plan when generated plans exceed the budget.
+const maxBudgetAttempts = 2
+
+var (
+	errBatchConstraint = errors.New("constraint-based generator does not support batch cooking")
+	errOverBudget      = errors.New("no plan fits within the budget")
 )
 
 func NewHandler(genAI *genai.Client, store *firestore.Client, search *discoveryengine.SearchClient, tasks *cloudtasks.Client, tasksConfig config.Tasks) *Handler {
@@ -47,7 +59,35 @@ type Handler struct {
 }
 
 func (h *Handler) GeneratePlan(ctx context.Context, req *frontendapi.GeneratePlanRequest) (*frontendapi.GeneratePlanResponse, error) {
-	recipeDocs := h.store.Collection("recipes").Query.Select("id", "title", "description", "ingredients", "additionalIngredients", "notes").
+	userID := firebaseauth.TokenFromContext(ctx).UID
+	scope, err := household.Resolve(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("generateplan: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
+	loc, err := localdate.Location(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("generateplan: resolving time zone: %w", err)
+	}
+
+	if req.GetBatchCooking() && req.GetGenerator() == frontendapi.PlanGenerator_PLAN_GENERATOR_CONSTRAINT {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errBatchConstraint)
+	}
+
+	// Costs are only estimated when planning within a budget.
+	var prices *pricing.Table
+	costs := map[string]int{}
+	if req.GetWeeklyBudget() > 0 {
+		prices, err = pricing.LoadTable(ctx, scope.IngredientPrices())
+		if err != nil {
+			return nil, fmt.Errorf("generateplan: loading ingredient prices: %w", err)
+		}
+	}
+
+	recipeDocs := h.store.Collection("recipes").Query.
+		Select("id", "title", "description", "ingredients", "additionalIngredients", "notes", "type", "genre", "content.ingredients", "content.additionalIngredients").
 		WhereEntity(firestore.PropertyFilter{
 			Path:     "source",
 			Operator: "not-in",
@@ -55,6 +95,7 @@ func (h *Handler) GeneratePlan(ctx context.Context, req *frontendapi.GeneratePla
 		}).Documents(ctx)
 
 	var content []*genai.Content
+	var candidates []planner.Recipe
 
 	reqJSONBytes, err := protojson.Marshal(req)
 	if err != nil {
@@ -68,14 +109,107 @@ func (h *Handler) GeneratePlan(ctx context.Context, req *frontendapi.GeneratePla
 			break
 		}
 
-		recipeJSON, err := json.Marshal(doc.Data())
+		var recipe cookchatdb.Recipe
+		if err := doc.DataTo(&recipe); err != nil {
+			return nil, fmt.Errorf("generateplan: decoding recipe document: %w", err)
+		}
+		candidate := planner.Recipe{
+			ID:    recipe.ID,
+			Type:  recipe.Type,
+			Genre: recipe.Genre,
+		}
+		for _, ing := range recipe.Content.Ingredients {
+			candidate.Ingredients = append(candidate.Ingredients, ing.Name)
+		}
+
+		// Ingredients are only needed for constraint-based generation and cost estimates,
+		// don't send them to the LLM.
+		data := doc.Data()
+		delete(data, "content")
+		if prices != nil {
+			candidate.Cost = prices.RecipeCost(&recipe.Content)
+			costs[recipe.ID] = candidate.Cost
+			data["estimatedCost"] = candidate.Cost
+		}
+		candidates = append(candidates, candidate)
+
+		recipeJSON, err := json.Marshal(data)
 		if err != nil {
 			return nil, fmt.Errorf("generateplan: marshalling recipe document to JSON: %w", err)
 		}
 		content = append(content, genai.NewContentFromText(string(recipeJSON), genai.RoleUser))
 	}
 
-	res, err := h.genAI.Models.GenerateContent(ctx, "gemini-3.6-flash", content, &genai.GenerateContentConfig{
+	if req.GetBatchCooking() {
+		return h.generateBatchPlan(ctx, req, content, scope.Plans(), costs, loc)
+	}
+
+	var plans []cookchatdb.Plan
+	switch req.GetGenerator() {
+	case frontendapi.PlanGenerator_PLAN_GENERATOR_CONSTRAINT:
+		plans, err = constraintPlans(req, candidates)
+	case frontendapi.PlanGenerator_PLAN_GENERATOR_LLM:
+		plans, err = h.generateLLMPlans(ctx, req, content, costs)
+	case frontendapi.PlanGenerator_PLAN_GENERATOR_UNSPECIFIED:
+		plans, err = h.generateLLMPlans(ctx, req, content, costs)
+		if err != nil {
+			// The constraint-based generator also respects the budget, choosing cheaper recipes
+			// when the LLM keeps exceeding it.
+			slog.WarnContext(ctx, "generateplan: generating plan with LLM failed, falling back to constraint-based generator", "error", err)
+			plans, err = constraintPlans(req, candidates)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var planIDs []string
+	if err := h.store.RunTransaction(ctx, func(_ context.Context, t *firestore.Transaction) error {
+		// The transaction may be retried.
+		planIDs = nil
+		plansCol := scope.Plans()
+		now := time.Now()
+		today := localdate.Today(loc)
+		for i, plan := range plans {
+			planDoc := plansCol.NewDoc()
+			plan.ID = planDoc.ID
+			plan.Status = cookchatdb.PlanStatusProcessing
+			plan.Type = cookchatdb.PlanTypeDaily
+			if len(plan.Recipes) > 3 {
+				plan.Recipes = plan.Recipes[:3]
+			}
+			localdate.Schedule(&plan, today.AddDate(0, 0, i))
+			plan.CreatedAt = now
+			if err := t.Set(planDoc, plan); err != nil {
+				return fmt.Errorf("generateplan: failed to set plan document: %w", err)
+			}
+			planIDs = append(planIDs, plan.ID)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("generateplan: save plans: %w", err)
+	}
+
+	if err := h.enqueueFill(ctx, planIDs); err != nil {
+		return nil, err
+	}
+
+	return &frontendapi.GeneratePlanResponse{}, nil
+}
+
+// enqueueFill enqueues filling the saved plans with planIDs.
+func (h *Handler) enqueueFill(ctx context.Context, planIDs []string) error {
+	for _, planID := range planIDs {
+		if err := filltask.Enqueue(ctx, h.tasks, h.tasksConfig, planID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generateLLMPlans generates the days of a plan with an LLM, choosing from the recipes in content.
+func (h *Handler) generateLLMPlans(ctx context.Context, req *frontendapi.GeneratePlanRequest, content []*genai.Content, costs map[string]int) ([]cookchatdb.Plan, error) {
+	genConfig := &genai.GenerateContentConfig{
 		SystemInstruction: genai.NewContentFromText(llm.GeneratePlanPrompt(), genai.RoleModel),
 		ResponseMIMEType:  "application/json",
 		ResponseSchema: &genai.Schema{
@@ -96,79 +230,262 @@ func (h *Handler) GeneratePlan(ctx context.Context, req *frontendapi.GeneratePla
 				Required: []string{"recipes"},
 			},
 		},
+	}
+	budget := planBudget(req)
+
+	var plans []cookchatdb.Plan
+	for attempt := 1; ; attempt++ {
+		res, err := h.genAI.Models.GenerateContent(ctx, "gemini-3.6-flash", content, genConfig)
+		if err != nil {
+			return nil, fmt.Errorf("generateplan: calling GenerateContent for plan: %w", err)
+		}
+		if len(res.Candidates) != 1 || len(res.Candidates[0].Content.Parts) != 1 || res.Candidates[0].Content.Parts[0].Text == "" {
+			return nil, fmt.Errorf("generateplan: unexpected response from generate ai for plan: %v", res)
+		}
+
+		plans = nil
+		if err := json.Unmarshal([]byte(res.Candidates[0].Content.Parts[0].Text), &plans); err != nil {
+			return nil, fmt.Errorf("generateplan: failed to unmarshal received plan: %w", err)
+		}
+		if len(plans) != int(req.GetNumDays()) {
+			return nil, fmt.Errorf("generateplan: unexpected number of days in plan: got %d, want %d", len(plans), req.GetNumDays())
+		}
+
+		cost := 0
+		for _, plan := range plans {
+			cost += recipesCost(costs, plan.Recipes)
+		}
+		if budget == 0 || cost <= budget {
+			break
+		}
+		if attempt == maxBudgetAttempts {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errOverBudget)
+		}
+		content = append(content, res.Candidates[0].Content, budgetFeedback(cost, budget))
+	}
+	return plans, nil
+}
+
+// constraintPlans generates the days of a plan with the constraint-based planner.
+func constraintPlans(req *frontendapi.GeneratePlanRequest, candidates []planner.Recipe) ([]cookchatdb.Plan, error) {
+	genres := make([]cookchatdb.RecipeGenre, 0, len(req.GetGenres()))
+	for _, g := range req.GetGenres() {
+		genres = append(genres, toDBGenre(g))
+	}
+	days, err := planner.Plan(candidates, planner.Request{
+		NumDays:     int(req.GetNumDays()),
+		Genres:      genres,
+		RecipeIDs:   req.GetRecipeIds(),
+		Ingredients: req.GetIngredients(),
+		Budget:      planBudget(req),
+		Seed:        uint64(time.Now().UnixNano()), //nolint:gosec // only for variety
 	})
 	if err != nil {
-		return nil, fmt.Errorf("generateplan: calling GenerateContent for plan: %w", err)
+		if errors.Is(err, planner.ErrNotEnoughMains) || errors.Is(err, planner.ErrOverBudget) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		if errors.Is(err, planner.ErrTooManyRecipes) || errors.Is(err, planner.ErrRecipeNotFound) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, fmt.Errorf("generateplan: generating plan with constraints: %w", err)
 	}
-	if len(res.Candidates) != 1 || len(res.Candidates[0].Content.Parts) != 1 || res.Candidates[0].Content.Parts[0].Text == "" {
-		return nil, fmt.Errorf("generateplan: unexpected response from generate ai for plan: %v", res)
+	plans := make([]cookchatdb.Plan, len(days))
+	for i, day := range days {
+		plans[i] = cookchatdb.Plan{Recipes: day.Recipes}
 	}
+	return plans, nil
+}
 
-	var plans []cookchatdb.Plan
-	if err := json.Unmarshal([]byte(res.Candidates[0].Content.Parts[0].Text), &plans); err != nil {
-		return nil, fmt.Errorf("generateplan: failed to unmarshal received plan: %w", err)
+func toDBGenre(genre frontendapi.RecipeGenre) cookchatdb.RecipeGenre {
+	switch genre {
+	case frontendapi.RecipeGenre_RECIPE_GENRE_JAPANESE:
+		return cookchatdb.RecipeGenreJapanese
+	case frontendapi.RecipeGenre_RECIPE_GENRE_CHINESE:
+		return cookchatdb.RecipeGenreChinese
+	case frontendapi.RecipeGenre_RECIPE_GENRE_WESTERN:
+		return cookchatdb.RecipeGenreWestern
+	case frontendapi.RecipeGenre_RECIPE_GENRE_KOREAN:
+		return cookchatdb.RecipeGenreKorean
+	case frontendapi.RecipeGenre_RECIPE_GENRE_ITALIAN:
+		return cookchatdb.RecipeGenreItalian
+	case frontendapi.RecipeGenre_RECIPE_GENRE_ETHNIC:
+		return cookchatdb.RecipeGenreEthnic
+	case frontendapi.RecipeGenre_RECIPE_GENRE_UNSPECIFIED:
+	}
+	return cookchatdb.RecipeGenreUnknown
+}
+
+// batchPlan is the LLM response for a batch-cooking plan.
+type batchPlan struct {
+	// Prep is the prep session cooking all dishes.
+	Prep struct {
+		Recipes []string `json:"recipes"`
+	} `json:"prep"`
+
+	// Days are the days after the prep session, eating the prepared dishes.
+	Days []struct {
+		Recipes []string `json:"recipes"`
+	} `json:"days"`
+}
+
+func (h *Handler) generateBatchPlan(ctx context.Context, req *frontendapi.GeneratePlanRequest, content []*genai.Content, plansCol *firestore.CollectionRef, costs map[string]int, loc *time.Location) (*frontendapi.GeneratePlanResponse, error) {
+	dayRecipesSchema := &genai.Schema{
+		Type:        "array",
+		Description: "The recipe IDs of dishes.",
+		Items: &genai.Schema{
+			Type: "string",
+		},
 	}
-	if len(plans) != int(req.GetNumDays()) {
-		return nil, fmt.Errorf("generateplan: unexpected number of days in plan: got %d, want %d", len(plans), req.GetNumDays())
+	genConfig := &genai.GenerateContentConfig{
+		SystemInstruction: genai.NewContentFromText(llm.GenerateBatchPlanPrompt(), genai.RoleModel),
+		ResponseMIMEType:  "application/json",
+		ResponseSchema: &genai.Schema{
+			Type:        "object",
+			Description: "The batch-cooking meal plan.",
+			Properties: map[string]*genai.Schema{
+				"prep": {
+					Type:        "object",
+					Description: "The prep session cooking all dishes of the plan.",
+					Properties: map[string]*genai.Schema{
+						"recipes": dayRecipesSchema,
+					},
+					Required: []string{"recipes"},
+				},
+				"days": {
+					Type:        "array",
+					Description: "The days of the meal plan after the prep session.",
+					Items: &genai.Schema{
+						Type:        "object",
+						Description: "The dishes eaten on a day of the meal plan, all from the prep session.",
+						Properties: map[string]*genai.Schema{
+							"recipes": dayRecipesSchema,
+						},
+						Required: []string{"recipes"},
+					},
+				},
+			},
+			Required: []string{"prep", "days"},
+		},
 	}
+	budget := planBudget(req)
 
-	userID := firebaseauth.TokenFromContext(ctx).UID
+	var batch batchPlan
+	for attempt := 1; ; attempt++ {
+		res, err := h.genAI.Models.GenerateContent(ctx, "gemini-3.6-flash", content, genConfig)
+		if err != nil {
+			return nil, fmt.Errorf("generateplan: calling GenerateContent for batch plan: %w", err)
+		}
+		if len(res.Candidates) != 1 || len(res.Candidates[0].Content.Parts) != 1 || res.Candidates[0].Content.Parts[0].Text == "" {
+			return nil, fmt.Errorf("generateplan: unexpected response from generate ai for batch plan: %v", res)
+		}
+
+		batch = batchPlan{}
+		if err := json.Unmarshal([]byte(res.Candidates[0].Content.Parts[0].Text), &batch); err != nil {
+			return nil, fmt.Errorf("generateplan: failed to unmarshal received batch plan: %w", err)
+		}
+		if len(batch.Days) != int(req.GetNumDays()) {
+			return nil, fmt.Errorf("generateplan: unexpected number of days in batch plan: got %d, want %d", len(batch.Days), req.GetNumDays())
+		}
+		if len(batch.Prep.Recipes) == 0 {
+			return nil, errors.New("generateplan: no dishes in batch plan prep session")
+		}
+		if err := removeUnpreparedDishes(&batch); err != nil {
+			return nil, err
+		}
+
+		// All costs are incurred in the prep session.
+		cost := recipesCost(costs, batch.Prep.Recipes)
+		if budget == 0 || cost <= budget {
+			break
+		}
+		if attempt == maxBudgetAttempts {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errOverBudget)
+		}
+		content = append(content, res.Candidates[0].Content, budgetFeedback(cost, budget))
+	}
 
+	var planIDs []string
 	if err := h.store.RunTransaction(ctx, func(_ context.Context, t *firestore.Transaction) error {
-		plansCol := h.store.Collection("users").Doc(userID).Collection("plans")
+		// The transaction may be retried.
+		planIDs = nil
 		now := time.Now()
-		for i, plan := range plans {
-			planDoc := plansCol.NewDoc()
-			plan.ID = planDoc.ID
-			plan.Status = cookchatdb.PlanStatusProcessing
-			if len(plan.Recipes) > 3 {
-				plan.Recipes = plan.Recipes[:3]
-			}
-			plan.ScheduledAt = now.Add(time.Duration(i) * 24 * time.Hour)
-			plan.CreatedAt = now
-			if err := t.Set(planDoc, plan); err != nil {
-				return fmt.Errorf("generateplan: failed to set plan document: %w", err)
-			}
+		today := localdate.Today(loc)
 
-			fillPlanReq, err := proto.Marshal(&tasksapi.FillPlanRequest{
-				PlanId: plan.ID,
-			})
-			if err != nil {
-				return fmt.Errorf("generateplan: marshaling fill plan request: %w", err)
-			}
+		prepDoc := plansCol.NewDoc()
+		prep := cookchatdb.Plan{
+			ID:          prepDoc.ID,
+			Recipes:     batch.Prep.Recipes,
+			CreatedAt:   now,
+			Status:      cookchatdb.PlanStatusProcessing,
+			Type:        cookchatdb.PlanTypeBatchPrep,
+			BatchDishes: make([]cookchatdb.BatchDish, len(batch.Prep.Recipes)),
+		}
+		for i, recipeID := range batch.Prep.Recipes {
+			prep.BatchDishes[i] = cookchatdb.BatchDish{RecipeID: recipeID}
+		}
+		localdate.Schedule(&prep, today)
+		if err := t.Set(prepDoc, prep); err != nil {
+			return fmt.Errorf("generateplan: failed to set batch prep plan document: %w", err)
+		}
+		planIDs = append(planIDs, prep.ID)
 
-			fbTok := firebaseauth.RawTokenFromContext(ctx)
-
-			task := &taskspb.CreateTaskRequest{
-				Parent: h.tasksConfig.Queue,
-				Task: &taskspb.Task{
-					MessageType: &taskspb.Task_HttpRequest{
-						HttpRequest: &taskspb.HttpRequest{
-							HttpMethod: taskspb.HttpMethod_POST,
-							Url:        h.tasksConfig.URL + "/tasksapi.TasksService/FillPlan",
-							Headers: map[string]string{
-								"Content-Type":             "application/proto",
-								"Content-Length":           strconv.Itoa(len(fillPlanReq)),
-								"X-Original-Authorization": "Bearer " + fbTok,
-							},
-							Body: fillPlanReq,
-							AuthorizationHeader: &taskspb.HttpRequest_OidcToken{
-								OidcToken: &taskspb.OidcToken{
-									ServiceAccountEmail: h.tasksConfig.Invoker,
-								},
-							},
-						},
-					},
-				},
+		for i, day := range batch.Days {
+			planDoc := plansCol.NewDoc()
+			plan := cookchatdb.Plan{
+				ID:          planDoc.ID,
+				Recipes:     day.Recipes,
+				CreatedAt:   now,
+				Status:      cookchatdb.PlanStatusProcessing,
+				Type:        cookchatdb.PlanTypeLeftovers,
+				BatchPlanID: prep.ID,
 			}
-			if _, err := h.tasks.CreateTask(ctx, task); err != nil {
-				return fmt.Errorf("generateplan: creating task: %w", err)
+			localdate.Schedule(&plan, today.AddDate(0, 0, i+1))
+			if err := t.Set(planDoc, plan); err != nil {
+				return fmt.Errorf("generateplan: failed to set leftovers plan document: %w", err)
 			}
+			planIDs = append(planIDs, plan.ID)
 		}
 		return nil
 	}); err != nil {
-		return nil, fmt.Errorf("generateplan: save plans: %w", err)
+		return nil, fmt.Errorf("generateplan: save batch plans: %w", err)
+	}
+
+	if err := h.enqueueFill(ctx, planIDs); err != nil {
+		return nil, err
 	}
 
 	return &frontendapi.GeneratePlanResponse{}, nil
 }
+
+// removeUnpreparedDishes removes dishes that are not cooked in the prep session from the days
+// of batch, returning an error if a day is left without dishes.
+func removeUnpreparedDishes(batch *batchPlan) error {
+	for i := range batch.Days {
+		day := &batch.Days[i]
+		day.Recipes = slices.DeleteFunc(day.Recipes, func(id string) bool { return !slices.Contains(batch.Prep.Recipes, id) })
+		if len(day.Recipes) == 0 {
+			return fmt.Errorf("generateplan: no prepared dishes on day %d of batch plan", i+1)
+		}
+	}
+	return nil
+}
+
+// planBudget returns the budget for the requested days prorated from the weekly budget, or 0
+// if there is no budget.
+func planBudget(req *frontendapi.GeneratePlanRequest) int {
+	return int(req.GetWeeklyBudget()) * int(req.GetNumDays()) / 7
+}
+
+func recipesCost(costs map[string]int, recipeIDs []string) int {
+	cost := 0
+	for _, id := range recipeIDs {
+		cost += costs[id]
+	}
+	return cost
+}
+
+func budgetFeedback(cost int, budget int) *genai.Content {
+	return genai.NewContentFromText(fmt.Sprintf(
+		"The estimated cost of the plan is %d yen, which exceeds the budget of %d yen. Choose cheaper recipes so the plan fits within the budget.",
+		cost, budget), genai.RoleUser)
+}
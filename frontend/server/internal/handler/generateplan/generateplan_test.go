@@ -0,0 +1,68 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package generateplan
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestRemoveUnpreparedDishes(t *testing.T) {
+	tests := []struct {
+		name    string
+		batch   string
+		want    [][]string
+		wantErr bool
+	}{
+		{
+			name:  "prepared dishes",
+			batch: `{"prep": {"recipes": ["a", "b"]}, "days": [{"recipes": ["a"]}, {"recipes": ["a", "b"]}]}`,
+			want:  [][]string{{"a"}, {"a", "b"}},
+		},
+		{
+			name:  "unprepared dishes removed",
+			batch: `{"prep": {"recipes": ["a", "b"]}, "days": [{"recipes": ["a", "c"]}, {"recipes": ["b"]}]}`,
+			want:  [][]string{{"a"}, {"b"}},
+		},
+		{
+			name:    "only unprepared dishes",
+			batch:   `{"prep": {"recipes": ["a", "b"]}, "days": [{"recipes": ["a"]}, {"recipes": ["c"]}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "day without dishes",
+			batch:   `{"prep": {"recipes": ["a", "b"]}, "days": [{"recipes": []}]}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var batch batchPlan
+			if err := json.Unmarshal([]byte(tc.batch), &batch); err != nil {
+				t.Fatal(err)
+			}
+
+			err := removeUnpreparedDishes(&batch)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := make([][]string, len(batch.Days))
+			for i, day := range batch.Days {
+				got[i] = day.Recipes
+			}
+			if !slices.EqualFunc(got, tc.want, slices.Equal) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package gethousehold
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) GetHousehold(ctx context.Context, _ *frontendapi.GetHouseholdRequest) (*frontendapi.GetHouseholdResponse, error) {
+	userID := firebaseauth.TokenFromContext(ctx).UID
+	scope, err := household.Resolve(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("gethousehold: resolving household: %w", err)
+	}
+	if scope.HouseholdID == "" {
+		return &frontendapi.GetHouseholdResponse{}, nil
+	}
+
+	doc, err := scope.Doc.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("gethousehold: fetching household: %w", err)
+	}
+	var dbHousehold cookchatdb.Household
+	if err := doc.DataTo(&dbHousehold); err != nil {
+		return nil, fmt.Errorf("gethousehold: decoding household: %w", err)
+	}
+
+	memberDocs, err := scope.Doc.Collection("members").OrderBy("joinedAt", firestore.Asc).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("gethousehold: fetching members: %w", err)
+	}
+	members := make([]*frontendapi.HouseholdMember, len(memberDocs))
+	for i, doc := range memberDocs {
+		var member cookchatdb.HouseholdMember
+		if err := doc.DataTo(&member); err != nil {
+			return nil, fmt.Errorf("gethousehold: decoding member: %w", err)
+		}
+		members[i] = &frontendapi.HouseholdMember{
+			UserId:   member.UserID,
+			Role:     roleToProto(member.Role),
+			JoinedAt: timestamppb.New(member.JoinedAt),
+		}
+	}
+
+	return &frontendapi.GetHouseholdResponse{
+		Household: &frontendapi.Household{
+			Id:      dbHousehold.ID,
+			Name:    dbHousehold.Name,
+			Members: members,
+		},
+		Role: roleToProto(scope.Role),
+	}, nil
+}
+
+func roleToProto(role cookchatdb.HouseholdRole) frontendapi.HouseholdRole {
+	switch role {
+	case cookchatdb.HouseholdRoleOwner:
+		return frontendapi.HouseholdRole_HOUSEHOLD_ROLE_OWNER
+	case cookchatdb.HouseholdRoleEditor:
+		return frontendapi.HouseholdRole_HOUSEHOLD_ROLE_EDITOR
+	case cookchatdb.HouseholdRoleViewer:
+		return frontendapi.HouseholdRole_HOUSEHOLD_ROLE_VIEWER
+	}
+	return frontendapi.HouseholdRole_HOUSEHOLD_ROLE_UNSPECIFIED
+}
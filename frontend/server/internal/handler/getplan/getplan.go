@@ -4,42 +4,81 @@
 package getplan
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
 	"cloud.google.com/go/firestore"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
 	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	"github.com/curioswitch/cookchat/common/pricing"
 	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
 	"github.com/curioswitch/cookchat/frontend/server/internal/auth"
 	"github.com/curioswitch/cookchat/frontend/server/internal/i18n"
 	"github.com/curioswitch/cookchat/frontend/server/internal/llm"
+	"github.com/curioswitch/cookchat/frontend/server/internal/planstore"
 )
 
-func NewHandler(store *firestore.Client) *Handler {
+func NewHandler(store *firestore.Client, prices *pricing.Cache) *Handler {
 	return &Handler{
-		store: store,
+		store:  store,
+		prices: prices,
 	}
 }
 
 type Handler struct {
-	store *firestore.Client
+	store  *firestore.Client
+	prices *pricing.Cache
 }
 
 func (h *Handler) GetPlan(ctx context.Context, req *frontendapi.GetPlanRequest) (*frontendapi.GetPlanResponse, error) {
-	language := i18n.UserLanguage(ctx)
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("getplan: resolving household: %w", err)
+	}
+
+	doc, _, err := planstore.Get(ctx, scope, req.GetPlanId())
+	if err != nil {
+		return nil, fmt.Errorf("getplan: %w", err)
+	}
+
+	plan, recipes, err := h.PlanFromSnapshot(ctx, doc, scope.IngredientPrices())
+	if err != nil {
+		return nil, err
+	}
 
-	doc, err := h.store.CollectionGroup("plans").Where("id", "==", req.GetPlanId()).Limit(1).Documents(ctx).Next()
-	if err != nil && !errors.Is(err, iterator.Done) {
-		return nil, fmt.Errorf("getplan: fetching plan: %w", err)
+	stepsJSON, err := json.Marshal(plan.GetStepGroups())
+	if err != nil {
+		return nil, fmt.Errorf("getplan: marshalling plan: %w", err)
+	}
+	recipesJSON, err := json.Marshal(recipes)
+	if err != nil {
+		return nil, fmt.Errorf("getplan: marshalling recipes: %w", err)
 	}
+	prompt := ""
+	if auth.IsCurioSwitchUser(ctx) {
+		prompt = llm.PlanChatPrompt(ctx, string(stepsJSON), string(recipesJSON))
+	}
+
+	return &frontendapi.GetPlanResponse{Plan: plan, LlmPrompt: prompt}, nil
+}
+
+// PlanFromSnapshot returns the plan in doc along with its recipes, estimating costs with the
+// ingredient prices in pricesCol. Callers must check the caller can access the plan.
+func (h *Handler) PlanFromSnapshot(ctx context.Context, doc *firestore.DocumentSnapshot, pricesCol *firestore.CollectionRef) (*frontendapi.Plan, []cookchatdb.Recipe, error) {
+	language := i18n.UserLanguage(ctx)
 
 	var dbPlan cookchatdb.Plan
 	if err := doc.DataTo(&dbPlan); err != nil {
-		return nil, fmt.Errorf("getplan: decoding plan: %w", err)
+		return nil, nil, fmt.Errorf("getplan: decoding plan: %w", err)
 	}
 	recipesCol := h.store.Collection("recipes")
 	iter := recipesCol.Query.WhereEntity(firestore.PropertyFilter{
@@ -56,20 +95,26 @@ func (h *Handler) GetPlan(ctx context.Context, req *frontendapi.GetPlanRequest)
 			break
 		}
 		if err != nil {
-			return nil, fmt.Errorf("getplan: fetching recipe: %w", err)
+			return nil, nil, fmt.Errorf("getplan: fetching recipe: %w", err)
 		}
 		var recipe cookchatdb.Recipe
 		if err := doc.DataTo(&recipe); err != nil {
-			return nil, fmt.Errorf("getplan: decoding recipe: %w", err)
+			return nil, nil, fmt.Errorf("getplan: decoding recipe: %w", err)
 		}
 		recipes = append(recipes, recipe)
 	}
 
+	prices, err := h.prices.Load(ctx, pricesCol)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getplan: loading ingredient prices: %w", err)
+	}
+
 	plan := &frontendapi.Plan{
 		Id:           dbPlan.ID,
 		Recipes:      make([]*frontendapi.RecipeSnippet, len(recipes)),
 		Ingredients:  make([]*frontendapi.IngredientSection, len(recipes)),
 		ServingSizes: make([]string, len(recipes)),
+		RecipeCosts:  make([]uint32, len(recipes)),
 		StepGroups:   make([]*frontendapi.StepGroup, len(dbPlan.StepGroups)),
 	}
 	switch dbPlan.Status {
@@ -77,6 +122,30 @@ func (h *Handler) GetPlan(ctx context.Context, req *frontendapi.GetPlanRequest)
 		plan.Status = frontendapi.PlanStatus_PLAN_STATUS_PROCESSING
 	case cookchatdb.PlanStatusActive:
 		plan.Status = frontendapi.PlanStatus_PLAN_STATUS_ACTIVE
+	case cookchatdb.PlanStatusFailed:
+		plan.Status = frontendapi.PlanStatus_PLAN_STATUS_FAILED
+		plan.FailureReason = dbPlan.FailureReason
+	}
+	plan.Attempts = uint32(max(dbPlan.Attempts, 0)) //nolint:gosec // checked for negative
+	switch dbPlan.Type {
+	case cookchatdb.PlanTypeBatchPrep:
+		plan.Type = frontendapi.PlanType_PLAN_TYPE_BATCH_PREP
+	case cookchatdb.PlanTypeLeftovers:
+		plan.Type = frontendapi.PlanType_PLAN_TYPE_LEFTOVERS
+	case cookchatdb.PlanTypeDaily, "":
+		plan.Type = frontendapi.PlanType_PLAN_TYPE_DAILY
+	}
+	plan.BatchPlanId = dbPlan.BatchPlanID
+	plan.LocalDate = dbPlan.Date
+	plan.TimeZone = dbPlan.TimeZone
+	for _, dish := range dbPlan.BatchDishes {
+		plan.BatchDishes = append(plan.BatchDishes, &frontendapi.BatchDish{
+			RecipeId:       dish.RecipeID,
+			Servings:       uint32(max(dish.Servings, 0)), //nolint:gosec // checked for negative
+			Storage:        dish.Storage,
+			StorageDays:    uint32(max(dish.StorageDays, 0)), //nolint:gosec // checked for negative
+			ReheatingNotes: dish.ReheatingNotes,
+		})
 	}
 	for i, recipe := range recipes {
 		cnt := recipe.LocalizedContent[language]
@@ -89,7 +158,24 @@ func (h *Handler) GetPlan(ctx context.Context, req *frontendapi.GetPlanRequest)
 			Summary:  cnt.Description,
 			ImageUrl: recipe.ImageURL,
 		}
+		switch recipe.Status {
+		case cookchatdb.RecipeStatusProcessing:
+			plan.Recipes[i].Status = frontendapi.RecipeStatus_RECIPE_STATUS_PROCESSING
+		case cookchatdb.RecipeStatusActive:
+			plan.Recipes[i].Status = frontendapi.RecipeStatus_RECIPE_STATUS_ACTIVE
+		case cookchatdb.RecipeStatusFailed:
+			plan.Recipes[i].Status = frontendapi.RecipeStatus_RECIPE_STATUS_FAILED
+		}
 		plan.ServingSizes[i] = cnt.ServingSize
+		if size, ok := dbPlan.ServingSizes[recipe.ID]; ok {
+			plan.ServingSizes[i] = size
+		}
+		// Prices are keyed by Japanese ingredient names so always use the source content.
+		cost := uint32(max(prices.RecipeCost(&recipe.Content), 0)) //nolint:gosec // checked for negative
+		plan.RecipeCosts[i] = cost
+		if dbPlan.Type != cookchatdb.PlanTypeLeftovers {
+			plan.EstimatedCost += cost
+		}
 		sec := &frontendapi.IngredientSection{
 			Title:       cnt.Title,
 			Ingredients: make([]*frontendapi.RecipeIngredient, len(cnt.Ingredients)),
@@ -125,19 +211,61 @@ func (h *Handler) GetPlan(ctx context.Context, req *frontendapi.GetPlanRequest)
 		plan.StepGroups[i] = stepGroup
 	}
 	plan.Notes = dbPlan.Notes
+	plan.Progress = progressToProto(&dbPlan)
+	plan.UpdateTime = timestamppb.New(doc.UpdateTime)
 
-	stepsJSON, err := json.Marshal(plan.GetStepGroups())
-	if err != nil {
-		return nil, fmt.Errorf("getplan: marshalling plan: %w", err)
-	}
-	recipesJSON, err := json.Marshal(recipes)
-	if err != nil {
-		return nil, fmt.Errorf("getplan: marshalling recipes: %w", err)
-	}
-	prompt := ""
-	if auth.IsCurioSwitchUser(ctx) {
-		prompt = llm.PlanChatPrompt(ctx, string(stepsJSON), string(recipesJSON))
+	return plan, recipes, nil
+}
+
+// stageOrder is the order stages are executed in.
+var stageOrder = []cookchatdb.ProgressStage{
+	cookchatdb.ProgressStageTranslate,
+	cookchatdb.ProgressStageRewrite,
+	cookchatdb.ProgressStageImage,
+	cookchatdb.ProgressStageStepImages,
+	cookchatdb.ProgressStageExecutionPlan,
+}
+
+func progressToProto(plan *cookchatdb.Plan) []*frontendapi.PlanProgress {
+	stages := slices.Collect(maps.Values(plan.Progress))
+	recipeOrder := func(recipeID string) int {
+		if recipeID == "" {
+			// Stages of the whole plan come after all recipes.
+			return len(plan.Recipes)
+		}
+		return slices.Index(plan.Recipes, recipeID)
 	}
+	slices.SortFunc(stages, func(a, b cookchatdb.StageProgress) int {
+		if c := cmp.Compare(recipeOrder(a.RecipeID), recipeOrder(b.RecipeID)); c != 0 {
+			return c
+		}
+		return cmp.Compare(slices.Index(stageOrder, a.Stage), slices.Index(stageOrder, b.Stage))
+	})
 
-	return &frontendapi.GetPlanResponse{Plan: plan, LlmPrompt: prompt}, nil
+	res := make([]*frontendapi.PlanProgress, len(stages))
+	for i, stage := range stages {
+		p := &frontendapi.PlanProgress{
+			RecipeId:  stage.RecipeID,
+			Done:      uint32(max(stage.Done, 0)),  //nolint:gosec // checked for negative
+			Total:     uint32(max(stage.Total, 0)), //nolint:gosec // checked for negative
+			StartedAt: timestamppb.New(stage.StartedAt),
+		}
+		switch stage.Stage {
+		case cookchatdb.ProgressStageTranslate:
+			p.Stage = frontendapi.PlanProgressStage_PLAN_PROGRESS_STAGE_TRANSLATE
+		case cookchatdb.ProgressStageRewrite:
+			p.Stage = frontendapi.PlanProgressStage_PLAN_PROGRESS_STAGE_REWRITE
+		case cookchatdb.ProgressStageImage:
+			p.Stage = frontendapi.PlanProgressStage_PLAN_PROGRESS_STAGE_IMAGE
+		case cookchatdb.ProgressStageStepImages:
+			p.Stage = frontendapi.PlanProgressStage_PLAN_PROGRESS_STAGE_STEP_IMAGES
+		case cookchatdb.ProgressStageExecutionPlan:
+			p.Stage = frontendapi.PlanProgressStage_PLAN_PROGRESS_STAGE_EXECUTION_PLAN
+		}
+		if !stage.CompletedAt.IsZero() {
+			p.CompletedAt = timestamppb.New(stage.CompletedAt)
+		}
+		res[i] = p
+	}
+	return res
 }
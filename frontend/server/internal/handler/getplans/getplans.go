@@ -11,10 +11,12 @@ import (
 	"cloud.google.com/go/firestore"
 	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
 	"google.golang.org/api/iterator"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
 	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+	"github.com/curioswitch/cookchat/frontend/server/internal/localdate"
+	"github.com/curioswitch/cookchat/frontend/server/internal/planstore"
 )
 
 func NewHandler(store *firestore.Client) *Handler {
@@ -28,12 +30,21 @@ type Handler struct {
 }
 
 func (h *Handler) GetPlans(ctx context.Context, req *frontendapi.GetPlansRequest) (*frontendapi.GetPlansResponse, error) {
-	start := req.GetStartDate().AsTime()
+	userID := firebaseauth.TokenFromContext(ctx).UID
+	loc, err := localdate.Location(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("getplans: resolving time zone: %w", err)
+	}
+
+	start := localdate.StartOfDay(req.GetStartDate().AsTime().In(loc))
 	end := start.AddDate(0, 0, int(req.GetNumDays()))
 
-	userID := firebaseauth.TokenFromContext(ctx).UID
+	scope, err := household.Resolve(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("getplans: resolving household: %w", err)
+	}
 
-	plansCol := h.store.Collection("users").Doc(userID).Collection("plans")
+	plansCol := scope.Plans()
 	iter := plansCol.Query.WhereEntity(firestore.AndFilter{
 		Filters: []firestore.EntityFilter{
 			firestore.PropertyFilter{
@@ -43,7 +54,7 @@ func (h *Handler) GetPlans(ctx context.Context, req *frontendapi.GetPlansRequest
 			},
 			firestore.PropertyFilter{
 				Path:     "scheduledAt",
-				Operator: "<=",
+				Operator: "<",
 				Value:    end,
 			},
 		},
@@ -51,7 +62,6 @@ func (h *Handler) GetPlans(ctx context.Context, req *frontendapi.GetPlansRequest
 	defer iter.Stop()
 
 	var dbPlans []cookchatdb.Plan
-	var recipeIDs []string
 	for {
 		doc, err := iter.Next()
 		if errors.Is(err, iterator.Done) {
@@ -65,60 +75,25 @@ func (h *Handler) GetPlans(ctx context.Context, req *frontendapi.GetPlansRequest
 		if err := doc.DataTo(&plan); err != nil {
 			return nil, fmt.Errorf("getplans: decoding plan: %w", err)
 		}
+		if !plan.DeletedAt.IsZero() {
+			continue
+		}
 		dbPlans = append(dbPlans, plan)
-		recipeIDs = append(recipeIDs, plan.Recipes...)
 	}
-	recipes := map[string]cookchatdb.Recipe{}
 
-	for len(recipeIDs) > 0 {
-		batch := recipeIDs
-		if len(batch) > 30 {
-			batch = batch[:30]
-		}
-		recipeIDs = recipeIDs[len(batch):]
-		recipesCol := h.store.Collection("recipes")
-		iter = recipesCol.Query.WhereEntity(firestore.PropertyFilter{
-			Path:     "id",
-			Operator: "in",
-			Value:    batch,
-		}).Documents(ctx)
-		defer iter.Stop()
-
-		for {
-			doc, err := iter.Next()
-			if errors.Is(err, iterator.Done) {
-				break
-			}
-			if err != nil {
-				return nil, fmt.Errorf("getplans: fetching recipe: %w", err)
-			}
-			var recipe cookchatdb.Recipe
-			if err := doc.DataTo(&recipe); err != nil {
-				return nil, fmt.Errorf("getplans: decoding recipe: %w", err)
-			}
-			recipes[recipe.ID] = recipe
-		}
+	recipes, err := planstore.Recipes(ctx, h.store, dbPlans)
+	if err != nil {
+		return nil, fmt.Errorf("getplans: fetching recipes: %w", err)
 	}
 
 	plans := make([]*frontendapi.PlanSnippet, len(dbPlans))
 	for i, dbPlan := range dbPlans {
-		plan := &frontendapi.PlanSnippet{
-			Id:   dbPlan.ID,
-			Date: timestamppb.New(dbPlan.ScheduledAt),
-		}
 		for _, recipeID := range dbPlan.Recipes {
-			if recipe, ok := recipes[recipeID]; ok {
-				plan.Recipes = append(plan.Recipes, &frontendapi.RecipeSnippet{
-					Id:       recipe.ID,
-					Title:    recipe.Content.Title,
-					Summary:  recipe.Content.Description,
-					ImageUrl: recipe.ImageURL,
-				})
-			} else {
+			if _, ok := recipes[recipeID]; !ok {
 				return nil, fmt.Errorf("getplans: recipe %s not found for plan %s", recipeID, dbPlan.ID)
 			}
 		}
-		plans[i] = plan
+		plans[i] = planstore.Snippet(&dbPlan, recipes, loc)
 	}
 
 	return &frontendapi.GetPlansResponse{Plans: plans}, nil
@@ -0,0 +1,180 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package getplantimeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+	"github.com/curioswitch/cookchat/frontend/server/internal/localdate"
+	"github.com/curioswitch/cookchat/frontend/server/internal/planstore"
+)
+
+// defaultActiveMinutes is used for steps without an estimated duration, for example
+// in plans created before durations were estimated.
+const defaultActiveMinutes = 5
+
+var (
+	errInvalidTimeZone = errors.New("invalid time zone")
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) GetPlanTimeline(ctx context.Context, req *frontendapi.GetPlanTimelineRequest) (*frontendapi.GetPlanTimelineResponse, error) {
+	userID := firebaseauth.TokenFromContext(ctx).UID
+
+	var loc *time.Location
+	if tz := req.GetTimeZone(); tz != "" {
+		l, err := time.LoadLocation(tz)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errInvalidTimeZone)
+		}
+		loc = l
+	} else {
+		l, err := localdate.Location(ctx, h.store, userID)
+		if err != nil {
+			return nil, fmt.Errorf("getplantimeline: resolving time zone: %w", err)
+		}
+		loc = l
+	}
+
+	scope, err := household.Resolve(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("getplantimeline: resolving household: %w", err)
+	}
+	_, plan, err := planstore.Get(ctx, scope, req.GetPlanId())
+	if err != nil {
+		return nil, fmt.Errorf("getplantimeline: %w", err)
+	}
+
+	serveAt := req.GetServeAt().AsTime().In(loc)
+	groups := buildTimeline(plan.StepGroups, serveAt)
+
+	res := &frontendapi.GetPlanTimelineResponse{
+		StartTime:  timestamppb.New(serveAt),
+		ServeAt:    timestamppb.New(serveAt),
+		StepGroups: make([]*frontendapi.TimelineStepGroup, len(groups)),
+	}
+	if len(groups) > 0 {
+		res.StartTime = timestamppb.New(groups[0].start)
+	}
+	for i, group := range groups {
+		g := &frontendapi.TimelineStepGroup{
+			Label:       group.label,
+			Note:        group.note,
+			StartTime:   timestamppb.New(group.start),
+			EndTime:     timestamppb.New(group.end),
+			Steps:       make([]*frontendapi.TimelineStep, len(group.steps)),
+			PreviousDay: group.previousDay,
+		}
+		for j, step := range group.steps {
+			g.Steps[j] = &frontendapi.TimelineStep{
+				Step: &frontendapi.RecipeStep{
+					Description: step.step.Description,
+					ImageUrl:    step.step.ImageURL,
+				},
+				StartTime:   timestamppb.New(step.start),
+				EndTime:     timestamppb.New(step.end),
+				PreviousDay: step.previousDay,
+			}
+		}
+		res.StepGroups[i] = g
+	}
+
+	return res, nil
+}
+
+type timelineStep struct {
+	step        cookchatdb.RecipeStep
+	start       time.Time
+	end         time.Time
+	previousDay bool
+}
+
+type timelineGroup struct {
+	label       string
+	note        string
+	start       time.Time
+	end         time.Time
+	steps       []timelineStep
+	previousDay bool
+}
+
+// buildTimeline schedules step groups so the final group finishes at serveAt. Groups are
+// executed in order. Within a group, the hands-on work of each step is done one after another
+// while passive waits overlap with the following steps, so a group finishes when both the
+// last hands-on work and the longest wait are done.
+func buildTimeline(stepGroups []cookchatdb.StepGroup, serveAt time.Time) []timelineGroup {
+	total := time.Duration(0)
+	for _, group := range stepGroups {
+		total += groupDuration(group)
+	}
+
+	dayStart := time.Date(serveAt.Year(), serveAt.Month(), serveAt.Day(), 0, 0, 0, 0, serveAt.Location())
+
+	cursor := serveAt.Add(-total)
+	groups := make([]timelineGroup, len(stepGroups))
+	for i, group := range stepGroups {
+		tg := timelineGroup{
+			label:       group.Label,
+			note:        group.Note,
+			start:       cursor,
+			end:         cursor.Add(groupDuration(group)),
+			steps:       make([]timelineStep, len(group.Steps)),
+			previousDay: cursor.Before(dayStart),
+		}
+		stepStart := cursor
+		for j, step := range group.Steps {
+			active, wait := stepDurations(step)
+			tg.steps[j] = timelineStep{
+				step:        step,
+				start:       stepStart,
+				end:         stepStart.Add(active + wait),
+				previousDay: stepStart.Before(dayStart),
+			}
+			stepStart = stepStart.Add(active)
+		}
+		groups[i] = tg
+		cursor = tg.end
+	}
+	return groups
+}
+
+func groupDuration(group cookchatdb.StepGroup) time.Duration {
+	elapsed := time.Duration(0)
+	end := time.Duration(0)
+	for _, step := range group.Steps {
+		active, wait := stepDurations(step)
+		end = max(end, elapsed+active+wait)
+		elapsed += active
+	}
+	return max(end, elapsed)
+}
+
+func stepDurations(step cookchatdb.RecipeStep) (time.Duration, time.Duration) {
+	active := step.ActiveMinutes
+	if active <= 0 {
+		active = defaultActiveMinutes
+	}
+	wait := max(step.WaitMinutes, 0)
+	return time.Duration(active) * time.Minute, time.Duration(wait) * time.Minute
+}
@@ -0,0 +1,244 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package getplantimeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+func TestStepDurations(t *testing.T) {
+	tests := []struct {
+		name       string
+		step       cookchatdb.RecipeStep
+		wantActive time.Duration
+		wantWait   time.Duration
+	}{
+		{
+			name:       "active and wait",
+			step:       cookchatdb.RecipeStep{ActiveMinutes: 10, WaitMinutes: 30},
+			wantActive: 10 * time.Minute,
+			wantWait:   30 * time.Minute,
+		},
+		{
+			name:       "zero durations",
+			step:       cookchatdb.RecipeStep{},
+			wantActive: defaultActiveMinutes * time.Minute,
+		},
+		{
+			name:       "wait only",
+			step:       cookchatdb.RecipeStep{WaitMinutes: 60},
+			wantActive: defaultActiveMinutes * time.Minute,
+			wantWait:   60 * time.Minute,
+		},
+		{
+			name:       "negative durations",
+			step:       cookchatdb.RecipeStep{ActiveMinutes: -1, WaitMinutes: -1},
+			wantActive: defaultActiveMinutes * time.Minute,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			active, wait := stepDurations(tc.step)
+			if active != tc.wantActive || wait != tc.wantWait {
+				t.Errorf("got %v/%v, want %v/%v", active, wait, tc.wantActive, tc.wantWait)
+			}
+		})
+	}
+}
+
+func TestGroupDuration(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []cookchatdb.RecipeStep
+		want  time.Duration
+	}{
+		{
+			name: "no steps",
+			want: 0,
+		},
+		{
+			name: "active only",
+			steps: []cookchatdb.RecipeStep{
+				{ActiveMinutes: 10},
+				{ActiveMinutes: 15},
+			},
+			want: 25 * time.Minute,
+		},
+		{
+			name: "wait overlaps following steps",
+			steps: []cookchatdb.RecipeStep{
+				{ActiveMinutes: 5, WaitMinutes: 10},
+				{ActiveMinutes: 20},
+			},
+			want: 25 * time.Minute,
+		},
+		{
+			name: "wait longer than following steps",
+			steps: []cookchatdb.RecipeStep{
+				{ActiveMinutes: 5, WaitMinutes: 60},
+				{ActiveMinutes: 10},
+			},
+			want: 65 * time.Minute,
+		},
+		{
+			name: "wait only",
+			steps: []cookchatdb.RecipeStep{
+				{WaitMinutes: 30},
+			},
+			want: (defaultActiveMinutes + 30) * time.Minute,
+		},
+		{
+			name: "zero durations",
+			steps: []cookchatdb.RecipeStep{
+				{},
+				{},
+			},
+			want: 2 * defaultActiveMinutes * time.Minute,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := groupDuration(cookchatdb.StepGroup{Steps: tc.steps}); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBuildTimeline(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type span struct {
+		start       string
+		end         string
+		previousDay bool
+	}
+	type group struct {
+		span
+		steps []span
+	}
+
+	tests := []struct {
+		name       string
+		stepGroups []cookchatdb.StepGroup
+		serveAt    time.Time
+		want       []group
+	}{
+		{
+			name:    "no groups",
+			serveAt: time.Date(2026, 10, 18, 19, 0, 0, 0, tokyo),
+		},
+		{
+			name: "groups in order",
+			stepGroups: []cookchatdb.StepGroup{
+				{Steps: []cookchatdb.RecipeStep{
+					{ActiveMinutes: 10, WaitMinutes: 30},
+					{ActiveMinutes: 10},
+				}},
+				{Steps: []cookchatdb.RecipeStep{
+					{ActiveMinutes: 15},
+				}},
+			},
+			serveAt: time.Date(2026, 10, 18, 19, 0, 0, 0, tokyo),
+			want: []group{
+				{
+					span: span{"18:05", "18:45", false},
+					steps: []span{
+						{"18:05", "18:45", false},
+						{"18:15", "18:25", false},
+					},
+				},
+				{
+					span: span{"18:45", "19:00", false},
+					steps: []span{
+						{"18:45", "19:00", false},
+					},
+				},
+			},
+		},
+		{
+			name: "wait only and zero durations",
+			stepGroups: []cookchatdb.StepGroup{
+				{Steps: []cookchatdb.RecipeStep{
+					{WaitMinutes: 20},
+					{},
+				}},
+			},
+			serveAt: time.Date(2026, 10, 18, 19, 0, 0, 0, tokyo),
+			want: []group{
+				{
+					span: span{"18:35", "19:00", false},
+					steps: []span{
+						{"18:35", "19:00", false},
+						{"18:40", "18:45", false},
+					},
+				},
+			},
+		},
+		{
+			name: "starts on previous day",
+			stepGroups: []cookchatdb.StepGroup{
+				{Steps: []cookchatdb.RecipeStep{
+					{ActiveMinutes: 10, WaitMinutes: 20},
+				}},
+				{Steps: []cookchatdb.RecipeStep{
+					{ActiveMinutes: 15},
+				}},
+			},
+			serveAt: time.Date(2026, 10, 18, 0, 30, 0, 0, tokyo),
+			want: []group{
+				{
+					span: span{"23:45", "00:15", true},
+					steps: []span{
+						{"23:45", "00:15", true},
+					},
+				},
+				{
+					span: span{"00:15", "00:30", false},
+					steps: []span{
+						{"00:15", "00:30", false},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			toSpan := func(start, end time.Time, previousDay bool) span {
+				if start.Location() != tokyo || end.Location() != tokyo {
+					t.Errorf("got times in %v, want %v", start.Location(), tokyo)
+				}
+				return span{start.Format("15:04"), end.Format("15:04"), previousDay}
+			}
+
+			got := buildTimeline(tc.stepGroups, tc.serveAt)
+			if len(got) != len(tc.want) {
+				t.Fatalf("got %d groups, want %d", len(got), len(tc.want))
+			}
+			for i, g := range got {
+				want := tc.want[i]
+				if s := toSpan(g.start, g.end, g.previousDay); s != want.span {
+					t.Errorf("group %d: got %v, want %v", i, s, want.span)
+				}
+				if len(g.steps) != len(want.steps) {
+					t.Fatalf("group %d: got %d steps, want %d", i, len(g.steps), len(want.steps))
+				}
+				for j, step := range g.steps {
+					if s := toSpan(step.start, step.end, step.previousDay); s != want.steps[j] {
+						t.Errorf("group %d step %d: got %v, want %v", i, j, s, want.steps[j])
+					}
+				}
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"google.golang.org/api/iterator"
 
 	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
 	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
 	"github.com/curioswitch/cookchat/frontend/server/internal/auth"
 	"github.com/curioswitch/cookchat/frontend/server/internal/i18n"
@@ -47,10 +48,12 @@ func (h *Handler) GetRecipe(ctx context.Context, req *frontendapi.GetRecipeReque
 		return nil, fmt.Errorf("getrecipe: unmarshalling recipe: %w", err)
 	}
 
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("getrecipe: resolving household: %w", err)
+	}
 	bookmarked := false
-	if doc, _ := h.store.Collection("users").
-		Doc(firebaseauth.TokenFromContext(ctx).UID).
-		Collection("bookmarks").Doc("recipe-" + req.GetRecipeId()).Get(ctx); doc != nil && doc.Exists() {
+	if doc, _ := scope.Bookmarks().Doc("recipe-" + req.GetRecipeId()).Get(ctx); doc != nil && doc.Exists() {
 		bookmarked = true
 	}
 
@@ -87,6 +90,9 @@ func recipeToProto(recipe *cookchatdb.Recipe, cnt *cookchatdb.RecipeContent) *fr
 		res.Status = frontendapi.RecipeStatus_RECIPE_STATUS_PROCESSING
 	case cookchatdb.RecipeStatusActive:
 		res.Status = frontendapi.RecipeStatus_RECIPE_STATUS_ACTIVE
+	case cookchatdb.RecipeStatusFailed:
+		res.Status = frontendapi.RecipeStatus_RECIPE_STATUS_FAILED
+		res.FailureReason = recipe.FailureReason
 	}
 
 	res.Title = cnt.Title
@@ -114,7 +120,7 @@ func recipeSourceToProto(src cookchatdb.RecipeSource) frontendapi.RecipeSource {
 		return frontendapi.RecipeSource_RECIPE_SOURCE_ORANGE_PAGE
 	case cookchatdb.RecipeSourceDelishKitchen:
 		return frontendapi.RecipeSource_RECIPE_SOURCE_DELISH_KITCHEN
-	case cookchatdb.RecipeSourceUser, cookchatdb.RecipeSourceAI:
+	case cookchatdb.RecipeSourceUser, cookchatdb.RecipeSourceAI, cookchatdb.RecipeSourceWeb:
 		fallthrough
 	default:
 		return frontendapi.RecipeSource_RECIPE_SOURCE_UNSPECIFIED
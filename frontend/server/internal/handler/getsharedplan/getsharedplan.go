@@ -0,0 +1,81 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package getsharedplan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+// Invalid, expired and revoked links are not distinguished so tokens can't be probed.
+var errLinkNotFound = errors.New("shared plan not found or link expired")
+
+// PlanFunc returns the plan in doc, estimating costs with the ingredient prices in pricesCol,
+// usually getplan.Handler.PlanFromSnapshot.
+type PlanFunc func(ctx context.Context, doc *firestore.DocumentSnapshot, pricesCol *firestore.CollectionRef) (*frontendapi.Plan, []cookchatdb.Recipe, error)
+
+func NewHandler(store *firestore.Client, plan PlanFunc) *Handler {
+	return &Handler{
+		store: store,
+		plan:  plan,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+	plan  PlanFunc
+}
+
+func (h *Handler) GetSharedPlan(ctx context.Context, req *frontendapi.GetSharedPlanRequest) (*frontendapi.GetSharedPlanResponse, error) {
+	linkDoc, err := h.store.Collection("planShareLinks").Doc(req.GetToken()).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, errLinkNotFound)
+		}
+		return nil, fmt.Errorf("getsharedplan: fetching link: %w", err)
+	}
+	var link cookchatdb.PlanShareLink
+	if err := linkDoc.DataTo(&link); err != nil {
+		return nil, fmt.Errorf("getsharedplan: decoding link: %w", err)
+	}
+	if link.Plan == nil || !link.RevokedAt.IsZero() || time.Now().After(link.ExpiresAt) {
+		return nil, connect.NewError(connect.CodeNotFound, errLinkNotFound)
+	}
+
+	planDoc, err := link.Plan.Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, errLinkNotFound)
+		}
+		return nil, fmt.Errorf("getsharedplan: fetching plan: %w", err)
+	}
+	var dbPlan cookchatdb.Plan
+	if err := planDoc.DataTo(&dbPlan); err != nil {
+		return nil, fmt.Errorf("getsharedplan: decoding plan: %w", err)
+	}
+	if !dbPlan.DeletedAt.IsZero() {
+		return nil, connect.NewError(connect.CodeNotFound, errLinkNotFound)
+	}
+
+	// Costs are estimated with the prices of the owner of the plan, the plans collection's
+	// parent.
+	plan, _, err := h.plan(ctx, planDoc, link.Plan.Parent.Parent.Collection("ingredientPrices"))
+	if err != nil {
+		return nil, err
+	}
+	// Viewers can't retry plans so don't expose internal failure details.
+	plan.FailureReason = ""
+
+	return &frontendapi.GetSharedPlanResponse{Plan: plan}, nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package getshoppinglist
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) GetShoppingList(ctx context.Context, _ *frontendapi.GetShoppingListRequest) (*frontendapi.GetShoppingListResponse, error) {
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("getshoppinglist: resolving household: %w", err)
+	}
+
+	docs, err := scope.ShoppingList().OrderBy("addedAt", firestore.Asc).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("getshoppinglist: fetching shopping list: %w", err)
+	}
+
+	res := &frontendapi.GetShoppingListResponse{
+		Entries: make([]*frontendapi.ShoppingListEntry, len(docs)),
+	}
+	for i, doc := range docs {
+		var entry cookchatdb.ShoppingListEntry
+		if err := doc.DataTo(&entry); err != nil {
+			return nil, fmt.Errorf("getshoppinglist: decoding shopping list entry: %w", err)
+		}
+		ingredients := make([]*frontendapi.ShoppingListIngredient, len(entry.Ingredients))
+		for j, ing := range entry.Ingredients {
+			ingredients[j] = &frontendapi.ShoppingListIngredient{
+				Name:     ing.Name,
+				Quantity: ing.Quantity,
+				Selected: ing.Selected,
+			}
+		}
+		res.Entries[i] = &frontendapi.ShoppingListEntry{
+			Id:          doc.Ref.ID,
+			RecipeId:    entry.RecipeID,
+			Title:       entry.Title,
+			ServingSize: entry.ServingSize,
+			Ingredients: ingredients,
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package getusersettings
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) GetUserSettings(ctx context.Context, _ *frontendapi.GetUserSettingsRequest) (*frontendapi.GetUserSettingsResponse, error) {
+	userID := firebaseauth.TokenFromContext(ctx).UID
+
+	var user cookchatdb.User
+	doc, err := h.store.Collection("users").Doc(userID).Get(ctx)
+	switch {
+	case status.Code(err) == codes.NotFound:
+	case err != nil:
+		return nil, fmt.Errorf("getusersettings: fetching user: %w", err)
+	default:
+		if err := doc.DataTo(&user); err != nil {
+			return nil, fmt.Errorf("getusersettings: decoding user: %w", err)
+		}
+	}
+
+	return &frontendapi.GetUserSettingsResponse{
+		Settings: &frontendapi.UserSettings{
+			TimeZone: user.TimeZone,
+		},
+	}, nil
+}
@@ -0,0 +1,143 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package listcookinghistory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+	"github.com/curioswitch/cookchat/frontend/server/internal/i18n"
+)
+
+const pageSize = 20
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) ListCookingHistory(ctx context.Context, req *frontendapi.ListCookingHistoryRequest) (*frontendapi.ListCookingHistoryResponse, error) {
+	userID := firebaseauth.TokenFromContext(ctx).UID
+
+	// Order by ID too so entries cooked at the same time are neither skipped nor repeated
+	// across pages.
+	q := h.store.Collection("users").Doc(userID).Collection("cookingHistory").
+		OrderBy("cookedAt", firestore.Desc).
+		OrderBy(firestore.DocumentID, firestore.Desc)
+	if p := req.GetPagination(); p.GetLastTimestampNanos() != 0 {
+		q = q.StartAfter(time.Unix(0, p.GetLastTimestampNanos()), p.GetLastId())
+	}
+	docs, err := q.Limit(pageSize).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("listcookinghistory: fetching history: %w", err)
+	}
+	if len(docs) == 0 {
+		return &frontendapi.ListCookingHistoryResponse{}, nil
+	}
+
+	entries := make([]cookchatdb.CookingHistoryEntry, len(docs))
+	var recipeIDs []string
+	for i, doc := range docs {
+		if err := doc.DataTo(&entries[i]); err != nil {
+			return nil, fmt.Errorf("listcookinghistory: decoding history entry: %w", err)
+		}
+		recipeIDs = append(recipeIDs, entries[i].RecipeIDs...)
+	}
+
+	recipes, err := h.getRecipes(ctx, recipeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	language := i18n.UserLanguage(ctx)
+
+	res := &frontendapi.ListCookingHistoryResponse{
+		Entries: make([]*frontendapi.CookingHistoryEntry, len(entries)),
+	}
+	for i, entry := range entries {
+		e := &frontendapi.CookingHistoryEntry{
+			Id:       entry.ID,
+			PlanId:   entry.PlanID,
+			CookedAt: timestamppb.New(entry.CookedAt),
+			Rating:   uint32(max(entry.Rating, 0)),   //nolint:gosec // checked for negative
+			Servings: uint32(max(entry.Servings, 0)), //nolint:gosec // checked for negative
+			Notes:    entry.Notes,
+		}
+		for _, recipeID := range entry.RecipeIDs {
+			recipe, ok := recipes[recipeID]
+			if !ok {
+				// Recipe may have been deleted since it was cooked.
+				continue
+			}
+			cnt := recipe.LocalizedContent[language]
+			if cnt == nil {
+				cnt = &recipe.Content
+			}
+			e.Recipes = append(e.Recipes, &frontendapi.RecipeSnippet{
+				Id:       recipe.ID,
+				Title:    cnt.Title,
+				Summary:  cnt.Description,
+				ImageUrl: recipe.ImageURL,
+			})
+		}
+		res.Entries[i] = e
+	}
+	if len(entries) == pageSize {
+		res.Pagination = &frontendapi.Pagination{
+			LastId:             docs[len(docs)-1].Ref.ID,
+			LastTimestampNanos: entries[len(entries)-1].CookedAt.UnixNano(),
+		}
+	}
+
+	return res, nil
+}
+
+func (h *Handler) getRecipes(ctx context.Context, recipeIDs []string) (map[string]cookchatdb.Recipe, error) {
+	recipes := map[string]cookchatdb.Recipe{}
+	for len(recipeIDs) > 0 {
+		batch := recipeIDs
+		if len(batch) > 30 {
+			batch = batch[:30]
+		}
+		recipeIDs = recipeIDs[len(batch):]
+		iter := h.store.Collection("recipes").Query.WhereEntity(firestore.PropertyFilter{
+			Path:     "id",
+			Operator: "in",
+			Value:    batch,
+		}).Documents(ctx)
+
+		for {
+			doc, err := iter.Next()
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			if err != nil {
+				iter.Stop()
+				return nil, fmt.Errorf("listcookinghistory: fetching recipe: %w", err)
+			}
+			var recipe cookchatdb.Recipe
+			if err := doc.DataTo(&recipe); err != nil {
+				iter.Stop()
+				return nil, fmt.Errorf("listcookinghistory: decoding recipe: %w", err)
+			}
+			recipes[recipe.ID] = recipe
+		}
+		iter.Stop()
+	}
+	return recipes, nil
+}
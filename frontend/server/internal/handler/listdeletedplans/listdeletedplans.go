@@ -0,0 +1,86 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package listdeletedplans
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+	"github.com/curioswitch/cookchat/frontend/server/internal/localdate"
+	"github.com/curioswitch/cookchat/frontend/server/internal/planstore"
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) ListDeletedPlans(ctx context.Context, _ *frontendapi.ListDeletedPlansRequest) (*frontendapi.ListDeletedPlansResponse, error) {
+	userID := firebaseauth.TokenFromContext(ctx).UID
+	loc, err := localdate.Location(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listdeletedplans: resolving time zone: %w", err)
+	}
+
+	scope, err := household.Resolve(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listdeletedplans: resolving household: %w", err)
+	}
+
+	iter := scope.Plans().Query.WhereEntity(firestore.PropertyFilter{
+		Path:     "deletedAt",
+		Operator: ">",
+		Value:    time.Time{},
+	}).OrderBy("deletedAt", firestore.Desc).Documents(ctx)
+	defer iter.Stop()
+
+	var dbPlans []cookchatdb.Plan
+	for {
+		doc, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listdeletedplans: fetching plan: %w", err)
+		}
+
+		var plan cookchatdb.Plan
+		if err := doc.DataTo(&plan); err != nil {
+			return nil, fmt.Errorf("listdeletedplans: decoding plan: %w", err)
+		}
+		dbPlans = append(dbPlans, plan)
+	}
+
+	recipes, err := planstore.Recipes(ctx, h.store, dbPlans)
+	if err != nil {
+		return nil, fmt.Errorf("listdeletedplans: fetching recipes: %w", err)
+	}
+
+	plans := make([]*frontendapi.DeletedPlan, len(dbPlans))
+	for i, dbPlan := range dbPlans {
+		// Recipes of trashed plans may have been cleaned up already, only list what remains.
+		plans[i] = &frontendapi.DeletedPlan{
+			Plan:      planstore.Snippet(&dbPlan, recipes, loc),
+			DeletedAt: timestamppb.New(dbPlan.DeletedAt),
+			PurgeAt:   timestamppb.New(dbPlan.DeletedAt.Add(cookchatdb.PlanTrashRetention)),
+		}
+	}
+
+	return &frontendapi.ListDeletedPlansResponse{Plans: plans}, nil
+}
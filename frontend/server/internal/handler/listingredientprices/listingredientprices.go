@@ -0,0 +1,52 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package listingredientprices
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+
+	"github.com/curioswitch/cookchat/common/household"
+	"github.com/curioswitch/cookchat/common/pricing"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) ListIngredientPrices(ctx context.Context, _ *frontendapi.ListIngredientPricesRequest) (*frontendapi.ListIngredientPricesResponse, error) {
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("listingredientprices: resolving household: %w", err)
+	}
+
+	table, err := pricing.LoadTable(ctx, scope.IngredientPrices())
+	if err != nil {
+		return nil, fmt.Errorf("listingredientprices: loading prices: %w", err)
+	}
+
+	prices := table.Prices()
+	res := &frontendapi.ListIngredientPricesResponse{
+		Prices: make([]*frontendapi.IngredientPrice, len(prices)),
+	}
+	for i, price := range prices {
+		res.Prices[i] = &frontendapi.IngredientPrice{
+			Name:   price.Name,
+			Unit:   price.Unit,
+			Yen:    price.Yen,
+			Custom: price.Custom,
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package listpantryitems
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) ListPantryItems(ctx context.Context, _ *frontendapi.ListPantryItemsRequest) (*frontendapi.ListPantryItemsResponse, error) {
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("listpantryitems: resolving household: %w", err)
+	}
+
+	docs, err := scope.Pantry().OrderBy("name", firestore.Asc).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("listpantryitems: fetching pantry: %w", err)
+	}
+
+	res := &frontendapi.ListPantryItemsResponse{
+		Items: make([]*frontendapi.PantryItem, len(docs)),
+	}
+	for i, doc := range docs {
+		var item cookchatdb.PantryItem
+		if err := doc.DataTo(&item); err != nil {
+			return nil, fmt.Errorf("listpantryitems: decoding pantry item: %w", err)
+		}
+		res.Items[i] = &frontendapi.PantryItem{
+			Name:     item.Name,
+			Quantity: item.Quantity,
+		}
+	}
+	return res, nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
 
 	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
 	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
 	"github.com/curioswitch/cookchat/frontend/server/internal/i18n"
 )
@@ -44,7 +45,11 @@ func (h *Handler) ListRecipes(ctx context.Context, req *frontendapi.ListRecipesR
 	var lastBookmark time.Time
 
 	if req.GetBookmarks() {
-		q := h.store.Collection("users").Doc(firebaseauth.TokenFromContext(ctx).UID).Collection("bookmarks").Query
+		scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+		if err != nil {
+			return nil, fmt.Errorf("listrecipes: resolving household: %w", err)
+		}
+		q := scope.Bookmarks().Query
 		if lts := req.GetPagination().GetLastTimestampNanos(); lts != 0 {
 			ts := time.Unix(0, lts)
 			q = q.Where("createdAt", "<", ts)
@@ -104,7 +109,7 @@ func (h *Handler) ListRecipes(ctx context.Context, req *frontendapi.ListRecipesR
 			return nil, fmt.Errorf("listrecipes: unmarshalling recipe: %w", err)
 		}
 
-		if recipe.Status == cookchatdb.RecipeStatusProcessing {
+		if recipe.Status == cookchatdb.RecipeStatusProcessing || recipe.Status == cookchatdb.RecipeStatusFailed {
 			continue
 		}
 
@@ -0,0 +1,83 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package markcooked
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/api/iterator"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+	"github.com/curioswitch/cookchat/frontend/server/internal/planstore"
+)
+
+var (
+	errRecipeNotFound = errors.New("recipe not found")
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) MarkCooked(ctx context.Context, req *frontendapi.MarkCookedRequest) (*frontendapi.MarkCookedResponse, error) {
+	userID := firebaseauth.TokenFromContext(ctx).UID
+	userDoc := h.store.Collection("users").Doc(userID)
+
+	now := time.Now()
+	entry := cookchatdb.CookingHistoryEntry{
+		CookedAt:  now,
+		Rating:    int(req.GetRating()),
+		Servings:  int(req.GetServings()),
+		Notes:     req.GetNotes(),
+		CreatedAt: now,
+	}
+	if req.GetCookedAt() != nil {
+		entry.CookedAt = req.GetCookedAt().AsTime()
+	}
+
+	switch req.GetTarget().(type) {
+	case *frontendapi.MarkCookedRequest_RecipeId:
+		_, err := h.store.Collection("recipes").Where("id", "==", req.GetRecipeId()).Limit(1).Documents(ctx).Next()
+		if err != nil {
+			if errors.Is(err, iterator.Done) {
+				return nil, connect.NewError(connect.CodeNotFound, errRecipeNotFound)
+			}
+			return nil, fmt.Errorf("markcooked: fetching recipe: %w", err)
+		}
+		entry.RecipeIDs = []string{req.GetRecipeId()}
+	case *frontendapi.MarkCookedRequest_PlanId:
+		scope, err := household.Resolve(ctx, h.store, userID)
+		if err != nil {
+			return nil, fmt.Errorf("markcooked: resolving household: %w", err)
+		}
+		_, plan, err := planstore.Get(ctx, scope, req.GetPlanId())
+		if err != nil {
+			return nil, fmt.Errorf("markcooked: %w", err)
+		}
+		entry.PlanID = plan.ID
+		entry.RecipeIDs = plan.Recipes
+	}
+
+	entryDoc := userDoc.Collection("cookingHistory").NewDoc()
+	entry.ID = entryDoc.ID
+	if _, err := entryDoc.Create(ctx, entry); err != nil {
+		return nil, fmt.Errorf("markcooked: saving history entry: %w", err)
+	}
+
+	return &frontendapi.MarkCookedResponse{EntryId: entry.ID}, nil
+}
@@ -8,8 +8,10 @@ import (
 	"fmt"
 
 	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
 	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
 
+	"github.com/curioswitch/cookchat/common/household"
 	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
 )
 
@@ -24,7 +26,15 @@ type Handler struct {
 }
 
 func (h *Handler) RemoveBookmark(ctx context.Context, req *frontendapi.RemoveBookmarkRequest) (*frontendapi.RemoveBookmarkResponse, error) {
-	doc := h.store.Collection("users").Doc(firebaseauth.TokenFromContext(ctx).UID).Collection("bookmarks").Doc("recipe-" + req.GetRecipeId())
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("removebookmark: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
+
+	doc := scope.Bookmarks().Doc("recipe-" + req.GetRecipeId())
 	if _, err := doc.Delete(ctx); err != nil {
 		return nil, fmt.Errorf("removebookmark: deleting bookmark: %w", err)
 	}
@@ -0,0 +1,77 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package removehouseholdmember
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+var (
+	errNotInHousehold   = errors.New("user is not in a household")
+	errMemberNotFound   = errors.New("member not found")
+	errPermissionDenied = errors.New("only owners can remove other members")
+	errOwnerCannotLeave = errors.New("owner cannot leave the household")
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) RemoveHouseholdMember(ctx context.Context, req *frontendapi.RemoveHouseholdMemberRequest) (*frontendapi.RemoveHouseholdMemberResponse, error) {
+	userID := firebaseauth.TokenFromContext(ctx).UID
+	scope, err := household.Resolve(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("removehouseholdmember: resolving household: %w", err)
+	}
+	if scope.HouseholdID == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errNotInHousehold)
+	}
+
+	targetID := req.GetUserId()
+	switch {
+	case targetID == userID && scope.Role == cookchatdb.HouseholdRoleOwner:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errOwnerCannotLeave)
+	case targetID != userID && scope.Role != cookchatdb.HouseholdRoleOwner:
+		return nil, connect.NewError(connect.CodePermissionDenied, errPermissionDenied)
+	}
+
+	memberDoc := scope.Doc.Collection("members").Doc(targetID)
+	if err := h.store.RunTransaction(ctx, func(_ context.Context, t *firestore.Transaction) error {
+		if _, err := t.Get(memberDoc); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return connect.NewError(connect.CodeNotFound, errMemberNotFound)
+			}
+			return fmt.Errorf("removehouseholdmember: fetching member: %w", err)
+		}
+		if err := t.Delete(memberDoc); err != nil {
+			return fmt.Errorf("removehouseholdmember: deleting member: %w", err)
+		}
+		if err := t.Set(h.store.Collection("users").Doc(targetID), map[string]any{"householdId": ""}, firestore.MergeAll); err != nil {
+			return fmt.Errorf("removehouseholdmember: saving user: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("removehouseholdmember: removing member: %w", err)
+	}
+
+	return &frontendapi.RemoveHouseholdMemberResponse{}, nil
+}
@@ -0,0 +1,42 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package removeshoppinglistentry
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) RemoveShoppingListEntry(ctx context.Context, req *frontendapi.RemoveShoppingListEntryRequest) (*frontendapi.RemoveShoppingListEntryResponse, error) {
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("removeshoppinglistentry: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
+
+	doc := scope.ShoppingList().Doc(req.GetEntryId())
+	if _, err := doc.Delete(ctx); err != nil {
+		return nil, fmt.Errorf("removeshoppinglistentry: deleting entry: %w", err)
+	}
+	return &frontendapi.RemoveShoppingListEntryResponse{}, nil
+}
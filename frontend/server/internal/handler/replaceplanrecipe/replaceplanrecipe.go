@@ -0,0 +1,130 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package replaceplanrecipe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	cloudtasks "cloud.google.com/go/cloudtasks/apiv2"
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+	"github.com/curioswitch/cookchat/frontend/server/internal/config"
+	"github.com/curioswitch/cookchat/frontend/server/internal/filltask"
+	"github.com/curioswitch/cookchat/frontend/server/internal/planstore"
+)
+
+var (
+	errRecipeNotFound    = errors.New("recipe not found")
+	errRecipeNotInPlan   = errors.New("recipe is not in the plan")
+	errAlreadyInPlan     = errors.New("replacement recipe is already in the plan")
+	errTypeMismatch      = errors.New("replacement recipe is a different type of dish")
+	errRecipeNotActive   = errors.New("replacement recipe is not ready to be planned")
+	errPlanProcessing    = errors.New("plan is still processing")
+	errBatchPlanNotDaily = errors.New("recipes can only be replaced in daily plans")
+)
+
+func NewHandler(store *firestore.Client, tasks *cloudtasks.Client, tasksConfig config.Tasks) *Handler {
+	return &Handler{
+		store:       store,
+		tasks:       tasks,
+		tasksConfig: tasksConfig,
+	}
+}
+
+type Handler struct {
+	store       *firestore.Client
+	tasks       *cloudtasks.Client
+	tasksConfig config.Tasks
+}
+
+func (h *Handler) ReplacePlanRecipe(ctx context.Context, req *frontendapi.ReplacePlanRecipeRequest) (*frontendapi.ReplacePlanRecipeResponse, error) {
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("replaceplanrecipe: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
+
+	recipeDocs, err := h.store.Collection("recipes").Query.WhereEntity(firestore.PropertyFilter{
+		Path:     "id",
+		Operator: "in",
+		Value:    []string{req.GetRecipeId(), req.GetReplacementRecipeId()},
+	}).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("replaceplanrecipe: fetching recipes: %w", err)
+	}
+	recipes := map[string]cookchatdb.Recipe{}
+	for _, doc := range recipeDocs {
+		var recipe cookchatdb.Recipe
+		if err := doc.DataTo(&recipe); err != nil {
+			return nil, fmt.Errorf("replaceplanrecipe: decoding recipe: %w", err)
+		}
+		recipes[recipe.ID] = recipe
+	}
+	replacement, ok := recipes[req.GetReplacementRecipeId()]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errRecipeNotFound)
+	}
+	// Recipes saved before statuses were recorded have none and are complete.
+	if replacement.Status != cookchatdb.RecipeStatusActive && replacement.Status != "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errRecipeNotActive)
+	}
+	if replaced, ok := recipes[req.GetRecipeId()]; ok && replaced.Type != replacement.Type {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errTypeMismatch)
+	}
+
+	planRef := scope.Plans().Doc(req.GetPlanId())
+	if err := h.store.RunTransaction(ctx, func(_ context.Context, tx *firestore.Transaction) error {
+		_, plan, err := planstore.GetInTransaction(tx, scope, req.GetPlanId())
+		if err != nil {
+			return fmt.Errorf("replaceplanrecipe: %w", err)
+		}
+		if plan.Type != cookchatdb.PlanTypeDaily && plan.Type != "" {
+			// Batch-prep and leftovers plans share dishes so can't be changed independently.
+			return connect.NewError(connect.CodeFailedPrecondition, errBatchPlanNotDaily)
+		}
+		if plan.Status == cookchatdb.PlanStatusProcessing {
+			return connect.NewError(connect.CodeFailedPrecondition, errPlanProcessing)
+		}
+		idx := slices.Index(plan.Recipes, req.GetRecipeId())
+		if idx == -1 {
+			return connect.NewError(connect.CodeInvalidArgument, errRecipeNotInPlan)
+		}
+		if slices.Contains(plan.Recipes, req.GetReplacementRecipeId()) {
+			return connect.NewError(connect.CodeInvalidArgument, errAlreadyInPlan)
+		}
+		plan.Recipes[idx] = req.GetReplacementRecipeId()
+
+		// The steps are regenerated for the new recipes by FillPlan.
+		return tx.Update(planRef, []firestore.Update{
+			{Path: "recipes", Value: plan.Recipes},
+			{Path: "stepGroups", Value: firestore.Delete},
+			{Path: "notes", Value: firestore.Delete},
+			{Path: "progress", Value: firestore.Delete},
+			{Path: "status", Value: cookchatdb.PlanStatusProcessing},
+			{Path: "failureReason", Value: firestore.Delete},
+			{Path: "attempts", Value: firestore.Delete},
+			{Path: "processingStartedAt", Value: time.Now()},
+			{FieldPath: firestore.FieldPath{"servingSizes", req.GetRecipeId()}, Value: firestore.Delete},
+		})
+	}); err != nil {
+		return nil, fmt.Errorf("replaceplanrecipe: replacing recipe: %w", err)
+	}
+
+	if err := filltask.Enqueue(ctx, h.tasks, h.tasksConfig, req.GetPlanId()); err != nil {
+		return nil, err
+	}
+
+	return &frontendapi.ReplacePlanRecipeResponse{}, nil
+}
@@ -0,0 +1,73 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package restoreplan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+var (
+	errPlanNotFound   = errors.New("plan not found")
+	errPlanNotInTrash = errors.New("plan is not in the trash")
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) RestorePlan(ctx context.Context, req *frontendapi.RestorePlanRequest) (*frontendapi.RestorePlanResponse, error) {
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("restoreplan: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
+
+	planDoc := scope.Plans().Doc(req.GetPlanId())
+	doc, err := planDoc.Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, errPlanNotFound)
+		}
+		return nil, fmt.Errorf("restoreplan: fetching plan: %w", err)
+	}
+	var plan cookchatdb.Plan
+	if err := doc.DataTo(&plan); err != nil {
+		return nil, fmt.Errorf("restoreplan: decoding plan: %w", err)
+	}
+	if plan.DeletedAt.IsZero() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errPlanNotInTrash)
+	}
+
+	// Guard against the plan being purged since it was fetched.
+	if _, err := planDoc.Update(ctx, []firestore.Update{
+		{Path: "deletedAt", Value: firestore.Delete},
+	}, firestore.LastUpdateTime(doc.UpdateTime)); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, connect.NewError(connect.CodeNotFound, errPlanNotFound)
+		}
+		return nil, fmt.Errorf("restoreplan: restoring plan: %w", err)
+	}
+
+	return &frontendapi.RestorePlanResponse{}, nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package retryplan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	cloudtasks "cloud.google.com/go/cloudtasks/apiv2"
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+	"github.com/curioswitch/cookchat/frontend/server/internal/config"
+	"github.com/curioswitch/cookchat/frontend/server/internal/filltask"
+	"github.com/curioswitch/cookchat/frontend/server/internal/planstore"
+)
+
+var (
+	errPlanNotFailed = errors.New("plan has not failed")
+)
+
+func NewHandler(store *firestore.Client, tasks *cloudtasks.Client, tasksConfig config.Tasks) *Handler {
+	return &Handler{
+		store:       store,
+		tasks:       tasks,
+		tasksConfig: tasksConfig,
+	}
+}
+
+type Handler struct {
+	store       *firestore.Client
+	tasks       *cloudtasks.Client
+	tasksConfig config.Tasks
+}
+
+func (h *Handler) RetryPlan(ctx context.Context, req *frontendapi.RetryPlanRequest) (*frontendapi.RetryPlanResponse, error) {
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("retryplan: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
+
+	now := time.Now()
+	var plan cookchatdb.Plan
+	if err := h.store.RunTransaction(ctx, func(_ context.Context, tx *firestore.Transaction) error {
+		doc, p, err := planstore.GetInTransaction(tx, scope, req.GetPlanId())
+		if err != nil {
+			return fmt.Errorf("retryplan: %w", err)
+		}
+		plan = p
+		if plan.Status != cookchatdb.PlanStatusFailed {
+			return connect.NewError(connect.CodeFailedPrecondition, errPlanNotFailed)
+		}
+		return tx.Update(doc.Ref, []firestore.Update{
+			{Path: "status", Value: cookchatdb.PlanStatusProcessing},
+			{Path: "failureReason", Value: firestore.Delete},
+			{Path: "attempts", Value: firestore.Delete},
+			{Path: "processingStartedAt", Value: now},
+		})
+	}); err != nil {
+		return nil, fmt.Errorf("retryplan: resetting plan: %w", err)
+	}
+
+	if err := h.resetFailedRecipes(ctx, plan.Recipes, now); err != nil {
+		return nil, err
+	}
+
+	if err := filltask.Enqueue(ctx, h.tasks, h.tasksConfig, plan.ID); err != nil {
+		return nil, err
+	}
+
+	return &frontendapi.RetryPlanResponse{}, nil
+}
+
+// resetFailedRecipes marks failed recipes of the plan as processing again so they are
+// post-processed by FillPlan.
+func (h *Handler) resetFailedRecipes(ctx context.Context, recipeIDs []string, now time.Time) error {
+	if len(recipeIDs) == 0 {
+		return nil
+	}
+	docs, err := h.store.Collection("recipes").Query.WhereEntity(firestore.AndFilter{
+		Filters: []firestore.EntityFilter{
+			firestore.PropertyFilter{
+				Path:     "id",
+				Operator: "in",
+				Value:    recipeIDs,
+			},
+			firestore.PropertyFilter{
+				Path:     "status",
+				Operator: "==",
+				Value:    string(cookchatdb.RecipeStatusFailed),
+			},
+		},
+	}).Documents(ctx).GetAll()
+	if err != nil {
+		return fmt.Errorf("retryplan: fetching failed recipes: %w", err)
+	}
+	for _, doc := range docs {
+		if _, err := doc.Ref.Update(ctx, []firestore.Update{
+			{Path: "status", Value: cookchatdb.RecipeStatusProcessing},
+			{Path: "failureReason", Value: firestore.Delete},
+			{Path: "attempts", Value: firestore.Delete},
+			{Path: "processingStartedAt", Value: now},
+		}); err != nil {
+			return fmt.Errorf("retryplan: resetting recipe: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package revokeshareableplanlink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+var (
+	errLinkNotFound = errors.New("link not found")
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) RevokeShareablePlanLink(ctx context.Context, req *frontendapi.RevokeShareablePlanLinkRequest) (*frontendapi.RevokeShareablePlanLinkResponse, error) {
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("revokeshareableplanlink: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
+
+	linkRef := h.store.Collection("planShareLinks").Doc(req.GetToken())
+	if err := h.store.RunTransaction(ctx, func(_ context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(linkRef)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return connect.NewError(connect.CodeNotFound, errLinkNotFound)
+			}
+			return fmt.Errorf("revokeshareableplanlink: fetching link: %w", err)
+		}
+		var link cookchatdb.PlanShareLink
+		if err := doc.DataTo(&link); err != nil {
+			return fmt.Errorf("revokeshareableplanlink: decoding link: %w", err)
+		}
+		// Links to plans outside the caller's scope are reported as missing to not reveal them.
+		if link.Plan == nil || link.Plan.Parent.Parent.Path != scope.Doc.Path {
+			return connect.NewError(connect.CodeNotFound, errLinkNotFound)
+		}
+		if !link.RevokedAt.IsZero() {
+			return nil
+		}
+		return tx.Update(linkRef, []firestore.Update{
+			{Path: "revokedAt", Value: time.Now()},
+		})
+	}); err != nil {
+		return nil, fmt.Errorf("revokeshareableplanlink: revoking link: %w", err)
+	}
+
+	return &frontendapi.RevokeShareablePlanLinkResponse{}, nil
+}
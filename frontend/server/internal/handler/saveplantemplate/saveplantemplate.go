@@ -0,0 +1,133 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package saveplantemplate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+var (
+	errTemplateNotFound = errors.New("plan template not found")
+	errDuplicateDay     = errors.New("day of week has more than one slot")
+	errRecipeNotFound   = errors.New("recipe not found")
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) SavePlanTemplate(ctx context.Context, req *frontendapi.SavePlanTemplateRequest) (*frontendapi.SavePlanTemplateResponse, error) {
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("saveplantemplate: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
+
+	now := time.Now()
+	template := cookchatdb.PlanTemplate{
+		Name:      req.GetName(),
+		Slots:     make([]cookchatdb.PlanTemplateSlot, len(req.GetSlots())),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	seen := map[time.Weekday]bool{}
+	var recipeIDs []string
+	for i, slot := range req.GetSlots() {
+		// DayOfWeek starts from Monday while time.Weekday starts from Sunday.
+		day := time.Weekday(int(slot.GetDayOfWeek()) % 7)
+		if seen[day] {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errDuplicateDay)
+		}
+		seen[day] = true
+		template.Slots[i] = cookchatdb.PlanTemplateSlot{
+			DayOfWeek: day,
+			Recipes:   slot.GetRecipeIds(),
+		}
+		for _, id := range slot.GetRecipeIds() {
+			if !slices.Contains(recipeIDs, id) {
+				recipeIDs = append(recipeIDs, id)
+			}
+		}
+	}
+	if err := h.checkRecipesExist(ctx, recipeIDs); err != nil {
+		return nil, err
+	}
+
+	templatesCol := scope.PlanTemplates()
+	if id := req.GetTemplateId(); id != "" {
+		templateDoc := templatesCol.Doc(id)
+		existing, err := templateDoc.Get(ctx)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, connect.NewError(connect.CodeNotFound, errTemplateNotFound)
+			}
+			return nil, fmt.Errorf("saveplantemplate: fetching template: %w", err)
+		}
+		var prev cookchatdb.PlanTemplate
+		if err := existing.DataTo(&prev); err != nil {
+			return nil, fmt.Errorf("saveplantemplate: decoding template: %w", err)
+		}
+		template.ID = id
+		template.CreatedAt = prev.CreatedAt
+		if _, err := templateDoc.Set(ctx, template); err != nil {
+			return nil, fmt.Errorf("saveplantemplate: saving template: %w", err)
+		}
+	} else {
+		templateDoc := templatesCol.NewDoc()
+		template.ID = templateDoc.ID
+		if _, err := templateDoc.Create(ctx, template); err != nil {
+			return nil, fmt.Errorf("saveplantemplate: creating template: %w", err)
+		}
+	}
+
+	return &frontendapi.SavePlanTemplateResponse{
+		TemplateId: template.ID,
+	}, nil
+}
+
+// checkRecipesExist returns an error if any of recipeIDs is not a recipe.
+func (h *Handler) checkRecipesExist(ctx context.Context, recipeIDs []string) error {
+	found := make([]string, 0, len(recipeIDs))
+	// Firestore allows at most 30 values for in filters.
+	for batch := range slices.Chunk(recipeIDs, 30) {
+		docs, err := h.store.Collection("recipes").Query.Select("id").WhereEntity(firestore.PropertyFilter{
+			Path: "id", Operator: "in", Value: batch,
+		}).Documents(ctx).GetAll()
+		if err != nil {
+			return fmt.Errorf("saveplantemplate: fetching recipes for template: %w", err)
+		}
+		for _, doc := range docs {
+			id, _ := doc.Data()["id"].(string)
+			found = append(found, id)
+		}
+	}
+	for _, id := range recipeIDs {
+		if !slices.Contains(found, id) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %s", errRecipeNotFound, id))
+		}
+	}
+	return nil
+}
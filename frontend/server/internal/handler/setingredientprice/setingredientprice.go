@@ -0,0 +1,73 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package setingredientprice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/api/iterator"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	"github.com/curioswitch/cookchat/common/pricing"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+func NewHandler(store *firestore.Client, prices *pricing.Cache) *Handler {
+	return &Handler{
+		store:  store,
+		prices: prices,
+	}
+}
+
+type Handler struct {
+	store  *firestore.Client
+	prices *pricing.Cache
+}
+
+func (h *Handler) SetIngredientPrice(ctx context.Context, req *frontendapi.SetIngredientPriceRequest) (*frontendapi.SetIngredientPriceResponse, error) {
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("setingredientprice: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
+
+	price := cookchatdb.IngredientPrice{
+		Name:      pricing.CanonicalName(req.GetName()),
+		Unit:      pricing.NormalizeUnit(req.GetUnit()),
+		Yen:       req.GetYen(),
+		UpdatedAt: time.Now(),
+	}
+
+	pricesCol := scope.IngredientPrices()
+	// Look up and save in a transaction so concurrent requests for the same ingredient don't
+	// both create a price.
+	if err := h.store.RunTransaction(ctx, func(_ context.Context, tx *firestore.Transaction) error {
+		existing, err := tx.Documents(pricesCol.Where("name", "==", price.Name).Where("unit", "==", price.Unit).Limit(1)).Next()
+		if err != nil && !errors.Is(err, iterator.Done) {
+			return fmt.Errorf("setingredientprice: fetching existing price: %w", err)
+		}
+		priceDoc := pricesCol.NewDoc()
+		if existing != nil {
+			priceDoc = existing.Ref
+		}
+		if err := tx.Set(priceDoc, price); err != nil {
+			return fmt.Errorf("setingredientprice: saving price: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	h.prices.Invalidate(pricesCol)
+
+	return &frontendapi.SetIngredientPriceResponse{}, nil
+}
@@ -0,0 +1,69 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package setpantryitem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/api/iterator"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	"github.com/curioswitch/cookchat/common/pricing"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) SetPantryItem(ctx context.Context, req *frontendapi.SetPantryItemRequest) (*frontendapi.SetPantryItemResponse, error) {
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("setpantryitem: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
+
+	item := cookchatdb.PantryItem{
+		Name:      pricing.CanonicalName(req.GetName()),
+		Quantity:  req.GetQuantity(),
+		UpdatedAt: time.Now(),
+	}
+
+	pantryCol := scope.Pantry()
+	// Look up and save in a transaction so concurrent requests for the same ingredient don't
+	// both create an item.
+	if err := h.store.RunTransaction(ctx, func(_ context.Context, tx *firestore.Transaction) error {
+		existing, err := tx.Documents(pantryCol.Where("name", "==", item.Name).Limit(1)).Next()
+		if err != nil && !errors.Is(err, iterator.Done) {
+			return fmt.Errorf("setpantryitem: fetching existing item: %w", err)
+		}
+		itemDoc := pantryCol.NewDoc()
+		if existing != nil {
+			itemDoc = existing.Ref
+		}
+		if err := tx.Set(itemDoc, item); err != nil {
+			return fmt.Errorf("setpantryitem: saving item: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &frontendapi.SetPantryItemResponse{}, nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package shareableplanlink
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+	"github.com/curioswitch/cookchat/frontend/server/internal/planstore"
+)
+
+// defaultTTLDays is how many days a link can be used for when not specified.
+const defaultTTLDays = 7
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) ShareablePlanLink(ctx context.Context, req *frontendapi.ShareablePlanLinkRequest) (*frontendapi.ShareablePlanLinkResponse, error) {
+	userID := firebaseauth.TokenFromContext(ctx).UID
+	scope, err := household.Resolve(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("shareableplanlink: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
+
+	planDoc, _, err := planstore.Get(ctx, scope, req.GetPlanId())
+	if err != nil {
+		return nil, fmt.Errorf("shareableplanlink: %w", err)
+	}
+	planRef := planDoc.Ref
+
+	ttlDays := int(req.GetTtlDays())
+	if ttlDays == 0 {
+		ttlDays = defaultTTLDays
+	}
+
+	now := time.Now()
+	// Document IDs are random so double as unguessable tokens, like household invitations.
+	linkDoc := h.store.Collection("planShareLinks").NewDoc()
+	link := cookchatdb.PlanShareLink{
+		ID:        linkDoc.ID,
+		Plan:      planRef,
+		CreatedBy: userID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(time.Duration(ttlDays) * 24 * time.Hour),
+	}
+	if _, err := linkDoc.Create(ctx, link); err != nil {
+		return nil, fmt.Errorf("shareableplanlink: saving link: %w", err)
+	}
+
+	return &frontendapi.ShareablePlanLinkResponse{
+		Token:     link.ID,
+		ExpiresAt: timestamppb.New(link.ExpiresAt),
+	}, nil
+}
@@ -21,6 +21,7 @@ import (
 	"google.golang.org/genai"
 
 	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
 	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
 	"github.com/curioswitch/cookchat/frontend/server/internal/auth"
 	"github.com/curioswitch/cookchat/frontend/server/internal/i18n"
@@ -73,8 +74,11 @@ func (h *Handler) StartChat(ctx context.Context, req *frontendapi.StartChatReque
 		prompt = llm.RecipeChatPrompt(ctx, string(recipeJSON))
 		recipePrompt = "The recipe in structured JSON format is as follows:\n" + string(recipeJSON)
 	} else if pid := req.GetPlanId(); pid != "" {
-		col := h.store.Collection("users").Doc(userID).Collection("plans")
-		doc, err := col.Doc(pid).Get(ctx)
+		scope, err := household.Resolve(ctx, h.store, userID)
+		if err != nil {
+			return nil, fmt.Errorf("startchat: resolving household: %w", err)
+		}
+		doc, err := scope.Plans().Doc(pid).Get(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("startchat: fetching plan: %w", err)
 		}
@@ -0,0 +1,204 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package suggestalternatives
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+	"github.com/curioswitch/cookchat/frontend/server/internal/localdate"
+	"github.com/curioswitch/cookchat/frontend/server/internal/planner"
+	"github.com/curioswitch/cookchat/frontend/server/internal/planstore"
+)
+
+const (
+	// maxAlternatives is the number of alternatives to suggest.
+	maxAlternatives = 10
+
+	// recentDays is how far back cooking history is considered recent.
+	recentDays = 14
+
+	// candidatePoolSize is the maximum number of recipes considered as alternatives. There can
+	// be many recipes of a type so only a pool of them is read.
+	candidatePoolSize = 300
+)
+
+var (
+	errRecipeNotInPlan   = errors.New("recipe is not in the plan")
+	errRecipeNotFound    = errors.New("recipe not found")
+	errUnknownRecipeType = errors.New("recipe type is unknown")
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) SuggestAlternatives(ctx context.Context, req *frontendapi.SuggestAlternativesRequest) (*frontendapi.SuggestAlternativesResponse, error) {
+	userID := firebaseauth.TokenFromContext(ctx).UID
+	scope, err := household.Resolve(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("suggestalternatives: resolving household: %w", err)
+	}
+
+	_, plan, err := planstore.Get(ctx, scope, req.GetPlanId())
+	if err != nil {
+		return nil, fmt.Errorf("suggestalternatives: %w", err)
+	}
+	if !slices.Contains(plan.Recipes, req.GetRecipeId()) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errRecipeNotInPlan)
+	}
+
+	recipesCol := h.store.Collection("recipes")
+
+	dayDocs, err := recipesCol.Query.WhereEntity(firestore.PropertyFilter{
+		Path:     "id",
+		Operator: "in",
+		Value:    plan.Recipes,
+	}).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("suggestalternatives: fetching plan recipes: %w", err)
+	}
+	var replaced planner.Recipe
+	var dayRecipes []planner.Recipe
+	for _, doc := range dayDocs {
+		var recipe cookchatdb.Recipe
+		if err := doc.DataTo(&recipe); err != nil {
+			return nil, fmt.Errorf("suggestalternatives: decoding plan recipe: %w", err)
+		}
+		if recipe.ID == req.GetRecipeId() {
+			replaced = toPlannerRecipe(&recipe)
+		} else {
+			dayRecipes = append(dayRecipes, toPlannerRecipe(&recipe))
+		}
+	}
+	if replaced.ID == "" {
+		return nil, connect.NewError(connect.CodeNotFound, errRecipeNotFound)
+	}
+	if replaced.Type == cookchatdb.RecipeTypeUnknown || replaced.Type == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errUnknownRecipeType)
+	}
+
+	recent, err := h.recentRecipeIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := h.candidatePool(ctx, replaced.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	recipes := map[string]cookchatdb.Recipe{}
+	var candidates []planner.Recipe
+	for _, doc := range pool {
+		var recipe cookchatdb.Recipe
+		if err := doc.DataTo(&recipe); err != nil {
+			return nil, fmt.Errorf("suggestalternatives: decoding recipe: %w", err)
+		}
+		// Recipes saved before statuses were recorded have none and are complete.
+		if recipe.Source == cookchatdb.RecipeSourceAI ||
+			(recipe.Status != cookchatdb.RecipeStatusActive && recipe.Status != "") {
+			continue
+		}
+		recipes[recipe.ID] = recipe
+		candidates = append(candidates, toPlannerRecipe(&recipe))
+	}
+
+	alternatives := planner.Alternatives(candidates, planner.AlternativesRequest{
+		Replaced:        replaced,
+		DayRecipes:      dayRecipes,
+		RecentRecipeIDs: recent,
+		Limit:           maxAlternatives,
+	})
+
+	res := make([]*frontendapi.RecipeSnippet, len(alternatives))
+	for i, alt := range alternatives {
+		recipe := recipes[alt.ID]
+		res[i] = &frontendapi.RecipeSnippet{
+			Id:       recipe.ID,
+			Title:    recipe.Content.Title,
+			Summary:  recipe.Content.Description,
+			ImageUrl: recipe.ImageURL,
+		}
+	}
+
+	return &frontendapi.SuggestAlternativesResponse{Alternatives: res}, nil
+}
+
+// candidatePool returns up to candidatePoolSize recipes of recipeType. The pool starts at a random
+// document ID, wrapping around to the first ones, so different alternatives can be suggested each
+// time without reading every recipe.
+func (h *Handler) candidatePool(ctx context.Context, recipeType cookchatdb.RecipeType) ([]*firestore.DocumentSnapshot, error) {
+	recipesCol := h.store.Collection("recipes")
+	query := recipesCol.Query.
+		Select("id", "source", "status", "type", "genre", "imageUrl", "content.title", "content.description", "content.ingredients").
+		Where("type", "==", string(recipeType)).
+		OrderBy(firestore.DocumentID, firestore.Asc)
+
+	// New document IDs are random, which makes them a convenient starting point.
+	start := recipesCol.NewDoc().ID
+	docs, err := query.StartAt(start).Limit(candidatePoolSize).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("suggestalternatives: fetching recipes: %w", err)
+	}
+	if len(docs) < candidatePoolSize {
+		rest, err := query.EndBefore(start).Limit(candidatePoolSize - len(docs)).Documents(ctx).GetAll()
+		if err != nil {
+			return nil, fmt.Errorf("suggestalternatives: fetching recipes: %w", err)
+		}
+		docs = append(docs, rest...)
+	}
+	return docs, nil
+}
+
+// recentRecipeIDs returns the IDs of recipes the user cooked within recentDays.
+func (h *Handler) recentRecipeIDs(ctx context.Context, userID string) ([]string, error) {
+	loc, err := localdate.Location(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("suggestalternatives: resolving time zone: %w", err)
+	}
+	start := localdate.Today(loc).AddDate(0, 0, -recentDays)
+
+	docs, err := h.store.Collection("users").Doc(userID).Collection("cookingHistory").
+		Where("cookedAt", ">=", start).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("suggestalternatives: fetching cooking history: %w", err)
+	}
+	var recipeIDs []string
+	for _, doc := range docs {
+		var entry cookchatdb.CookingHistoryEntry
+		if err := doc.DataTo(&entry); err != nil {
+			return nil, fmt.Errorf("suggestalternatives: decoding cooking history: %w", err)
+		}
+		recipeIDs = append(recipeIDs, entry.RecipeIDs...)
+	}
+	return recipeIDs, nil
+}
+
+func toPlannerRecipe(recipe *cookchatdb.Recipe) planner.Recipe {
+	r := planner.Recipe{
+		ID:    recipe.ID,
+		Type:  recipe.Type,
+		Genre: recipe.Genre,
+	}
+	for _, ing := range recipe.Content.Ingredients {
+		r.Ingredients = append(r.Ingredients, ing.Name)
+	}
+	return r
+}
@@ -6,60 +6,253 @@ package updateplan
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"time"
 
 	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
 	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
 	"google.golang.org/genai"
 
 	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
 	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
 	"github.com/curioswitch/cookchat/frontend/server/internal/llm"
+	"github.com/curioswitch/cookchat/frontend/server/internal/planstore"
 )
 
-func NewHandler(genAI *genai.Client, store *firestore.Client) *Handler {
+var (
+	errRecipeNotFound    = errors.New("recipe not found")
+	errNoRecipes         = errors.New("plan must have at least one recipe")
+	errUnknownRecipe     = errors.New("serving size set for recipe not in the plan")
+	errPlanProcessing    = errors.New("plan is still processing")
+	errPlanModified      = errors.New("plan was updated concurrently")
+	errBatchPlanNotDaily = errors.New("step groups can only be regenerated for daily plans")
+	// Step groups are edited as a whole so saving them without a version would silently
+	// drop concurrent edits.
+	errUpdateTimeRequired = errors.New("update_time is required when updating step_groups")
+)
+
+// GetPlanFunc gets a plan, usually FrontendService.GetPlan.
+type GetPlanFunc func(ctx context.Context, req *frontendapi.GetPlanRequest) (*frontendapi.GetPlanResponse, error)
+
+func NewHandler(genAI *genai.Client, store *firestore.Client, getPlan GetPlanFunc) *Handler {
 	return &Handler{
-		genAI: genAI,
-		store: store,
+		genAI:   genAI,
+		store:   store,
+		getPlan: getPlan,
 	}
 }
 
 type Handler struct {
-	genAI *genai.Client
-	store *firestore.Client
+	genAI   *genai.Client
+	store   *firestore.Client
+	getPlan GetPlanFunc
 }
 
 func (h *Handler) UpdatePlan(ctx context.Context, req *frontendapi.UpdatePlanRequest) (*frontendapi.UpdatePlanResponse, error) {
-	rDocs, err := h.store.Collection("recipes").Query.WhereEntity(firestore.PropertyFilter{
-		Path: "id", Operator: "in", Value: req.GetRecipeIds(),
-	}).Documents(ctx).GetAll()
+	userID := firebaseauth.TokenFromContext(ctx).UID
+	scope, err := household.Resolve(ctx, h.store, userID)
 	if err != nil {
-		return nil, fmt.Errorf("updateplan: fetching recipes for plan: %w", err)
+		return nil, fmt.Errorf("updateplan: resolving household: %w", err)
 	}
-	recipes := make([]cookchatdb.Recipe, len(rDocs))
-	for i, doc := range rDocs {
-		if err := doc.DataTo(&recipes[i]); err != nil {
-			return nil, fmt.Errorf("updateplan: failed to unmarshal recipe document: %w", err)
-		}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
 	}
 
-	plan, err := h.fillPlan(ctx, cookchatdb.Plan{
-		ID:      req.GetPlanId(),
-		Recipes: req.GetRecipeIds(),
-	})
+	paths, err := updatePaths(req)
 	if err != nil {
 		return nil, err
 	}
 
-	userID := firebaseauth.TokenFromContext(ctx).UID
-	plansCol := h.store.Collection("users").Doc(userID).Collection("plans")
-	planID := plan.ID
-	planDoc := plansCol.Doc(planID)
-	if _, err := planDoc.Set(ctx, plan); err != nil {
-		return nil, fmt.Errorf("updateplan: failed to set plan document: %w", err)
+	var updateTime time.Time
+	if req.GetUpdateTime() != nil {
+		updateTime = req.GetUpdateTime().AsTime()
+	}
+
+	// Step groups are generated before the transaction since it takes a while. Concurrent
+	// updates in the meantime are caught by the transaction.
+	var generated *cookchatdb.Plan
+	if slices.Contains(paths, "recipe_ids") {
+		if len(req.GetRecipeIds()) == 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errNoRecipes)
+		}
+		if err := h.checkRecipesExist(ctx, req.GetRecipeIds()); err != nil {
+			return nil, err
+		}
+		if generatesStepGroups(paths) {
+			doc, current, err := planstore.Get(ctx, scope, req.GetPlanId())
+			if err != nil {
+				return nil, fmt.Errorf("updateplan: %w", err)
+			}
+			if current.Type != cookchatdb.PlanTypeDaily && current.Type != "" {
+				// The execution plan prompt is for cooking a single day. Batch-prep and leftovers
+				// plans share dishes and are planned together.
+				return nil, connect.NewError(connect.CodeFailedPrecondition, errBatchPlanNotDaily)
+			}
+			if updateTime.IsZero() {
+				// Generated step groups replace the whole plan's, so they must not be saved
+				// over an update made while generating even if the client sent no version.
+				updateTime = doc.UpdateTime
+			}
+			plan, err := h.fillPlan(ctx, cookchatdb.Plan{
+				ID:      req.GetPlanId(),
+				Recipes: req.GetRecipeIds(),
+			})
+			if err != nil {
+				return nil, err
+			}
+			generated = &plan
+		}
+	}
+
+	planRef := scope.Plans().Doc(req.GetPlanId())
+	if err := h.store.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, plan, err := planstore.GetInTransaction(tx, scope, req.GetPlanId())
+		if err != nil {
+			return fmt.Errorf("updateplan: %w", err)
+		}
+		if !updateTime.IsZero() && !doc.UpdateTime.Equal(updateTime) {
+			return connect.NewError(connect.CodeAborted, errPlanModified)
+		}
+		if plan.Status == cookchatdb.PlanStatusProcessing {
+			// FillPlan replaces the plan when it finishes, which would drop the update.
+			return connect.NewError(connect.CodeFailedPrecondition, errPlanProcessing)
+		}
+
+		updates, err := planUpdates(paths, req, &plan, generated)
+		if err != nil {
+			return err
+		}
+		return tx.Update(planRef, updates)
+	}); err != nil {
+		return nil, fmt.Errorf("updateplan: updating plan: %w", err)
+	}
+
+	res, err := h.getPlan(ctx, &frontendapi.GetPlanRequest{PlanId: req.GetPlanId()})
+	if err != nil {
+		return nil, fmt.Errorf("updateplan: fetching updated plan: %w", err)
+	}
+
+	return &frontendapi.UpdatePlanResponse{Plan: res.GetPlan()}, nil
+}
+
+// updatePaths returns the fields of the plan to update for req.
+func updatePaths(req *frontendapi.UpdatePlanRequest) ([]string, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		// Clients before field masks only updated recipes.
+		paths = []string{"recipe_ids"}
+	}
+	for _, path := range paths {
+		switch path {
+		case "recipe_ids", "notes", "step_groups", "serving_sizes":
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported update_mask path %q", path))
+		}
 	}
+	if slices.Contains(paths, "step_groups") && req.GetUpdateTime() == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errUpdateTimeRequired)
+	}
+	return paths, nil
+}
+
+// generatesStepGroups returns whether updating paths replaces the step groups of the plan with
+// newly generated ones, which is when recipes are updated without also updating step groups.
+func generatesStepGroups(paths []string) bool {
+	return slices.Contains(paths, "recipe_ids") && !slices.Contains(paths, "step_groups")
+}
 
-	return &frontendapi.UpdatePlanResponse{}, nil
+// planUpdates returns the updates to plan for paths of req. generated is the plan generated for
+// new recipes, if any.
+func planUpdates(paths []string, req *frontendapi.UpdatePlanRequest, plan *cookchatdb.Plan, generated *cookchatdb.Plan) ([]firestore.Update, error) {
+	recipeIDs := plan.Recipes
+	var updates []firestore.Update
+	for _, path := range paths {
+		switch path {
+		case "recipe_ids":
+			recipeIDs = req.GetRecipeIds()
+			updates = append(updates, firestore.Update{Path: "recipes", Value: recipeIDs})
+			if generated != nil {
+				updates = append(updates, firestore.Update{Path: "stepGroups", Value: generated.StepGroups})
+				if !slices.Contains(paths, "notes") {
+					updates = append(updates, firestore.Update{Path: "notes", Value: generated.Notes})
+				}
+			}
+			if !slices.Contains(paths, "serving_sizes") {
+				// Drop serving sizes of removed recipes.
+				sizes := maps.Clone(plan.ServingSizes)
+				maps.DeleteFunc(sizes, func(recipeID string, _ string) bool {
+					return !slices.Contains(recipeIDs, recipeID)
+				})
+				if len(sizes) != len(plan.ServingSizes) {
+					updates = append(updates, firestore.Update{Path: "servingSizes", Value: sizes})
+				}
+			}
+		case "notes":
+			updates = append(updates, firestore.Update{Path: "notes", Value: req.GetNotes()})
+		case "step_groups":
+			updates = append(updates, firestore.Update{Path: "stepGroups", Value: stepGroupsFromProto(req.GetStepGroups(), plan.StepGroups)})
+		case "serving_sizes":
+			updates = append(updates, firestore.Update{Path: "servingSizes", Value: req.GetServingSizes()})
+		}
+	}
+	if slices.Contains(paths, "serving_sizes") {
+		for recipeID := range req.GetServingSizes() {
+			if !slices.Contains(recipeIDs, recipeID) {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errUnknownRecipe)
+			}
+		}
+	}
+	return updates, nil
+}
+
+// checkRecipesExist returns an error if any of recipeIDs is not a recipe.
+func (h *Handler) checkRecipesExist(ctx context.Context, recipeIDs []string) error {
+	docs, err := h.store.Collection("recipes").Query.Select("id").WhereEntity(firestore.PropertyFilter{
+		Path: "id", Operator: "in", Value: recipeIDs,
+	}).Documents(ctx).GetAll()
+	if err != nil {
+		return fmt.Errorf("updateplan: fetching recipes for plan: %w", err)
+	}
+	found := make([]string, 0, len(docs))
+	for _, doc := range docs {
+		id, _ := doc.Data()["id"].(string)
+		found = append(found, id)
+	}
+	for _, id := range recipeIDs {
+		if !slices.Contains(found, id) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %s", errRecipeNotFound, id))
+		}
+	}
+	return nil
+}
+
+// stepGroupsFromProto converts edited step groups, keeping the estimated times of steps
+// that are unchanged from prev since clients don't edit them.
+func stepGroupsFromProto(groups []*frontendapi.StepGroup, prev []cookchatdb.StepGroup) []cookchatdb.StepGroup {
+	res := make([]cookchatdb.StepGroup, len(groups))
+	for i, group := range groups {
+		res[i] = cookchatdb.StepGroup{
+			Label: group.GetLabel(),
+			Steps: make([]cookchatdb.RecipeStep, len(group.GetSteps())),
+			Note:  group.GetNote(),
+		}
+		for j, step := range group.GetSteps() {
+			res[i].Steps[j] = cookchatdb.RecipeStep{
+				Description: step.GetDescription(),
+				ImageURL:    step.GetImageUrl(),
+			}
+			if i < len(prev) && j < len(prev[i].Steps) && prev[i].Steps[j].Description == step.GetDescription() {
+				res[i].Steps[j].ActiveMinutes = prev[i].Steps[j].ActiveMinutes
+				res[i].Steps[j].WaitMinutes = prev[i].Steps[j].WaitMinutes
+			}
+		}
+	}
+	return res
 }
 
 func (h *Handler) fillPlan(ctx context.Context, plan cookchatdb.Plan) (cookchatdb.Plan, error) {
@@ -117,8 +310,16 @@ func (h *Handler) fillPlan(ctx context.Context, plan cookchatdb.Plan) (cookchatd
 											Type:        "string",
 											Description: "The image URL for the step.",
 										},
+										"activeMinutes": {
+											Type:        "integer",
+											Description: "The estimated hands-on time of the step in minutes.",
+										},
+										"waitMinutes": {
+											Type:        "integer",
+											Description: "The estimated passive time after the step in minutes, such as simmering or marinating.",
+										},
 									},
-									Required: []string{"description"},
+									Required: []string{"description", "activeMinutes", "waitMinutes"},
 								},
 							},
 							"note": {
@@ -0,0 +1,249 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package updateplan
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+func TestUpdatePaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *frontendapi.UpdatePlanRequest
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "no mask",
+			req:  &frontendapi.UpdatePlanRequest{},
+			want: []string{"recipe_ids"},
+		},
+		{
+			name: "mask",
+			req: &frontendapi.UpdatePlanRequest{
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"notes", "serving_sizes"}},
+			},
+			want: []string{"notes", "serving_sizes"},
+		},
+		{
+			name: "step groups with update time",
+			req: &frontendapi.UpdatePlanRequest{
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"step_groups"}},
+				UpdateTime: timestamppb.Now(),
+			},
+			want: []string{"step_groups"},
+		},
+		{
+			name: "step groups without update time",
+			req: &frontendapi.UpdatePlanRequest{
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"notes", "step_groups"}},
+			},
+			wantErr: errUpdateTimeRequired,
+		},
+		{
+			name: "unsupported path",
+			req: &frontendapi.UpdatePlanRequest{
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"status"}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			paths, err := updatePaths(tc.req)
+			if tc.want == nil {
+				if connect.CodeOf(err) != connect.CodeInvalidArgument {
+					t.Fatalf("got error %v, want invalid argument", err)
+				}
+				if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+					t.Errorf("got error %v, want %v", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(paths, tc.want) {
+				t.Errorf("got paths %v, want %v", paths, tc.want)
+			}
+		})
+	}
+}
+
+func TestGeneratesStepGroups(t *testing.T) {
+	tests := []struct {
+		paths []string
+		want  bool
+	}{
+		{paths: []string{"recipe_ids"}, want: true},
+		{paths: []string{"recipe_ids", "notes"}, want: true},
+		{paths: []string{"recipe_ids", "step_groups"}, want: false},
+		{paths: []string{"step_groups"}, want: false},
+		{paths: []string{"notes", "serving_sizes"}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(strings.Join(tc.paths, ","), func(t *testing.T) {
+			if got := generatesStepGroups(tc.paths); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPlanUpdates(t *testing.T) {
+	plan := &cookchatdb.Plan{
+		Recipes: []string{"main", "side"},
+		ServingSizes: map[string]string{
+			"main": "2人分",
+			"side": "1人分",
+		},
+		StepGroups: []cookchatdb.StepGroup{
+			{Label: "準備", Steps: []cookchatdb.RecipeStep{{Description: "切る", ActiveMinutes: 5}}},
+		},
+	}
+	generated := &cookchatdb.Plan{
+		StepGroups: []cookchatdb.StepGroup{{Label: "調理"}},
+		Notes:      []string{"generated"},
+	}
+
+	tests := []struct {
+		name      string
+		paths     []string
+		req       *frontendapi.UpdatePlanRequest
+		generated *cookchatdb.Plan
+		want      map[string]any
+		wantErr   error
+	}{
+		{
+			name:      "recipes",
+			paths:     []string{"recipe_ids"},
+			req:       &frontendapi.UpdatePlanRequest{RecipeIds: []string{"main", "soup"}},
+			generated: generated,
+			want: map[string]any{
+				"recipes":      []string{"main", "soup"},
+				"stepGroups":   generated.StepGroups,
+				"notes":        generated.Notes,
+				"servingSizes": map[string]string{"main": "2人分"},
+			},
+		},
+		{
+			name:  "recipes with edited notes and steps",
+			paths: []string{"recipe_ids", "notes", "step_groups"},
+			req: &frontendapi.UpdatePlanRequest{
+				RecipeIds: []string{"main", "side"},
+				Notes:     []string{"edited"},
+				StepGroups: []*frontendapi.StepGroup{
+					{Label: "準備", Steps: []*frontendapi.RecipeStep{{Description: "切る"}}},
+				},
+			},
+			want: map[string]any{
+				"recipes": []string{"main", "side"},
+				"notes":   []string{"edited"},
+				"stepGroups": []cookchatdb.StepGroup{
+					{Label: "準備", Steps: []cookchatdb.RecipeStep{{Description: "切る", ActiveMinutes: 5}}},
+				},
+			},
+		},
+		{
+			name:  "serving sizes",
+			paths: []string{"serving_sizes"},
+			req:   &frontendapi.UpdatePlanRequest{ServingSizes: map[string]string{"side": "3人分"}},
+			want: map[string]any{
+				"servingSizes": map[string]string{"side": "3人分"},
+			},
+		},
+		{
+			name:    "serving size of removed recipe",
+			paths:   []string{"recipe_ids", "serving_sizes"},
+			req:     &frontendapi.UpdatePlanRequest{RecipeIds: []string{"main"}, ServingSizes: map[string]string{"side": "3人分"}},
+			wantErr: errUnknownRecipe,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			updates, err := planUpdates(tc.paths, tc.req, plan, tc.generated)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("got error %v, want %v", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := updateValues(updates); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got updates %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStepGroupsFromProto(t *testing.T) {
+	prev := []cookchatdb.StepGroup{
+		{
+			Label: "準備",
+			Steps: []cookchatdb.RecipeStep{
+				{Description: "切る", ActiveMinutes: 5},
+				{Description: "漬ける", ActiveMinutes: 1, WaitMinutes: 30},
+			},
+		},
+	}
+	groups := []*frontendapi.StepGroup{
+		{
+			Label: "下ごしらえ",
+			Note:  "前日でも可",
+			Steps: []*frontendapi.RecipeStep{
+				{Description: "切る", ImageUrl: "https://example.com/cut.png"},
+				{Description: "一晩漬ける"},
+			},
+		},
+		{
+			Label: "仕上げ",
+			Steps: []*frontendapi.RecipeStep{{Description: "焼く"}},
+		},
+	}
+
+	want := []cookchatdb.StepGroup{
+		{
+			Label: "下ごしらえ",
+			Note:  "前日でも可",
+			Steps: []cookchatdb.RecipeStep{
+				// Unchanged steps keep their estimates.
+				{Description: "切る", ImageURL: "https://example.com/cut.png", ActiveMinutes: 5},
+				// Edited steps are no longer estimated.
+				{Description: "一晩漬ける"},
+			},
+		},
+		{
+			Label: "仕上げ",
+			Steps: []cookchatdb.RecipeStep{{Description: "焼く"}},
+		},
+	}
+
+	if got := stepGroupsFromProto(groups, prev); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func updateValues(updates []firestore.Update) map[string]any {
+	res := make(map[string]any, len(updates))
+	for _, u := range updates {
+		res[u.Path] = u.Value
+	}
+	return res
+}
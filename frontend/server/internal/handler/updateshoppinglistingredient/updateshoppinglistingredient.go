@@ -0,0 +1,77 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package updateshoppinglistingredient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+var (
+	errEntryNotFound      = errors.New("shopping list entry not found")
+	errIngredientNotFound = errors.New("ingredient not found in shopping list entry")
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) UpdateShoppingListIngredient(ctx context.Context, req *frontendapi.UpdateShoppingListIngredientRequest) (*frontendapi.UpdateShoppingListIngredientResponse, error) {
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return nil, fmt.Errorf("updateshoppinglistingredient: resolving household: %w", err)
+	}
+	if !scope.CanEdit() {
+		return nil, connect.NewError(connect.CodePermissionDenied, household.ErrReadOnly)
+	}
+
+	entryRef := scope.ShoppingList().Doc(req.GetEntryId())
+	// Update in a transaction so members checking off different ingredients at the same time
+	// don't overwrite each other.
+	if err := h.store.RunTransaction(ctx, func(_ context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(entryRef)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return connect.NewError(connect.CodeNotFound, errEntryNotFound)
+			}
+			return fmt.Errorf("updateshoppinglistingredient: fetching entry: %w", err)
+		}
+		var entry cookchatdb.ShoppingListEntry
+		if err := doc.DataTo(&entry); err != nil {
+			return fmt.Errorf("updateshoppinglistingredient: decoding entry: %w", err)
+		}
+		idx := int(req.GetIngredientIndex())
+		if idx >= len(entry.Ingredients) {
+			return connect.NewError(connect.CodeInvalidArgument, errIngredientNotFound)
+		}
+		if entry.Ingredients[idx].Selected == req.GetSelected() {
+			return nil
+		}
+		entry.Ingredients[idx].Selected = req.GetSelected()
+		return tx.Update(entryRef, []firestore.Update{
+			{Path: "ingredients", Value: entry.Ingredients},
+		})
+	}); err != nil {
+		return nil, fmt.Errorf("updateshoppinglistingredient: updating ingredient: %w", err)
+	}
+
+	return &frontendapi.UpdateShoppingListIngredientResponse{}, nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package updateusersettings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+var errInvalidTimeZone = errors.New("invalid time zone")
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) UpdateUserSettings(ctx context.Context, req *frontendapi.UpdateUserSettingsRequest) (*frontendapi.UpdateUserSettingsResponse, error) {
+	userID := firebaseauth.TokenFromContext(ctx).UID
+
+	tz := req.GetSettings().GetTimeZone()
+	if tz != "" {
+		// LoadLocation accepts Local, which is the time zone of the server, not the user.
+		if _, err := time.LoadLocation(tz); err != nil || tz == "Local" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errInvalidTimeZone)
+		}
+	}
+
+	if _, err := h.store.Collection("users").Doc(userID).Set(ctx, map[string]any{
+		"timeZone": tz,
+	}, firestore.MergeAll); err != nil {
+		return nil, fmt.Errorf("updateusersettings: saving user: %w", err)
+	}
+
+	return &frontendapi.UpdateUserSettingsResponse{}, nil
+}
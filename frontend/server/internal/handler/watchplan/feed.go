@@ -0,0 +1,80 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package watchplan
+
+import (
+	"context"
+	"log/slog"
+
+	"cloud.google.com/go/firestore"
+	"golang.org/x/sync/errgroup"
+)
+
+// Feed notifies of changes to a plan and its recipes.
+type Feed interface {
+	// Watch returns a channel that receives a value when watching starts and whenever the plan
+	// or one of its recipes changes. Changes that happen before the previous one is received
+	// are coalesced. The channel is closed when ctx is done or watching fails.
+	Watch(ctx context.Context, planRef *firestore.DocumentRef, recipeIDs []string) <-chan struct{}
+}
+
+// NewFirestoreFeed returns a Feed backed by Firestore snapshot listeners.
+func NewFirestoreFeed(store *firestore.Client) Feed {
+	return &firestoreFeed{
+		store: store,
+	}
+}
+
+type firestoreFeed struct {
+	store *firestore.Client
+}
+
+func (f *firestoreFeed) Watch(ctx context.Context, planRef *firestore.DocumentRef, recipeIDs []string) <-chan struct{} {
+	changes := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case changes <- struct{}{}:
+		default:
+		}
+	}
+
+	grp, watchCtx := errgroup.WithContext(ctx)
+	grp.Go(func() error {
+		iter := planRef.Snapshots(watchCtx)
+		defer iter.Stop()
+		for {
+			if _, err := iter.Next(); err != nil {
+				return err
+			}
+			notify()
+		}
+	})
+	// Plans never have more than a few recipes so they fit in a single in query.
+	if len(recipeIDs) > 0 {
+		grp.Go(func() error {
+			iter := f.store.Collection("recipes").Query.WhereEntity(firestore.PropertyFilter{
+				Path:     "id",
+				Operator: "in",
+				Value:    recipeIDs,
+			}).Snapshots(watchCtx)
+			defer iter.Stop()
+			for {
+				if _, err := iter.Next(); err != nil {
+					return err
+				}
+				notify()
+			}
+		})
+	}
+
+	go func() {
+		// Listeners also return an error when ctx is done, which is not a failure.
+		if err := grp.Wait(); ctx.Err() == nil {
+			slog.WarnContext(ctx, "watchplan: listening for plan changes", "error", err)
+		}
+		close(changes)
+	}()
+
+	return changes
+}
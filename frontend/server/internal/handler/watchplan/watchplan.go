@@ -0,0 +1,95 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package watchplan
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+	"github.com/curioswitch/cookchat/frontend/server/internal/planstore"
+)
+
+// maxWatchDuration is the longest a single stream stays open. Clients watch again if the
+// plan is still processing so streams don't outlive server instances indefinitely.
+const maxWatchDuration = 10 * time.Minute
+
+// GetPlanFunc gets a plan, usually FrontendService.GetPlan.
+type GetPlanFunc func(ctx context.Context, req *frontendapi.GetPlanRequest) (*frontendapi.GetPlanResponse, error)
+
+func NewHandler(store *firestore.Client, feed Feed, getPlan GetPlanFunc) *Handler {
+	return &Handler{
+		store:   store,
+		feed:    feed,
+		getPlan: getPlan,
+	}
+}
+
+type Handler struct {
+	store   *firestore.Client
+	feed    Feed
+	getPlan GetPlanFunc
+}
+
+func (h *Handler) WatchPlan(ctx context.Context, req *connect.Request[frontendapi.WatchPlanRequest], stream *connect.ServerStream[frontendapi.WatchPlanResponse]) error {
+	planID := req.Msg.GetPlanId()
+
+	scope, err := household.Resolve(ctx, h.store, firebaseauth.TokenFromContext(ctx).UID)
+	if err != nil {
+		return fmt.Errorf("watchplan: resolving household: %w", err)
+	}
+	doc, plan, err := planstore.Get(ctx, scope, planID)
+	if err != nil {
+		return fmt.Errorf("watchplan: %w", err)
+	}
+	planRef := doc.Ref
+
+	ctx, cancel := context.WithTimeout(ctx, maxWatchDuration)
+	defer cancel()
+
+	changes := h.feed.Watch(ctx, planRef, plan.Recipes)
+	return watch(ctx, changes, func(ctx context.Context) (*frontendapi.Plan, error) {
+		res, err := h.getPlan(ctx, &frontendapi.GetPlanRequest{PlanId: planID})
+		if err != nil {
+			return nil, err
+		}
+		return res.GetPlan(), nil
+	}, func(res *frontendapi.WatchPlanResponse) error {
+		return stream.Send(res)
+	})
+}
+
+// watch sends the plan returned by get each time changes receives a value, skipping
+// snapshots that are unchanged from the previous one. It returns once the plan is no longer
+// processing or changes is closed.
+func watch(ctx context.Context, changes <-chan struct{}, get func(ctx context.Context) (*frontendapi.Plan, error), send func(res *frontendapi.WatchPlanResponse) error) error {
+	var last *frontendapi.Plan
+	for range changes {
+		plan, err := get(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				// Watch is over, the client should watch again if needed.
+				return nil
+			}
+			return fmt.Errorf("watchplan: getting plan: %w", err)
+		}
+		if last == nil || !proto.Equal(plan, last) {
+			if err := send(&frontendapi.WatchPlanResponse{Plan: plan}); err != nil {
+				return fmt.Errorf("watchplan: sending plan: %w", err)
+			}
+			last = plan
+		}
+		if plan.GetStatus() != frontendapi.PlanStatus_PLAN_STATUS_PROCESSING {
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package watchplan
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+func processingPlan(imageURL string) *frontendapi.Plan {
+	return &frontendapi.Plan{
+		Id:     "plan",
+		Status: frontendapi.PlanStatus_PLAN_STATUS_PROCESSING,
+		Recipes: []*frontendapi.RecipeSnippet{
+			{Id: "recipe", ImageUrl: imageURL},
+		},
+	}
+}
+
+// runWatch runs watch with a change for each of snapshots, returning the sent plans.
+func runWatch(t *testing.T, snapshots []*frontendapi.Plan, closeChanges bool) ([]*frontendapi.Plan, error) {
+	t.Helper()
+
+	changes := make(chan struct{}, len(snapshots))
+	for range snapshots {
+		changes <- struct{}{}
+	}
+	if closeChanges {
+		close(changes)
+	}
+
+	next := 0
+	get := func(context.Context) (*frontendapi.Plan, error) {
+		if next == len(snapshots) {
+			t.Fatal("get called more times than changes were sent")
+		}
+		plan := snapshots[next]
+		next++
+		return plan, nil
+	}
+	var sent []*frontendapi.Plan
+	send := func(res *frontendapi.WatchPlanResponse) error {
+		sent = append(sent, res.GetPlan())
+		return nil
+	}
+
+	err := watch(t.Context(), changes, get, send)
+	return sent, err
+}
+
+func TestWatch(t *testing.T) {
+	active := &frontendapi.Plan{
+		Id:     "plan",
+		Status: frontendapi.PlanStatus_PLAN_STATUS_ACTIVE,
+	}
+	failed := &frontendapi.Plan{
+		Id:     "plan",
+		Status: frontendapi.PlanStatus_PLAN_STATUS_FAILED,
+	}
+
+	tests := []struct {
+		name      string
+		snapshots []*frontendapi.Plan
+		closed    bool
+		want      []*frontendapi.Plan
+	}{
+		{
+			name:      "until active",
+			snapshots: []*frontendapi.Plan{processingPlan(""), processingPlan("image.png"), active},
+			want:      []*frontendapi.Plan{processingPlan(""), processingPlan("image.png"), active},
+		},
+		{
+			name:      "until failed",
+			snapshots: []*frontendapi.Plan{processingPlan(""), failed},
+			want:      []*frontendapi.Plan{processingPlan(""), failed},
+		},
+		{
+			name:      "already active",
+			snapshots: []*frontendapi.Plan{active},
+			want:      []*frontendapi.Plan{active},
+		},
+		{
+			name:      "unchanged skipped",
+			snapshots: []*frontendapi.Plan{processingPlan(""), processingPlan(""), processingPlan("image.png")},
+			closed:    true,
+			want:      []*frontendapi.Plan{processingPlan(""), processingPlan("image.png")},
+		},
+		{
+			name:      "feed closed",
+			snapshots: nil,
+			closed:    true,
+			want:      nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := runWatch(t, tc.snapshots, tc.closed)
+			if err != nil {
+				t.Fatalf("watch() error = %v", err)
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("watch() sent %d plans, want %d", len(got), len(tc.want))
+			}
+			for i := range got {
+				if !proto.Equal(got[i], tc.want[i]) {
+					t.Errorf("watch() sent[%d] = %v, want %v", i, got[i], tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestWatchGetError(t *testing.T) {
+	changes := make(chan struct{}, 1)
+	changes <- struct{}{}
+
+	errGet := errors.New("get failed")
+	err := watch(t.Context(), changes, func(context.Context) (*frontendapi.Plan, error) {
+		return nil, errGet
+	}, func(*frontendapi.WatchPlanResponse) error {
+		t.Fatal("send called after get failed")
+		return nil
+	})
+	if !errors.Is(err, errGet) {
+		t.Errorf("watch() error = %v, want %v", err, errGet)
+	}
+}
+
+func TestWatchCanceled(t *testing.T) {
+	changes := make(chan struct{}, 1)
+	changes <- struct{}{}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	err := watch(ctx, changes, func(context.Context) (*frontendapi.Plan, error) {
+		cancel()
+		return nil, context.Canceled
+	}, func(*frontendapi.WatchPlanResponse) error {
+		t.Fatal("send called after get failed")
+		return nil
+	})
+	if err != nil {
+		t.Errorf("watch() error = %v, want nil", err)
+	}
+}
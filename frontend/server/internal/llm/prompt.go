@@ -278,6 +278,10 @@ If genres are provided, generate meals that fit those genres.
 
 If characteristics are provided, generate meals that fit those characteristics.
 
+If a weekly budget in yen is provided, each recipe will include its estimated ingredient cost as estimatedCost. Choose recipes so the
+total estimated cost of all days stays within the weekly budget prorated to the number of days requested, preferring cheaper recipes
+and recipes that share ingredients.
+
 Return the days of the plan, with each day containing the recipe IDs for the recipes for that day. Also, provide an execution plan
 for the recipes. Group steps from different recipes together into step groups, trying to allow for parallel execution of steps within
 a group where possible. It is fine for a group to contain only a single step. Copy the description and image URL as is into the step
@@ -285,6 +289,31 @@ within a group. If there is any note for execution within a step group, such as
 If there are any notes to consider when preparing the entire plan, return them.
 `
 
+func GenerateBatchPlanPrompt() string {
+	return generateBatchPlanPrompt
+}
+
+const generateBatchPlanPrompt = `You help users schedule batch-cooking (作り置き) meal plans. The user cooks several dishes in a single
+prep session and eats them over the following days as leftovers instead of cooking every day. The user will provide requirements for
+the plan like the number of days to eat from the prep session (1 meal per day), ingredients to include in the plan, desired genres, and
+desired characteristics. The list of recipes to choose from will also be provided.
+
+Choose dishes for the prep session that keep well refrigerated or frozen for the requested number of days and reheat well. Avoid dishes
+that must be eaten immediately, such as fried food that loses its texture or raw seafood. Aim for a small number of dishes that can be
+combined into varied meals, with main, side, and soup dishes, and never more than three dishes in a meal.
+
+If recipe IDs are provided in the request, they must be included in the prep session. Consider desired ingredients when planning if
+provided - the intent is to consume as many ingredients as possible to prevent ingredient waste. If genres or characteristics are
+provided, choose dishes that fit them.
+
+If a weekly budget in yen is provided, each recipe will include its estimated ingredient cost as estimatedCost. Choose dishes so the
+total estimated cost of the prep session stays within the weekly budget prorated to the number of days requested.
+
+Return the recipe IDs of all dishes cooked in the prep session, and for each day after the prep session, the recipe IDs of the dishes
+eaten that day. Every dish eaten on a day must be one of the dishes cooked in the prep session. Dishes that keep for fewer days should
+be eaten on earlier days.
+`
+
 func GenerateExecutionPlanPrompt() string {
 	return generateExecutionPlanPrompt
 }
@@ -294,12 +323,20 @@ a meal. Provide an execution plan for the recipes. Group steps from different re
 parallel execution of steps within a group where possible. It is fine for a group to contain only a single step. Copy the description
 and image URL as is into the step within a group - do not add any prefix to the description. If there is any note for execution within
 a step group, such as which step to execute while waiting on another, provide it. If there are any notes to consider when preparing the
-entire plan, return them. Only return text in Japanese.
+entire plan, return them. For each step, estimate the hands-on time in minutes as activeMinutes and any passive time that follows it,
+such as simmering, marinating, chilling, or soaking, in minutes as waitMinutes. Use 0 for waitMinutes when there is no passive time.
+Only return text in Japanese.
 `
 
-func ChatPlanPrompt(recentRecipes string) string {
+func ChatPlanPrompt(recentRecipes string, weeklyBudget int) string {
 	schemaBytes, _ := json.Marshal(cookchatdb.RecipeContentSchema) //nolint
-	return fmt.Sprintf(chatPlanPrompt, recentRecipes, schemaBytes)
+	budget := "The user has not set a budget."
+	if weeklyBudget > 0 {
+		budget = fmt.Sprintf("The user's weekly food budget is %d yen. Prefer affordable recipes and recipes that share ingredients "+
+			"so the total ingredient cost of the planned days stays within the budget prorated to the number of days, and mention a "+
+			"rough cost estimate when suggesting recipes.", weeklyBudget)
+	}
+	return fmt.Sprintf(chatPlanPrompt, recentRecipes, budget, schemaBytes)
 }
 
 const chatPlanPrompt = `You are a cooking assistant helping users to schedule meal plans via a text chat. Your goal is to assign
@@ -336,9 +373,19 @@ Only return recipes that are sourced. If you do not have a URL for a recipe, do
 
 The recipes the user has recently cooked are: %s. Avoid recommending the same recipe as one of these.
 
+%s
+
 Suggest the recipes to the user with a useful snippet. Confirm if they want to include them in the plan. Do not present the recipe itself,
 just a title and description of it. If they confirm, continue until filling in the requsted plans.
 
+If the user wants to batch cook (作り置き), cooking several dishes in one prep session and eating them over the following days instead
+of cooking every day, suggest dishes that keep well refrigerated or frozen and reheat well, with enough servings to cover the days.
+When the user is satisfied with the dishes, generate the batch-cooking plan. This is the final message of the conversation. The first
+line of the content must be "GENERATED BATCH COOKING PLAN" - do not add any text before it. The second line must be a JSON object with
+the field "dishes", an array of the recipes cooked in the prep session in the same format as the recipes of a meal plan described
+below, and the field "days", an array with an item for each day after the prep session, each item being an array of indexes into
+"dishes" for the dishes eaten that day.
+
 When the user is satisfied with the recipes, generate the meal plans. This is the final message of the conversation. The first line of the
 content must be "GENERATED MEAL PLAN" - do not add any text before it. The second line must be a JSON array, with each item corresponding
 to a day. Each item is an array of recipes. The recipes must have their content included as a JSON object. Do not copy from the sourced
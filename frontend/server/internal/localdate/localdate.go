@@ -0,0 +1,69 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package localdate computes the calendar dates of plans in the time zone of a user.
+package localdate
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+// DefaultTimeZone is the time zone for users that have not set one. Most users are in Japan.
+const DefaultTimeZone = "Asia/Tokyo"
+
+// Location returns the time zone preferred by the user, or DefaultTimeZone if they have not
+// set one.
+func Location(ctx context.Context, store *firestore.Client, userID string) (*time.Location, error) {
+	tz := DefaultTimeZone
+
+	doc, err := store.Collection("users").Doc(userID).Get(ctx)
+	switch {
+	case status.Code(err) == codes.NotFound:
+	case err != nil:
+		return nil, fmt.Errorf("localdate: fetching user: %w", err)
+	default:
+		var user cookchatdb.User
+		if err := doc.DataTo(&user); err != nil {
+			return nil, fmt.Errorf("localdate: decoding user: %w", err)
+		}
+		if user.TimeZone != "" {
+			tz = user.TimeZone
+		}
+	}
+
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		// Time zones are validated when saved so this should only happen if the tz database
+		// drops a zone.
+		slog.WarnContext(ctx, "localdate: invalid user time zone, using default", "timeZone", tz, "error", err)
+		return time.LoadLocation(DefaultTimeZone)
+	}
+	return loc, nil
+}
+
+// Today returns the start of the current day in loc.
+func Today(loc *time.Location) time.Time {
+	return StartOfDay(time.Now().In(loc))
+}
+
+// StartOfDay returns the start of the day of t in its location.
+func StartOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// Schedule schedules the plan on the day starting at day, recording the calendar date and
+// time zone along with the instant so the date doesn't depend on the zone it is read in.
+func Schedule(plan *cookchatdb.Plan, day time.Time) {
+	plan.ScheduledAt = day
+	plan.Date = day.Format(time.DateOnly)
+	plan.TimeZone = day.Location().String()
+}
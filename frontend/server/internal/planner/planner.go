@@ -0,0 +1,375 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package planner generates meal plans deterministically from constraints, without an LLM.
+package planner
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+// ErrNotEnoughMains is returned when there are fewer usable main dishes than requested days.
+var ErrNotEnoughMains = errors.New("planner: not enough main dishes for requested days")
+
+// ErrTooManyRecipes is returned when more recipes are required than there are requested days.
+var ErrTooManyRecipes = errors.New("planner: more required recipes than requested days")
+
+// ErrRecipeNotFound is returned when required recipes are not among the recipes to choose from.
+var ErrRecipeNotFound = errors.New("planner: required recipe not found")
+
+// ErrOverBudget is returned when even the cheapest main dishes exceed the budget.
+var ErrOverBudget = errors.New("planner: no plan fits within the budget")
+
+const (
+	// genreScore is the score for a recipe matching a requested genre.
+	genreScore = 2
+	// ingredientScore is the score for each requested ingredient a recipe uses that has not
+	// been used yet in the plan.
+	ingredientScore = 3
+	// repeatPenalty is the penalty for each time a side dish or soup was already used in the plan.
+	repeatPenalty = 5
+	// sharedIngredientScore is the score for each ingredient an alternative shares with the
+	// rest of the day, so fewer ingredients need to be bought.
+	sharedIngredientScore = 1
+	// recentPenalty is the penalty for an alternative that was cooked recently.
+	recentPenalty = 5
+)
+
+// Recipe is a recipe that can be chosen for a plan.
+type Recipe struct {
+	// ID is the ID of the recipe.
+	ID string
+
+	// Type is the type of the recipe. Recipes of unknown type are never chosen unless required.
+	Type cookchatdb.RecipeType
+
+	// Genre is the genre of the recipe.
+	Genre cookchatdb.RecipeGenre
+
+	// Ingredients are the names of the ingredients of the recipe.
+	Ingredients []string
+
+	// Cost is the estimated cost of the recipe in yen, or 0 if unknown.
+	Cost int
+}
+
+// Request is the constraints for generating a plan.
+type Request struct {
+	// NumDays is the number of days to plan.
+	NumDays int
+
+	// Genres are genres to prefer. If empty, all genres are treated equally.
+	Genres []cookchatdb.RecipeGenre
+
+	// RecipeIDs are recipes that must be used as the main dish of a day. There must be no
+	// more than NumDays.
+	RecipeIDs []string
+
+	// Ingredients are ingredients to use in the plan, as many as possible.
+	Ingredients []string
+
+	// Budget is the budget for the entire plan in yen, or 0 for no budget. Recipes costing
+	// more than the remaining budget allows for a day are not chosen, except for the cheapest
+	// main dish if none fit.
+	Budget int
+
+	// Seed shuffles recipes with the same score for variety between plans. A Seed of 0
+	// keeps ties ordered by recipe ID.
+	Seed uint64
+}
+
+// Day is the recipes for a day of a plan, starting with the main dish followed by any side
+// dish and soup.
+type Day struct {
+	// Recipes are the IDs of the recipes for the day.
+	Recipes []string
+}
+
+// Plan generates a plan with one main dish and, when available, one side dish and one soup
+// per day. Main dishes are never repeated. ErrOverBudget is returned if the plan exceeds the
+// budget.
+func Plan(recipes []Recipe, req Request) ([]Day, error) {
+	p := newPlanner(recipes, req)
+
+	if len(slices.Compact(slices.Sorted(slices.Values(req.RecipeIDs)))) > req.NumDays {
+		return nil, ErrTooManyRecipes
+	}
+
+	required := make([]Recipe, 0, len(req.RecipeIDs))
+	var notFound []string
+	for _, id := range req.RecipeIDs {
+		r, ok := p.byID[id]
+		if !ok {
+			if !slices.Contains(notFound, id) {
+				notFound = append(notFound, id)
+			}
+			continue
+		}
+		if slices.ContainsFunc(required, func(o Recipe) bool { return o.ID == id }) {
+			continue
+		}
+		required = append(required, r)
+	}
+	if len(notFound) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrRecipeNotFound, strings.Join(notFound, ", "))
+	}
+
+	numMains := len(required)
+	for _, r := range p.mains {
+		if !slices.ContainsFunc(required, func(o Recipe) bool { return o.ID == r.ID }) {
+			numMains++
+		}
+	}
+	if numMains < req.NumDays {
+		return nil, ErrNotEnoughMains
+	}
+
+	for _, r := range required {
+		p.usedMains[r.ID] = true
+	}
+
+	days := make([]Day, req.NumDays)
+	for i := range days {
+		daysLeft := req.NumDays - i
+
+		var main Recipe
+		if i < len(required) {
+			main = required[i]
+		} else {
+			var ok bool
+			main, ok = p.best(p.mains, daysLeft, 0)
+			if !ok {
+				main = p.cheapestMain()
+			}
+		}
+		p.use(main)
+		p.usedMains[main.ID] = true
+		dayCost := main.Cost
+		day := Day{Recipes: []string{main.ID}}
+
+		if side, ok := p.best(p.sides, daysLeft, dayCost); ok {
+			p.use(side)
+			dayCost += side.Cost
+			day.Recipes = append(day.Recipes, side.ID)
+		}
+		if soup, ok := p.best(p.soups, daysLeft, dayCost); ok {
+			p.use(soup)
+			dayCost += soup.Cost
+			day.Recipes = append(day.Recipes, soup.ID)
+		}
+		p.spent += dayCost
+
+		days[i] = day
+	}
+
+	if req.Budget > 0 && p.spent > req.Budget {
+		return nil, ErrOverBudget
+	}
+
+	return days, nil
+}
+
+type planner struct {
+	req Request
+
+	byID  map[string]Recipe
+	mains []Recipe
+	sides []Recipe
+	soups []Recipe
+
+	usedMains map[string]bool
+	uses      map[string]int
+	covered   map[string]bool
+	spent     int
+}
+
+func newPlanner(recipes []Recipe, req Request) *planner {
+	sorted := slices.Clone(recipes)
+	slices.SortFunc(sorted, func(a, b Recipe) int { return cmp.Compare(a.ID, b.ID) })
+	if req.Seed != 0 {
+		rand.New(rand.NewPCG(req.Seed, req.Seed)).Shuffle(len(sorted), func(i, j int) { //nolint:gosec // not for security
+			sorted[i], sorted[j] = sorted[j], sorted[i]
+		})
+	}
+
+	p := &planner{
+		req:       req,
+		byID:      make(map[string]Recipe, len(sorted)),
+		usedMains: map[string]bool{},
+		uses:      map[string]int{},
+		covered:   map[string]bool{},
+	}
+	for _, r := range sorted {
+		p.byID[r.ID] = r
+		switch r.Type {
+		case cookchatdb.RecipeTypeMainDish:
+			p.mains = append(p.mains, r)
+		case cookchatdb.RecipeTypeSideDish:
+			p.sides = append(p.sides, r)
+		case cookchatdb.RecipeTypeSoup:
+			p.soups = append(p.soups, r)
+		case cookchatdb.RecipeTypeUnknown:
+		}
+	}
+	return p
+}
+
+// best returns the highest scoring candidate, skipping mains that were already used and
+// recipes over the budget. Ties keep the order of candidates.
+func (p *planner) best(candidates []Recipe, daysLeft int, dayCost int) (Recipe, bool) {
+	var best Recipe
+	bestScore := 0
+	found := false
+	for _, r := range candidates {
+		if r.Type == cookchatdb.RecipeTypeMainDish && p.usedMains[r.ID] {
+			continue
+		}
+		if p.overBudget(r, daysLeft, dayCost) {
+			continue
+		}
+		score := p.score(r)
+		if !found || score > bestScore {
+			best = r
+			bestScore = score
+			found = true
+		}
+	}
+	return best, found
+}
+
+func (p *planner) score(r Recipe) int {
+	score := 0
+	if slices.Contains(p.req.Genres, r.Genre) {
+		score += genreScore
+	}
+	for _, ing := range p.req.Ingredients {
+		if !p.covered[ing] && usesIngredient(r, ing) {
+			score += ingredientScore
+		}
+	}
+	score -= p.uses[r.ID] * repeatPenalty
+	return score
+}
+
+// overBudget returns whether adding r to a day already costing dayCost exceeds the remaining
+// budget split evenly over the days left. Recipes with unknown cost are never over budget.
+func (p *planner) overBudget(r Recipe, daysLeft int, dayCost int) bool {
+	if p.req.Budget == 0 || r.Cost == 0 {
+		return false
+	}
+	allowance := (p.req.Budget - p.spent) / daysLeft
+	return dayCost+r.Cost > allowance
+}
+
+// cheapestMain returns the cheapest main dish that was not used yet. Ties keep the order of
+// mains.
+func (p *planner) cheapestMain() Recipe {
+	var cheapest Recipe
+	found := false
+	for _, r := range p.mains {
+		if p.usedMains[r.ID] {
+			continue
+		}
+		if !found || r.Cost < cheapest.Cost {
+			cheapest = r
+			found = true
+		}
+	}
+	return cheapest
+}
+
+func (p *planner) use(r Recipe) {
+	p.uses[r.ID]++
+	for _, ing := range p.req.Ingredients {
+		if usesIngredient(r, ing) {
+			p.covered[ing] = true
+		}
+	}
+}
+
+func usesIngredient(r Recipe, ingredient string) bool {
+	ingredient = strings.ToLower(strings.TrimSpace(ingredient))
+	if ingredient == "" {
+		return false
+	}
+	for _, name := range r.Ingredients {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if strings.Contains(name, ingredient) || strings.Contains(ingredient, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// AlternativesRequest is the context for suggesting replacements for a recipe in a plan.
+type AlternativesRequest struct {
+	// Replaced is the recipe being replaced. Alternatives have the same type and prefer
+	// the same genre.
+	Replaced Recipe
+
+	// DayRecipes are the other recipes of the day. They are never suggested, and
+	// alternatives sharing ingredients with them are preferred.
+	DayRecipes []Recipe
+
+	// RecentRecipeIDs are recipes cooked recently, which are ranked lower.
+	RecentRecipeIDs []string
+
+	// Limit is the maximum number of alternatives to return, or 0 for no limit.
+	Limit int
+}
+
+// Alternatives returns recipes that can replace req.Replaced, best first. Ties are
+// ordered by recipe ID.
+func Alternatives(recipes []Recipe, req AlternativesRequest) []Recipe {
+	type scored struct {
+		recipe Recipe
+		score  int
+	}
+
+	var candidates []scored
+	for _, r := range recipes {
+		if r.Type != req.Replaced.Type || r.ID == req.Replaced.ID ||
+			slices.ContainsFunc(req.DayRecipes, func(o Recipe) bool { return o.ID == r.ID }) {
+			continue
+		}
+		score := 0
+		if r.Genre != cookchatdb.RecipeGenreUnknown && r.Genre == req.Replaced.Genre {
+			score += genreScore
+		}
+		for _, ing := range r.Ingredients {
+			if slices.ContainsFunc(req.DayRecipes, func(o Recipe) bool { return usesIngredient(o, ing) }) {
+				score += sharedIngredientScore
+			}
+		}
+		if slices.Contains(req.RecentRecipeIDs, r.ID) {
+			score -= recentPenalty
+		}
+		candidates = append(candidates, scored{recipe: r, score: score})
+	}
+
+	slices.SortFunc(candidates, func(a, b scored) int {
+		if c := cmp.Compare(b.score, a.score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.recipe.ID, b.recipe.ID)
+	})
+	if req.Limit > 0 && len(candidates) > req.Limit {
+		candidates = candidates[:req.Limit]
+	}
+
+	res := make([]Recipe, len(candidates))
+	for i, c := range candidates {
+		res[i] = c.recipe
+	}
+	return res
+}
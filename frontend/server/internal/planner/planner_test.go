@@ -0,0 +1,218 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package planner
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+func testRecipes() []Recipe {
+	return []Recipe{
+		{ID: "main-curry", Type: cookchatdb.RecipeTypeMainDish, Genre: cookchatdb.RecipeGenreJapanese, Ingredients: []string{"玉ねぎ", "にんじん", "豚肉"}, Cost: 600},
+		{ID: "main-mapo", Type: cookchatdb.RecipeTypeMainDish, Genre: cookchatdb.RecipeGenreChinese, Ingredients: []string{"豆腐", "豚ひき肉"}, Cost: 400},
+		{ID: "main-pasta", Type: cookchatdb.RecipeTypeMainDish, Genre: cookchatdb.RecipeGenreItalian, Ingredients: []string{"スパゲッティ", "トマト"}, Cost: 300},
+		{ID: "main-steak", Type: cookchatdb.RecipeTypeMainDish, Genre: cookchatdb.RecipeGenreWestern, Ingredients: []string{"牛肉"}, Cost: 2000},
+		{ID: "side-salad", Type: cookchatdb.RecipeTypeSideDish, Genre: cookchatdb.RecipeGenreWestern, Ingredients: []string{"キャベツ", "トマト"}, Cost: 150},
+		{ID: "side-namul", Type: cookchatdb.RecipeTypeSideDish, Genre: cookchatdb.RecipeGenreKorean, Ingredients: []string{"もやし"}, Cost: 80},
+		{ID: "soup-miso", Type: cookchatdb.RecipeTypeSoup, Genre: cookchatdb.RecipeGenreJapanese, Ingredients: []string{"豆腐", "味噌"}, Cost: 100},
+		{ID: "soup-consomme", Type: cookchatdb.RecipeTypeSoup, Genre: cookchatdb.RecipeGenreWestern, Ingredients: []string{"玉ねぎ", "コンソメ"}, Cost: 120},
+		{ID: "unknown", Type: cookchatdb.RecipeTypeUnknown, Ingredients: []string{"玉ねぎ"}},
+	}
+}
+
+func mains(days []Day) []string {
+	res := make([]string, len(days))
+	for i, day := range days {
+		res[i] = day.Recipes[0]
+	}
+	return res
+}
+
+func TestPlan(t *testing.T) {
+	tests := []struct {
+		name string
+		req  Request
+		want []Day
+	}{
+		{
+			name: "no constraints",
+			req:  Request{NumDays: 2},
+			want: []Day{
+				{Recipes: []string{"main-curry", "side-namul", "soup-consomme"}},
+				{Recipes: []string{"main-mapo", "side-salad", "soup-miso"}},
+			},
+		},
+		{
+			name: "genres",
+			req:  Request{NumDays: 2, Genres: []cookchatdb.RecipeGenre{cookchatdb.RecipeGenreWestern}},
+			want: []Day{
+				{Recipes: []string{"main-steak", "side-salad", "soup-consomme"}},
+				{Recipes: []string{"main-curry", "side-namul", "soup-miso"}},
+			},
+		},
+		{
+			name: "required recipes",
+			req:  Request{NumDays: 2, RecipeIDs: []string{"main-pasta", "unknown", "main-pasta"}},
+			want: []Day{
+				{Recipes: []string{"main-pasta", "side-namul", "soup-consomme"}},
+				{Recipes: []string{"unknown", "side-salad", "soup-miso"}},
+			},
+		},
+		{
+			name: "ingredients",
+			req:  Request{NumDays: 2, Ingredients: []string{"豆腐", "トマト"}},
+			want: []Day{
+				{Recipes: []string{"main-mapo", "side-salad", "soup-consomme"}},
+				{Recipes: []string{"main-curry", "side-namul", "soup-miso"}},
+			},
+		},
+		{
+			name: "budget",
+			req:  Request{NumDays: 2, Genres: []cookchatdb.RecipeGenre{cookchatdb.RecipeGenreWestern}, Budget: 1600},
+			want: []Day{
+				{Recipes: []string{"main-curry", "side-salad"}},
+				{Recipes: []string{"main-mapo", "side-namul", "soup-consomme"}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Plan(testRecipes(), tc.req)
+			if err != nil {
+				t.Fatalf("Plan() error = %v", err)
+			}
+			if !slices.EqualFunc(got, tc.want, func(a, b Day) bool { return slices.Equal(a.Recipes, b.Recipes) }) {
+				t.Errorf("Plan() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPlanNoRepeatedMains(t *testing.T) {
+	got, err := Plan(testRecipes(), Request{NumDays: 4})
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	m := mains(got)
+	slices.Sort(m)
+	if len(slices.Compact(m)) != 4 {
+		t.Errorf("Plan() repeated mains: %v", mains(got))
+	}
+}
+
+func TestPlanNotEnoughMains(t *testing.T) {
+	_, err := Plan(testRecipes(), Request{NumDays: 5})
+	if !errors.Is(err, ErrNotEnoughMains) {
+		t.Errorf("Plan() error = %v, want %v", err, ErrNotEnoughMains)
+	}
+}
+
+func TestPlanTooManyRecipes(t *testing.T) {
+	_, err := Plan(testRecipes(), Request{NumDays: 2, RecipeIDs: []string{"main-pasta", "unknown", "main-steak"}})
+	if !errors.Is(err, ErrTooManyRecipes) {
+		t.Errorf("Plan() error = %v, want %v", err, ErrTooManyRecipes)
+	}
+}
+
+func TestPlanRecipeNotFound(t *testing.T) {
+	_, err := Plan(testRecipes(), Request{NumDays: 2, RecipeIDs: []string{"main-pasta", "main-deleted"}})
+	if !errors.Is(err, ErrRecipeNotFound) {
+		t.Errorf("Plan() error = %v, want %v", err, ErrRecipeNotFound)
+	}
+}
+
+func TestPlanOverBudget(t *testing.T) {
+	_, err := Plan(testRecipes(), Request{NumDays: 2, Budget: 500})
+	if !errors.Is(err, ErrOverBudget) {
+		t.Errorf("Plan() error = %v, want %v", err, ErrOverBudget)
+	}
+}
+
+func TestPlanSeed(t *testing.T) {
+	req := Request{NumDays: 3, Seed: 42}
+	first, err := Plan(testRecipes(), req)
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	second, err := Plan(testRecipes(), req)
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	if !slices.EqualFunc(first, second, func(a, b Day) bool { return slices.Equal(a.Recipes, b.Recipes) }) {
+		t.Errorf("Plan() not deterministic for seed: %v, %v", first, second)
+	}
+}
+
+func TestAlternatives(t *testing.T) {
+	byID := map[string]Recipe{}
+	for _, r := range testRecipes() {
+		byID[r.ID] = r
+	}
+
+	tests := []struct {
+		name string
+		req  AlternativesRequest
+		want []string
+	}{
+		{
+			name: "shared ingredients",
+			req: AlternativesRequest{
+				Replaced:   byID["main-curry"],
+				DayRecipes: []Recipe{byID["side-salad"], byID["soup-miso"]},
+			},
+			want: []string{"main-mapo", "main-pasta", "main-steak"},
+		},
+		{
+			name: "genre",
+			req: AlternativesRequest{
+				Replaced: Recipe{ID: "main-new", Type: cookchatdb.RecipeTypeMainDish, Genre: cookchatdb.RecipeGenreWestern},
+			},
+			want: []string{"main-steak", "main-curry", "main-mapo", "main-pasta"},
+		},
+		{
+			name: "recent",
+			req: AlternativesRequest{
+				Replaced:        byID["main-curry"],
+				DayRecipes:      []Recipe{byID["side-salad"], byID["soup-miso"]},
+				RecentRecipeIDs: []string{"main-mapo"},
+			},
+			want: []string{"main-pasta", "main-steak", "main-mapo"},
+		},
+		{
+			name: "limit",
+			req: AlternativesRequest{
+				Replaced:   byID["main-curry"],
+				DayRecipes: []Recipe{byID["side-salad"], byID["soup-miso"]},
+				Limit:      1,
+			},
+			want: []string{"main-mapo"},
+		},
+		{
+			name: "day recipes excluded",
+			req: AlternativesRequest{
+				Replaced:   byID["soup-miso"],
+				DayRecipes: []Recipe{byID["main-curry"], byID["soup-consomme"]},
+			},
+			want: []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Alternatives(testRecipes(), tc.req)
+			ids := make([]string, len(got))
+			for i, r := range got {
+				ids[i] = r.ID
+			}
+			if !slices.Equal(ids, tc.want) {
+				t.Errorf("Alternatives() = %v, want %v", ids, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package planstore loads plans and their recipes for handlers. Plans in the trash are only
+// visible to the trash handlers, so everything else should load plans through Get.
+package planstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
+	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
+)
+
+// maxInValues is the maximum number of values Firestore allows in an in filter.
+const maxInValues = 30
+
+// ErrPlanNotFound is returned when a plan does not exist or is in the trash.
+var ErrPlanNotFound = errors.New("plan not found")
+
+// Get returns the plan with id in scope along with its snapshot. Plans in the trash are treated
+// as not existing.
+func Get(ctx context.Context, scope household.Scope, id string) (*firestore.DocumentSnapshot, cookchatdb.Plan, error) {
+	doc, err := scope.Plans().Doc(id).Get(ctx)
+	return decode(doc, err)
+}
+
+// GetInTransaction is Get within the transaction tx.
+func GetInTransaction(tx *firestore.Transaction, scope household.Scope, id string) (*firestore.DocumentSnapshot, cookchatdb.Plan, error) {
+	doc, err := tx.Get(scope.Plans().Doc(id))
+	return decode(doc, err)
+}
+
+func decode(doc *firestore.DocumentSnapshot, err error) (*firestore.DocumentSnapshot, cookchatdb.Plan, error) {
+	var plan cookchatdb.Plan
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, plan, connect.NewError(connect.CodeNotFound, ErrPlanNotFound)
+		}
+		return nil, plan, fmt.Errorf("planstore: fetching plan: %w", err)
+	}
+	if err := doc.DataTo(&plan); err != nil {
+		return nil, plan, fmt.Errorf("planstore: decoding plan: %w", err)
+	}
+	if !plan.DeletedAt.IsZero() {
+		return nil, plan, connect.NewError(connect.CodeNotFound, ErrPlanNotFound)
+	}
+	return doc, plan, nil
+}
+
+// Recipes returns the recipes of plans keyed by ID. Recipes that no longer exist are missing
+// from the result.
+func Recipes(ctx context.Context, store *firestore.Client, plans []cookchatdb.Plan) (map[string]cookchatdb.Recipe, error) {
+	var recipeIDs []string
+	for _, plan := range plans {
+		recipeIDs = append(recipeIDs, plan.Recipes...)
+	}
+
+	recipes := map[string]cookchatdb.Recipe{}
+	recipesCol := store.Collection("recipes")
+	for len(recipeIDs) > 0 {
+		batch := recipeIDs[:min(len(recipeIDs), maxInValues)]
+		recipeIDs = recipeIDs[len(batch):]
+		docs, err := recipesCol.Query.WhereEntity(firestore.PropertyFilter{
+			Path:     "id",
+			Operator: "in",
+			Value:    batch,
+		}).Documents(ctx).GetAll()
+		if err != nil {
+			return nil, fmt.Errorf("planstore: fetching recipes: %w", err)
+		}
+		for _, doc := range docs {
+			var recipe cookchatdb.Recipe
+			if err := doc.DataTo(&recipe); err != nil {
+				return nil, fmt.Errorf("planstore: decoding recipe: %w", err)
+			}
+			recipes[recipe.ID] = recipe
+		}
+	}
+	return recipes, nil
+}
+
+// Snippet returns the snippet of plan with its recipes in recipes, which don't include recipes
+// missing from it. Dates of plans without a stored local date are computed in loc.
+func Snippet(plan *cookchatdb.Plan, recipes map[string]cookchatdb.Recipe, loc *time.Location) *frontendapi.PlanSnippet {
+	snippet := &frontendapi.PlanSnippet{
+		Id:        plan.ID,
+		Date:      timestamppb.New(plan.ScheduledAt),
+		LocalDate: plan.Date,
+	}
+	if snippet.LocalDate == "" {
+		// Plans created before dates were stored.
+		snippet.LocalDate = plan.ScheduledAt.In(loc).Format(time.DateOnly)
+	}
+	switch plan.Type {
+	case cookchatdb.PlanTypeBatchPrep:
+		snippet.Type = frontendapi.PlanType_PLAN_TYPE_BATCH_PREP
+	case cookchatdb.PlanTypeLeftovers:
+		snippet.Type = frontendapi.PlanType_PLAN_TYPE_LEFTOVERS
+	case cookchatdb.PlanTypeDaily, "":
+		snippet.Type = frontendapi.PlanType_PLAN_TYPE_DAILY
+	}
+	switch plan.Status {
+	case cookchatdb.PlanStatusProcessing:
+		snippet.Status = frontendapi.PlanStatus_PLAN_STATUS_PROCESSING
+	case cookchatdb.PlanStatusActive:
+		snippet.Status = frontendapi.PlanStatus_PLAN_STATUS_ACTIVE
+	case cookchatdb.PlanStatusFailed:
+		snippet.Status = frontendapi.PlanStatus_PLAN_STATUS_FAILED
+	}
+	for _, recipeID := range plan.Recipes {
+		recipe, ok := recipes[recipeID]
+		if !ok {
+			continue
+		}
+		snippet.Recipes = append(snippet.Recipes, &frontendapi.RecipeSnippet{
+			Id:       recipe.ID,
+			Title:    recipe.Content.Title,
+			Summary:  recipe.Content.Description,
+			ImageUrl: recipe.ImageURL,
+		})
+	}
+	return snippet
+}
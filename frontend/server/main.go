@@ -13,34 +13,69 @@ import (
 	"os"
 	"slices"
 	"strings"
+	"time"
 
 	cloudtasks "cloud.google.com/go/cloudtasks/apiv2"
 	discoveryengine "cloud.google.com/go/discoveryengine/apiv1"
 	"cloud.google.com/go/storage"
+	"connectrpc.com/connect"
 	firebase "firebase.google.com/go/v4"
 	"github.com/curioswitch/go-curiostack/server"
 	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/openai/openai-go/v3"
 	"google.golang.org/genai"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/curioswitch/cookchat/common/pricing"
 	frontendapi "github.com/curioswitch/cookchat/frontend/api/go"
 	"github.com/curioswitch/cookchat/frontend/api/go/frontendapiconnect"
 	"github.com/curioswitch/cookchat/frontend/server/internal/config"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/accepthouseholdinvitation"
 	"github.com/curioswitch/cookchat/frontend/server/internal/handler/addbookmark"
 	"github.com/curioswitch/cookchat/frontend/server/internal/handler/addrecipe"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/addshoppinglistentry"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/applyplantemplate"
 	"github.com/curioswitch/cookchat/frontend/server/internal/handler/chatplan"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/createhousehold"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/createhouseholdinvitation"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/deleteingredientprice"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/deletepantryitem"
 	"github.com/curioswitch/cookchat/frontend/server/internal/handler/deleteplan"
 	"github.com/curioswitch/cookchat/frontend/server/internal/handler/generateplan"
 	"github.com/curioswitch/cookchat/frontend/server/internal/handler/generaterecipe"
 	"github.com/curioswitch/cookchat/frontend/server/internal/handler/getchatmessages"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/gethousehold"
 	"github.com/curioswitch/cookchat/frontend/server/internal/handler/getplan"
 	"github.com/curioswitch/cookchat/frontend/server/internal/handler/getplans"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/getplantimeline"
 	"github.com/curioswitch/cookchat/frontend/server/internal/handler/getrecipe"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/getsharedplan"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/getshoppinglist"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/getusersettings"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/listcookinghistory"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/listdeletedplans"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/listingredientprices"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/listpantryitems"
 	"github.com/curioswitch/cookchat/frontend/server/internal/handler/listrecipes"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/markcooked"
 	"github.com/curioswitch/cookchat/frontend/server/internal/handler/removebookmark"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/removehouseholdmember"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/removeshoppinglistentry"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/replaceplanrecipe"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/restoreplan"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/retryplan"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/revokeshareableplanlink"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/saveplantemplate"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/setingredientprice"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/setpantryitem"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/shareableplanlink"
 	"github.com/curioswitch/cookchat/frontend/server/internal/handler/startchat"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/suggestalternatives"
 	"github.com/curioswitch/cookchat/frontend/server/internal/handler/updateplan"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/updateshoppinglistingredient"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/updateusersettings"
+	"github.com/curioswitch/cookchat/frontend/server/internal/handler/watchplan"
 	"github.com/curioswitch/cookchat/frontend/server/internal/i18n"
 )
 
@@ -142,6 +177,9 @@ func setupServer(ctx context.Context, conf *config.Config, s *server.Server) err
 		switch {
 		case strings.HasPrefix(r.URL.Path, "/internal/"):
 			return false
+		// Shared plans can be viewed by anyone with the link without signing in.
+		case r.URL.Path == frontendapiconnect.FrontendServiceGetSharedPlanProcedure:
+			return false
 		default:
 			return true
 		}
@@ -204,6 +242,12 @@ func setupServer(ctx context.Context, conf *config.Config, s *server.Server) err
 					frontendapi.RecipeGenre_RECIPE_GENRE_JAPANESE,
 					frontendapi.RecipeGenre_RECIPE_GENRE_ITALIAN,
 				},
+				WeeklyBudget: 10000,
+			},
+			{
+				NumDays:      4,
+				Ingredients:  []string{"chicken", "carrots"},
+				BatchCooking: true,
 			},
 		})
 
@@ -229,13 +273,37 @@ func setupServer(ctx context.Context, conf *config.Config, s *server.Server) err
 			},
 		})
 
+	// Prices are read for every plan but rarely change.
+	prices := pricing.NewCache(time.Minute)
+
+	// GetPlan is also used by handlers that return the full plan.
+	getPlan := getplan.NewHandler(firestore, prices)
+
 	server.HandleConnectUnary(s,
 		frontendapiconnect.FrontendServiceUpdatePlanProcedure,
-		updateplan.NewHandler(genAI, firestore).UpdatePlan,
+		updateplan.NewHandler(genAI, firestore, getPlan.GetPlan).UpdatePlan,
 		[]*frontendapi.UpdatePlanRequest{
 			{},
 		})
 
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceSuggestAlternativesProcedure,
+		suggestalternatives.NewHandler(firestore).SuggestAlternatives,
+		[]*frontendapi.SuggestAlternativesRequest{
+			{
+				PlanId: "9dtuoh4be12Otv8cevrM",
+			},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceReplacePlanRecipeProcedure,
+		replaceplanrecipe.NewHandler(firestore, tasks, conf.Tasks).ReplacePlanRecipe,
+		[]*frontendapi.ReplacePlanRecipeRequest{
+			{
+				PlanId: "9dtuoh4be12Otv8cevrM",
+			},
+		})
+
 	server.HandleConnectUnary(s,
 		frontendapiconnect.FrontendServiceDeletePlanProcedure,
 		deleteplan.NewHandler(genAI, firestore).DeletePlan,
@@ -243,6 +311,31 @@ func setupServer(ctx context.Context, conf *config.Config, s *server.Server) err
 			{},
 		})
 
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceRestorePlanProcedure,
+		restoreplan.NewHandler(firestore).RestorePlan,
+		[]*frontendapi.RestorePlanRequest{
+			{
+				PlanId: "9dtuoh4be12Otv8cevrM",
+			},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceListDeletedPlansProcedure,
+		listdeletedplans.NewHandler(firestore).ListDeletedPlans,
+		[]*frontendapi.ListDeletedPlansRequest{
+			{},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceRetryPlanProcedure,
+		retryplan.NewHandler(firestore, tasks, conf.Tasks).RetryPlan,
+		[]*frontendapi.RetryPlanRequest{
+			{
+				PlanId: "9dtuoh4be12Otv8cevrM",
+			},
+		})
+
 	server.HandleConnectUnary(s,
 		frontendapiconnect.FrontendServiceGetPlansProcedure,
 		getplans.NewHandler(firestore).GetPlans,
@@ -252,11 +345,50 @@ func setupServer(ctx context.Context, conf *config.Config, s *server.Server) err
 
 	server.HandleConnectUnary(s,
 		frontendapiconnect.FrontendServiceGetPlanProcedure,
-		getplan.NewHandler(firestore).GetPlan,
+		getPlan.GetPlan,
 		[]*frontendapi.GetPlanRequest{
 			{},
 		})
 
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceShareablePlanLinkProcedure,
+		shareableplanlink.NewHandler(firestore).ShareablePlanLink,
+		[]*frontendapi.ShareablePlanLinkRequest{
+			{
+				PlanId: "9dtuoh4be12Otv8cevrM",
+			},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceRevokeShareablePlanLinkProcedure,
+		revokeshareableplanlink.NewHandler(firestore).RevokeShareablePlanLink,
+		[]*frontendapi.RevokeShareablePlanLinkRequest{
+			{},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceGetSharedPlanProcedure,
+		getsharedplan.NewHandler(firestore, getPlan.PlanFromSnapshot).GetSharedPlan,
+		[]*frontendapi.GetSharedPlanRequest{
+			{},
+		})
+
+	// Streaming handlers are registered on the mux directly since there is no helper for them.
+	mux.Handle(frontendapiconnect.FrontendServiceWatchPlanProcedure, connect.NewServerStreamHandler(
+		frontendapiconnect.FrontendServiceWatchPlanProcedure,
+		watchplan.NewHandler(firestore, watchplan.NewFirestoreFeed(firestore), getPlan.GetPlan).WatchPlan,
+	))
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceGetPlanTimelineProcedure,
+		getplantimeline.NewHandler(firestore).GetPlanTimeline,
+		[]*frontendapi.GetPlanTimelineRequest{
+			{
+				ServeAt:  timestamppb.New(time.Date(2025, 1, 1, 19, 0, 0, 0, time.UTC)),
+				TimeZone: "Asia/Tokyo",
+			},
+		})
+
 	server.HandleConnectUnary(s,
 		frontendapiconnect.FrontendServiceAddRecipeProcedure,
 		addrecipe.NewHandler(firestore, storage, publicBucket).AddRecipe,
@@ -327,6 +459,124 @@ func setupServer(ctx context.Context, conf *config.Config, s *server.Server) err
 			},
 		})
 
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceSavePlanTemplateProcedure,
+		saveplantemplate.NewHandler(firestore).SavePlanTemplate,
+		[]*frontendapi.SavePlanTemplateRequest{
+			{
+				Name: "いつもの一週間",
+				Slots: []*frontendapi.PlanTemplateSlot{
+					{
+						DayOfWeek: frontendapi.DayOfWeek_DAY_OF_WEEK_FRIDAY,
+						RecipeIds: []string{"02JNMi0W1605TLxzQt6v"},
+					},
+				},
+			},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceApplyPlanTemplateProcedure,
+		applyplantemplate.NewHandler(firestore, tasks, conf.Tasks).ApplyPlanTemplate,
+		[]*frontendapi.ApplyPlanTemplateRequest{
+			{
+				TemplateId: "Yx2Vb8nQ4LwP0cR7tKs1",
+				WeekStart:  timestamppb.New(time.Date(2025, 7, 7, 0, 0, 0, 0, time.UTC)),
+				TimeZone:   "Asia/Tokyo",
+			},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceListIngredientPricesProcedure,
+		listingredientprices.NewHandler(firestore).ListIngredientPrices,
+		[]*frontendapi.ListIngredientPricesRequest{
+			{},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceSetIngredientPriceProcedure,
+		setingredientprice.NewHandler(firestore, prices).SetIngredientPrice,
+		[]*frontendapi.SetIngredientPriceRequest{
+			{
+				Name: "鶏もも肉",
+				Unit: "g",
+				Yen:  1.2,
+			},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceDeleteIngredientPriceProcedure,
+		deleteingredientprice.NewHandler(firestore, prices).DeleteIngredientPrice,
+		[]*frontendapi.DeleteIngredientPriceRequest{
+			{
+				Name: "鶏もも肉",
+				Unit: "g",
+			},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceMarkCookedProcedure,
+		markcooked.NewHandler(firestore).MarkCooked,
+		[]*frontendapi.MarkCookedRequest{
+			{
+				Target: &frontendapi.MarkCookedRequest_RecipeId{
+					RecipeId: "02JNMi0W1605TLxzQt6v",
+				},
+				Rating:   5,
+				Servings: 2,
+				Notes:    "Added more garlic.",
+			},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceListCookingHistoryProcedure,
+		listcookinghistory.NewHandler(firestore).ListCookingHistory,
+		[]*frontendapi.ListCookingHistoryRequest{
+			{},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceCreateHouseholdProcedure,
+		createhousehold.NewHandler(firestore).CreateHousehold,
+		[]*frontendapi.CreateHouseholdRequest{
+			{
+				Name: "山田家",
+			},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceGetHouseholdProcedure,
+		gethousehold.NewHandler(firestore).GetHousehold,
+		[]*frontendapi.GetHouseholdRequest{
+			{},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceCreateHouseholdInvitationProcedure,
+		createhouseholdinvitation.NewHandler(firestore).CreateHouseholdInvitation,
+		[]*frontendapi.CreateHouseholdInvitationRequest{
+			{
+				Role: frontendapi.HouseholdRole_HOUSEHOLD_ROLE_EDITOR,
+			},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceAcceptHouseholdInvitationProcedure,
+		accepthouseholdinvitation.NewHandler(firestore).AcceptHouseholdInvitation,
+		[]*frontendapi.AcceptHouseholdInvitationRequest{
+			{
+				InvitationId: "9f8e7d6c5b4a39281706",
+			},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceRemoveHouseholdMemberProcedure,
+		removehouseholdmember.NewHandler(firestore).RemoveHouseholdMember,
+		[]*frontendapi.RemoveHouseholdMemberRequest{
+			{
+				UserId: "kR3xT9vLmQ2wYp8sN4bJ6hF1dC5a",
+			},
+		})
+
 	server.HandleConnectUnary(s,
 		frontendapiconnect.FrontendServiceAddBookmarkProcedure,
 		addbookmark.NewHandler(firestore).AddBookmark,
@@ -345,6 +595,97 @@ func setupServer(ctx context.Context, conf *config.Config, s *server.Server) err
 			},
 		})
 
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceGetShoppingListProcedure,
+		getshoppinglist.NewHandler(firestore).GetShoppingList,
+		[]*frontendapi.GetShoppingListRequest{
+			{},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceAddShoppingListEntryProcedure,
+		addshoppinglistentry.NewHandler(firestore).AddShoppingListEntry,
+		[]*frontendapi.AddShoppingListEntryRequest{
+			{
+				RecipeId:    "02JNMi0W1605TLxzQt6v",
+				Title:       "鶏の照り焼き",
+				ServingSize: "2人分",
+				Ingredients: []*frontendapi.ShoppingListIngredient{
+					{
+						Name:     "鶏もも肉",
+						Quantity: "300g",
+					},
+				},
+			},
+			{
+				Title: "牛乳",
+			},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceUpdateShoppingListIngredientProcedure,
+		updateshoppinglistingredient.NewHandler(firestore).UpdateShoppingListIngredient,
+		[]*frontendapi.UpdateShoppingListIngredientRequest{
+			{
+				EntryId:         "recipe-02JNMi0W1605TLxzQt6v",
+				IngredientIndex: 0,
+				Selected:        true,
+			},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceRemoveShoppingListEntryProcedure,
+		removeshoppinglistentry.NewHandler(firestore).RemoveShoppingListEntry,
+		[]*frontendapi.RemoveShoppingListEntryRequest{
+			{
+				EntryId: "recipe-02JNMi0W1605TLxzQt6v",
+			},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceListPantryItemsProcedure,
+		listpantryitems.NewHandler(firestore).ListPantryItems,
+		[]*frontendapi.ListPantryItemsRequest{
+			{},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceSetPantryItemProcedure,
+		setpantryitem.NewHandler(firestore).SetPantryItem,
+		[]*frontendapi.SetPantryItemRequest{
+			{
+				Name:     "玉ねぎ",
+				Quantity: "3個",
+			},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceDeletePantryItemProcedure,
+		deletepantryitem.NewHandler(firestore).DeletePantryItem,
+		[]*frontendapi.DeletePantryItemRequest{
+			{
+				Name: "玉ねぎ",
+			},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceGetUserSettingsProcedure,
+		getusersettings.NewHandler(firestore).GetUserSettings,
+		[]*frontendapi.GetUserSettingsRequest{
+			{},
+		})
+
+	server.HandleConnectUnary(s,
+		frontendapiconnect.FrontendServiceUpdateUserSettingsProcedure,
+		updateusersettings.NewHandler(firestore).UpdateUserSettings,
+		[]*frontendapi.UpdateUserSettingsRequest{
+			{
+				Settings: &frontendapi.UserSettings{
+					TimeZone: "Asia/Tokyo",
+				},
+			},
+		})
+
 	server.EnableDocsFirebaseAuth(s, "alpha.cookchat.curioswitch.org")
 
 	if err := server.Start(ctx, s); err != nil {
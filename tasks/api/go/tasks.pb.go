@@ -7,10 +7,8 @@
 package tasksapi
 
 import (
-	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -104,16 +102,197 @@ func (*FillPlanResponse) Descriptor() ([]byte, []int) {
 	return file_tasksapi_tasks_proto_rawDescGZIP(), []int{1}
 }
 
+type SweepStuckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SweepStuckRequest) Reset() {
+	*x = SweepStuckRequest{}
+	mi := &file_tasksapi_tasks_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SweepStuckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SweepStuckRequest) ProtoMessage() {}
+
+func (x *SweepStuckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tasksapi_tasks_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SweepStuckRequest.ProtoReflect.Descriptor instead.
+func (*SweepStuckRequest) Descriptor() ([]byte, []int) {
+	return file_tasksapi_tasks_proto_rawDescGZIP(), []int{2}
+}
+
+type SweepStuckResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of plans marked failed.
+	FailedPlans uint32 `protobuf:"varint,1,opt,name=failed_plans,json=failedPlans,proto3" json:"failed_plans,omitempty"`
+	// The number of recipes marked failed.
+	FailedRecipes uint32 `protobuf:"varint,2,opt,name=failed_recipes,json=failedRecipes,proto3" json:"failed_recipes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SweepStuckResponse) Reset() {
+	*x = SweepStuckResponse{}
+	mi := &file_tasksapi_tasks_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SweepStuckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SweepStuckResponse) ProtoMessage() {}
+
+func (x *SweepStuckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tasksapi_tasks_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SweepStuckResponse.ProtoReflect.Descriptor instead.
+func (*SweepStuckResponse) Descriptor() ([]byte, []int) {
+	return file_tasksapi_tasks_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SweepStuckResponse) GetFailedPlans() uint32 {
+	if x != nil {
+		return x.FailedPlans
+	}
+	return 0
+}
+
+func (x *SweepStuckResponse) GetFailedRecipes() uint32 {
+	if x != nil {
+		return x.FailedRecipes
+	}
+	return 0
+}
+
+type PurgeDeletedPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDeletedPlansRequest) Reset() {
+	*x = PurgeDeletedPlansRequest{}
+	mi := &file_tasksapi_tasks_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeletedPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeletedPlansRequest) ProtoMessage() {}
+
+func (x *PurgeDeletedPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tasksapi_tasks_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeletedPlansRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDeletedPlansRequest) Descriptor() ([]byte, []int) {
+	return file_tasksapi_tasks_proto_rawDescGZIP(), []int{4}
+}
+
+type PurgeDeletedPlansResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of plans permanently deleted.
+	PurgedPlans   uint32 `protobuf:"varint,1,opt,name=purged_plans,json=purgedPlans,proto3" json:"purged_plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDeletedPlansResponse) Reset() {
+	*x = PurgeDeletedPlansResponse{}
+	mi := &file_tasksapi_tasks_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeletedPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeletedPlansResponse) ProtoMessage() {}
+
+func (x *PurgeDeletedPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tasksapi_tasks_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeletedPlansResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDeletedPlansResponse) Descriptor() ([]byte, []int) {
+	return file_tasksapi_tasks_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PurgeDeletedPlansResponse) GetPurgedPlans() uint32 {
+	if x != nil {
+		return x.PurgedPlans
+	}
+	return 0
+}
+
 var File_tasksapi_tasks_proto protoreflect.FileDescriptor
 
 const file_tasksapi_tasks_proto_rawDesc = "" +
 	"\n" +
-	"\x14tasksapi/tasks.proto\x12\btasksapi\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"*\n" +
+	"\x14tasksapi/tasks.proto\x12\btasksapi\"*\n" +
 	"\x0fFillPlanRequest\x12\x17\n" +
 	"\aplan_id\x18\x01 \x01(\tR\x06planId\"\x12\n" +
-	"\x10FillPlanResponse2Q\n" +
+	"\x10FillPlanResponse\"\x13\n" +
+	"\x11SweepStuckRequest\"^\n" +
+	"\x12SweepStuckResponse\x12!\n" +
+	"\ffailed_plans\x18\x01 \x01(\rR\vfailedPlans\x12%\n" +
+	"\x0efailed_recipes\x18\x02 \x01(\rR\rfailedRecipes\"\x1a\n" +
+	"\x18PurgeDeletedPlansRequest\">\n" +
+	"\x19PurgeDeletedPlansResponse\x12!\n" +
+	"\fpurged_plans\x18\x01 \x01(\rR\vpurgedPlans2\xf8\x01\n" +
 	"\fTasksService\x12A\n" +
-	"\bFillPlan\x12\x19.tasksapi.FillPlanRequest\x1a\x1a.tasksapi.FillPlanResponseB7Z5github.com/curioswitch/cookchat/tasks/api/go;tasksapib\x06proto3"
+	"\bFillPlan\x12\x19.tasksapi.FillPlanRequest\x1a\x1a.tasksapi.FillPlanResponse\x12G\n" +
+	"\n" +
+	"SweepStuck\x12\x1b.tasksapi.SweepStuckRequest\x1a\x1c.tasksapi.SweepStuckResponse\x12\\\n" +
+	"\x11PurgeDeletedPlans\x12\".tasksapi.PurgeDeletedPlansRequest\x1a#.tasksapi.PurgeDeletedPlansResponseB7Z5github.com/curioswitch/cookchat/tasks/api/go;tasksapib\x06proto3"
 
 var (
 	file_tasksapi_tasks_proto_rawDescOnce sync.Once
@@ -127,16 +306,24 @@ func file_tasksapi_tasks_proto_rawDescGZIP() []byte {
 	return file_tasksapi_tasks_proto_rawDescData
 }
 
-var file_tasksapi_tasks_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_tasksapi_tasks_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_tasksapi_tasks_proto_goTypes = []any{
-	(*FillPlanRequest)(nil),  // 0: tasksapi.FillPlanRequest
-	(*FillPlanResponse)(nil), // 1: tasksapi.FillPlanResponse
+	(*FillPlanRequest)(nil),           // 0: tasksapi.FillPlanRequest
+	(*FillPlanResponse)(nil),          // 1: tasksapi.FillPlanResponse
+	(*SweepStuckRequest)(nil),         // 2: tasksapi.SweepStuckRequest
+	(*SweepStuckResponse)(nil),        // 3: tasksapi.SweepStuckResponse
+	(*PurgeDeletedPlansRequest)(nil),  // 4: tasksapi.PurgeDeletedPlansRequest
+	(*PurgeDeletedPlansResponse)(nil), // 5: tasksapi.PurgeDeletedPlansResponse
 }
 var file_tasksapi_tasks_proto_depIdxs = []int32{
 	0, // 0: tasksapi.TasksService.FillPlan:input_type -> tasksapi.FillPlanRequest
-	1, // 1: tasksapi.TasksService.FillPlan:output_type -> tasksapi.FillPlanResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
+	2, // 1: tasksapi.TasksService.SweepStuck:input_type -> tasksapi.SweepStuckRequest
+	4, // 2: tasksapi.TasksService.PurgeDeletedPlans:input_type -> tasksapi.PurgeDeletedPlansRequest
+	1, // 3: tasksapi.TasksService.FillPlan:output_type -> tasksapi.FillPlanResponse
+	3, // 4: tasksapi.TasksService.SweepStuck:output_type -> tasksapi.SweepStuckResponse
+	5, // 5: tasksapi.TasksService.PurgeDeletedPlans:output_type -> tasksapi.PurgeDeletedPlansResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -153,7 +340,7 @@ func file_tasksapi_tasks_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tasksapi_tasks_proto_rawDesc), len(file_tasksapi_tasks_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -35,12 +35,23 @@ const (
 const (
 	// TasksServiceFillPlanProcedure is the fully-qualified name of the TasksService's FillPlan RPC.
 	TasksServiceFillPlanProcedure = "/tasksapi.TasksService/FillPlan"
+	// TasksServiceSweepStuckProcedure is the fully-qualified name of the TasksService's SweepStuck RPC.
+	TasksServiceSweepStuckProcedure = "/tasksapi.TasksService/SweepStuck"
+	// TasksServicePurgeDeletedPlansProcedure is the fully-qualified name of the TasksService's
+	// PurgeDeletedPlans RPC.
+	TasksServicePurgeDeletedPlansProcedure = "/tasksapi.TasksService/PurgeDeletedPlans"
 )
 
 // TasksServiceClient is a client for the tasksapi.TasksService service.
 type TasksServiceClient interface {
 	// Fill details of a plan.
 	FillPlan(context.Context, *connect.Request[_go.FillPlanRequest]) (*connect.Response[_go.FillPlanResponse], error)
+	// Mark plans and recipes that have been processing for too long as failed.
+	// Called periodically by a scheduler rather than by users.
+	SweepStuck(context.Context, *connect.Request[_go.SweepStuckRequest]) (*connect.Response[_go.SweepStuckResponse], error)
+	// Permanently delete plans that have been in the trash past the retention period.
+	// Called periodically by a scheduler rather than by users.
+	PurgeDeletedPlans(context.Context, *connect.Request[_go.PurgeDeletedPlansRequest]) (*connect.Response[_go.PurgeDeletedPlansResponse], error)
 }
 
 // NewTasksServiceClient constructs a client for the tasksapi.TasksService service. By default, it
@@ -60,12 +71,26 @@ func NewTasksServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(tasksServiceMethods.ByName("FillPlan")),
 			connect.WithClientOptions(opts...),
 		),
+		sweepStuck: connect.NewClient[_go.SweepStuckRequest, _go.SweepStuckResponse](
+			httpClient,
+			baseURL+TasksServiceSweepStuckProcedure,
+			connect.WithSchema(tasksServiceMethods.ByName("SweepStuck")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeDeletedPlans: connect.NewClient[_go.PurgeDeletedPlansRequest, _go.PurgeDeletedPlansResponse](
+			httpClient,
+			baseURL+TasksServicePurgeDeletedPlansProcedure,
+			connect.WithSchema(tasksServiceMethods.ByName("PurgeDeletedPlans")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // tasksServiceClient implements TasksServiceClient.
 type tasksServiceClient struct {
-	fillPlan *connect.Client[_go.FillPlanRequest, _go.FillPlanResponse]
+	fillPlan          *connect.Client[_go.FillPlanRequest, _go.FillPlanResponse]
+	sweepStuck        *connect.Client[_go.SweepStuckRequest, _go.SweepStuckResponse]
+	purgeDeletedPlans *connect.Client[_go.PurgeDeletedPlansRequest, _go.PurgeDeletedPlansResponse]
 }
 
 // FillPlan calls tasksapi.TasksService.FillPlan.
@@ -73,10 +98,26 @@ func (c *tasksServiceClient) FillPlan(ctx context.Context, req *connect.Request[
 	return c.fillPlan.CallUnary(ctx, req)
 }
 
+// SweepStuck calls tasksapi.TasksService.SweepStuck.
+func (c *tasksServiceClient) SweepStuck(ctx context.Context, req *connect.Request[_go.SweepStuckRequest]) (*connect.Response[_go.SweepStuckResponse], error) {
+	return c.sweepStuck.CallUnary(ctx, req)
+}
+
+// PurgeDeletedPlans calls tasksapi.TasksService.PurgeDeletedPlans.
+func (c *tasksServiceClient) PurgeDeletedPlans(ctx context.Context, req *connect.Request[_go.PurgeDeletedPlansRequest]) (*connect.Response[_go.PurgeDeletedPlansResponse], error) {
+	return c.purgeDeletedPlans.CallUnary(ctx, req)
+}
+
 // TasksServiceHandler is an implementation of the tasksapi.TasksService service.
 type TasksServiceHandler interface {
 	// Fill details of a plan.
 	FillPlan(context.Context, *connect.Request[_go.FillPlanRequest]) (*connect.Response[_go.FillPlanResponse], error)
+	// Mark plans and recipes that have been processing for too long as failed.
+	// Called periodically by a scheduler rather than by users.
+	SweepStuck(context.Context, *connect.Request[_go.SweepStuckRequest]) (*connect.Response[_go.SweepStuckResponse], error)
+	// Permanently delete plans that have been in the trash past the retention period.
+	// Called periodically by a scheduler rather than by users.
+	PurgeDeletedPlans(context.Context, *connect.Request[_go.PurgeDeletedPlansRequest]) (*connect.Response[_go.PurgeDeletedPlansResponse], error)
 }
 
 // NewTasksServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -92,10 +133,26 @@ func NewTasksServiceHandler(svc TasksServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(tasksServiceMethods.ByName("FillPlan")),
 		connect.WithHandlerOptions(opts...),
 	)
+	tasksServiceSweepStuckHandler := connect.NewUnaryHandler(
+		TasksServiceSweepStuckProcedure,
+		svc.SweepStuck,
+		connect.WithSchema(tasksServiceMethods.ByName("SweepStuck")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tasksServicePurgeDeletedPlansHandler := connect.NewUnaryHandler(
+		TasksServicePurgeDeletedPlansProcedure,
+		svc.PurgeDeletedPlans,
+		connect.WithSchema(tasksServiceMethods.ByName("PurgeDeletedPlans")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/tasksapi.TasksService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TasksServiceFillPlanProcedure:
 			tasksServiceFillPlanHandler.ServeHTTP(w, r)
+		case TasksServiceSweepStuckProcedure:
+			tasksServiceSweepStuckHandler.ServeHTTP(w, r)
+		case TasksServicePurgeDeletedPlansProcedure:
+			tasksServicePurgeDeletedPlansHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -108,3 +165,11 @@ type UnimplementedTasksServiceHandler struct{}
 func (UnimplementedTasksServiceHandler) FillPlan(context.Context, *connect.Request[_go.FillPlanRequest]) (*connect.Response[_go.FillPlanResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tasksapi.TasksService.FillPlan is not implemented"))
 }
+
+func (UnimplementedTasksServiceHandler) SweepStuck(context.Context, *connect.Request[_go.SweepStuckRequest]) (*connect.Response[_go.SweepStuckResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tasksapi.TasksService.SweepStuck is not implemented"))
+}
+
+func (UnimplementedTasksServiceHandler) PurgeDeletedPlans(context.Context, *connect.Request[_go.PurgeDeletedPlansRequest]) (*connect.Response[_go.PurgeDeletedPlansResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tasksapi.TasksService.PurgeDeletedPlans is not implemented"))
+}
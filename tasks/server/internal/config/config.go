@@ -7,6 +7,17 @@ import (
 	"github.com/curioswitch/go-curiostack/config"
 )
 
+// Scheduler contains the configuration for jobs run by Cloud Scheduler.
+type Scheduler struct {
+	// Audience is the audience of the ID tokens sent by Cloud Scheduler, usually the URL of the server.
+	Audience string `koanf:"audience"`
+	// Invoker is the email of the service account Cloud Scheduler jobs run as.
+	Invoker string `koanf:"invoker"`
+}
+
 type Config struct {
 	config.Common
+
+	// Scheduler is the configuration for jobs run by Cloud Scheduler.
+	Scheduler Scheduler `koanf:"scheduler"`
 }
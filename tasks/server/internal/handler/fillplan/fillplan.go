@@ -6,7 +6,10 @@ package fillplan
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
+	"slices"
 
 	firestore "cloud.google.com/go/firestore"
 	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
@@ -14,11 +17,28 @@ import (
 	"google.golang.org/genai"
 
 	"github.com/curioswitch/cookchat/common/cookchatdb"
+	"github.com/curioswitch/cookchat/common/household"
 	"github.com/curioswitch/cookchat/common/recipegen"
 	tasksapi "github.com/curioswitch/cookchat/tasks/api/go"
 	"github.com/curioswitch/cookchat/tasks/server/internal/llm"
 )
 
+const (
+	// reheatMinutes is the estimated time to reheat a dish in a leftovers plan.
+	reheatMinutes = 5
+
+	// maxAttempts is the number of failed attempts after which a plan or recipe is marked
+	// failed and the task is no longer retried.
+	maxAttempts = 3
+)
+
+var (
+	errBatchPlanNotReady = errors.New("fillplan: batch prep plan not yet filled")
+	errBatchPlanFailed   = errors.New("fillplan: batch prep plan failed")
+	errPlanTrashed       = errors.New("fillplan: plan trashed while filling")
+	errPlanChanged       = errors.New("fillplan: plan recipes changed while filling")
+)
+
 func NewHandler(store *firestore.Client, genAI *genai.Client, processor *recipegen.PostProcessor) *Handler {
 	return &Handler{
 		store:     store,
@@ -36,7 +56,12 @@ type Handler struct {
 func (h *Handler) FillPlan(ctx context.Context, req *tasksapi.FillPlanRequest) (*tasksapi.FillPlanResponse, error) {
 	userID := firebaseauth.TokenFromContext(ctx).UID
 
-	plansCol := h.store.Collection("users").Doc(userID).Collection("plans")
+	scope, err := household.Resolve(ctx, h.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("fillplan: resolving household: %w", err)
+	}
+
+	plansCol := scope.Plans()
 	planDoc, err := plansCol.Doc(req.GetPlanId()).Get(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("fillplan: getting plan doc: %w", err)
@@ -46,6 +71,34 @@ func (h *Handler) FillPlan(ctx context.Context, req *tasksapi.FillPlanRequest) (
 	if err := planDoc.DataTo(&plan); err != nil {
 		return nil, fmt.Errorf("fillplan: parsing plan doc: %w", err)
 	}
+	attempts := plan.Attempts
+
+	if err := h.fillPlan(ctx, plansCol, planDoc.Ref, &plan); err != nil {
+		if errors.Is(err, errBatchPlanNotReady) {
+			return nil, err
+		}
+		if recordFailure(ctx, planDoc.Ref, attempts, err, string(cookchatdb.PlanStatusFailed)) {
+			// Stop retrying the task, the user can retry the plan with RetryPlan.
+			slog.ErrorContext(ctx, "fillplan: plan failed", "planId", plan.ID, "error", err)
+			return &tasksapi.FillPlanResponse{}, nil
+		}
+		return nil, err
+	}
+
+	return &tasksapi.FillPlanResponse{}, nil
+}
+
+func (h *Handler) fillPlan(ctx context.Context, plansCol *firestore.CollectionRef, planRef *firestore.DocumentRef, plan *cookchatdb.Plan) error {
+	filledRecipes := slices.Clone(plan.Recipes)
+
+	if plan.Type == cookchatdb.PlanTypeLeftovers {
+		if err := h.fillLeftoversPlan(ctx, plansCol, plan); err != nil {
+			return err
+		}
+		return h.savePlan(ctx, planRef, filledRecipes, plan)
+	}
+
+	progress := newProgressTracker(ctx, planRef)
 
 	var grp errgroup.Group
 
@@ -61,10 +114,16 @@ func (h *Handler) FillPlan(ctx context.Context, req *tasksapi.FillPlanRequest) (
 			if err := recipeDoc.DataTo(&recipe); err != nil {
 				return fmt.Errorf("fillplan: parsing recipe doc: %w", err)
 			}
-			if err := h.processor.PostProcessRecipe(ctx, &recipe); err != nil {
-				return fmt.Errorf("fillplan: post processing recipe: %w", err)
+			if err := h.processor.PostProcessRecipe(ctx, &recipe, func(stage cookchatdb.ProgressStage, done int, total int) {
+				progress.report(ctx, recipeID, stage, done, total)
+			}); err != nil {
+				err = fmt.Errorf("fillplan: post processing recipe: %w", err)
+				recordFailure(ctx, recipeDoc.Ref, recipe.Attempts, err, string(cookchatdb.RecipeStatusFailed))
+				return err
 			}
 			recipe.Status = cookchatdb.RecipeStatusActive
+			recipe.FailureReason = ""
+			recipe.Attempts = 0
 			if _, err := recipeDoc.Ref.Set(ctx, recipe); err != nil {
 				return fmt.Errorf("fillplan: updating recipe doc: %w", err)
 			}
@@ -72,8 +131,11 @@ func (h *Handler) FillPlan(ctx context.Context, req *tasksapi.FillPlanRequest) (
 			return nil
 		})
 	}
-	if err := grp.Wait(); err != nil {
-		return nil, err
+	err := grp.Wait()
+	// Recipes report progress for every item, make sure the last of it is recorded.
+	progress.flush(ctx)
+	if err != nil {
+		return err
 	}
 
 	content := make([]*genai.Content, len(recipes))
@@ -84,12 +146,12 @@ func (h *Handler) FillPlan(ctx context.Context, req *tasksapi.FillPlanRequest) (
 			StepImageURLs: recipe.StepImageURLs,
 		})
 		if err != nil {
-			return nil, fmt.Errorf("fillplan: marshaling recipe content: %w", err)
+			return fmt.Errorf("fillplan: marshaling recipe content: %w", err)
 		}
 		content[i] = genai.NewContentFromText(string(recipeJSON), genai.RoleUser)
 	}
 
-	res, err := h.genAI.Models.GenerateContent(ctx, "gemini-3.6-flash", content, &genai.GenerateContentConfig{
+	config := &genai.GenerateContentConfig{
 		SystemInstruction: genai.NewContentFromText(llm.GenerateExecutionPlanPrompt(), genai.RoleModel),
 		ResponseMIMEType:  "application/json",
 		ResponseSchema: &genai.Schema{
@@ -127,8 +189,16 @@ func (h *Handler) FillPlan(ctx context.Context, req *tasksapi.FillPlanRequest) (
 											Type:        "string",
 											Description: "The image URL for the step.",
 										},
+										"activeMinutes": {
+											Type:        "integer",
+											Description: "The estimated hands-on time of the step in minutes.",
+										},
+										"waitMinutes": {
+											Type:        "integer",
+											Description: "The estimated passive time after the step in minutes, such as simmering or marinating.",
+										},
 									},
-									Required: []string{"description"},
+									Required: []string{"description", "activeMinutes", "waitMinutes"},
 								},
 							},
 							"note": {
@@ -149,23 +219,183 @@ func (h *Handler) FillPlan(ctx context.Context, req *tasksapi.FillPlanRequest) (
 			},
 			Required: []string{"recipes", "stepGroups"},
 		},
-	})
+	}
+	if plan.Type == cookchatdb.PlanTypeBatchPrep {
+		config.SystemInstruction = genai.NewContentFromText(llm.GenerateBatchExecutionPlanPrompt(), genai.RoleModel)
+		config.ResponseSchema.Properties["batchDishes"] = batchDishesSchema
+		config.ResponseSchema.Required = append(config.ResponseSchema.Required, "batchDishes")
+	}
+
+	progress.report(ctx, "", cookchatdb.ProgressStageExecutionPlan, 0, 1)
+	// Generating takes a while so show that it started right away.
+	progress.flush(ctx)
+	res, err := h.genAI.Models.GenerateContent(ctx, "gemini-3.6-flash", content, config)
 	if err != nil {
-		return nil, fmt.Errorf("fillplan: generating execution plan: %w", err)
+		return fmt.Errorf("fillplan: generating execution plan: %w", err)
 	}
 	if len(res.Candidates) != 1 || len(res.Candidates[0].Content.Parts) != 1 || res.Candidates[0].Content.Parts[0].Text == "" {
-		return nil, fmt.Errorf("fillplan: unexpected generation result: %v", res)
+		return fmt.Errorf("fillplan: unexpected generation result: %v", res)
 	}
 
-	if err := json.Unmarshal([]byte(res.Candidates[0].Content.Parts[0].Text), &plan); err != nil {
-		return nil, fmt.Errorf("fillplan: parsing generation result: %w", err)
+	if err := json.Unmarshal([]byte(res.Candidates[0].Content.Parts[0].Text), plan); err != nil {
+		return fmt.Errorf("fillplan: parsing generation result: %w", err)
 	}
-	plan.Status = cookchatdb.PlanStatusActive
-	if _, err := planDoc.Ref.Set(ctx, plan); err != nil {
-		return nil, fmt.Errorf("fillplan: updating plan doc: %w", err)
+	if plan.Type == cookchatdb.PlanTypeBatchPrep {
+		plan.BatchDishes = slices.DeleteFunc(plan.BatchDishes, func(dish cookchatdb.BatchDish) bool {
+			return !slices.Contains(plan.Recipes, dish.RecipeID)
+		})
 	}
+	progress.report(ctx, "", cookchatdb.ProgressStageExecutionPlan, 1, 1)
+	// Pending progress is saved together with the filled plan.
+	plan.Progress = progress.snapshot()
+	return h.savePlan(ctx, planRef, filledRecipes, plan)
+}
 
-	return &tasksapi.FillPlanResponse{}, nil
+// savePlan saves the fields filled for filledRecipes to the plan, leaving any other fields
+// the user may have changed while filling. Nothing is saved if the plan was trashed or its
+// recipes were replaced in the meantime, in which case a newer task fills it again if needed.
+func (h *Handler) savePlan(ctx context.Context, planRef *firestore.DocumentRef, filledRecipes []string, plan *cookchatdb.Plan) error {
+	err := h.store.RunTransaction(ctx, func(_ context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(planRef)
+		if err != nil {
+			return fmt.Errorf("fillplan: getting plan doc: %w", err)
+		}
+		var current cookchatdb.Plan
+		if err := doc.DataTo(&current); err != nil {
+			return fmt.Errorf("fillplan: parsing plan doc: %w", err)
+		}
+		if !current.DeletedAt.IsZero() {
+			return errPlanTrashed
+		}
+		if !slices.Equal(current.Recipes, filledRecipes) {
+			return errPlanChanged
+		}
+
+		updates := []firestore.Update{
+			{Path: "stepGroups", Value: plan.StepGroups},
+			{Path: "notes", Value: plan.Notes},
+			{Path: "status", Value: cookchatdb.PlanStatusActive},
+			{Path: "failureReason", Value: firestore.Delete},
+			{Path: "attempts", Value: firestore.Delete},
+		}
+		if len(plan.BatchDishes) > 0 {
+			updates = append(updates, firestore.Update{Path: "batchDishes", Value: plan.BatchDishes})
+		} else {
+			updates = append(updates, firestore.Update{Path: "batchDishes", Value: firestore.Delete})
+		}
+		if len(plan.Progress) > 0 {
+			updates = append(updates, firestore.Update{Path: "progress", Value: plan.Progress})
+		}
+		return tx.Update(planRef, updates)
+	})
+	if errors.Is(err, errPlanTrashed) || errors.Is(err, errPlanChanged) {
+		slog.InfoContext(ctx, "fillplan: discarding filled plan", "planId", plan.ID, "reason", err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("fillplan: updating plan doc: %w", err)
+	}
+	return nil
+}
+
+// recordFailure records a failed attempt to process the plan or recipe in doc, which had
+// attempts failures before this one, marking it with failedStatus once maxAttempts is
+// reached. It returns whether doc was marked failed.
+func recordFailure(ctx context.Context, doc *firestore.DocumentRef, attempts int, cause error, failedStatus string) bool {
+	attempts++
+	failed := attempts >= maxAttempts
+	updates := []firestore.Update{
+		{Path: "attempts", Value: attempts},
+		{Path: "failureReason", Value: cause.Error()},
+	}
+	if failed {
+		updates = append(updates, firestore.Update{Path: "status", Value: failedStatus})
+	}
+	if _, err := doc.Update(ctx, updates); err != nil {
+		slog.WarnContext(ctx, "fillplan: recording failure", "error", err)
+		return false
+	}
+	return failed
+}
+
+// fillLeftoversPlan fills a leftovers plan with the storage and reheating details of the
+// dishes from its batch-prep plan. Nothing is cooked, so there is no execution plan to generate.
+func (h *Handler) fillLeftoversPlan(ctx context.Context, plansCol *firestore.CollectionRef, plan *cookchatdb.Plan) error {
+	batchDoc, err := plansCol.Doc(plan.BatchPlanID).Get(ctx)
+	if err != nil {
+		return fmt.Errorf("fillplan: getting batch prep plan doc: %w", err)
+	}
+	var batch cookchatdb.Plan
+	if err := batchDoc.DataTo(&batch); err != nil {
+		return fmt.Errorf("fillplan: parsing batch prep plan doc: %w", err)
+	}
+	if batch.Status == cookchatdb.PlanStatusFailed {
+		return errBatchPlanFailed
+	}
+	if batch.Status != cookchatdb.PlanStatusActive {
+		// Returning an error causes the task to be retried after the batch-prep plan is filled.
+		return errBatchPlanNotReady
+	}
+
+	plan.BatchDishes = nil
+	var steps []cookchatdb.RecipeStep
+	for _, recipeID := range plan.Recipes {
+		idx := slices.IndexFunc(batch.BatchDishes, func(dish cookchatdb.BatchDish) bool {
+			return dish.RecipeID == recipeID
+		})
+		if idx == -1 {
+			continue
+		}
+		dish := batch.BatchDishes[idx]
+		plan.BatchDishes = append(plan.BatchDishes, dish)
+		if dish.ReheatingNotes != "" {
+			steps = append(steps, cookchatdb.RecipeStep{
+				Description:   dish.ReheatingNotes,
+				ActiveMinutes: reheatMinutes,
+			})
+		}
+	}
+	plan.StepGroups = nil
+	if len(steps) > 0 {
+		plan.StepGroups = []cookchatdb.StepGroup{
+			{
+				Label: "温め直し",
+				Steps: steps,
+			},
+		}
+	}
+	return nil
+}
+
+var batchDishesSchema = &genai.Schema{
+	Type:        "array",
+	Description: "The dishes cooked in the batch-cooking session with how to store and reheat them.",
+	Items: &genai.Schema{
+		Type: "object",
+		Properties: map[string]*genai.Schema{
+			"recipeId": {
+				Type:        "string",
+				Description: "The recipe ID of the dish.",
+			},
+			"servings": {
+				Type:        "integer",
+				Description: "The number of servings to cook.",
+			},
+			"storage": {
+				Type:        "string",
+				Description: "How to store the dish, e.g. 冷蔵 or 冷凍.",
+			},
+			"storageDays": {
+				Type:        "integer",
+				Description: "The number of days the dish keeps when stored.",
+			},
+			"reheatingNotes": {
+				Type:        "string",
+				Description: "How to reheat the dish before eating, including the name of the dish.",
+			},
+		},
+		Required: []string{"recipeId", "servings", "storage", "storageDays", "reheatingNotes"},
+	},
 }
 
 type contentWithID struct {
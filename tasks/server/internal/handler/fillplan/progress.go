@@ -0,0 +1,140 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package fillplan
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/firestore"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+// progressWriteInterval is the minimum time between writes of progress. Progress is reported
+// for every processed item, which would otherwise mean many writes to the same document.
+const progressWriteInterval = time.Second
+
+// progressTracker records the progress of filling a plan on the plan document so users can
+// follow it. Failing to record progress does not fail filling the plan.
+type progressTracker struct {
+	write    func(ctx context.Context, updates []firestore.Update) error
+	interval time.Duration
+
+	// writeMu is held while writing so writes are not reordered.
+	writeMu sync.Mutex
+
+	mu        sync.Mutex
+	progress  map[string]cookchatdb.StageProgress
+	pending   map[string]struct{}
+	lastWrite time.Time
+}
+
+// newProgressTracker returns a tracker for the plan, clearing any progress from a previous
+// attempt.
+func newProgressTracker(ctx context.Context, planRef *firestore.DocumentRef) *progressTracker {
+	if _, err := planRef.Update(ctx, []firestore.Update{
+		{Path: "progress", Value: firestore.Delete},
+	}); err != nil {
+		slog.WarnContext(ctx, "fillplan: clearing progress", "error", err)
+	}
+	return newProgressTrackerWithWriter(func(ctx context.Context, updates []firestore.Update) error {
+		_, err := planRef.Update(ctx, updates)
+		return err
+	}, progressWriteInterval)
+}
+
+// newProgressTrackerWithWriter returns a tracker saving progress with write at most once per
+// interval, except when flushed.
+func newProgressTrackerWithWriter(write func(ctx context.Context, updates []firestore.Update) error, interval time.Duration) *progressTracker {
+	return &progressTracker{
+		write:    write,
+		interval: interval,
+		progress: map[string]cookchatdb.StageProgress{},
+		pending:  map[string]struct{}{},
+	}
+}
+
+// report records that done of total items of stage are complete for the recipe, or for the
+// whole plan if recipeID is empty. Progress is written if it has not been written within the
+// interval, otherwise it is left for a later report or flush.
+func (t *progressTracker) report(ctx context.Context, recipeID string, stage cookchatdb.ProgressStage, done int, total int) {
+	key := string(stage)
+	if recipeID != "" {
+		key = recipeID + "-" + key
+	}
+	now := time.Now()
+
+	t.mu.Lock()
+	p, ok := t.progress[key]
+	if !ok {
+		p = cookchatdb.StageProgress{
+			Stage:     stage,
+			RecipeID:  recipeID,
+			StartedAt: now,
+		}
+	}
+	// Items complete concurrently so counts may be reported out of order.
+	p.Done = max(p.Done, done)
+	p.Total = total
+	if p.Done >= p.Total && p.CompletedAt.IsZero() {
+		p.CompletedAt = now
+	}
+	t.progress[key] = p
+	t.pending[key] = struct{}{}
+	due := now.Sub(t.lastWrite) >= t.interval
+	t.mu.Unlock()
+
+	if due {
+		t.writePending(ctx, false)
+	}
+}
+
+// flush writes any progress not written yet.
+func (t *progressTracker) flush(ctx context.Context) {
+	t.writePending(ctx, true)
+}
+
+// writePending writes progress reported since the last write. Unless force is set, nothing is
+// written if another write is in progress or happened within the interval.
+func (t *progressTracker) writePending(ctx context.Context, force bool) {
+	if force {
+		t.writeMu.Lock()
+	} else if !t.writeMu.TryLock() {
+		return
+	}
+	defer t.writeMu.Unlock()
+
+	t.mu.Lock()
+	now := time.Now()
+	if !force && now.Sub(t.lastWrite) < t.interval {
+		t.mu.Unlock()
+		return
+	}
+	updates := make([]firestore.Update, 0, len(t.pending))
+	for _, key := range slices.Sorted(maps.Keys(t.pending)) {
+		updates = append(updates, firestore.Update{FieldPath: firestore.FieldPath{"progress", key}, Value: t.progress[key]})
+	}
+	clear(t.pending)
+	t.lastWrite = now
+	t.mu.Unlock()
+
+	if len(updates) == 0 {
+		return
+	}
+	if err := t.write(ctx, updates); err != nil {
+		slog.WarnContext(ctx, "fillplan: recording progress", "error", err)
+	}
+}
+
+// snapshot returns the progress recorded so far.
+func (t *progressTracker) snapshot() map[string]cookchatdb.StageProgress {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return maps.Clone(t.progress)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package fillplan
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/firestore"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+)
+
+// recordingWriter records the progress written by a tracker.
+type recordingWriter struct {
+	mu     sync.Mutex
+	writes [][]firestore.Update
+}
+
+func (w *recordingWriter) write(_ context.Context, updates []firestore.Update) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.writes = append(w.writes, updates)
+	return nil
+}
+
+// last returns the last written progress of key.
+func (w *recordingWriter) last(key string) (cookchatdb.StageProgress, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for i := len(w.writes) - 1; i >= 0; i-- {
+		for _, u := range w.writes[i] {
+			if u.FieldPath[1] == key {
+				return u.Value.(cookchatdb.StageProgress), true
+			}
+		}
+	}
+	return cookchatdb.StageProgress{}, false
+}
+
+func TestProgressTrackerThrottlesWrites(t *testing.T) {
+	ctx := context.Background()
+	w := &recordingWriter{}
+	tracker := newProgressTrackerWithWriter(w.write, time.Hour)
+
+	for done := range 4 {
+		tracker.report(ctx, "recipe", cookchatdb.ProgressStageStepImages, done, 3)
+	}
+	tracker.report(ctx, "", cookchatdb.ProgressStageExecutionPlan, 0, 1)
+
+	// Only the first report is written within the interval.
+	if len(w.writes) != 1 {
+		t.Fatalf("got %d writes before flush, want 1", len(w.writes))
+	}
+	if p, _ := w.last("recipe-" + string(cookchatdb.ProgressStageStepImages)); p.Done != 0 {
+		t.Errorf("got done %d in first write, want 0", p.Done)
+	}
+
+	tracker.flush(ctx)
+	if len(w.writes) != 2 {
+		t.Fatalf("got %d writes after flush, want 2", len(w.writes))
+	}
+	if len(w.writes[1]) != 2 {
+		t.Errorf("got %d updates in flush, want 2", len(w.writes[1]))
+	}
+	p, _ := w.last("recipe-" + string(cookchatdb.ProgressStageStepImages))
+	if p.Done != 3 || p.Total != 3 || p.CompletedAt.IsZero() {
+		t.Errorf("got %+v after flush, want completed 3 of 3", p)
+	}
+	if p, ok := w.last(string(cookchatdb.ProgressStageExecutionPlan)); !ok || p.RecipeID != "" || p.Done != 0 {
+		t.Errorf("got %+v, %v for plan stage, want started", p, ok)
+	}
+
+	// Nothing is pending so flushing again writes nothing.
+	tracker.flush(ctx)
+	if len(w.writes) != 2 {
+		t.Errorf("got %d writes after second flush, want 2", len(w.writes))
+	}
+}
+
+func TestProgressTrackerWritesEveryInterval(t *testing.T) {
+	ctx := context.Background()
+	w := &recordingWriter{}
+	tracker := newProgressTrackerWithWriter(w.write, 0)
+
+	for done := range 3 {
+		tracker.report(ctx, "recipe", cookchatdb.ProgressStageStepImages, done, 2)
+	}
+	if len(w.writes) != 3 {
+		t.Errorf("got %d writes, want 3", len(w.writes))
+	}
+}
+
+func TestProgressTrackerOutOfOrder(t *testing.T) {
+	ctx := context.Background()
+	w := &recordingWriter{}
+	tracker := newProgressTrackerWithWriter(w.write, time.Hour)
+
+	tracker.report(ctx, "recipe", cookchatdb.ProgressStageStepImages, 2, 3)
+	tracker.report(ctx, "recipe", cookchatdb.ProgressStageStepImages, 1, 3)
+
+	p := tracker.snapshot()["recipe-"+string(cookchatdb.ProgressStageStepImages)]
+	if p.Done != 2 || !p.CompletedAt.IsZero() {
+		t.Errorf("got %+v, want 2 of 3 not completed", p)
+	}
+}
+
+func TestProgressTrackerConcurrent(t *testing.T) {
+	ctx := context.Background()
+	w := &recordingWriter{}
+	tracker := newProgressTrackerWithWriter(w.write, time.Millisecond)
+
+	const total = 100
+	var wg sync.WaitGroup
+	for done := range total {
+		wg.Go(func() {
+			tracker.report(ctx, "recipe", cookchatdb.ProgressStageStepImages, done+1, total)
+		})
+	}
+	wg.Wait()
+	tracker.flush(ctx)
+
+	p, _ := w.last("recipe-" + string(cookchatdb.ProgressStageStepImages))
+	if p.Done != total || p.CompletedAt.IsZero() {
+		t.Errorf("got %+v, want completed %d of %d", p, total, total)
+	}
+}
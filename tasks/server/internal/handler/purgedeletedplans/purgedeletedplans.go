@@ -0,0 +1,59 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package purgedeletedplans
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	tasksapi "github.com/curioswitch/cookchat/tasks/api/go"
+)
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) PurgeDeletedPlans(ctx context.Context, _ *tasksapi.PurgeDeletedPlansRequest) (*tasksapi.PurgeDeletedPlansResponse, error) {
+	cutoff := time.Now().Add(-cookchatdb.PlanTrashRetention)
+
+	iter := h.store.CollectionGroup("plans").Where("deletedAt", "<", cutoff).Documents(ctx)
+	defer iter.Stop()
+
+	var purged uint32
+	for {
+		doc, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("purgedeletedplans: fetching deleted plans: %w", err)
+		}
+		if _, err := doc.Ref.Delete(ctx, firestore.LastUpdateTime(doc.UpdateTime)); err != nil {
+			if status.Code(err) == codes.FailedPrecondition {
+				// Updated concurrently, most likely by the plan being restored.
+				continue
+			}
+			return nil, fmt.Errorf("purgedeletedplans: deleting plan: %w", err)
+		}
+		purged++
+	}
+
+	return &tasksapi.PurgeDeletedPlansResponse{
+		PurgedPlans: purged,
+	}, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package sweepstuck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/curioswitch/cookchat/common/cookchatdb"
+	tasksapi "github.com/curioswitch/cookchat/tasks/api/go"
+)
+
+// processingTimeout is how long a plan or recipe can be processing before it is considered
+// stuck. It is longer than the FillPlan task takes including its retries.
+const processingTimeout = time.Hour
+
+const timeoutReason = "processing timed out"
+
+func NewHandler(store *firestore.Client) *Handler {
+	return &Handler{
+		store: store,
+	}
+}
+
+type Handler struct {
+	store *firestore.Client
+}
+
+func (h *Handler) SweepStuck(ctx context.Context, _ *tasksapi.SweepStuckRequest) (*tasksapi.SweepStuckResponse, error) {
+	cutoff := time.Now().Add(-processingTimeout)
+
+	failedPlans, err := sweep(ctx, h.store.CollectionGroup("plans").Query, string(cookchatdb.PlanStatusProcessing), string(cookchatdb.PlanStatusFailed), cutoff, func(doc *firestore.DocumentSnapshot) (time.Time, error) {
+		var plan cookchatdb.Plan
+		if err := doc.DataTo(&plan); err != nil {
+			return time.Time{}, fmt.Errorf("sweepstuck: parsing plan doc: %w", err)
+		}
+		if !plan.DeletedAt.IsZero() {
+			// Trashed plans are never filled again so don't need to be failed for a retry.
+			return time.Time{}, nil
+		}
+		if plan.ProcessingStartedAt.IsZero() {
+			return plan.CreatedAt, nil
+		}
+		return plan.ProcessingStartedAt, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	failedRecipes, err := sweep(ctx, h.store.Collection("recipes").Query, string(cookchatdb.RecipeStatusProcessing), string(cookchatdb.RecipeStatusFailed), cutoff, func(doc *firestore.DocumentSnapshot) (time.Time, error) {
+		var recipe cookchatdb.Recipe
+		if err := doc.DataTo(&recipe); err != nil {
+			return time.Time{}, fmt.Errorf("sweepstuck: parsing recipe doc: %w", err)
+		}
+		return recipe.ProcessingStartedAt, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &tasksapi.SweepStuckResponse{
+		FailedPlans:   failedPlans,
+		FailedRecipes: failedRecipes,
+	}, nil
+}
+
+// sweep marks documents in q with processingStatus that started processing before cutoff
+// with failedStatus, returning the number of documents marked. Documents for which startedAt
+// returns zero are skipped, such as those without a recorded start of processing since it is
+// unknown whether they are stuck.
+func sweep(ctx context.Context, q firestore.Query, processingStatus string, failedStatus string, cutoff time.Time,
+	startedAt func(doc *firestore.DocumentSnapshot) (time.Time, error),
+) (uint32, error) {
+	iter := q.Where("status", "==", processingStatus).Documents(ctx)
+	defer iter.Stop()
+
+	var failed uint32
+	for {
+		doc, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("sweepstuck: fetching processing docs: %w", err)
+		}
+		started, err := startedAt(doc)
+		if err != nil {
+			return 0, err
+		}
+		if started.IsZero() || !started.Before(cutoff) {
+			continue
+		}
+		if _, err := doc.Ref.Update(ctx, []firestore.Update{
+			{Path: "status", Value: failedStatus},
+			{Path: "failureReason", Value: timeoutReason},
+		}, firestore.LastUpdateTime(doc.UpdateTime)); err != nil {
+			if status.Code(err) == codes.FailedPrecondition {
+				// Updated concurrently, most likely by the task finishing.
+				continue
+			}
+			return 0, fmt.Errorf("sweepstuck: marking doc failed: %w", err)
+		}
+		failed++
+	}
+	return failed, nil
+}
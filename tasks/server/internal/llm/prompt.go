@@ -13,5 +13,26 @@ parallel execution of steps within a group where possible. It is fine for a grou
 and image URL as is into the step within a group - do not add any prefix to the description. The image URL for a step is the same
 indexed item within the s array of the recipe. If there is any note for execution within
 a step group, such as which step to execute while waiting on another, provide it. If there are any notes to consider when preparing the
-entire plan, return them. Only return text in Japanese.
+entire plan, return them. For each step, estimate the hands-on time in minutes as activeMinutes and any passive time that follows it,
+such as simmering, marinating, chilling, or soaking, in minutes as waitMinutes. Use 0 for waitMinutes when there is no passive time.
+Only return text in Japanese.
+`
+
+func GenerateBatchExecutionPlanPrompt() string {
+	return generateBatchExecutionPlanPrompt
+}
+
+const generateBatchExecutionPlanPrompt = `You help users schedule batch-cooking (作り置き) meal plans. The user has selected recipes to cook
+together in a single prep session, storing the dishes to eat over the following days. Provide an execution plan for cooking all of the
+recipes in one session. Group steps from different recipes together into step groups, trying to allow for parallel execution of steps
+within a group where possible. It is fine for a group to contain only a single step. Copy the description and image URL as is into the
+step within a group - do not add any prefix to the description. The image URL for a step is the same indexed item within the
+stepImageUrls array of the recipe. If there is any note for execution within a step group, such as which step to execute while waiting
+on another, provide it. Finish with a step group for cooling and packing the dishes into containers for storage. For each step, estimate
+the hands-on time in minutes as activeMinutes and any passive time that follows it, such as simmering, marinating, chilling, or soaking,
+in minutes as waitMinutes. Use 0 for waitMinutes when there is no passive time.
+
+For each recipe, also return how to store the dish, the number of days it keeps when stored that way, the number of servings to cook,
+and how to reheat the dish before eating. The reheating notes must include the name of the dish since they are shown on their own.
+If there are any notes to consider when preparing the entire plan, return them. Only return text in Japanese.
 `
@@ -0,0 +1,52 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package schedulerauth authenticates requests for jobs run by Cloud Scheduler, which have no
+// user and instead send an ID token for the service account the job runs as.
+package schedulerauth
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"google.golang.org/api/idtoken"
+)
+
+// Validator validates Google-signed ID tokens, usually *idtoken.Validator.
+type Validator interface {
+	Validate(ctx context.Context, token string, audience string) (*idtoken.Payload, error)
+}
+
+// NewMiddleware returns middleware allowing only requests with an ID token for audience issued
+// to the service account with email invoker, as sent by Cloud Scheduler jobs with OIDC
+// authentication. Other requests are rejected with 401 Unauthorized.
+func NewMiddleware(validator Validator, audience string, invoker string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || token == "" {
+				http.Error(w, "missing scheduler token", http.StatusUnauthorized)
+				return
+			}
+			payload, err := validator.Validate(ctx, token, audience)
+			if err != nil {
+				slog.WarnContext(ctx, "schedulerauth: invalid scheduler token", "error", err)
+				http.Error(w, "invalid scheduler token", http.StatusUnauthorized)
+				return
+			}
+			email, _ := payload.Claims["email"].(string)
+			verified, _ := payload.Claims["email_verified"].(bool)
+			if invoker == "" || email != invoker || !verified {
+				slog.WarnContext(ctx, "schedulerauth: scheduler token for unexpected account", "email", email)
+				http.Error(w, "invalid scheduler token", http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) CurioSwitch (choko@curioswitch.org)
+// SPDX-License-Identifier: BUSL-1.1
+
+package schedulerauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/api/idtoken"
+)
+
+const (
+	testAudience = "https://tasks.example.com"
+	testInvoker  = "scheduler@example.iam.gserviceaccount.com"
+)
+
+// fakeValidator accepts tokens that are keys of payloads for testAudience.
+type fakeValidator map[string]*idtoken.Payload
+
+func (v fakeValidator) Validate(_ context.Context, token string, audience string) (*idtoken.Payload, error) {
+	payload, ok := v[token]
+	if !ok || audience != testAudience {
+		return nil, errors.New("invalid token")
+	}
+	return payload, nil
+}
+
+func TestMiddleware(t *testing.T) {
+	validator := fakeValidator{
+		"scheduler":  {Claims: map[string]any{"email": testInvoker, "email_verified": true}},
+		"unverified": {Claims: map[string]any{"email": testInvoker, "email_verified": false}},
+		"other":      {Claims: map[string]any{"email": "other@example.iam.gserviceaccount.com", "email_verified": true}},
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		want          int
+	}{
+		{name: "scheduler", authorization: "Bearer scheduler", want: http.StatusOK},
+		{name: "missing", authorization: "", want: http.StatusUnauthorized},
+		{name: "not bearer", authorization: "Basic scheduler", want: http.StatusUnauthorized},
+		{name: "invalid", authorization: "Bearer invalid", want: http.StatusUnauthorized},
+		{name: "unverified email", authorization: "Bearer unverified", want: http.StatusUnauthorized},
+		{name: "other account", authorization: "Bearer other", want: http.StatusUnauthorized},
+	}
+
+	handler := NewMiddleware(validator, testAudience, testInvoker)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/tasksapi.TasksService/SweepStuck", nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tc.want {
+				t.Errorf("got status %d, want %d", rec.Code, tc.want)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/curioswitch/go-curiostack/server"
 	"github.com/curioswitch/go-usegcp/middleware/firebaseauth"
 	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/api/idtoken"
 	"google.golang.org/genai"
 
 	"github.com/curioswitch/cookchat/common/file"
@@ -28,6 +29,9 @@ import (
 	"github.com/curioswitch/cookchat/tasks/api/go/tasksapiconnect"
 	"github.com/curioswitch/cookchat/tasks/server/internal/config"
 	"github.com/curioswitch/cookchat/tasks/server/internal/handler/fillplan"
+	"github.com/curioswitch/cookchat/tasks/server/internal/handler/purgedeletedplans"
+	"github.com/curioswitch/cookchat/tasks/server/internal/handler/sweepstuck"
+	"github.com/curioswitch/cookchat/tasks/server/internal/schedulerauth"
 )
 
 //go:embed conf/*.yaml
@@ -104,16 +108,26 @@ func setupServer(ctx context.Context, conf *config.Config, s *server.Server) err
 
 	fbMW := firebaseauth.NewMiddleware(fbAuth)
 
+	tokenValidator, err := idtoken.NewValidator(ctx)
+	if err != nil {
+		return fmt.Errorf("main: create id token validator: %w", err)
+	}
+	schedulerMW := schedulerauth.NewMiddleware(tokenValidator, conf.Scheduler.Audience, conf.Scheduler.Invoker)
+
 	mux.Use(middleware.Maybe(func(h http.Handler) http.Handler {
 		return fbMW(h)
 	}, func(r *http.Request) bool {
 		switch {
 		case strings.HasPrefix(r.URL.Path, "/internal/"):
 			return false
+		case scheduled(r):
+			return false
 		default:
 			return true
 		}
 	}))
+	// Scheduled jobs have no user, so instead require the scheduler's service account.
+	mux.Use(middleware.Maybe(schedulerMW, scheduled))
 
 	server.HandleConnectUnary(s,
 		tasksapiconnect.TasksServiceFillPlanProcedure,
@@ -125,6 +139,22 @@ func setupServer(ctx context.Context, conf *config.Config, s *server.Server) err
 		},
 	)
 
+	server.HandleConnectUnary(s,
+		tasksapiconnect.TasksServiceSweepStuckProcedure,
+		sweepstuck.NewHandler(firestore).SweepStuck,
+		[]*tasksapi.SweepStuckRequest{
+			{},
+		},
+	)
+
+	server.HandleConnectUnary(s,
+		tasksapiconnect.TasksServicePurgeDeletedPlansProcedure,
+		purgedeletedplans.NewHandler(firestore).PurgeDeletedPlans,
+		[]*tasksapi.PurgeDeletedPlansRequest{
+			{},
+		},
+	)
+
 	server.EnableDocsFirebaseAuth(s, "alpha.cookchat.curioswitch.org")
 
 	if err := server.Start(ctx, s); err != nil {
@@ -132,3 +162,14 @@ func setupServer(ctx context.Context, conf *config.Config, s *server.Server) err
 	}
 	return nil
 }
+
+// scheduled returns whether r is for a job run by Cloud Scheduler.
+func scheduled(r *http.Request) bool {
+	switch r.URL.Path {
+	case tasksapiconnect.TasksServiceSweepStuckProcedure,
+		tasksapiconnect.TasksServicePurgeDeletedPlansProcedure:
+		return true
+	default:
+		return false
+	}
+}